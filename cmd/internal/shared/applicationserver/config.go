@@ -20,8 +20,11 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 )
 
 // DefaultWebhookTemplatesConfig is the default configuration for the Webhook templates.
@@ -44,12 +47,43 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		Timeout:   5 * time.Second,
 		QueueSize: 16,
 		Workers:   16,
+		Retry: web.RetryConfig{
+			MaxAttempts:    5,
+			InitialBackoff: 10 * time.Second,
+			MaxBackoff:     10 * time.Minute,
+		},
+		MaxQueuedDeliveries: 100000,
+		MaxFailedDeliveries: 100,
+		Health: web.HealthConfig{
+			SuspendInterval: 5 * time.Minute,
+		},
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
 	},
+	Packages: applicationserver.ApplicationPackagesConfig{
+		Storage: storage.Config{
+			Retention: storage.RetentionConfig{
+				TTL:              7 * 24 * time.Hour,
+				DeviceLimit:      1000,
+				ApplicationLimit: 10000,
+			},
+		},
+	},
 	EndDeviceFetcher: applicationserver.EndDeviceFetcherConfig{
 		Cache: applicationserver.EndDeviceFetcherCacheConfig{
 			Enable: true,
 			TTL:    5 * time.Minute,
 		},
 	},
+	Formatters: applicationserver.FormattersConfig{
+		Repository: applicationserver.RepositoryFormatterConfig{
+			Cache: devicerepository.CacheConfig{
+				TTL:  time.Hour,
+				Size: 1024,
+			},
+		},
+		GRPCService: grpcservice.Config{
+			Timeout:        5 * time.Second,
+			MaxConnections: 128,
+		},
+	},
 }
@@ -90,6 +90,12 @@ var DefaultRedisConfig = redis.Config{
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
+	Redis: config.RedisEvents{
+		Store: config.RedisEventsStore{
+			TTL:         24 * time.Hour,
+			EntityCount: 100,
+		},
+	},
 }
 
 // DefaultBlobConfig is the default config for the blob store.
@@ -116,6 +122,9 @@ var DefaultRightsConfig = config.Rights{
 // DefaultKeyVaultConfig is the default config for key vaults.
 var DefaultKeyVaultConfig = config.KeyVault{
 	Provider: "static",
+	File: config.KeyVaultFile{
+		ReloadInterval: time.Minute,
+	},
 }
 
 // DefaultServiceBase is the default base config for a service.
@@ -33,7 +33,14 @@ func InitializeEvents(ctx context.Context, taskStarter component.TaskStarter, co
 	case "internal":
 		return nil // this is the default.
 	case "redis":
-		events.SetDefaultPubSub(redis.NewPubSub(ctx, taskStarter, conf.Events.Redis))
+		if conf.Events.Redis.Store.Enable {
+			events.SetDefaultPubSub(redis.NewStore(ctx, taskStarter, conf.Events.Redis.Config, redis.StoreConfig{
+				TTL:         conf.Events.Redis.Store.TTL,
+				EntityCount: conf.Events.Redis.Store.EntityCount,
+			}))
+			return nil
+		}
+		events.SetDefaultPubSub(redis.NewPubSub(ctx, taskStarter, conf.Events.Redis.Config))
 		return nil
 	case "cloud":
 		ps, err := cloud.NewPubSub(ctx, taskStarter, conf.Events.Cloud.PublishURL, conf.Events.Cloud.SubscribeURL)
@@ -50,10 +50,25 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1882", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8882", shared.DefaultPublicHost),
 	},
+	MQTTChirpStack: config.MQTT{
+		Listen:           ":1888",
+		ListenTLS:        ":8888",
+		PublicAddress:    fmt.Sprintf("%s:1888", shared.DefaultPublicHost),
+		PublicTLSAddress: fmt.Sprintf("%s:8888", shared.DefaultPublicHost),
+	},
 	UpdateConnectionStatsDebounceTime: 3 * time.Second,
 	BasicStation: gatewayserver.BasicStationConfig{
 		Config:    ws.DefaultConfig,
 		Listen:    ":1887",
 		ListenTLS: ":8887",
 	},
+	Alerts: gatewayserver.AlertsConfig{
+		CheckInterval:       time.Minute,
+		SilenceThreshold:    30 * time.Minute,
+		MissedStatusCount:   3,
+		DisconnectThreshold: 15 * time.Minute,
+	},
+	History: gatewayserver.HistoryConfig{
+		FlushInterval: time.Minute,
+	},
 }
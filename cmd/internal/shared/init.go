@@ -26,7 +26,7 @@ func InitializeFallbacks(conf *config.ServiceBase) error {
 	}
 	// Fallback to the default Redis configuration for the events system
 	if conf.Events.Redis.IsZero() {
-		conf.Events.Redis = conf.Redis
+		conf.Events.Redis.Config = conf.Redis
 	}
 	return nil
 }
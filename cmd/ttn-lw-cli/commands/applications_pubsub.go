@@ -30,10 +30,12 @@ import (
 )
 
 var (
-	selectApplicationPubSubFlags       = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
-	setApplicationPubSubFlags          = util.FieldFlags(&ttnpb.ApplicationPubSub{})
-	natsProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
-	mqttProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	selectApplicationPubSubFlags         = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
+	setApplicationPubSubFlags            = util.FieldFlags(&ttnpb.ApplicationPubSub{})
+	natsProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
+	mqttProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	awsIoTProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider{}, "aws-iot")
+	kafkaProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka")
 
 	selectAllApplicationPubSubFlags = util.SelectAllFlagSet("application pub/sub")
 )
@@ -54,6 +56,16 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-ca", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-cert", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-key", ""))
+	flagSet.Bool("aws-iot", false, "use the AWS IoT provider")
+	flagSet.AddFlagSet(awsIoTProviderApplicationPubSubFlags)
+	flagSet.String("aws-iot.default.stack-name", "", "use the default integration with the given CloudFormation stack name")
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-key", ""))
+	flagSet.Bool("kafka", false, "use the Apache Kafka provider")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("kafka.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-key", ""))
 	addDeprecatedProviderFlags(flagSet)
 	return flagSet
 }
@@ -266,6 +278,79 @@ var (
 				}
 			}
 
+			if awsIoT, _ := cmd.Flags().GetBool("aws-iot"); awsIoT {
+				for _, name := range []string{
+					"aws-iot.tls-client-cert",
+					"aws-iot.tls-client-key",
+				} {
+					if !cmd.Flags().Changed(name + "-local-file") {
+						continue
+					}
+					data, err := getDataBytes(name, cmd.Flags())
+					if err != nil {
+						return err
+					}
+					err = cmd.Flags().Set(name, hex.EncodeToString(data))
+					if err != nil {
+						return err
+					}
+				}
+				if pubsub.GetAWSIoT() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_AWSIoT{
+						AWSIoT: &ttnpb.ApplicationPubSub_AWSIoTProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), awsIoTProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if err = util.SetFields(pubsub.GetAWSIoT(), awsIoTProviderApplicationPubSubFlags, "aws-iot"); err != nil {
+					return err
+				}
+				if cmd.Flags().Changed("aws-iot.default.stack-name") {
+					stackName, _ := cmd.Flags().GetString("aws-iot.default.stack-name")
+					pubsub.GetAWSIoT().Deployment = &ttnpb.ApplicationPubSub_AWSIoTProvider_Default{
+						Default: &ttnpb.ApplicationPubSub_AWSIoTProvider_DefaultIntegration{
+							StackName: stackName,
+						},
+					}
+					paths = append(paths, "provider.aws_iot.deployment")
+				}
+			}
+
+			if kafka, _ := cmd.Flags().GetBool("kafka"); kafka {
+				if pubsub.GetKafka() == nil {
+					paths = append(paths, "provider")
+					pubsub.Provider = &ttnpb.ApplicationPubSub_Kafka{
+						Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+					}
+				} else {
+					providerPaths := util.UpdateFieldMask(cmd.Flags(), kafkaProviderApplicationPubSubFlags)
+					providerPaths = ttnpb.FieldsWithPrefix("provider", providerPaths...)
+					paths = append(paths, providerPaths...)
+				}
+				if useTLS, _ := cmd.Flags().GetBool("kafka.use-tls"); useTLS {
+					for _, name := range []string{
+						"kafka.tls-ca",
+						"kafka.tls-client-cert",
+						"kafka.tls-client-key",
+					} {
+						data, err := getDataBytes(name, cmd.Flags())
+						if err != nil {
+							return err
+						}
+						err = cmd.Flags().Set(name, hex.EncodeToString(data))
+						if err != nil {
+							return err
+						}
+					}
+				}
+				if err = util.SetFields(pubsub.GetKafka(), kafkaProviderApplicationPubSubFlags, "kafka"); err != nil {
+					return err
+				}
+			}
+
 			res, err := ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
 				ApplicationPubSub: *pubsub,
 				FieldMask:         types.FieldMask{Paths: paths},
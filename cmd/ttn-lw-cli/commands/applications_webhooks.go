@@ -184,6 +184,20 @@ var (
 			headers, _ := cmd.Flags().GetStringSlice("headers")
 			webhook.Headers = mergeKV(webhook.Headers, headers)
 			webhook.ApplicationWebhookIdentifiers = *webhookID
+			switch cert, err := getDataBytes("client-certificate", cmd.Flags()); {
+			case err == nil:
+				webhook.ClientCertificate = cert
+				paths = append(paths, "client_certificate")
+			case !errors.Resemble(err, errNoData):
+				return err
+			}
+			switch key, err := getDataBytes("client-private-key", cmd.Flags()); {
+			case err == nil:
+				webhook.ClientPrivateKey = &ttnpb.Secret{Value: key}
+				paths = append(paths, "client_private_key")
+			case !errors.Resemble(err, errNoData):
+				return err
+			}
 
 			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
 			if err != nil {
@@ -219,6 +233,65 @@ var (
 				return err
 			}
 
+			return nil
+		},
+	}
+	applicationsWebhooksFailedDeliveriesCommand = &cobra.Command{
+		Use:   "failed-deliveries",
+		Short: "Application webhook failed deliveries commands",
+	}
+	applicationsWebhooksFailedDeliveriesListCommand = &cobra.Command{
+		Use:     "list [application-id] [webhook-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the failed deliveries of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+				ApplicationWebhookIdentifiers: *webhookID,
+				Limit:                         limit,
+				Page:                          page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Deliveries)
+		},
+	}
+	applicationsWebhooksFailedDeliveriesReplayCommand = &cobra.Command{
+		Use:   "replay [application-id] [webhook-id]",
+		Short: "Replay the failed deliveries of an application webhook",
+		Long: `Replay the failed deliveries of an application webhook.
+If no delivery IDs are given, all failed deliveries of the webhook are replayed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			deliveryIDs, _ := cmd.Flags().GetStringSlice("delivery-id")
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).ReplayFailedDeliveries(ctx, &ttnpb.ReplayApplicationWebhookFailedDeliveriesRequest{
+				ApplicationWebhookIdentifiers: *webhookID,
+				DeliveryIDs:                   deliveryIDs,
+			})
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -237,8 +310,17 @@ func init() {
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(setApplicationWebhookFlags)
 	applicationsWebhooksSetCommand.Flags().AddFlagSet(headersFlags())
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(dataFlags("client-certificate", "PEM encoded client certificate for mutual TLS"))
+	applicationsWebhooksSetCommand.Flags().AddFlagSet(dataFlags("client-private-key", "PEM encoded private key of the client certificate"))
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
+	applicationsWebhooksFailedDeliveriesListCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksFailedDeliveriesListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsWebhooksFailedDeliveriesCommand.AddCommand(applicationsWebhooksFailedDeliveriesListCommand)
+	applicationsWebhooksFailedDeliveriesReplayCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksFailedDeliveriesReplayCommand.Flags().StringSlice("delivery-id", nil, "IDs of the failed deliveries to replay")
+	applicationsWebhooksFailedDeliveriesCommand.AddCommand(applicationsWebhooksFailedDeliveriesReplayCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksFailedDeliveriesCommand)
 	applicationsCommand.AddCommand(applicationsWebhooksCommand)
 }
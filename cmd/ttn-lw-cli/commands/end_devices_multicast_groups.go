@@ -0,0 +1,156 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errNoMulticastGroupChanges = errors.DefineInvalidArgument("no_multicast_group_changes", "no multicast group changes specified")
+
+func setMulticastGroupFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("member-device-ids", nil, "device IDs of the members in the application of the multicast device")
+	flagSet.StringSlice("gateway-ids", nil, "IDs of the gateways to transmit multicast downlinks")
+	flagSet.Uint32("antenna-index", 0, "antenna index of the gateways")
+	return flagSet
+}
+
+var (
+	endDevicesMulticastGroupCommand = &cobra.Command{
+		Use:     "multicast-group",
+		Aliases: []string{"mc-group"},
+		Short:   "Multicast group commands",
+	}
+	endDevicesMulticastGroupGetCommand = &cobra.Command{
+		Use:   "get [application-id] [device-id]",
+		Short: "Get the multicast group of a multicast device",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).Get(ctx, &ttnpb.GetMulticastGroupRequest{
+				EndDeviceIdentifiers: *devID,
+				FieldMask:            types.FieldMask{Paths: []string{"member_ids", "gateways"}},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastGroupSetCommand = &cobra.Command{
+		Use:     "set [application-id] [device-id]",
+		Aliases: []string{"update"},
+		Short:   "Set the multicast group of a multicast device",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			grp := ttnpb.MulticastGroup{
+				EndDeviceIdentifiers: *devID,
+			}
+			var paths []string
+			if cmd.Flags().Changed("member-device-ids") {
+				deviceIDs, _ := cmd.Flags().GetStringSlice("member-device-ids")
+				for _, deviceID := range deviceIDs {
+					grp.MemberIDs = append(grp.MemberIDs, ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: devID.ApplicationIdentifiers,
+						DeviceID:               deviceID,
+					})
+				}
+				paths = append(paths, "member_ids")
+			}
+			if cmd.Flags().Changed("gateway-ids") {
+				gatewayIDs, _ := cmd.Flags().GetStringSlice("gateway-ids")
+				antennaIndex, _ := cmd.Flags().GetUint32("antenna-index")
+				for _, gatewayID := range gatewayIDs {
+					grp.Gateways = append(grp.Gateways, ttnpb.GatewayAntennaIdentifiers{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: gatewayID},
+						AntennaIndex:       antennaIndex,
+					})
+				}
+				paths = append(paths, "gateways")
+			}
+			if len(paths) == 0 {
+				return errNoMulticastGroupChanges
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsMulticastGroupRegistryClient(ns).Set(ctx, &ttnpb.SetMulticastGroupRequest{
+				MulticastGroup: grp,
+				FieldMask:      types.FieldMask{Paths: paths},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesMulticastGroupDeleteCommand = &cobra.Command{
+		Use:     "delete [application-id] [device-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete the multicast group of a multicast device",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNsMulticastGroupRegistryClient(ns).Delete(ctx, devID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	endDevicesMulticastGroupGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastGroupCommand.AddCommand(endDevicesMulticastGroupGetCommand)
+	endDevicesMulticastGroupSetCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastGroupSetCommand.Flags().AddFlagSet(setMulticastGroupFlags())
+	endDevicesMulticastGroupCommand.AddCommand(endDevicesMulticastGroupSetCommand)
+	endDevicesMulticastGroupDeleteCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesMulticastGroupCommand.AddCommand(endDevicesMulticastGroupDeleteCommand)
+	endDevicesCommand.AddCommand(endDevicesMulticastGroupCommand)
+}
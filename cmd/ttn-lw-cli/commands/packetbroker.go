@@ -0,0 +1,283 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	setPacketBrokerRoutingPolicyUplinkFlags   = util.FieldFlags(&ttnpb.PacketBrokerRoutingPolicyUplink{}, "uplink")
+	setPacketBrokerRoutingPolicyDownlinkFlags = util.FieldFlags(&ttnpb.PacketBrokerRoutingPolicyDownlink{}, "downlink")
+)
+
+var (
+	errNoPacketBrokerNetID   = errors.DefineInvalidArgument("no_packet_broker_net_id", "no NetID set")
+	errPacketBrokerNetID     = errors.DefineInvalidArgument("packet_broker_net_id", "invalid NetID `{net_id}`")
+	errPacketBrokerDefaultID = errors.DefineInvalidArgument("packet_broker_default_id", "NetID and default policy cannot be combined")
+)
+
+func packetBrokerNetworkIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("net-id", "", "LoRa Alliance NetID (hex)")
+	flagSet.String("tenant-id", "", "tenant ID within the NetID")
+	return flagSet
+}
+
+func packetBrokerDefaultPolicyFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("defaults", false, "default routing policy")
+	return flagSet
+}
+
+// getPacketBrokerNetworkID returns the network identifier from the flags or the positional arguments.
+// If no NetID is set, nil is returned.
+func getPacketBrokerNetworkID(flagSet *pflag.FlagSet, args []string) (*ttnpb.PacketBrokerNetworkIdentifier, error) {
+	netIDStr, _ := flagSet.GetString("net-id")
+	tenantID, _ := flagSet.GetString("tenant-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		netIDStr = args[0]
+	case 2:
+		netIDStr, tenantID = args[0], args[1]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		netIDStr, tenantID = args[0], args[1]
+	}
+	if netIDStr == "" {
+		return nil, nil
+	}
+	var netID types.NetID
+	if err := netID.UnmarshalText([]byte(netIDStr)); err != nil {
+		return nil, errPacketBrokerNetID.WithAttributes("net_id", netIDStr).WithCause(err)
+	}
+	return &ttnpb.PacketBrokerNetworkIdentifier{
+		NetID:    netID.MarshalNumber(),
+		TenantID: tenantID,
+	}, nil
+}
+
+// getPacketBrokerPolicyTarget returns the Home Network that the routing policy command targets.
+// If the default routing policy is targeted, nil is returned.
+func getPacketBrokerPolicyTarget(flagSet *pflag.FlagSet, args []string) (*ttnpb.PacketBrokerNetworkIdentifier, error) {
+	id, err := getPacketBrokerNetworkID(flagSet, args)
+	if err != nil {
+		return nil, err
+	}
+	defaults, _ := flagSet.GetBool("defaults")
+	switch {
+	case defaults && id != nil:
+		return nil, errPacketBrokerDefaultID
+	case !defaults && id == nil:
+		return nil, errNoPacketBrokerNetID
+	}
+	return id, nil
+}
+
+var (
+	packetBrokerCommand = &cobra.Command{
+		Use:     "packetbroker",
+		Aliases: []string{"pb"},
+		Short:   "Packet Broker commands",
+	}
+	packetBrokerHomeNetworksCommand = &cobra.Command{
+		Use:     "home-networks",
+		Aliases: []string{"home-network", "hn"},
+		Short:   "Packet Broker Home Network commands",
+	}
+	packetBrokerHomeNetworksListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List Home Networks registered with Packet Broker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewPbaClient(pba).ListHomeNetworks(ctx, ttnpb.Empty)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Networks)
+		},
+	}
+	packetBrokerHomeNetworksPoliciesCommand = &cobra.Command{
+		Use:     "policies",
+		Aliases: []string{"policy"},
+		Short:   "Packet Broker Home Network routing policy commands",
+	}
+	packetBrokerHomeNetworksPoliciesListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the routing policies for specific Home Networks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewPbaClient(pba).ListHomeNetworkRoutingPolicies(ctx, ttnpb.Empty)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Policies)
+		},
+	}
+	packetBrokerHomeNetworksPoliciesGetCommand = &cobra.Command{
+		Use:     "get [net-id] [tenant-id]",
+		Aliases: []string{"info"},
+		Short:   "Get the default routing policy or the routing policy for a Home Network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeNetworkID, err := getPacketBrokerPolicyTarget(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewPbaClient(pba)
+			var res interface{}
+			if homeNetworkID == nil {
+				res, err = client.GetHomeNetworkDefaultRoutingPolicy(ctx, ttnpb.Empty)
+			} else {
+				res, err = client.GetHomeNetworkRoutingPolicy(ctx, homeNetworkID)
+			}
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	packetBrokerHomeNetworksPoliciesSetCommand = &cobra.Command{
+		Use:     "set [net-id] [tenant-id]",
+		Aliases: []string{"update"},
+		Short:   "Set the default routing policy or the routing policy for a Home Network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeNetworkID, err := getPacketBrokerPolicyTarget(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			var (
+				uplink   ttnpb.PacketBrokerRoutingPolicyUplink
+				downlink ttnpb.PacketBrokerRoutingPolicyDownlink
+			)
+			if err := util.SetFields(&uplink, setPacketBrokerRoutingPolicyUplinkFlags, "uplink"); err != nil {
+				return err
+			}
+			if err := util.SetFields(&downlink, setPacketBrokerRoutingPolicyDownlinkFlags, "downlink"); err != nil {
+				return err
+			}
+
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewPbaClient(pba)
+			if homeNetworkID == nil {
+				_, err = client.SetHomeNetworkDefaultRoutingPolicy(ctx, &ttnpb.SetPacketBrokerDefaultRoutingPolicyRequest{
+					Uplink:   &uplink,
+					Downlink: &downlink,
+				})
+			} else {
+				_, err = client.SetHomeNetworkRoutingPolicy(ctx, &ttnpb.SetPacketBrokerRoutingPolicyRequest{
+					HomeNetworkID: homeNetworkID,
+					Uplink:        &uplink,
+					Downlink:      &downlink,
+				})
+			}
+			return err
+		},
+	}
+	packetBrokerHomeNetworksPoliciesDeleteCommand = &cobra.Command{
+		Use:     "delete [net-id] [tenant-id]",
+		Aliases: []string{"del", "remove", "rm"},
+		Short:   "Delete the default routing policy or the routing policy for a Home Network",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			homeNetworkID, err := getPacketBrokerPolicyTarget(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewPbaClient(pba)
+			if homeNetworkID == nil {
+				_, err = client.DeleteHomeNetworkDefaultRoutingPolicy(ctx, ttnpb.Empty)
+			} else {
+				_, err = client.DeleteHomeNetworkRoutingPolicy(ctx, homeNetworkID)
+			}
+			return err
+		},
+	}
+	packetBrokerForwardersCommand = &cobra.Command{
+		Use:     "forwarders",
+		Aliases: []string{"forwarder", "fwd"},
+		Short:   "Packet Broker Forwarder commands",
+	}
+	packetBrokerForwardersListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List Forwarders registered with Packet Broker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pba, err := api.Dial(ctx, config.PacketBrokerAgentGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewPbaClient(pba).ListForwarders(ctx, ttnpb.Empty)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Networks)
+		},
+	}
+)
+
+func init() {
+	packetBrokerHomeNetworksCommand.AddCommand(packetBrokerHomeNetworksListCommand)
+	packetBrokerHomeNetworksPoliciesCommand.AddCommand(packetBrokerHomeNetworksPoliciesListCommand)
+	packetBrokerHomeNetworksPoliciesGetCommand.Flags().AddFlagSet(packetBrokerNetworkIDFlags())
+	packetBrokerHomeNetworksPoliciesGetCommand.Flags().AddFlagSet(packetBrokerDefaultPolicyFlags())
+	packetBrokerHomeNetworksPoliciesCommand.AddCommand(packetBrokerHomeNetworksPoliciesGetCommand)
+	packetBrokerHomeNetworksPoliciesSetCommand.Flags().AddFlagSet(packetBrokerNetworkIDFlags())
+	packetBrokerHomeNetworksPoliciesSetCommand.Flags().AddFlagSet(packetBrokerDefaultPolicyFlags())
+	packetBrokerHomeNetworksPoliciesSetCommand.Flags().AddFlagSet(setPacketBrokerRoutingPolicyUplinkFlags)
+	packetBrokerHomeNetworksPoliciesSetCommand.Flags().AddFlagSet(setPacketBrokerRoutingPolicyDownlinkFlags)
+	packetBrokerHomeNetworksPoliciesCommand.AddCommand(packetBrokerHomeNetworksPoliciesSetCommand)
+	packetBrokerHomeNetworksPoliciesDeleteCommand.Flags().AddFlagSet(packetBrokerNetworkIDFlags())
+	packetBrokerHomeNetworksPoliciesDeleteCommand.Flags().AddFlagSet(packetBrokerDefaultPolicyFlags())
+	packetBrokerHomeNetworksPoliciesCommand.AddCommand(packetBrokerHomeNetworksPoliciesDeleteCommand)
+	packetBrokerHomeNetworksCommand.AddCommand(packetBrokerHomeNetworksPoliciesCommand)
+	packetBrokerCommand.AddCommand(packetBrokerHomeNetworksCommand)
+	packetBrokerForwardersCommand.AddCommand(packetBrokerForwardersListCommand)
+	packetBrokerCommand.AddCommand(packetBrokerForwardersCommand)
+	Root.AddCommand(packetBrokerCommand)
+}
@@ -139,6 +139,7 @@ func isAtomicType(t reflect.Type, maskOnly bool) bool {
 			"BoolValue",
 			"BytesValue",
 			"DoubleValue",
+			"FieldMask",
 			"FloatValue",
 			"Int32Value",
 			"Int64Value",
@@ -260,6 +261,9 @@ func addField(fs *pflag.FlagSet, name string, t reflect.Type, maskOnly bool) {
 		case "BytesValue":
 			fs.String(name, "", "(hex)")
 			return
+		case "FieldMask":
+			fs.StringSlice(name, nil, "")
+			return
 		}
 
 	case "go.thethings.network/lorawan-stack/v3/pkg/ttnpb":
@@ -555,6 +559,8 @@ func setField(rv reflect.Value, path []string, v reflect.Value) error {
 							return err
 						}
 						field.Set(reflect.ValueOf(types.BytesValue{Value: buf}))
+					case "FieldMask":
+						field.Set(reflect.ValueOf(types.FieldMask{Paths: v.Interface().([]string)}))
 					}
 				case ft.PkgPath() == "go.thethings.network/lorawan-stack/v3/pkg/ttnpb":
 					switch typeName := ft.Name(); typeName {
@@ -25,12 +25,16 @@ import (
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	asioapfuotaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
+	asioapstorageredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -69,7 +73,7 @@ func NewNetworkServerDownlinkTaskRedis(conf Config) *redis.Client {
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var start struct {
@@ -83,6 +87,7 @@ var startCommand = &cobra.Command{
 			DeviceTemplateConverter    bool
 			QRCodeGenerator            bool
 			PacketBrokerAgent          bool
+			DeviceClaimingServer       bool
 		}
 		startDefault := len(args) == 0
 		for _, arg := range args {
@@ -115,6 +120,8 @@ var startCommand = &cobra.Command{
 				start.QRCodeGenerator = true
 			case "pba":
 				start.PacketBrokerAgent = true
+			case "dcs":
+				start.DeviceClaimingServer = true
 			case "all":
 				start.IdentityServer = true
 				start.GatewayServer = true
@@ -126,6 +133,7 @@ var startCommand = &cobra.Command{
 				start.DeviceTemplateConverter = true
 				start.QRCodeGenerator = true
 				start.PacketBrokerAgent = true
+				start.DeviceClaimingServer = true
 			default:
 				return errUnknownComponent.WithAttributes("component", arg)
 			}
@@ -198,6 +206,19 @@ var startCommand = &cobra.Command{
 					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats")),
 				}
 			}
+			if len(config.GS.History.Retention) > 0 {
+				levels, err := config.GS.History.Levels()
+				if err != nil {
+					return shared.ErrInitializeGatewayServer.WithCause(err)
+				}
+				config.GS.History.Registry = &gsredis.GatewayConnectionHistoryRegistry{
+					Redis:  redis.New(config.Redis.WithNamespace("gs", "history")),
+					Levels: levels,
+				}
+			}
+			config.GS.Alerts.Registry = &gsredis.GatewayAlertRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("gs", "alerts")),
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -226,6 +247,9 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeNetworkServer.WithCause(err)
 			}
 			config.NS.Devices = devices
+			config.NS.MulticastGroups = &nsredis.MulticastGroupRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "multicast-groups")),
+			}
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
 			}
@@ -264,16 +288,39 @@ var startCommand = &cobra.Command{
 			config.AS.Packages.Registry = &asioapredis.ApplicationPackagesRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages")),
 			}
+			config.AS.Packages.Storage.Storage = &asioapstorageredis.ApplicationUpStorage{
+				Redis:     redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages", "storage")),
+				Retention: config.AS.Packages.Storage.Retention,
+			}
+			config.AS.Packages.FUOTA.Sessions = &asioapfuotaredis.SessionRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("as", "io", "applicationpackages", "fuota")),
+			}
 			if config.AS.Webhooks.Target != "" {
 				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{
 					Redis: redis.New(config.Redis.WithNamespace("as", "io", "webhooks")),
 				}
+				if config.AS.Webhooks.PersistDeliveries {
+					webhookDeliveries := asiowebredis.NewDeliveryQueue(
+						redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "deliveries")),
+						config.AS.Webhooks.MaxQueuedDeliveries, config.AS.Webhooks.MaxFailedDeliveries,
+						"as", redisConsumerID,
+					)
+					if err := webhookDeliveries.Init(); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					config.AS.Webhooks.Deliveries = webhookDeliveries
+				}
 			}
 			fetcher, err := config.AS.EndDeviceFetcher.NewFetcher(c)
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
 			config.AS.EndDeviceFetcher.Fetcher = fetcher
+			repositoryFetcher, err := config.ServiceBase.DeviceRepositoryFetcher(ctx)
+			if err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Formatters.Repository.Fetcher = repositoryFetcher
 			as, err := applicationserver.New(c, &config.AS)
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
@@ -353,6 +400,18 @@ var startCommand = &cobra.Command{
 			_ = pba
 		}
 
+		if start.DeviceClaimingServer || startDefault {
+			logger.Info("Setting up Device Claiming Server")
+			config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("dcs", "authorized-applications")),
+			}
+			dcs, err := deviceclaimingserver.New(c, &config.DCS)
+			if err != nil {
+				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+			}
+			_ = dcs
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
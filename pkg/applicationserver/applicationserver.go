@@ -31,8 +31,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/awsiot" // The AWS IoT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/kafka"  // The Apache Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"   // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/nats"   // The NATS integration provider
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -45,6 +47,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
@@ -60,14 +64,15 @@ type ApplicationServer struct {
 
 	config *Config
 
-	linkMode         LinkMode
-	linkRegistry     LinkRegistry
-	deviceRegistry   DeviceRegistry
-	formatters       payloadFormatters
-	webhooks         web.Webhooks
-	webhookTemplates web.TemplateStore
-	pubsub           *pubsub.PubSub
-	appPackages      packages.Server
+	linkMode          LinkMode
+	linkRegistry      LinkRegistry
+	deviceRegistry    DeviceRegistry
+	formatters        payloadFormatters
+	webhooks          web.Webhooks
+	webhookTemplates  web.TemplateStore
+	webhookDeliveries web.DeliveryQueue
+	pubsub            *pubsub.PubSub
+	appPackages       packages.Server
 
 	links              sync.Map
 	linkErrors         sync.Map
@@ -124,8 +129,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		linkRegistry:   conf.Links,
 		deviceRegistry: wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
 		formatters: payloadFormatters(map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncodeDecoder{
-			ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
-			ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+			ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT:   javascript.New(),
+			ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP:   cayennelpp.New(),
+			ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE: grpcservice.New(ctx, c, conf.Formatters.GRPCService),
 		}),
 		interopClient:    interopCl,
 		interopID:        conf.Interop.ID,
@@ -135,6 +141,9 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	if as.endDeviceFetcher == nil {
 		as.endDeviceFetcher = &NoopEndDeviceFetcher{}
 	}
+	if fetcher := conf.Formatters.Repository.Fetcher; fetcher != nil {
+		as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(fetcher, conf.Formatters.Repository.Cache)
+	}
 	retryIO := io.NewRetryServer(as)
 
 	as.grpc.asDevices = asEndDeviceRegistryServer{
@@ -192,10 +201,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
-	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as); err != nil {
+	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as, as.KeyVault); err != nil {
 		return nil, err
 	} else if webhooks != nil {
 		as.webhooks = webhooks
+		as.webhookDeliveries = conf.Webhooks.Deliveries
 		as.defaultSubscribers = append(as.defaultSubscribers, webhooks.NewSubscription())
 		c.RegisterWeb(webhooks)
 	}
@@ -234,7 +244,7 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates, as.webhookDeliveries, as.KeyVault, as.config.Webhooks.EncryptionKeyID))
 	}
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
@@ -22,13 +22,20 @@ import (
 	"github.com/bluele/gcache"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	fuotav1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -66,6 +73,18 @@ type EndDeviceFetcherCacheConfig struct {
 	Size   int           `name:"size" description:"Cache size"`
 }
 
+// FormattersConfig represents configuration for payload formatters in Application Server.
+type FormattersConfig struct {
+	Repository  RepositoryFormatterConfig `name:"repository" description:"Device Repository payload formatter configuration"`
+	GRPCService grpcservice.Config        `name:"grpc-service" description:"gRPC service payload formatter configuration"`
+}
+
+// RepositoryFormatterConfig represents configuration for the Device Repository payload formatter.
+type RepositoryFormatterConfig struct {
+	Fetcher fetch.Interface              `name:"-"`
+	Cache   devicerepository.CacheConfig `name:"cache" description:"Cache configuration options for Device Repository codecs"`
+}
+
 // Config represents the ApplicationServer configuration.
 type Config struct {
 	LinkMode         string                    `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
@@ -77,6 +96,7 @@ type Config struct {
 	PubSub           PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
 	Packages         ApplicationPackagesConfig `name:"packages" description:"Application packages configuration"`
 	Interop          InteropConfig             `name:"interop" description:"Interop client configuration"`
+	Formatters       FormattersConfig          `name:"formatters" description:"Payload formatters configuration"`
 	DeviceKEKLabel   string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 }
 
@@ -101,13 +121,20 @@ var (
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
-	Templates web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry            web.WebhookRegistry `name:"-"`
+	Deliveries          web.DeliveryQueue   `name:"-"`
+	Target              string              `name:"target" description:"Target of the integration (direct)"`
+	Timeout             time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize           int                 `name:"queue-size" description:"Number of requests to queue in memory, if deliveries are not persisted"`
+	Workers             int                 `name:"workers" description:"Number of workers to process requests"`
+	PersistDeliveries   bool                `name:"persist-deliveries" description:"Persist deliveries and retry failed deliveries"`
+	Retry               web.RetryConfig     `name:"retry" description:"Retry policy of persisted webhook deliveries"`
+	MaxQueuedDeliveries int64               `name:"max-queued-deliveries" description:"Maximum number of persisted deliveries in the queue, after which new deliveries are rejected (0 is unlimited)"`
+	MaxFailedDeliveries int64               `name:"max-failed-deliveries" description:"Maximum number of failed deliveries to keep per webhook"`
+	Health              web.HealthConfig    `name:"health" description:"Health tracking and suspension of failing webhooks"`
+	EncryptionKeyID     string              `name:"encryption-key-id" description:"ID of the key used to encrypt webhook secrets at rest"`
+	Templates           web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
+	Downlinks           web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
 }
 
 // PubSubConfig contains go-cloud pub/sub configuration of the Application Server.
@@ -119,11 +146,17 @@ type PubSubConfig struct {
 type ApplicationPackagesConfig struct {
 	packages.Config `name:",squash"`
 	Registry        packages.Registry `name:"-"`
+	Storage         storage.Config    `name:"storage" description:"Storage Integration configuration"`
+	FUOTA           fuotav1.Config    `name:"fuota" description:"FUOTA configuration"`
 }
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
-func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
+// If Deliveries is set, requests are persisted and retried according to the retry policy.
+// Otherwise, if QueueSize or Workers is set, requests are queued in memory.
+// If the health failure threshold is set, failing webhooks are suspended and probed periodically.
+// The key vault is used to decrypt the webhook secrets.
+func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server, keyVault crypto.KeyVault) (web.Webhooks, error) {
 	var target web.Sink
 	switch c.Target {
 	case "":
@@ -140,7 +173,30 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
-	if c.QueueSize > 0 || c.Workers > 0 {
+	credentials := &web.CredentialsSink{
+		Target:   target,
+		Registry: c.Registry,
+		KeyVault: keyVault,
+	}
+	target = credentials
+	if c.Health.FailureThreshold > 0 {
+		target = &web.HealthSink{
+			Target:   target,
+			Registry: c.Registry,
+			Config:   c.Health,
+			Probe:    c.Deliveries == nil,
+		}
+	}
+	switch {
+	case c.Deliveries != nil:
+		target = &web.PersistentSink{
+			Target:   target,
+			Queue:    c.Deliveries,
+			Registry: c.Registry,
+			Workers:  c.Workers,
+			Retry:    c.Retry,
+		}
+	case c.QueueSize > 0 || c.Workers > 0:
 		target = &web.QueuedSink{
 			Target:  target,
 			Queue:   make(chan *http.Request, c.QueueSize),
@@ -154,7 +210,7 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 			}
 		}()
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, target, c.Downlinks), nil
+	return web.NewWebhooks(ctx, server, credentials.WrapRegistry(c.Registry), target, c.Downlinks), nil
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
@@ -178,6 +234,28 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	loradmsHandler := loraclouddevicemanagementv1.New(server, c.Registry)
 	handlers[loradmsHandler.Package().Name] = loradmsHandler
 
+	// Initialize LoRaWAN Application Layer Clock Synchronization v1 package handler
+	alcsyncHandler := alcsyncv1.New(server, c.Registry)
+	handlers[alcsyncHandler.Package().Name] = alcsyncHandler
+
+	// Initialize Storage Integration package handler
+	if c.Storage.Storage != nil {
+		storageHandler, err := storage.New(ctx, server, c.Storage)
+		if err != nil {
+			return nil, err
+		}
+		handlers[storageHandler.Package().Name] = storageHandler
+	}
+
+	// Initialize FUOTA package handler
+	if c.FUOTA.Sessions != nil {
+		fuotaHandler, err := fuotav1.New(ctx, server, c.Registry, c.FUOTA)
+		if err != nil {
+			return nil, err
+		}
+		handlers[fuotaHandler.Package().Name] = fuotaHandler
+	}
+
 	return packages.New(ctx, server, c.Registry, handlers)
 }
 
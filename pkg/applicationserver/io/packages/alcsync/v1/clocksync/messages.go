@@ -0,0 +1,168 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clocksync implements the LoRaWAN Application Layer Clock Synchronization messages.
+package clocksync
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// PackageIdentifier is the identifier of the Application Layer Clock Synchronization package.
+	PackageIdentifier = 1
+	// PackageVersion is the version of the Application Layer Clock Synchronization package.
+	PackageVersion = 1
+	// FPort is the default FPort of the Application Layer Clock Synchronization package.
+	FPort = 202
+)
+
+// Command identifiers.
+const (
+	PackageVersionCID           = 0x00
+	AppTimeCID                  = 0x01
+	DeviceAppTimePeriodicityCID = 0x02
+	ForceDeviceResyncCID        = 0x03
+)
+
+const (
+	maxDeviceAppTimePeriodicity    = 0xf
+	maxForceDeviceResyncNbTransmit = 0x7
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errPayloadLength  = errors.DefineInvalidArgument("payload_length", "invalid payload length for command `{cid}`")
+	errFieldRange     = errors.DefineInvalidArgument("field_range", "field `{field}` value `{value}` is out of range")
+)
+
+// PackageVersionReq requests the package identifier and version of the end device.
+type PackageVersionReq struct{}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (PackageVersionReq) MarshalBinary() ([]byte, error) {
+	return []byte{PackageVersionCID}, nil
+}
+
+// AppTimeAns is the answer to AppTimeReq.
+type AppTimeAns struct {
+	// TimeCorrection is the correction in seconds that the end device must apply to its clock.
+	TimeCorrection int32
+	// TokenAns is the token of the corresponding AppTimeReq.
+	TokenAns uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ans AppTimeAns) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 6)
+	b[0] = AppTimeCID
+	binary.LittleEndian.PutUint32(b[1:5], uint32(ans.TimeCorrection))
+	b[5] = ans.TokenAns & 0xf
+	return b, nil
+}
+
+// DeviceAppTimePeriodicityReq sets the periodicity of AppTimeReq transmissions of the end device.
+type DeviceAppTimePeriodicityReq struct {
+	// Period is the periodicity exponent; the end device transmits AppTimeReq every 128*2^Period seconds.
+	Period uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req DeviceAppTimePeriodicityReq) MarshalBinary() ([]byte, error) {
+	if req.Period > maxDeviceAppTimePeriodicity {
+		return nil, errFieldRange.WithAttributes("field", "period", "value", req.Period)
+	}
+	return []byte{DeviceAppTimePeriodicityCID, req.Period}, nil
+}
+
+// ForceDeviceResyncReq forces the end device to resynchronize its clock.
+type ForceDeviceResyncReq struct {
+	// NbTransmissions is the number of AppTimeReq transmissions of the end device.
+	NbTransmissions uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req ForceDeviceResyncReq) MarshalBinary() ([]byte, error) {
+	if req.NbTransmissions > maxForceDeviceResyncNbTransmit {
+		return nil, errFieldRange.WithAttributes("field", "nb_transmissions", "value", req.NbTransmissions)
+	}
+	return []byte{ForceDeviceResyncCID, req.NbTransmissions}, nil
+}
+
+// PackageVersionAns is the answer to PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// AppTimeReq requests the application time.
+type AppTimeReq struct {
+	// DeviceTime is the time of the end device clock in GPS epoch seconds modulo 2^32.
+	DeviceTime uint32
+	// AnsRequired indicates whether the end device requires an answer.
+	AnsRequired bool
+	TokenReq    uint8
+}
+
+// DeviceAppTimePeriodicityAns is the answer to DeviceAppTimePeriodicityReq.
+type DeviceAppTimePeriodicityAns struct {
+	NotSupported bool
+	// Time is the time of the end device clock in GPS epoch seconds modulo 2^32.
+	Time uint32
+}
+
+var uplinkLengths = map[byte]int{
+	PackageVersionCID:           2,
+	AppTimeCID:                  5,
+	DeviceAppTimePeriodicityCID: 5,
+}
+
+// UnmarshalUplink parses the commands in an uplink payload.
+// The returned commands are of type PackageVersionAns, AppTimeReq or DeviceAppTimePeriodicityAns.
+func UnmarshalUplink(b []byte) ([]interface{}, error) {
+	var cmds []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		n, ok := uplinkLengths[cid]
+		if !ok {
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < 1+n {
+			return nil, errPayloadLength.WithAttributes("cid", cid)
+		}
+		p := b[1 : 1+n]
+		b = b[1+n:]
+		switch cid {
+		case PackageVersionCID:
+			cmds = append(cmds, PackageVersionAns{
+				PackageIdentifier: p[0],
+				PackageVersion:    p[1],
+			})
+		case AppTimeCID:
+			cmds = append(cmds, AppTimeReq{
+				DeviceTime:  binary.LittleEndian.Uint32(p[0:4]),
+				AnsRequired: p[4]&0x10 != 0,
+				TokenReq:    p[4] & 0xf,
+			})
+		case DeviceAppTimePeriodicityCID:
+			cmds = append(cmds, DeviceAppTimePeriodicityAns{
+				NotSupported: p[0]&0x1 != 0,
+				Time:         binary.LittleEndian.Uint32(p[1:5]),
+			})
+		}
+	}
+	return cmds, nil
+}
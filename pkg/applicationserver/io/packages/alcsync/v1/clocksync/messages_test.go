@@ -0,0 +1,91 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksync_test
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	for i, tc := range []struct {
+		Message  encoding.BinaryMarshaler
+		Expected []byte
+		Error    bool
+	}{
+		{
+			Message:  PackageVersionReq{},
+			Expected: []byte{0x00},
+		},
+		{
+			Message:  AppTimeAns{TimeCorrection: -2, TokenAns: 5},
+			Expected: []byte{0x01, 0xfe, 0xff, 0xff, 0xff, 0x05},
+		},
+		{
+			Message:  DeviceAppTimePeriodicityReq{Period: 3},
+			Expected: []byte{0x02, 0x03},
+		},
+		{
+			Message: DeviceAppTimePeriodicityReq{Period: 16},
+			Error:   true,
+		},
+		{
+			Message:  ForceDeviceResyncReq{NbTransmissions: 2},
+			Expected: []byte{0x03, 0x02},
+		},
+		{
+			Message: ForceDeviceResyncReq{NbTransmissions: 8},
+			Error:   true,
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := tc.Message.MarshalBinary()
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestUnmarshalUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	cmds, err := UnmarshalUplink([]byte{
+		0x00, 0x01, 0x01,
+		0x01, 0x78, 0x56, 0x34, 0x12, 0x13,
+		0x02, 0x01, 0x04, 0x03, 0x02, 0x01,
+	})
+	a.So(err, should.BeNil)
+	a.So(cmds, should.Resemble, []interface{}{
+		PackageVersionAns{PackageIdentifier: 1, PackageVersion: 1},
+		AppTimeReq{DeviceTime: 0x12345678, AnsRequired: true, TokenReq: 3},
+		DeviceAppTimePeriodicityAns{NotSupported: true, Time: 0x01020304},
+	})
+
+	_, err = UnmarshalUplink([]byte{0x01, 0x78, 0x56})
+	a.So(err, should.NotBeNil)
+
+	_, err = UnmarshalUplink([]byte{0x10})
+	a.So(err, should.NotBeNil)
+}
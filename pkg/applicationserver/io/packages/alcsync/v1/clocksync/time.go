@@ -0,0 +1,57 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksync
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// UplinkTime returns the time at which the uplink message was received.
+// UplinkTime returns the gateway time of the first gateway that provides it, and falls back to the time at which
+// the Network Server received the uplink message.
+func UplinkTime(msg *ttnpb.ApplicationUplink) time.Time {
+	for _, md := range msg.RxMetadata {
+		if md.Time != nil && !md.Time.IsZero() {
+			return *md.Time
+		}
+	}
+	return msg.ReceivedAt
+}
+
+// GPSSeconds returns the GPS epoch seconds of t modulo 2^32.
+func GPSSeconds(t time.Time) uint32 {
+	return uint32(gpstime.ToGPS(t) / time.Second)
+}
+
+// NewAppTimeAns returns the answer to the AppTimeReq in the uplink message.
+// NewAppTimeAns returns false if the end device does not require an answer and the absolute time correction
+// is less than the threshold.
+func NewAppTimeAns(msg *ttnpb.ApplicationUplink, req AppTimeReq, threshold time.Duration) (AppTimeAns, bool) {
+	correction := int32(GPSSeconds(UplinkTime(msg)) - req.DeviceTime)
+	abs := time.Duration(correction) * time.Second
+	if abs < 0 {
+		abs = -abs
+	}
+	if !req.AnsRequired && (correction == 0 || abs < threshold) {
+		return AppTimeAns{}, false
+	}
+	return AppTimeAns{
+		TimeCorrection: correction,
+		TokenAns:       req.TokenReq,
+	}, true
+}
@@ -0,0 +1,84 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clocksync_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNewAppTimeAns(t *testing.T) {
+	receivedAt := gpstime.Parse(1000 * time.Second)
+	gatewayTime := gpstime.Parse(990 * time.Second)
+
+	for i, tc := range []struct {
+		Uplink    *ttnpb.ApplicationUplink
+		Request   AppTimeReq
+		Threshold time.Duration
+		Expected  AppTimeAns
+		OK        bool
+	}{
+		{
+			Uplink:  &ttnpb.ApplicationUplink{ReceivedAt: receivedAt},
+			Request: AppTimeReq{DeviceTime: 1000, TokenReq: 1},
+		},
+		{
+			Uplink:   &ttnpb.ApplicationUplink{ReceivedAt: receivedAt},
+			Request:  AppTimeReq{DeviceTime: 1000, AnsRequired: true, TokenReq: 1},
+			Expected: AppTimeAns{TimeCorrection: 0, TokenAns: 1},
+			OK:       true,
+		},
+		{
+			Uplink:   &ttnpb.ApplicationUplink{ReceivedAt: receivedAt},
+			Request:  AppTimeReq{DeviceTime: 1003, TokenReq: 2},
+			Expected: AppTimeAns{TimeCorrection: -3, TokenAns: 2},
+			OK:       true,
+		},
+		{
+			Uplink:    &ttnpb.ApplicationUplink{ReceivedAt: receivedAt},
+			Request:   AppTimeReq{DeviceTime: 1003, TokenReq: 2},
+			Threshold: 5 * time.Second,
+		},
+		{
+			Uplink: &ttnpb.ApplicationUplink{
+				ReceivedAt: receivedAt,
+				RxMetadata: []*ttnpb.RxMetadata{
+					{},
+					{Time: &gatewayTime},
+				},
+			},
+			Request:   AppTimeReq{DeviceTime: 1000, TokenReq: 3},
+			Threshold: 5 * time.Second,
+			Expected:  AppTimeAns{TimeCorrection: -10, TokenAns: 3},
+			OK:        true,
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			ans, ok := NewAppTimeAns(tc.Uplink, tc.Request, tc.Threshold)
+			a.So(ok, should.Equal, tc.OK)
+			if tc.OK {
+				a.So(ans, should.Resemble, tc.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+type packageData struct {
+	threshold *time.Duration
+}
+
+const thresholdField = "threshold"
+
+var errInvalidFieldType = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+
+func (d *packageData) fromStruct(st *types.Struct) error {
+	fields := st.GetFields()
+	value, ok := fields[thresholdField]
+	if !ok {
+		return nil
+	}
+	numberValue, ok := value.GetKind().(*types.Value_NumberValue)
+	if !ok {
+		return errInvalidFieldType.WithAttributes(
+			"field", thresholdField,
+			"type", fmt.Sprintf("%T", value),
+		)
+	}
+	threshold := time.Duration(numberValue.NumberValue * float64(time.Second))
+	d.threshold = &threshold
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"context"
+	"fmt"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// SetDeviceAppTimePeriodicity implements ttnpb.ApplicationClockSyncServer.
+func (p *ClockSyncPackage) SetDeviceAppTimePeriodicity(ctx context.Context, req *ttnpb.SetDeviceAppTimePeriodicityRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	fPort, err := p.fPort(ctx, req.EndDeviceIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:packages:alcsync:%s", events.NewCorrelationID()))
+	if err := p.push(ctx, req.EndDeviceIdentifiers, fPort, clocksync.DeviceAppTimePeriodicityReq{
+		Period: uint8(req.Period),
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ForceDeviceResync implements ttnpb.ApplicationClockSyncServer.
+func (p *ClockSyncPackage) ForceDeviceResync(ctx context.Context, req *ttnpb.ForceDeviceResyncRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	fPort, err := p.fPort(ctx, req.EndDeviceIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:packages:alcsync:%s", events.NewCorrelationID()))
+	if err := p.push(ctx, req.EndDeviceIdentifiers, fPort, clocksync.ForceDeviceResyncReq{
+		NbTransmissions: uint8(req.NbTransmissions),
+	}); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// fPort returns the FPort of the package for the end device, from the association of the end device
+// or the default association of the application.
+func (p *ClockSyncPackage) fPort(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (uint32, error) {
+	paths := []string{
+		"f_port",
+		"package_name",
+	}
+	associations, err := p.registry.ListAssociations(ctx, ids, paths)
+	if err != nil {
+		return 0, err
+	}
+	for _, assoc := range associations {
+		if assoc.PackageName == PackageName {
+			return assoc.FPort, nil
+		}
+	}
+	defaults, err := p.registry.ListDefaultAssociations(ctx, ids.ApplicationIdentifiers, paths)
+	if err != nil {
+		return 0, err
+	}
+	for _, def := range defaults {
+		if def.PackageName == PackageName {
+			return def.FPort, nil
+		}
+	}
+	return 0, errNoAssociation.New()
+}
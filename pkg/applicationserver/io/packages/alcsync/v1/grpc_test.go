@@ -0,0 +1,186 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRPCs(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Registry       *mockPackageRegistry
+		Rights         []ttnpb.Right
+		Call           func(p *ClockSyncPackage, ctx context.Context) error
+		Downlinks      []*ttnpb.ApplicationDownlink
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "SetDeviceAppTimePeriodicity/NoRights",
+			Registry: &mockPackageRegistry{
+				associations: []*ttnpb.ApplicationPackageAssociation{association(0, 0)},
+			},
+			Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_TRAFFIC_READ},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.SetDeviceAppTimePeriodicity(ctx, &ttnpb.SetDeviceAppTimePeriodicityRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					Period:               4,
+				})
+				return err
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:     "SetDeviceAppTimePeriodicity/NoAssociation",
+			Registry: &mockPackageRegistry{},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.SetDeviceAppTimePeriodicity(ctx, &ttnpb.SetDeviceAppTimePeriodicityRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					Period:               4,
+				})
+				return err
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "SetDeviceAppTimePeriodicity/Association",
+			Registry: &mockPackageRegistry{
+				associations: []*ttnpb.ApplicationPackageAssociation{association(42, 0)},
+			},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.SetDeviceAppTimePeriodicity(ctx, &ttnpb.SetDeviceAppTimePeriodicityRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					Period:               4,
+				})
+				return err
+			},
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      42,
+					FRMPayload: []byte{clocksync.DeviceAppTimePeriodicityCID, 0x04},
+				},
+			},
+		},
+		{
+			Name: "SetDeviceAppTimePeriodicity/InvalidPeriod",
+			Registry: &mockPackageRegistry{
+				associations: []*ttnpb.ApplicationPackageAssociation{association(42, 0)},
+			},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.SetDeviceAppTimePeriodicity(ctx, &ttnpb.SetDeviceAppTimePeriodicityRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					Period:               16,
+				})
+				return err
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "ForceDeviceResync/DefaultAssociation",
+			Registry: &mockPackageRegistry{
+				defaults: []*ttnpb.ApplicationPackageDefaultAssociation{
+					{
+						ApplicationPackageDefaultAssociationIdentifiers: ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+							ApplicationIdentifiers: applicationIDs,
+							FPort:                  clocksync.FPort,
+						},
+						PackageName: PackageName,
+					},
+				},
+			},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.ForceDeviceResync(ctx, &ttnpb.ForceDeviceResyncRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					NbTransmissions:      3,
+				})
+				return err
+			},
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.ForceDeviceResyncCID, 0x03},
+				},
+			},
+		},
+		{
+			Name: "ForceDeviceResync/OtherPackage",
+			Registry: &mockPackageRegistry{
+				associations: []*ttnpb.ApplicationPackageAssociation{
+					{
+						ApplicationPackageAssociationIdentifiers: ttnpb.ApplicationPackageAssociationIdentifiers{
+							EndDeviceIdentifiers: deviceIDs,
+							FPort:                clocksync.FPort,
+						},
+						PackageName: "other",
+					},
+				},
+			},
+			Call: func(p *ClockSyncPackage, ctx context.Context) error {
+				_, err := p.ForceDeviceResync(ctx, &ttnpb.ForceDeviceResyncRequest{
+					EndDeviceIdentifiers: deviceIDs,
+					NbTransmissions:      3,
+				})
+				return err
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+			rightList := tc.Rights
+			if rightList == nil {
+				rightList = []ttnpb.Right{ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE}
+			}
+			ctx = rights.NewContext(ctx, rights.Rights{
+				ApplicationRights: map[string]*ttnpb.Rights{
+					unique.ID(ctx, applicationIDs): ttnpb.RightsFrom(rightList...),
+				},
+			})
+
+			p, server := newTestPackage(t, tc.Registry)
+			err := tc.Call(p, ctx)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			downlinks, err := server.DownlinkQueueList(ctx, deviceIDs)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if !a.So(downlinks, should.HaveLength, len(tc.Downlinks)) {
+				t.FailNow()
+			}
+			for i, down := range downlinks {
+				a.So(down.FPort, should.Equal, tc.Downlinks[i].FPort)
+				a.So(down.FRMPayload, should.Resemble, tc.Downlinks[i].FRMPayload)
+				a.So(down.CorrelationIDs, should.NotBeEmpty)
+			}
+		})
+	}
+}
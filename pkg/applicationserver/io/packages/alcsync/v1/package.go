@@ -0,0 +1,208 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the LoRaWAN Application Layer Clock Synchronization package.
+const PackageName = "alcsync-v1"
+
+// ClockSyncPackage is the LoRaWAN Application Layer Clock Synchronization application package.
+type ClockSyncPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationClockSyncServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var errNoAssociation = errors.DefineFailedPrecondition("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/alcsync/v1")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+	if fPort != msg.FPort {
+		return nil
+	}
+
+	cmds, err := clocksync.UnmarshalUplink(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse clock synchronization uplink")
+		return nil
+	}
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:alcsync:%s", events.NewCorrelationID()))...)
+	for _, cmd := range cmds {
+		switch cmd := cmd.(type) {
+		case clocksync.AppTimeReq:
+			var threshold time.Duration
+			if data.threshold != nil {
+				threshold = *data.threshold
+			}
+			ans, ok := clocksync.NewAppTimeAns(msg, cmd, threshold)
+			if !ok {
+				logger.Debug("Device clock is synchronized")
+				continue
+			}
+			logger.WithField("time_correction", ans.TimeCorrection).Debug("Answer device time request")
+			if err := p.push(ctx, up.EndDeviceIdentifiers, fPort, ans); err != nil {
+				return err
+			}
+		case clocksync.DeviceAppTimePeriodicityAns:
+			if err := p.sendServiceData(ctx, up, map[string]*types.Value{
+				"type": {
+					Kind: &types.Value_StringValue{StringValue: "device_app_time_periodicity"},
+				},
+				"not_supported": {
+					Kind: &types.Value_BoolValue{BoolValue: cmd.NotSupported},
+				},
+				"device_time": {
+					Kind: &types.Value_NumberValue{NumberValue: float64(cmd.Time)},
+				},
+			}); err != nil {
+				return err
+			}
+		case clocksync.PackageVersionAns:
+			if err := p.sendServiceData(ctx, up, map[string]*types.Value{
+				"type": {
+					Kind: &types.Value_StringValue{StringValue: "package_version"},
+				},
+				"package_identifier": {
+					Kind: &types.Value_NumberValue{NumberValue: float64(cmd.PackageIdentifier)},
+				},
+				"package_version": {
+					Kind: &types.Value_NumberValue{NumberValue: float64(cmd.PackageVersion)},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *ClockSyncPackage) sendServiceData(ctx context.Context, up *ttnpb.ApplicationUp, fields map[string]*types.Value) error {
+	now := time.Now().UTC()
+	return p.server.SendUp(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+		CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:           &now,
+		Up: &ttnpb.ApplicationUp_ServiceData{
+			ServiceData: &ttnpb.ApplicationServiceData{
+				Data:    &types.Struct{Fields: fields},
+				Service: PackageName,
+			},
+		},
+	})
+}
+
+type binaryMarshaler interface {
+	MarshalBinary() ([]byte, error)
+}
+
+func (p *ClockSyncPackage) push(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fPort uint32, msg binaryMarshaler) error {
+	b, err := msg.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	down := &ttnpb.ApplicationDownlink{
+		FPort:          fPort,
+		FRMPayload:     b,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+	}
+	if err := p.server.DownlinkQueuePush(ctx, ids, []*ttnpb.ApplicationDownlink{down}); err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to push downlink to device")
+		return err
+	}
+	return nil
+}
+
+func mergePackageData(def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (*packageData, uint32, error) {
+	var defaultData, associationData packageData
+	fPort := uint32(clocksync.FPort)
+	if def != nil {
+		if err := defaultData.fromStruct(def.Data); err != nil {
+			return nil, 0, err
+		}
+		if def.FPort != 0 {
+			fPort = def.FPort
+		}
+	}
+	if assoc != nil {
+		if err := associationData.fromStruct(assoc.Data); err != nil {
+			return nil, 0, err
+		}
+		if assoc.FPort != 0 {
+			fPort = assoc.FPort
+		}
+	}
+	var merged packageData
+	for _, data := range []*packageData{
+		&defaultData,
+		&associationData,
+	} {
+		if data.threshold != nil {
+			merged.threshold = data.threshold
+		}
+	}
+	return &merged, fPort, nil
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *ClockSyncPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: clocksync.FPort,
+	}
+}
+
+// New instantiates the LoRaWAN Application Layer Clock Synchronization package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &ClockSyncPackage{server, registry}
+}
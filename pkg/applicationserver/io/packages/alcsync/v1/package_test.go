@@ -0,0 +1,310 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	applicationIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	deviceIDs      = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: applicationIDs, DeviceID: "test-dev"}
+
+	uplinkTime = time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC)
+)
+
+type mockPackageRegistry struct {
+	packages.Registry
+	defaults     []*ttnpb.ApplicationPackageDefaultAssociation
+	associations []*ttnpb.ApplicationPackageAssociation
+}
+
+func (r *mockPackageRegistry) ListDefaultAssociations(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationPackageDefaultAssociation, error) {
+	return r.defaults, nil
+}
+
+func (r *mockPackageRegistry) ListAssociations(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) ([]*ttnpb.ApplicationPackageAssociation, error) {
+	return r.associations, nil
+}
+
+func newTestPackage(t *testing.T, registry packages.Registry) (*ClockSyncPackage, mock.Server) {
+	server := mock.NewServer(componenttest.NewComponent(t, &component.Config{}))
+	return New(server, registry).(*ClockSyncPackage), server
+}
+
+func association(fPort uint32, threshold float64) *ttnpb.ApplicationPackageAssociation {
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		ApplicationPackageAssociationIdentifiers: ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIdentifiers: deviceIDs,
+			FPort:                fPort,
+		},
+		PackageName: PackageName,
+	}
+	if threshold > 0 {
+		assoc.Data = &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"threshold": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: threshold},
+				},
+			},
+		}
+	}
+	return assoc
+}
+
+func appTimeReq(deviceTime uint32, ansRequired bool, token uint8) []byte {
+	b := make([]byte, 6)
+	b[0] = clocksync.AppTimeCID
+	binary.LittleEndian.PutUint32(b[1:5], deviceTime)
+	b[5] = token & 0xf
+	if ansRequired {
+		b[5] |= 0x10
+	}
+	return b
+}
+
+func uplink(fPort uint32, frmPayload []byte, gatewayTime *time.Time) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: deviceIDs,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FRMPayload: frmPayload,
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"},
+						Time:               gatewayTime,
+					},
+				},
+				ReceivedAt: uplinkTime,
+			},
+		},
+	}
+}
+
+func TestHandleUp(t *testing.T) {
+	gpsTime := clocksync.GPSSeconds(uplinkTime)
+	gatewayTime := uplinkTime.Add(-time.Minute)
+
+	for _, tc := range []struct {
+		Name           string
+		Association    *ttnpb.ApplicationPackageAssociation
+		Up             *ttnpb.ApplicationUp
+		Downlinks      []*ttnpb.ApplicationDownlink
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NoAssociation",
+			Up:             uplink(clocksync.FPort, appTimeReq(gpsTime, true, 1), nil),
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name:        "ClockBehind",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime-10, false, 3), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0x0a, 0x00, 0x00, 0x00, 0x03},
+				},
+			},
+		},
+		{
+			Name:        "ClockAhead",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime+5, false, 15), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0xfb, 0xff, 0xff, 0xff, 0x0f},
+				},
+			},
+		},
+		{
+			Name:        "GatewayTime",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime, false, 0), &gatewayTime),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0xc4, 0xff, 0xff, 0xff, 0x00},
+				},
+			},
+		},
+		{
+			Name:        "Synchronized",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime, false, 1), nil),
+		},
+		{
+			Name:        "SynchronizedAnswerRequired",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime, true, 1), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0x00, 0x00, 0x00, 0x00, 0x01},
+				},
+			},
+		},
+		{
+			Name:        "BelowThreshold",
+			Association: association(0, 30),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime-10, false, 2), nil),
+		},
+		{
+			Name:        "BelowThresholdAnswerRequired",
+			Association: association(0, 30),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime-10, true, 2), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0x0a, 0x00, 0x00, 0x00, 0x02},
+				},
+			},
+		},
+		{
+			Name:        "AboveThreshold",
+			Association: association(0, 30),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime-60, false, 2), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      clocksync.FPort,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0x3c, 0x00, 0x00, 0x00, 0x02},
+				},
+			},
+		},
+		{
+			Name:        "AssociationFPort",
+			Association: association(42, 0),
+			Up:          uplink(42, appTimeReq(gpsTime-1, false, 4), nil),
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      42,
+					FRMPayload: []byte{clocksync.AppTimeCID, 0x01, 0x00, 0x00, 0x00, 0x04},
+				},
+			},
+		},
+		{
+			Name:        "OtherFPort",
+			Association: association(42, 0),
+			Up:          uplink(clocksync.FPort, appTimeReq(gpsTime-1, true, 4), nil),
+		},
+		{
+			Name:        "InvalidPayload",
+			Association: association(0, 0),
+			Up:          uplink(clocksync.FPort, []byte{0xff}, nil),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			p, server := newTestPackage(t, &mockPackageRegistry{})
+			err := p.HandleUp(ctx, nil, tc.Association, tc.Up)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			downlinks, err := server.DownlinkQueueList(ctx, deviceIDs)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if !a.So(downlinks, should.HaveLength, len(tc.Downlinks)) {
+				t.FailNow()
+			}
+			for i, down := range downlinks {
+				a.So(down.FPort, should.Equal, tc.Downlinks[i].FPort)
+				a.So(down.FRMPayload, should.Resemble, tc.Downlinks[i].FRMPayload)
+				a.So(down.CorrelationIDs, should.NotBeEmpty)
+			}
+		})
+	}
+}
+
+func TestHandleUpServiceData(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	p, server := newTestPackage(t, &mockPackageRegistry{})
+	sub, err := server.Subscribe(rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, applicationIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		},
+	}), "test", applicationIDs)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	frmPayload := []byte{
+		clocksync.PackageVersionCID, 0x01, 0x01,
+		clocksync.DeviceAppTimePeriodicityCID, 0x01, 0x78, 0x56, 0x34, 0x12,
+	}
+	if !a.So(p.HandleUp(ctx, nil, association(0, 0), uplink(clocksync.FPort, frmPayload, nil)), should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, fields := range []map[string]*pbtypes.Value{
+		{
+			"type":               {Kind: &pbtypes.Value_StringValue{StringValue: "package_version"}},
+			"package_identifier": {Kind: &pbtypes.Value_NumberValue{NumberValue: 1}},
+			"package_version":    {Kind: &pbtypes.Value_NumberValue{NumberValue: 1}},
+		},
+		{
+			"type":          {Kind: &pbtypes.Value_StringValue{StringValue: "device_app_time_periodicity"}},
+			"not_supported": {Kind: &pbtypes.Value_BoolValue{BoolValue: true}},
+			"device_time":   {Kind: &pbtypes.Value_NumberValue{NumberValue: 0x12345678}},
+		},
+	} {
+		select {
+		case up := <-sub.Up():
+			a.So(up.EndDeviceIdentifiers, should.Resemble, deviceIDs)
+			serviceData := up.GetServiceData()
+			if !a.So(serviceData, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(serviceData.Service, should.Equal, PackageName)
+			a.So(serviceData.Data.Fields, should.Resemble, fields)
+		default:
+			t.Fatal("Expected service data")
+		}
+	}
+
+	downlinks, err := server.DownlinkQueueList(ctx, deviceIDs)
+	a.So(err, should.BeNil)
+	a.So(downlinks, should.BeEmpty)
+}
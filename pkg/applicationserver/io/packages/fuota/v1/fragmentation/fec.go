@@ -0,0 +1,93 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentation
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+// MaxFragments is the maximum number of fragments in a fragmentation session, including redundant fragments.
+const MaxFragments = 0x3fff
+
+var (
+	errFragmentSize = errors.DefineInvalidArgument("fragment_size", "fragment size `{size}` is invalid")
+	errTooLarge     = errors.DefineInvalidArgument("too_large", "data block of `{length}` bytes results in more than `{max}` fragments")
+)
+
+// prbs23 is the 23-bit pseudo-random binary sequence generator of the fragmentation algorithm.
+func prbs23(x uint32) uint32 {
+	b0 := x & 1
+	b1 := (x & 0x20) >> 5
+	return x>>1 + (b0^b1)<<22
+}
+
+func isPowerOf2(x int) bool {
+	return x > 0 && x&(x-1) == 0
+}
+
+// MatrixLine returns the line of the parity check matrix for the coded fragment n, where n starts at 1,
+// given m uncoded fragments. Element i of the line is true if uncoded fragment i+1 is part of the coded fragment.
+func MatrixLine(n, m int) []bool {
+	line := make([]bool, m)
+	var mm int
+	if isPowerOf2(m) {
+		mm = 1
+	}
+	x := uint32(1 + 1001*n)
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+mm))
+		}
+		line[r] = true
+	}
+	return line
+}
+
+// Fragment splits the data block in fragments of the given size, and appends the given number of redundant
+// forward error correction fragments. The last uncoded fragment is padded with zeroes.
+// Fragment returns the fragments and the number of padding bytes.
+func Fragment(data []byte, size, redundancy int) ([][]byte, int, error) {
+	if size <= 0 || size > 0xff {
+		return nil, 0, errFragmentSize.WithAttributes("size", size)
+	}
+	m := (len(data) + size - 1) / size
+	if m == 0 || m+redundancy > MaxFragments {
+		return nil, 0, errTooLarge.WithAttributes(
+			"length", len(data),
+			"max", MaxFragments,
+		)
+	}
+	padding := m*size - len(data)
+	padded := make([]byte, m*size)
+	copy(padded, data)
+
+	fragments := make([][]byte, 0, m+redundancy)
+	for i := 0; i < m; i++ {
+		fragments = append(fragments, padded[i*size:(i+1)*size])
+	}
+	for n := 1; n <= redundancy; n++ {
+		coded := make([]byte, size)
+		for i, ok := range MatrixLine(n, m) {
+			if !ok {
+				continue
+			}
+			for j, b := range fragments[i] {
+				coded[j] ^= b
+			}
+		}
+		fragments = append(fragments, coded)
+	}
+	return fragments, padding, nil
+}
@@ -0,0 +1,135 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentation_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// decode reconstructs the m uncoded fragments from the received fragments, indexed by fragment counter minus one.
+// decode returns false if the received fragments are insufficient to reconstruct the data block.
+func decode(received map[int][]byte, m int) ([][]byte, bool) {
+	type equation struct {
+		coeffs []bool
+		data   []byte
+	}
+	var eqs []equation
+	for idx, frag := range received {
+		coeffs := make([]bool, m)
+		if idx < m {
+			coeffs[idx] = true
+		} else {
+			coeffs = MatrixLine(idx-m+1, m)
+		}
+		eqs = append(eqs, equation{coeffs, append([]byte(nil), frag...)})
+	}
+	res := make([][]byte, m)
+	for col := 0; col < m; col++ {
+		pivot := -1
+		for i := col; i < len(eqs); i++ {
+			if eqs[i].coeffs[col] {
+				pivot = i
+				break
+			}
+		}
+		if pivot < 0 {
+			return nil, false
+		}
+		eqs[col], eqs[pivot] = eqs[pivot], eqs[col]
+		for i := range eqs {
+			if i == col || !eqs[i].coeffs[col] {
+				continue
+			}
+			for j := range eqs[i].coeffs {
+				eqs[i].coeffs[j] = eqs[i].coeffs[j] != eqs[col].coeffs[j]
+			}
+			for j := range eqs[i].data {
+				eqs[i].data[j] ^= eqs[col].data[j]
+			}
+		}
+	}
+	for i := 0; i < m; i++ {
+		res[i] = eqs[i].data
+	}
+	return res, true
+}
+
+func TestMatrixLine(t *testing.T) {
+	for _, m := range []int{2, 3, 8, 10, 64, 100} {
+		t.Run(fmt.Sprintf("M=%d", m), func(t *testing.T) {
+			a := assertions.New(t)
+			for n := 1; n <= 10; n++ {
+				line := MatrixLine(n, m)
+				a.So(line, should.HaveLength, m)
+				var count int
+				for _, ok := range line {
+					if ok {
+						count++
+					}
+				}
+				a.So(count, should.BeGreaterThan, 0)
+				a.So(count, should.BeLessThanOrEqualTo, m/2)
+				a.So(MatrixLine(n, m), should.Resemble, line)
+			}
+		})
+	}
+}
+
+func TestFragment(t *testing.T) {
+	a := assertions.New(t)
+
+	_, _, err := Fragment([]byte{0x1}, 0, 0)
+	a.So(err, should.NotBeNil)
+	_, _, err = Fragment(nil, 10, 0)
+	a.So(err, should.NotBeNil)
+	_, _, err = Fragment(make([]byte, MaxFragments*2), 2, 1)
+	a.So(err, should.NotBeNil)
+
+	data := make([]byte, 1000)
+	rand.New(rand.NewSource(42)).Read(data)
+
+	const size, redundancy = 48, 20
+	fragments, padding, err := Fragment(data, size, redundancy)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	m := (len(data) + size - 1) / size
+	a.So(fragments, should.HaveLength, m+redundancy)
+	a.So(padding, should.Equal, m*size-len(data))
+	for _, frag := range fragments {
+		a.So(frag, should.HaveLength, size)
+	}
+	a.So(bytes.Join(fragments[:m], nil)[:len(data)], should.Resemble, data)
+
+	// Lose some of the uncoded fragments and reconstruct them using the redundant fragments.
+	received := make(map[int][]byte)
+	for i, frag := range fragments {
+		if i%7 == 3 && i < m {
+			continue
+		}
+		received[i] = frag
+	}
+	decoded, ok := decode(received, m)
+	if a.So(ok, should.BeTrue) {
+		a.So(bytes.Join(decoded, nil)[:len(data)], should.Resemble, data)
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentation implements the LoRaWAN Fragmented Data Block Transport messages and forward error correction.
+package fragmentation
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const (
+	// PackageIdentifier is the identifier of the Fragmented Data Block Transport package.
+	PackageIdentifier = 3
+	// PackageVersion is the version of the Fragmented Data Block Transport package.
+	PackageVersion = 1
+	// FPort is the default FPort of the Fragmented Data Block Transport package.
+	FPort = 201
+)
+
+// Command identifiers.
+const (
+	PackageVersionCID    = 0x00
+	FragSessionStatusCID = 0x01
+	FragSessionSetupCID  = 0x02
+	FragSessionDeleteCID = 0x03
+	DataFragmentCID      = 0x08
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errPayloadLength  = errors.DefineInvalidArgument("payload_length", "invalid payload length for command `{cid}`")
+	errFieldRange     = errors.DefineInvalidArgument("field_range", "field `{field}` value `{value}` is out of range")
+)
+
+// PackageVersionReq requests the package identifier and version of the end device.
+type PackageVersionReq struct{}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (PackageVersionReq) MarshalBinary() ([]byte, error) {
+	return []byte{PackageVersionCID}, nil
+}
+
+// FragSessionStatusReq requests the status of a fragmentation session.
+type FragSessionStatusReq struct {
+	FragIndex uint8
+	// Participants indicates whether all end devices should answer, or only the end devices that are missing fragments.
+	Participants bool
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req FragSessionStatusReq) MarshalBinary() ([]byte, error) {
+	if req.FragIndex > 3 {
+		return nil, errFieldRange.WithAttributes("field", "frag_index", "value", req.FragIndex)
+	}
+	param := req.FragIndex << 1
+	if req.Participants {
+		param |= 1
+	}
+	return []byte{FragSessionStatusCID, param}, nil
+}
+
+// FragSessionSetupReq sets up a fragmentation session.
+type FragSessionSetupReq struct {
+	FragIndex      uint8
+	McGroupBitMask uint8
+	NbFrag         uint16
+	FragSize       uint8
+	// FragmentationMatrix is the fragmentation matrix. Only matrix 0 is defined.
+	FragmentationMatrix uint8
+	BlockAckDelay       uint8
+	// Padding is the number of padding bytes in the last fragment.
+	Padding    uint8
+	Descriptor uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req FragSessionSetupReq) MarshalBinary() ([]byte, error) {
+	for _, f := range []struct {
+		name  string
+		value uint8
+		max   uint8
+	}{
+		{"frag_index", req.FragIndex, 3},
+		{"mc_group_bit_mask", req.McGroupBitMask, 0xf},
+		{"fragmentation_matrix", req.FragmentationMatrix, 7},
+		{"block_ack_delay", req.BlockAckDelay, 7},
+	} {
+		if f.value > f.max {
+			return nil, errFieldRange.WithAttributes("field", f.name, "value", f.value)
+		}
+	}
+	b := make([]byte, 11)
+	b[0] = FragSessionSetupCID
+	b[1] = req.FragIndex<<4 | req.McGroupBitMask
+	binary.LittleEndian.PutUint16(b[2:4], req.NbFrag)
+	b[4] = req.FragSize
+	b[5] = req.FragmentationMatrix<<3 | req.BlockAckDelay
+	b[6] = req.Padding
+	binary.LittleEndian.PutUint32(b[7:11], req.Descriptor)
+	return b, nil
+}
+
+// FragSessionDeleteReq deletes a fragmentation session.
+type FragSessionDeleteReq struct {
+	FragIndex uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req FragSessionDeleteReq) MarshalBinary() ([]byte, error) {
+	if req.FragIndex > 3 {
+		return nil, errFieldRange.WithAttributes("field", "frag_index", "value", req.FragIndex)
+	}
+	return []byte{FragSessionDeleteCID, req.FragIndex}, nil
+}
+
+// DataFragment is a fragment of a data block.
+type DataFragment struct {
+	FragIndex uint8
+	// N is the fragment counter, starting at 1.
+	N       uint16
+	Payload []byte
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req DataFragment) MarshalBinary() ([]byte, error) {
+	if req.FragIndex > 3 {
+		return nil, errFieldRange.WithAttributes("field", "frag_index", "value", req.FragIndex)
+	}
+	if req.N == 0 || req.N > 0x3fff {
+		return nil, errFieldRange.WithAttributes("field", "n", "value", req.N)
+	}
+	b := make([]byte, 3, 3+len(req.Payload))
+	b[0] = DataFragmentCID
+	binary.LittleEndian.PutUint16(b[1:3], uint16(req.FragIndex)<<14|req.N)
+	return append(b, req.Payload...), nil
+}
+
+// PackageVersionAns is the answer to PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// FragSessionStatusAns is the answer to FragSessionStatusReq.
+type FragSessionStatusAns struct {
+	FragIndex             uint8
+	NbFragReceived        uint16
+	MissingFrag           uint8
+	NotEnoughMatrixMemory bool
+}
+
+// FragSessionSetupAns is the answer to FragSessionSetupReq.
+type FragSessionSetupAns struct {
+	FragIndex                    uint8
+	WrongDescriptor              bool
+	FragSessionIndexNotSupported bool
+	NotEnoughMemory              bool
+	EncodingUnsupported          bool
+}
+
+// OK returns whether the end device accepted the fragmentation session.
+func (ans FragSessionSetupAns) OK() bool {
+	return !ans.WrongDescriptor && !ans.FragSessionIndexNotSupported && !ans.NotEnoughMemory && !ans.EncodingUnsupported
+}
+
+// FragSessionDeleteAns is the answer to FragSessionDeleteReq.
+type FragSessionDeleteAns struct {
+	FragIndex           uint8
+	SessionDoesNotExist bool
+}
+
+var answerLengths = map[byte]int{
+	PackageVersionCID:    2,
+	FragSessionStatusCID: 4,
+	FragSessionSetupCID:  1,
+	FragSessionDeleteCID: 1,
+}
+
+// UnmarshalAnswers parses the answers in an uplink payload.
+// The returned answers are of type PackageVersionAns, FragSessionStatusAns, FragSessionSetupAns or FragSessionDeleteAns.
+func UnmarshalAnswers(b []byte) ([]interface{}, error) {
+	var answers []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		n, ok := answerLengths[cid]
+		if !ok {
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < 1+n {
+			return nil, errPayloadLength.WithAttributes("cid", cid)
+		}
+		p := b[1 : 1+n]
+		b = b[1+n:]
+		switch cid {
+		case PackageVersionCID:
+			answers = append(answers, PackageVersionAns{
+				PackageIdentifier: p[0],
+				PackageVersion:    p[1],
+			})
+		case FragSessionStatusCID:
+			receivedAndIndex := binary.LittleEndian.Uint16(p[0:2])
+			answers = append(answers, FragSessionStatusAns{
+				FragIndex:             uint8(receivedAndIndex >> 14),
+				NbFragReceived:        receivedAndIndex & 0x3fff,
+				MissingFrag:           p[2],
+				NotEnoughMatrixMemory: p[3]&0x1 != 0,
+			})
+		case FragSessionSetupCID:
+			answers = append(answers, FragSessionSetupAns{
+				FragIndex:                    p[0] >> 6,
+				WrongDescriptor:              p[0]&0x8 != 0,
+				FragSessionIndexNotSupported: p[0]&0x4 != 0,
+				NotEnoughMemory:              p[0]&0x2 != 0,
+				EncodingUnsupported:          p[0]&0x1 != 0,
+			})
+		case FragSessionDeleteCID:
+			answers = append(answers, FragSessionDeleteAns{
+				FragIndex:           p[0] & 0x3,
+				SessionDoesNotExist: p[0]&0x4 != 0,
+			})
+		}
+	}
+	return answers, nil
+}
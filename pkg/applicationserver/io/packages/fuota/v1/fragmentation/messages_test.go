@@ -0,0 +1,106 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentation_test
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	for i, tc := range []struct {
+		Message  encoding.BinaryMarshaler
+		Expected []byte
+		Error    bool
+	}{
+		{
+			Message:  PackageVersionReq{},
+			Expected: []byte{0x00},
+		},
+		{
+			Message:  FragSessionStatusReq{FragIndex: 2, Participants: true},
+			Expected: []byte{0x01, 0x05},
+		},
+		{
+			Message: FragSessionSetupReq{
+				FragIndex:      1,
+				McGroupBitMask: 0x1,
+				NbFrag:         0x0102,
+				FragSize:       50,
+				BlockAckDelay:  3,
+				Padding:        7,
+				Descriptor:     0x0a0b0c0d,
+			},
+			Expected: []byte{0x02, 0x11, 0x02, 0x01, 0x32, 0x03, 0x07, 0x0d, 0x0c, 0x0b, 0x0a},
+		},
+		{
+			Message: FragSessionSetupReq{FragIndex: 4},
+			Error:   true,
+		},
+		{
+			Message:  FragSessionDeleteReq{FragIndex: 3},
+			Expected: []byte{0x03, 0x03},
+		},
+		{
+			Message:  DataFragment{FragIndex: 1, N: 2, Payload: []byte{0xaa, 0xbb}},
+			Expected: []byte{0x08, 0x02, 0x40, 0xaa, 0xbb},
+		},
+		{
+			Message: DataFragment{FragIndex: 1},
+			Error:   true,
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := tc.Message.MarshalBinary()
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestUnmarshalAnswers(t *testing.T) {
+	a := assertions.New(t)
+
+	answers, err := UnmarshalAnswers([]byte{
+		0x00, 0x03, 0x01,
+		0x01, 0x0a, 0x80, 0x02, 0x01,
+		0x02, 0x48,
+		0x03, 0x06,
+	})
+	a.So(err, should.BeNil)
+	a.So(answers, should.Resemble, []interface{}{
+		PackageVersionAns{PackageIdentifier: 3, PackageVersion: 1},
+		FragSessionStatusAns{FragIndex: 2, NbFragReceived: 10, MissingFrag: 2, NotEnoughMatrixMemory: true},
+		FragSessionSetupAns{FragIndex: 1, WrongDescriptor: true},
+		FragSessionDeleteAns{FragIndex: 2, SessionDoesNotExist: true},
+	})
+	a.So(answers[2].(FragSessionSetupAns).OK(), should.BeFalse)
+
+	_, err = UnmarshalAnswers([]byte{0x01, 0x0a})
+	a.So(err, should.NotBeNil)
+
+	_, err = UnmarshalAnswers([]byte{0x08})
+	a.So(err, should.NotBeNil)
+}
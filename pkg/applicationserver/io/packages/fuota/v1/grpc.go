@@ -0,0 +1,342 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuotav1
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/multicastsetup"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errSessionNotFound    = errors.DefineNotFound("session_not_found", "FUOTA session not found")
+	errSessionExists      = errors.DefineAlreadyExists("session_exists", "FUOTA session already exists")
+	errInvalidClass       = errors.DefineInvalidArgument("invalid_class", "multicast session class `{class}` is not class B or class C")
+	errClassBSessionTime  = errors.DefineInvalidArgument("class_b_session_time", "class B session time must be the start of a beacon period")
+	errSessionTimePast    = errors.DefineInvalidArgument("session_time_past", "session time `{session_time}` is in the past")
+	errNoMcKey            = errors.DefineInvalidArgument("no_mc_key", "no multicast key")
+	errTargetEndDevice    = errors.DefineInvalidArgument("target_end_device", "target end device `{device_id}` is not in application `{application_id}`")
+	errEndDeviceInSession = errors.DefineFailedPrecondition("end_device_in_session", "end device `{device_id}` takes part in FUOTA session of `{session_device_id}`")
+	errNoMcKEKey          = errors.DefineFailedPrecondition("no_mc_ke_key", "no McKEKey in package association of end device `{device_id}`")
+	errNoFirmwareBucket   = errors.DefineFailedPrecondition("no_firmware_bucket", "no firmware bucket configured")
+	errFirmwareBucket     = errors.DefinePermissionDenied("firmware_bucket", "firmware bucket `{bucket}` is not the configured firmware bucket")
+	errFirmwarePath       = errors.DefineInvalidArgument("firmware_path", "invalid firmware path `{path}`")
+	errReadFirmware       = errors.DefineUnavailable("read_firmware", "read firmware image `{path}` from bucket `{bucket}`")
+)
+
+// beaconPeriod is the class B beacon period.
+const beaconPeriod = 128 * time.Second
+
+// Get implements ttnpb.ApplicationFUOTASessionsServer.
+func (p *fuotaPackage) Get(ctx context.Context, req *ttnpb.GetFUOTASessionRequest) (*ttnpb.FUOTASession, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES); err != nil {
+		return nil, err
+	}
+	session, err := p.sessions.Get(ctx, req.EndDeviceIdentifiers, req.FieldMask.Paths)
+	if errors.IsNotFound(err) {
+		return nil, errSessionNotFound.WithCause(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Start implements ttnpb.ApplicationFUOTASessionsServer.
+func (p *fuotaPackage) Start(ctx context.Context, req *ttnpb.StartFUOTASessionRequest) (*ttnpb.FUOTASession, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	mc := req.Multicast
+	switch mc.Class {
+	case ttnpb.CLASS_B:
+		if gpstime.ToGPS(mc.SessionTime)%beaconPeriod != 0 {
+			return nil, errClassBSessionTime.New()
+		}
+	case ttnpb.CLASS_C:
+	default:
+		return nil, errInvalidClass.WithAttributes("class", mc.Class)
+	}
+	if !mc.SessionTime.After(time.Now()) {
+		return nil, errSessionTimePast.WithAttributes("session_time", mc.SessionTime)
+	}
+	if mc.Key.IsZero() {
+		return nil, errNoMcKey.New()
+	}
+
+	logger := log.FromContext(ctx)
+	mcKEKeys := make([]types.AES128Key, 0, len(req.TargetEndDeviceIDs))
+	for _, ids := range req.TargetEndDeviceIDs {
+		if ids.ApplicationIdentifiers != req.ApplicationIdentifiers {
+			return nil, errTargetEndDevice.WithAttributes(
+				"device_id", ids.DeviceID,
+				"application_id", req.ApplicationID,
+			)
+		}
+		switch session, err := p.sessions.GetByEndDeviceID(ctx, ids, nil); {
+		case err == nil:
+			return nil, errEndDeviceInSession.WithAttributes(
+				"device_id", ids.DeviceID,
+				"session_device_id", session.DeviceID,
+			)
+		case !errors.IsNotFound(err):
+			return nil, err
+		}
+		data, err := p.packageData(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		if data.mcKEKey == nil {
+			return nil, errNoMcKEKey.WithAttributes("device_id", ids.DeviceID)
+		}
+		mcKEKeys = append(mcKEKeys, *data.mcKEKey)
+	}
+
+	fragments, padding, err := p.readFirmware(ctx, req.Firmware, req.Fragmentation)
+	if err != nil {
+		return nil, err
+	}
+	nbFrag := len(fragments) - int(req.Fragmentation.Redundancy)
+
+	sessionTime := clocksync.GPSSeconds(mc.SessionTime)
+	var sessionReq binaryMarshaler
+	switch mc.Class {
+	case ttnpb.CLASS_B:
+		sessionReq = multicastsetup.McClassBSessionReq{
+			McGroupID:   uint8(mc.GroupID),
+			SessionTime: sessionTime,
+			TimeOut:     uint8(mc.SessionTimeout),
+			Periodicity: uint8(mc.PingSlotPeriodicity),
+			DLFrequency: mc.Frequency,
+			DR:          uint8(mc.DataRateIndex),
+		}
+	case ttnpb.CLASS_C:
+		sessionReq = multicastsetup.McClassCSessionReq{
+			McGroupID:      uint8(mc.GroupID),
+			SessionTime:    sessionTime,
+			SessionTimeOut: uint8(mc.SessionTimeout),
+			DLFrequency:    mc.Frequency,
+			DR:             uint8(mc.DataRateIndex),
+		}
+	}
+	fragSessionSetupReq := fragmentation.FragSessionSetupReq{
+		FragIndex:      uint8(req.Fragmentation.Index),
+		McGroupBitMask: 1 << mc.GroupID,
+		NbFrag:         uint16(nbFrag),
+		FragSize:       uint8(req.Fragmentation.FragmentSize),
+		BlockAckDelay:  uint8(req.Fragmentation.BlockAckDelay),
+		Padding:        uint8(padding),
+		Descriptor:     req.Firmware.Descriptor_,
+	}
+
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(fragments))
+	for i, fragment := range fragments {
+		down, err := newDownlink(ctx, fragmentation.FPort, fragmentation.DataFragment{
+			FragIndex: uint8(req.Fragmentation.Index),
+			N:         uint16(i + 1),
+			Payload:   fragment,
+		})
+		if err != nil {
+			return nil, err
+		}
+		downlinks = append(downlinks, down)
+	}
+	// The first fragment is transmitted at the start of the multicast session.
+	// The other fragments follow as soon as possible.
+	downlinks[0].ClassBC = &ttnpb.ApplicationDownlink_ClassBC{
+		AbsoluteTime: &mc.SessionTime,
+	}
+
+	session := &ttnpb.FUOTASession{
+		EndDeviceIdentifiers: req.EndDeviceIdentifiers,
+		Firmware:             req.Firmware,
+		Multicast:            req.Multicast,
+		Fragmentation:        req.Fragmentation,
+		EndDevices:           make([]ttnpb.FUOTAEndDeviceStatus, 0, len(req.TargetEndDeviceIDs)),
+		Fragments:            uint32(nbFrag),
+	}
+	for _, ids := range req.TargetEndDeviceIDs {
+		session.EndDevices = append(session.EndDevices, ttnpb.FUOTAEndDeviceStatus{
+			EndDeviceIDs: ids,
+		})
+	}
+	paths := []string{
+		"end_devices",
+		"firmware",
+		"fragmentation",
+		"fragments",
+		"multicast",
+	}
+	session, err = p.sessions.Set(ctx, req.EndDeviceIdentifiers, paths, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if stored != nil {
+			return nil, nil, errSessionExists.New()
+		}
+		return session, append(paths,
+			"ids.application_ids",
+			"ids.device_id",
+		), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := p.pushSetup(ctx, req, mcKEKeys, sessionReq, fragSessionSetupReq, downlinks); err != nil {
+		// Delete the session, so that the target end devices can take part in another session.
+		if _, deleteErr := p.sessions.Set(ctx, req.EndDeviceIdentifiers, nil, func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+			return nil, nil, nil
+		}); deleteErr != nil {
+			logger.WithError(deleteErr).Warn("Failed to delete FUOTA session")
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// pushSetup pushes the multicast group, fragmentation session and multicast session setup requests to the target
+// end devices, and the fragments of the firmware image to the multicast end device.
+func (p *fuotaPackage) pushSetup(ctx context.Context, req *ttnpb.StartFUOTASessionRequest, mcKEKeys []types.AES128Key, sessionReq, fragSessionSetupReq binaryMarshaler, downlinks []*ttnpb.ApplicationDownlink) error {
+	logger := log.FromContext(ctx)
+	mc := req.Multicast
+	for i, ids := range req.TargetEndDeviceIDs {
+		logger := logger.WithField("device_id", ids.DeviceID)
+		if err := p.push(ctx, ids, multicastsetup.FPort, multicastsetup.McGroupSetupReq{
+			McGroupID:      uint8(mc.GroupID),
+			McAddr:         mc.DevAddr,
+			McKeyEncrypted: crypto.EncryptMcKey(mcKEKeys[i], *mc.Key),
+			MinMcFCount:    mc.MinFCnt,
+			MaxMcFCount:    mc.MaxFCnt,
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to push multicast group setup")
+			return err
+		}
+		if err := p.push(ctx, ids, fragmentation.FPort, fragSessionSetupReq); err != nil {
+			logger.WithError(err).Warn("Failed to push fragmentation session setup")
+			return err
+		}
+		if err := p.push(ctx, ids, multicastsetup.FPort, sessionReq); err != nil {
+			logger.WithError(err).Warn("Failed to push multicast session setup")
+			return err
+		}
+	}
+	if err := p.server.DownlinkQueuePush(ctx, req.EndDeviceIdentifiers, downlinks); err != nil {
+		logger.WithError(err).Warn("Failed to push fragments")
+		return err
+	}
+	return nil
+}
+
+// Delete implements ttnpb.ApplicationFUOTASessionsServer.
+func (p *fuotaPackage) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES); err != nil {
+		return nil, err
+	}
+	_, err := p.sessions.Set(ctx, *ids, nil, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if stored == nil {
+			return nil, nil, errSessionNotFound.New()
+		}
+		return nil, nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// packageData returns the package data of the end device, from the default association of the application
+// and the association of the end device.
+func (p *fuotaPackage) packageData(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*packageData, error) {
+	paths := []string{
+		"data",
+		"package_name",
+	}
+	var data packageData
+	defaults, err := p.registry.ListDefaultAssociations(ctx, ids.ApplicationIdentifiers, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defaults {
+		if def.PackageName != PackageName {
+			continue
+		}
+		if err := data.fromStruct(def.Data); err != nil {
+			return nil, err
+		}
+	}
+	associations, err := p.registry.ListAssociations(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, assoc := range associations {
+		if assoc.PackageName != PackageName {
+			continue
+		}
+		if err := data.fromStruct(assoc.Data); err != nil {
+			return nil, err
+		}
+	}
+	return &data, nil
+}
+
+// readFirmware reads the firmware image from the blob store and returns the fragments and the number of padding bytes.
+// Only firmware images in the configured bucket, under the configured path prefix, can be read.
+func (p *fuotaPackage) readFirmware(ctx context.Context, firmware *ttnpb.FUOTAFirmware, settings *ttnpb.FUOTAFragmentationSettings) ([][]byte, int, error) {
+	if p.firmware.Bucket == "" {
+		return nil, 0, errNoFirmwareBucket.New()
+	}
+	if firmware.Bucket != p.firmware.Bucket {
+		return nil, 0, errFirmwareBucket.WithAttributes("bucket", firmware.Bucket)
+	}
+	if path.IsAbs(firmware.Path) {
+		return nil, 0, errFirmwarePath.WithAttributes("path", firmware.Path)
+	}
+	for _, elem := range strings.Split(firmware.Path, "/") {
+		if elem == ".." {
+			return nil, 0, errFirmwarePath.WithAttributes("path", firmware.Path)
+		}
+	}
+	name := path.Clean(firmware.Path)
+	if name == "." {
+		return nil, 0, errFirmwarePath.WithAttributes("path", firmware.Path)
+	}
+	name = path.Join(p.firmware.Path, name)
+	bucket, err := p.server.GetBaseConfig(ctx).Blob.Bucket(ctx, p.firmware.Bucket)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer bucket.Close()
+	data, err := bucket.ReadAll(ctx, name)
+	if err != nil {
+		return nil, 0, errReadFirmware.WithCause(err).WithAttributes(
+			"bucket", firmware.Bucket,
+			"path", firmware.Path,
+		)
+	}
+	return fragmentation.Fragment(data, int(settings.FragmentSize), int(settings.Redundancy))
+}
@@ -0,0 +1,529 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuotav1
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1/clocksync"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/multicastsetup"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	errNotFound = errors.DefineNotFound("not_found", "not found")
+	errPush     = errors.DefineUnavailable("push", "push failed")
+
+	applicationIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	multicastIDs   = ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: applicationIDs, DeviceID: "test-mc"}
+	targetIDs      = []ttnpb.EndDeviceIdentifiers{
+		{ApplicationIdentifiers: applicationIDs, DeviceID: "test-dev-1"},
+		{ApplicationIdentifiers: applicationIDs, DeviceID: "test-dev-2"},
+	}
+	mcKEKey = types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	mcKey   = types.AES128Key{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}
+)
+
+type mockSessionRegistry struct {
+	mu       sync.Mutex
+	sessions map[string]*ttnpb.FUOTASession
+}
+
+func copySession(session *ttnpb.FUOTASession) *ttnpb.FUOTASession {
+	res := *session
+	res.EndDevices = append([]ttnpb.FUOTAEndDeviceStatus(nil), session.EndDevices...)
+	return &res
+}
+
+func (r *mockSessionRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session, ok := r.sessions[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return copySession(session), nil
+}
+
+func (r *mockSessionRegistry) GetByEndDeviceID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, session := range r.sessions {
+		for _, dev := range session.EndDevices {
+			if dev.EndDeviceIDs.ApplicationIdentifiers == ids.ApplicationIdentifiers && dev.EndDeviceIDs.DeviceID == ids.DeviceID {
+				return copySession(session), nil
+			}
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (r *mockSessionRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error)) (*ttnpb.FUOTASession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	var stored *ttnpb.FUOTASession
+	if session, ok := r.sessions[uid]; ok {
+		stored = copySession(session)
+	}
+	session, _, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		delete(r.sessions, uid)
+		return nil, nil
+	}
+	r.sessions[uid] = copySession(session)
+	return session, nil
+}
+
+type mockPackageRegistry struct {
+	packages.Registry
+	associations map[string][]*ttnpb.ApplicationPackageAssociation
+}
+
+func (r *mockPackageRegistry) ListDefaultAssociations(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) ([]*ttnpb.ApplicationPackageDefaultAssociation, error) {
+	return nil, nil
+}
+
+func (r *mockPackageRegistry) ListAssociations(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) ([]*ttnpb.ApplicationPackageAssociation, error) {
+	return r.associations[unique.ID(ctx, ids)], nil
+}
+
+type mockServer struct {
+	mock.Server
+	mu           sync.Mutex
+	failDeviceID string
+}
+
+func (s *mockServer) setFailDeviceID(id string) {
+	s.mu.Lock()
+	s.failDeviceID = id
+	s.mu.Unlock()
+}
+
+// DownlinkQueuePush implements io.Server.
+// The push fails for the end device with the configured failing device ID.
+func (s *mockServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	s.mu.Lock()
+	fail := ids.DeviceID == s.failDeviceID
+	s.mu.Unlock()
+	if fail {
+		return errPush.New()
+	}
+	return s.Server.DownlinkQueuePush(ctx, ids, items)
+}
+
+// writeFirmware writes the firmware images to a new blob directory and returns the directory.
+func writeFirmware(t *testing.T) string {
+	dir := test.Must(ioutil.TempDir("", "lorawan-stack-fuota-test")).(string)
+	for name, data := range map[string]string{
+		"firmware/images/v1.bin": "firmware",
+		"firmware/secret":        "secret",
+		"other/secret":           "secret",
+	} {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+			t.Fatalf("Failed to write file: %v", err)
+		}
+	}
+	return dir
+}
+
+func newTestServer(t *testing.T, dir string) *mockServer {
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			Blob: config.BlobConfig{
+				Provider: "local",
+				Local: config.BlobConfigLocal{
+					Directory: dir,
+				},
+			},
+		},
+	})
+	return &mockServer{
+		Server: mock.NewServer(c),
+	}
+}
+
+// newTestPackage returns a FUOTA package with the firmware images of writeFirmware, and the McKEKey of the target
+// end devices in their package associations.
+func newTestPackage(ctx context.Context, t *testing.T, dir string) (*fuotaPackage, *mockServer, *mockSessionRegistry) {
+	server := newTestServer(t, dir)
+	sessions := &mockSessionRegistry{
+		sessions: make(map[string]*ttnpb.FUOTASession),
+	}
+	registry := &mockPackageRegistry{
+		associations: make(map[string][]*ttnpb.ApplicationPackageAssociation),
+	}
+	for _, ids := range targetIDs {
+		registry.associations[unique.ID(ctx, ids)] = []*ttnpb.ApplicationPackageAssociation{
+			{
+				ApplicationPackageAssociationIdentifiers: ttnpb.ApplicationPackageAssociationIdentifiers{
+					EndDeviceIdentifiers: ids,
+					FPort:                multicastsetup.FPort,
+				},
+				PackageName: PackageName,
+				Data: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						mcKEKeyField: {
+							Kind: &pbtypes.Value_StringValue{StringValue: mcKEKey.String()},
+						},
+					},
+				},
+			},
+		}
+	}
+	handler, err := New(ctx, server, registry, Config{
+		Sessions: sessions,
+		Firmware: config.BlobPathConfig{
+			Bucket: "firmware",
+			Path:   "images",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create FUOTA package: %v", err)
+	}
+	return handler.(*fuotaPackage), server, sessions
+}
+
+func withRights(ctx context.Context) context.Context {
+	return rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, applicationIDs): ttnpb.RightsFrom(
+				ttnpb.RIGHT_APPLICATION_SETTINGS_PACKAGES,
+				ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+			),
+		},
+	})
+}
+
+func startRequest(sessionTime time.Time) *ttnpb.StartFUOTASessionRequest {
+	key := mcKey
+	return &ttnpb.StartFUOTASessionRequest{
+		EndDeviceIdentifiers: multicastIDs,
+		Firmware: &ttnpb.FUOTAFirmware{
+			Bucket:      "firmware",
+			Path:        "v1.bin",
+			Descriptor_: 0x42,
+		},
+		Multicast: &ttnpb.FUOTAMulticastSettings{
+			GroupID:        1,
+			DevAddr:        types.DevAddr{0x01, 0x02, 0x03, 0x04},
+			Key:            &key,
+			MaxFCnt:        0xffff,
+			Class:          ttnpb.CLASS_C,
+			SessionTime:    sessionTime,
+			SessionTimeout: 4,
+			Frequency:      869525000,
+			DataRateIndex:  ttnpb.DATA_RATE_0,
+		},
+		Fragmentation: &ttnpb.FUOTAFragmentationSettings{
+			FragmentSize: 4,
+			Redundancy:   1,
+		},
+		TargetEndDeviceIDs: targetIDs,
+	}
+}
+
+func TestReadFirmware(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	dir := writeFirmware(t)
+	defer os.RemoveAll(dir)
+	server := newTestServer(t, dir)
+	settings := &ttnpb.FUOTAFragmentationSettings{
+		FragmentSize: 4,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Config         config.BlobPathConfig
+		Firmware       *ttnpb.FUOTAFirmware
+		Fragments      [][]byte
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Valid",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "./v1.bin",
+			},
+			Fragments: [][]byte{
+				[]byte("firm"),
+				[]byte("ware"),
+			},
+		},
+		{
+			Name: "NoBucket",
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "images/v1.bin",
+			},
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "OtherBucket",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "other",
+				Path:   "secret",
+			},
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "ParentPath",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "../secret",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NestedParentPath",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "v1/../../secret",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "AbsolutePath",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "/secret",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NotFound",
+			Config: config.BlobPathConfig{
+				Bucket: "firmware",
+				Path:   "images",
+			},
+			Firmware: &ttnpb.FUOTAFirmware{
+				Bucket: "firmware",
+				Path:   "v2.bin",
+			},
+			ErrorAssertion: errors.IsUnavailable,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			p := &fuotaPackage{
+				server:   server,
+				firmware: tc.Config,
+			}
+			fragments, padding, err := p.readFirmware(ctx, tc.Firmware, settings)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(fragments, should.Resemble, tc.Fragments)
+			a.So(padding, should.Equal, 0)
+		})
+	}
+}
+
+func TestSessions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	dir := writeFirmware(t)
+	defer os.RemoveAll(dir)
+	p, server, _ := newTestPackage(ctx, t, dir)
+
+	sessionTime := time.Now().Add(time.Hour).UTC()
+	req := startRequest(sessionTime)
+
+	_, err := p.Start(rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, applicationIDs): nil,
+		},
+	}), req)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx = withRights(ctx)
+
+	// Firmware in another bucket.
+	otherReq := startRequest(sessionTime)
+	otherReq.Firmware.Bucket = "other"
+	_, err = p.Start(ctx, otherReq)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// Session time in the past.
+	_, err = p.Start(ctx, startRequest(time.Now().Add(-time.Hour)))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = p.Get(ctx, &ttnpb.GetFUOTASessionRequest{EndDeviceIdentifiers: multicastIDs})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	session, err := p.Start(ctx, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(session.EndDeviceIdentifiers, should.Resemble, multicastIDs)
+	a.So(session.Fragments, should.Equal, 2)
+	a.So(session.EndDevices, should.Resemble, []ttnpb.FUOTAEndDeviceStatus{
+		{EndDeviceIDs: targetIDs[0]},
+		{EndDeviceIDs: targetIDs[1]},
+	})
+
+	marshal := func(msg binaryMarshaler) []byte {
+		return test.Must(msg.MarshalBinary()).([]byte)
+	}
+	for _, ids := range targetIDs {
+		downlinks, err := server.DownlinkQueueList(ctx, ids)
+		if !a.So(err, should.BeNil) || !a.So(downlinks, should.HaveLength, 3) {
+			t.FailNow()
+		}
+		a.So(downlinks[0].FPort, should.Equal, multicastsetup.FPort)
+		a.So(downlinks[0].FRMPayload, should.Resemble, marshal(multicastsetup.McGroupSetupReq{
+			McGroupID:      1,
+			McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+			McKeyEncrypted: crypto.EncryptMcKey(mcKEKey, mcKey),
+			MaxMcFCount:    0xffff,
+		}))
+		a.So(downlinks[1].FPort, should.Equal, fragmentation.FPort)
+		a.So(downlinks[1].FRMPayload, should.Resemble, marshal(fragmentation.FragSessionSetupReq{
+			McGroupBitMask: 1 << 1,
+			NbFrag:         2,
+			FragSize:       4,
+			Descriptor:     0x42,
+		}))
+		a.So(downlinks[2].FPort, should.Equal, multicastsetup.FPort)
+		a.So(downlinks[2].FRMPayload, should.Resemble, marshal(multicastsetup.McClassCSessionReq{
+			McGroupID:      1,
+			SessionTime:    clocksync.GPSSeconds(sessionTime),
+			SessionTimeOut: 4,
+			DLFrequency:    869525000,
+		}))
+	}
+
+	fragments, err := server.DownlinkQueueList(ctx, multicastIDs)
+	if !a.So(err, should.BeNil) || !a.So(fragments, should.HaveLength, 3) {
+		t.FailNow()
+	}
+	for _, down := range fragments {
+		a.So(down.FPort, should.Equal, fragmentation.FPort)
+	}
+	a.So(fragments[0].FRMPayload, should.Resemble, marshal(fragmentation.DataFragment{
+		N:       1,
+		Payload: []byte("firm"),
+	}))
+	a.So(fragments[1].FRMPayload, should.Resemble, marshal(fragmentation.DataFragment{
+		N:       2,
+		Payload: []byte("ware"),
+	}))
+	a.So(fragments[2].FRMPayload[:3], should.Resemble, marshal(fragmentation.DataFragment{
+		N: 3,
+	}))
+	if a.So(fragments[0].ClassBC, should.NotBeNil) {
+		a.So(*fragments[0].ClassBC.AbsoluteTime, should.Equal, sessionTime)
+	}
+
+	// The target end devices take part in the session.
+	_, err = p.Start(ctx, req)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	stored, err := p.Get(ctx, &ttnpb.GetFUOTASessionRequest{EndDeviceIdentifiers: multicastIDs})
+	if a.So(err, should.BeNil) {
+		a.So(stored, should.Resemble, session)
+	}
+
+	_, err = p.Delete(ctx, &multicastIDs)
+	a.So(err, should.BeNil)
+	_, err = p.Get(ctx, &ttnpb.GetFUOTASessionRequest{EndDeviceIdentifiers: multicastIDs})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = p.Delete(ctx, &multicastIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestStartPushFailure(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx = withRights(ctx)
+
+	dir := writeFirmware(t)
+	defer os.RemoveAll(dir)
+
+	for _, failDeviceID := range []string{
+		targetIDs[1].DeviceID,
+		multicastIDs.DeviceID,
+	} {
+		t.Run(failDeviceID, func(t *testing.T) {
+			a := assertions.New(t)
+			p, server, sessions := newTestPackage(ctx, t, dir)
+			req := startRequest(time.Now().Add(time.Hour))
+
+			server.setFailDeviceID(failDeviceID)
+			_, err := p.Start(ctx, req)
+			a.So(errors.IsUnavailable(err), should.BeTrue)
+			a.So(sessions.sessions, should.BeEmpty)
+
+			// The target end devices are not locked out of new sessions.
+			server.setFailDeviceID("")
+			_, err = p.Start(ctx, req)
+			a.So(err, should.BeNil)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetup implements the LoRaWAN Remote Multicast Setup messages.
+package multicastsetup
+
+import (
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+const (
+	// PackageIdentifier is the identifier of the Remote Multicast Setup package.
+	PackageIdentifier = 2
+	// PackageVersion is the version of the Remote Multicast Setup package.
+	PackageVersion = 1
+	// FPort is the default FPort of the Remote Multicast Setup package.
+	FPort = 200
+)
+
+// Command identifiers.
+const (
+	PackageVersionCID  = 0x00
+	McGroupStatusCID   = 0x01
+	McGroupSetupCID    = 0x02
+	McGroupDeleteCID   = 0x03
+	McClassCSessionCID = 0x04
+	McClassBSessionCID = 0x05
+)
+
+const (
+	maxMcGroupID        = 3
+	maxSessionTimeout   = 0xf
+	maxPingSlotPeriod   = 7
+	dlFrequencyStepSize = 100
+	maxDLFrequency      = 0xffffff * dlFrequencyStepSize
+)
+
+var (
+	errUnknownCommand = errors.DefineInvalidArgument("unknown_command", "unknown command `{cid}`")
+	errPayloadLength  = errors.DefineInvalidArgument("payload_length", "invalid payload length for command `{cid}`")
+	errFieldRange     = errors.DefineInvalidArgument("field_range", "field `{field}` value `{value}` is out of range")
+)
+
+// PackageVersionReq requests the package identifier and version of the end device.
+type PackageVersionReq struct{}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (PackageVersionReq) MarshalBinary() ([]byte, error) {
+	return []byte{PackageVersionCID}, nil
+}
+
+// McGroupStatusReq requests the status of the multicast groups in the mask.
+type McGroupStatusReq struct {
+	ReqGroupMask uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McGroupStatusReq) MarshalBinary() ([]byte, error) {
+	if req.ReqGroupMask > 0xf {
+		return nil, errFieldRange.WithAttributes("field", "req_group_mask", "value", req.ReqGroupMask)
+	}
+	return []byte{McGroupStatusCID, req.ReqGroupMask}, nil
+}
+
+// McGroupSetupReq sets up a multicast group.
+type McGroupSetupReq struct {
+	McGroupID uint8
+	McAddr    types.DevAddr
+	// McKeyEncrypted is the multicast group key, encrypted with the McKEKey of the end device.
+	McKeyEncrypted types.AES128Key
+	MinMcFCount    uint32
+	MaxMcFCount    uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McGroupSetupReq) MarshalBinary() ([]byte, error) {
+	if req.McGroupID > maxMcGroupID {
+		return nil, errFieldRange.WithAttributes("field", "mc_group_id", "value", req.McGroupID)
+	}
+	b := make([]byte, 30)
+	b[0] = McGroupSetupCID
+	b[1] = req.McGroupID
+	copy(b[2:6], reverse(req.McAddr[:]))
+	copy(b[6:22], req.McKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], req.MinMcFCount)
+	binary.LittleEndian.PutUint32(b[26:30], req.MaxMcFCount)
+	return b, nil
+}
+
+// McGroupDeleteReq deletes a multicast group.
+type McGroupDeleteReq struct {
+	McGroupID uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McGroupDeleteReq) MarshalBinary() ([]byte, error) {
+	if req.McGroupID > maxMcGroupID {
+		return nil, errFieldRange.WithAttributes("field", "mc_group_id", "value", req.McGroupID)
+	}
+	return []byte{McGroupDeleteCID, req.McGroupID}, nil
+}
+
+func marshalSessionReq(cid, mcGroupID uint8, sessionTime uint32, param uint8, dlFrequency uint64, dr uint8) ([]byte, error) {
+	if mcGroupID > maxMcGroupID {
+		return nil, errFieldRange.WithAttributes("field", "mc_group_id", "value", mcGroupID)
+	}
+	if dlFrequency > maxDLFrequency || dlFrequency%dlFrequencyStepSize != 0 {
+		return nil, errFieldRange.WithAttributes("field", "dl_frequency", "value", dlFrequency)
+	}
+	if dr > 0xf {
+		return nil, errFieldRange.WithAttributes("field", "dr", "value", dr)
+	}
+	b := make([]byte, 11)
+	b[0] = cid
+	b[1] = mcGroupID
+	binary.LittleEndian.PutUint32(b[2:6], sessionTime)
+	b[6] = param
+	freq := uint32(dlFrequency / dlFrequencyStepSize)
+	b[7], b[8], b[9] = byte(freq), byte(freq>>8), byte(freq>>16)
+	b[10] = dr
+	return b, nil
+}
+
+// McClassCSessionReq sets up a class C multicast session.
+type McClassCSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start time of the session, in GPS epoch seconds modulo 2^32.
+	SessionTime uint32
+	// SessionTimeOut is the maximum duration of the session, as an exponent of 2 seconds.
+	SessionTimeOut uint8
+	// DLFrequency is the downlink frequency in Hz.
+	DLFrequency uint64
+	DR          uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McClassCSessionReq) MarshalBinary() ([]byte, error) {
+	if req.SessionTimeOut > maxSessionTimeout {
+		return nil, errFieldRange.WithAttributes("field", "session_time_out", "value", req.SessionTimeOut)
+	}
+	return marshalSessionReq(McClassCSessionCID, req.McGroupID, req.SessionTime, req.SessionTimeOut, req.DLFrequency, req.DR)
+}
+
+// McClassBSessionReq sets up a class B multicast session.
+type McClassBSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start time of the session, in GPS epoch seconds modulo 2^32.
+	// The session time must be the start of a beacon period, which is a multiple of 128 seconds.
+	SessionTime uint32
+	// TimeOut is the maximum duration of the session, as an exponent of 2 beacon periods.
+	TimeOut uint8
+	// Periodicity is the ping slot periodicity.
+	Periodicity uint8
+	// DLFrequency is the downlink frequency in Hz.
+	DLFrequency uint64
+	DR          uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req McClassBSessionReq) MarshalBinary() ([]byte, error) {
+	if req.TimeOut > maxSessionTimeout {
+		return nil, errFieldRange.WithAttributes("field", "time_out", "value", req.TimeOut)
+	}
+	if req.Periodicity > maxPingSlotPeriod {
+		return nil, errFieldRange.WithAttributes("field", "periodicity", "value", req.Periodicity)
+	}
+	return marshalSessionReq(McClassBSessionCID, req.McGroupID, req.SessionTime, req.Periodicity<<4|req.TimeOut, req.DLFrequency, req.DR)
+}
+
+// PackageVersionAns is the answer to PackageVersionReq.
+type PackageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// McGroupStatus is the status of a multicast group.
+type McGroupStatus struct {
+	McGroupID uint8
+	McAddr    types.DevAddr
+}
+
+// McGroupStatusAns is the answer to McGroupStatusReq.
+type McGroupStatusAns struct {
+	NbTotalGroups uint8
+	Groups        []McGroupStatus
+}
+
+// McGroupSetupAns is the answer to McGroupSetupReq.
+type McGroupSetupAns struct {
+	McGroupID uint8
+	IDError   bool
+}
+
+// McGroupDeleteAns is the answer to McGroupDeleteReq.
+type McGroupDeleteAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+}
+
+// McSessionAns is the answer to McClassCSessionReq and McClassBSessionReq.
+type McSessionAns struct {
+	// ClassB indicates whether this is the answer to McClassBSessionReq.
+	ClassB           bool
+	McGroupID        uint8
+	McGroupUndefined bool
+	FreqError        bool
+	DRError          bool
+	// TimeToStart is the number of seconds until the session starts. It is only set if the session is accepted.
+	TimeToStart uint32
+}
+
+// OK returns whether the end device accepted the multicast session.
+func (ans McSessionAns) OK() bool {
+	return !ans.McGroupUndefined && !ans.FreqError && !ans.DRError
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
+
+// UnmarshalAnswers parses the answers in an uplink payload.
+// The returned answers are of type PackageVersionAns, McGroupStatusAns, McGroupSetupAns, McGroupDeleteAns or McSessionAns.
+func UnmarshalAnswers(b []byte) ([]interface{}, error) {
+	var answers []interface{}
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		var n int
+		switch cid {
+		case PackageVersionCID:
+			n = 2
+		case McGroupStatusCID:
+			if len(b) < 1 {
+				return nil, errPayloadLength.WithAttributes("cid", cid)
+			}
+			for mask := b[0] & 0xf; mask != 0; mask &= mask - 1 {
+				n += 5
+			}
+			n++
+		case McGroupSetupCID, McGroupDeleteCID:
+			n = 1
+		case McClassCSessionCID, McClassBSessionCID:
+			n = 1
+			if len(b) > 0 && b[0]&0x38 == 0 {
+				n += 3
+			}
+		default:
+			return nil, errUnknownCommand.WithAttributes("cid", cid)
+		}
+		if len(b) < n {
+			return nil, errPayloadLength.WithAttributes("cid", cid)
+		}
+		p := b[:n]
+		b = b[n:]
+		switch cid {
+		case PackageVersionCID:
+			answers = append(answers, PackageVersionAns{
+				PackageIdentifier: p[0],
+				PackageVersion:    p[1],
+			})
+		case McGroupStatusCID:
+			ans := McGroupStatusAns{
+				NbTotalGroups: (p[0] >> 4) & 0x7,
+			}
+			for p = p[1:]; len(p) >= 5; p = p[5:] {
+				var addr types.DevAddr
+				copy(addr[:], reverse(p[1:5]))
+				ans.Groups = append(ans.Groups, McGroupStatus{
+					McGroupID: p[0] & 0x3,
+					McAddr:    addr,
+				})
+			}
+			answers = append(answers, ans)
+		case McGroupSetupCID:
+			answers = append(answers, McGroupSetupAns{
+				McGroupID: p[0] & 0x3,
+				IDError:   p[0]&0x4 != 0,
+			})
+		case McGroupDeleteCID:
+			answers = append(answers, McGroupDeleteAns{
+				McGroupID:        p[0] & 0x3,
+				McGroupUndefined: p[0]&0x4 != 0,
+			})
+		case McClassCSessionCID, McClassBSessionCID:
+			ans := McSessionAns{
+				ClassB:           cid == McClassBSessionCID,
+				McGroupID:        p[0] & 0x3,
+				McGroupUndefined: p[0]&0x20 != 0,
+				FreqError:        p[0]&0x10 != 0,
+				DRError:          p[0]&0x8 != 0,
+			}
+			if len(p) == 4 {
+				ans.TimeToStart = uint32(p[1]) | uint32(p[2])<<8 | uint32(p[3])<<16
+			}
+			answers = append(answers, ans)
+		}
+	}
+	return answers, nil
+}
@@ -0,0 +1,143 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetup_test
+
+import (
+	"encoding"
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/multicastsetup"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	for i, tc := range []struct {
+		Message  encoding.BinaryMarshaler
+		Expected []byte
+		Error    bool
+	}{
+		{
+			Message:  PackageVersionReq{},
+			Expected: []byte{0x00},
+		},
+		{
+			Message:  McGroupStatusReq{ReqGroupMask: 0x3},
+			Expected: []byte{0x01, 0x03},
+		},
+		{
+			Message: McGroupSetupReq{
+				McGroupID:      1,
+				McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				McKeyEncrypted: types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				MinMcFCount:    0x10,
+				MaxMcFCount:    0x0100,
+			},
+			Expected: []byte{
+				0x02, 0x01,
+				0x04, 0x03, 0x02, 0x01,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+				0x10, 0x00, 0x00, 0x00,
+				0x00, 0x01, 0x00, 0x00,
+			},
+		},
+		{
+			Message: McGroupSetupReq{McGroupID: 4},
+			Error:   true,
+		},
+		{
+			Message:  McGroupDeleteReq{McGroupID: 2},
+			Expected: []byte{0x03, 0x02},
+		},
+		{
+			Message: McClassCSessionReq{
+				McGroupID:      1,
+				SessionTime:    0x12345678,
+				SessionTimeOut: 10,
+				DLFrequency:    869525000,
+				DR:             3,
+			},
+			Expected: []byte{0x04, 0x01, 0x78, 0x56, 0x34, 0x12, 0x0a, 0xd2, 0xad, 0x84, 0x03},
+		},
+		{
+			Message: McClassCSessionReq{DLFrequency: 869525050},
+			Error:   true,
+		},
+		{
+			Message: McClassBSessionReq{
+				McGroupID:   0,
+				SessionTime: 0x80,
+				TimeOut:     2,
+				Periodicity: 5,
+				DLFrequency: 869525000,
+				DR:          3,
+			},
+			Expected: []byte{0x05, 0x00, 0x80, 0x00, 0x00, 0x00, 0x52, 0xd2, 0xad, 0x84, 0x03},
+		},
+		{
+			Message: McClassBSessionReq{Periodicity: 8},
+			Error:   true,
+		},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := tc.Message.MarshalBinary()
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestUnmarshalAnswers(t *testing.T) {
+	a := assertions.New(t)
+
+	answers, err := UnmarshalAnswers([]byte{
+		0x00, 0x02, 0x01,
+		0x01, 0x23, 0x00, 0x04, 0x03, 0x02, 0x01, 0x01, 0x08, 0x07, 0x06, 0x05,
+		0x02, 0x05,
+		0x03, 0x02,
+		0x04, 0x01, 0x10, 0x00, 0x00,
+		0x05, 0x12,
+	})
+	a.So(err, should.BeNil)
+	a.So(answers, should.Resemble, []interface{}{
+		PackageVersionAns{PackageIdentifier: 2, PackageVersion: 1},
+		McGroupStatusAns{
+			NbTotalGroups: 2,
+			Groups: []McGroupStatus{
+				{McGroupID: 0, McAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04}},
+				{McGroupID: 1, McAddr: types.DevAddr{0x05, 0x06, 0x07, 0x08}},
+			},
+		},
+		McGroupSetupAns{McGroupID: 1, IDError: true},
+		McGroupDeleteAns{McGroupID: 2},
+		McSessionAns{McGroupID: 1, TimeToStart: 0x10},
+		McSessionAns{ClassB: true, McGroupID: 2, FreqError: true},
+	})
+	a.So(answers[4].(McSessionAns).OK(), should.BeTrue)
+	a.So(answers[5].(McSessionAns).OK(), should.BeFalse)
+
+	_, err = UnmarshalAnswers([]byte{0x01, 0x01, 0x00})
+	a.So(err, should.NotBeNil)
+
+	_, err = UnmarshalAnswers([]byte{0x06})
+	a.So(err, should.NotBeNil)
+}
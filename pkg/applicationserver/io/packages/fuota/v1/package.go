@@ -0,0 +1,255 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fuotav1 implements the firmware update over the air application package.
+// The package implements the LoRa Alliance Remote Multicast Setup and Fragmented Data Block Transport specifications.
+// The clock of the end devices is synchronized by the Application Layer Clock Synchronization package.
+package fuotav1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/multicastsetup"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the FUOTA application package.
+const PackageName = "fuota-v1"
+
+// Config contains the FUOTA application package configuration.
+type Config struct {
+	Sessions SessionRegistry       `name:"-"`
+	Firmware config.BlobPathConfig `name:"firmware" description:"Bucket and path prefix of the firmware images"`
+}
+
+type fuotaPackage struct {
+	ctx      context.Context
+	server   io.Server
+	registry packages.Registry
+	sessions SessionRegistry
+	firmware config.BlobPathConfig
+}
+
+var errNoSessionRegistry = errors.DefineFailedPrecondition("no_session_registry", "no session registry configured")
+
+// New returns a new FUOTA application package.
+func New(ctx context.Context, server io.Server, registry packages.Registry, conf Config) (packages.ApplicationPackageHandler, error) {
+	if conf.Sessions == nil {
+		return nil, errNoSessionRegistry.New()
+	}
+	return &fuotaPackage{
+		ctx:      log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fuota/v1"),
+		server:   server,
+		registry: registry,
+		sessions: conf.Sessions,
+		firmware: conf.Firmware,
+	}, nil
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *fuotaPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: fragmentation.FPort,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *fuotaPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationFUOTASessionsServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *fuotaPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+// The package handles the uplink messages on the Remote Multicast Setup and Fragmented Data Block Transport FPorts.
+// Other uplink messages are ignored.
+func (p *fuotaPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fuota/v1")
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIDs, fmt.Sprintf("as:packages:fuota:%s", events.NewCorrelationID()))...)
+	switch msg.FPort {
+	case multicastsetup.FPort:
+		return p.handleMulticastSetup(ctx, up.EndDeviceIdentifiers, msg)
+	case fragmentation.FPort:
+		return p.handleFragmentation(ctx, up.EndDeviceIdentifiers, msg)
+	default:
+		return nil
+	}
+}
+
+// updateEndDeviceStatus updates the status of the end device in the session it takes part in.
+func (p *fuotaPackage) updateEndDeviceStatus(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.FUOTAEndDeviceStatus)) error {
+	session, err := p.sessions.GetByEndDeviceID(ctx, ids, nil)
+	if errors.IsNotFound(err) {
+		log.FromContext(ctx).Debug("End device does not take part in a FUOTA session")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = p.sessions.Set(ctx, session.EndDeviceIdentifiers, []string{"end_devices"}, func(stored *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if stored == nil {
+			return nil, nil, nil
+		}
+		for i := range stored.EndDevices {
+			if stored.EndDevices[i].EndDeviceIDs.ApplicationIdentifiers == ids.ApplicationIdentifiers &&
+				stored.EndDevices[i].EndDeviceIDs.DeviceID == ids.DeviceID {
+				f(&stored.EndDevices[i])
+			}
+		}
+		return stored, []string{"end_devices"}, nil
+	})
+	return err
+}
+
+// advanceState advances the state of the end device, unless the end device failed.
+func advanceState(status *ttnpb.FUOTAEndDeviceStatus, state ttnpb.FUOTAEndDeviceState) {
+	if status.State != ttnpb.FUOTA_END_DEVICE_FAILED && state > status.State {
+		status.State = state
+	}
+}
+
+func (p *fuotaPackage) handleMulticastSetup(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationUplink) error {
+	logger := log.FromContext(ctx)
+	answers, err := multicastsetup.UnmarshalAnswers(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse remote multicast setup uplink")
+		return nil
+	}
+	for _, ans := range answers {
+		var state ttnpb.FUOTAEndDeviceState
+		switch ans := ans.(type) {
+		case multicastsetup.McGroupSetupAns:
+			if ans.IDError {
+				logger.WithField("mc_group_id", ans.McGroupID).Warn("End device rejected multicast group setup")
+				state = ttnpb.FUOTA_END_DEVICE_FAILED
+			} else {
+				state = ttnpb.FUOTA_END_DEVICE_MULTICAST_GROUP_SETUP
+			}
+		case multicastsetup.McSessionAns:
+			if !ans.OK() {
+				logger.WithFields(log.Fields(
+					"mc_group_id", ans.McGroupID,
+					"mc_group_undefined", ans.McGroupUndefined,
+					"freq_error", ans.FreqError,
+					"dr_error", ans.DRError,
+				)).Warn("End device rejected multicast session")
+				state = ttnpb.FUOTA_END_DEVICE_FAILED
+			} else {
+				logger.WithField("time_to_start", ans.TimeToStart).Debug("End device scheduled multicast session")
+				state = ttnpb.FUOTA_END_DEVICE_READY
+			}
+		default:
+			continue
+		}
+		if err := p.updateEndDeviceStatus(ctx, ids, func(status *ttnpb.FUOTAEndDeviceStatus) {
+			advanceState(status, state)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *fuotaPackage) handleFragmentation(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationUplink) error {
+	logger := log.FromContext(ctx)
+	answers, err := fragmentation.UnmarshalAnswers(msg.FRMPayload)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to parse fragmentation uplink")
+		return nil
+	}
+	for _, ans := range answers {
+		var f func(*ttnpb.FUOTAEndDeviceStatus)
+		switch ans := ans.(type) {
+		case fragmentation.FragSessionSetupAns:
+			state := ttnpb.FUOTA_END_DEVICE_FRAGMENTATION_SESSION_SETUP
+			if !ans.OK() {
+				logger.WithFields(log.Fields(
+					"frag_index", ans.FragIndex,
+					"wrong_descriptor", ans.WrongDescriptor,
+					"frag_session_index_not_supported", ans.FragSessionIndexNotSupported,
+					"not_enough_memory", ans.NotEnoughMemory,
+					"encoding_unsupported", ans.EncodingUnsupported,
+				)).Warn("End device rejected fragmentation session")
+				state = ttnpb.FUOTA_END_DEVICE_FAILED
+			}
+			f = func(status *ttnpb.FUOTAEndDeviceStatus) {
+				advanceState(status, state)
+			}
+		case fragmentation.FragSessionStatusAns:
+			f = func(status *ttnpb.FUOTAEndDeviceStatus) {
+				status.ReceivedFragments = uint32(ans.NbFragReceived)
+				status.MissingFragments = uint32(ans.MissingFrag)
+			}
+		default:
+			continue
+		}
+		if err := p.updateEndDeviceStatus(ctx, ids, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type binaryMarshaler interface {
+	MarshalBinary() ([]byte, error)
+}
+
+func newDownlink(ctx context.Context, fPort uint32, msg binaryMarshaler) (*ttnpb.ApplicationDownlink, error) {
+	b, err := msg.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationDownlink{
+		FPort:          fPort,
+		FRMPayload:     b,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+	}, nil
+}
+
+func (p *fuotaPackage) push(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fPort uint32, msgs ...binaryMarshaler) error {
+	downlinks := make([]*ttnpb.ApplicationDownlink, 0, len(msgs))
+	for _, msg := range msgs {
+		down, err := newDownlink(ctx, fPort, msg)
+		if err != nil {
+			return err
+		}
+		downlinks = append(downlinks, down)
+	}
+	if err := p.server.DownlinkQueuePush(ctx, ids, downlinks); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to push downlink")
+		return err
+	}
+	return nil
+}
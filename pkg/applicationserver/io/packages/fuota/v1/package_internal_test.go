@@ -0,0 +1,163 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuotav1
+
+import (
+	"os"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/fragmentation"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/multicastsetup"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHandleUp(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	dir := writeFirmware(t)
+	defer os.RemoveAll(dir)
+	p, _, sessions := newTestPackage(ctx, t, dir)
+
+	_, err := sessions.Set(ctx, multicastIDs, nil, func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		return &ttnpb.FUOTASession{
+			EndDeviceIdentifiers: multicastIDs,
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0]},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		}, nil, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		PackageName: PackageName,
+	}
+	up := func(ids ttnpb.EndDeviceIdentifiers, fPort uint32, frmPayload []byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      fPort,
+					FRMPayload: frmPayload,
+				},
+			},
+		}
+	}
+
+	err = p.HandleUp(ctx, nil, nil, up(targetIDs[0], multicastsetup.FPort, []byte{multicastsetup.McGroupSetupCID, 0x01}))
+	a.So(errors.IsInternal(err), should.BeTrue)
+
+	for _, tc := range []struct {
+		Name       string
+		Up         *ttnpb.ApplicationUp
+		EndDevices []ttnpb.FUOTAEndDeviceStatus
+	}{
+		{
+			Name: "McGroupSetupAns",
+			Up:   up(targetIDs[0], multicastsetup.FPort, []byte{multicastsetup.McGroupSetupCID, 0x01}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_MULTICAST_GROUP_SETUP},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		},
+		{
+			Name: "FragSessionSetupAns",
+			Up:   up(targetIDs[0], fragmentation.FPort, []byte{fragmentation.FragSessionSetupCID, 0x00}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_FRAGMENTATION_SESSION_SETUP},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		},
+		{
+			Name: "McClassCSessionAns",
+			Up:   up(targetIDs[0], multicastsetup.FPort, []byte{multicastsetup.McClassCSessionCID, 0x01, 0x10, 0x00, 0x00}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		},
+		{
+			Name: "McGroupSetupAnsAfterReady",
+			Up:   up(targetIDs[0], multicastsetup.FPort, []byte{multicastsetup.McGroupSetupCID, 0x01}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		},
+		{
+			Name: "FragSessionStatusAns",
+			Up:   up(targetIDs[0], fragmentation.FPort, []byte{fragmentation.FragSessionStatusCID, 0x02, 0x00, 0x01, 0x00}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY, ReceivedFragments: 2, MissingFragments: 1},
+				{EndDeviceIDs: targetIDs[1]},
+			},
+		},
+		{
+			Name: "McGroupSetupAnsIDError",
+			Up:   up(targetIDs[1], multicastsetup.FPort, []byte{multicastsetup.McGroupSetupCID, 0x05}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY, ReceivedFragments: 2, MissingFragments: 1},
+				{EndDeviceIDs: targetIDs[1], State: ttnpb.FUOTA_END_DEVICE_FAILED},
+			},
+		},
+		{
+			Name: "McClassCSessionAnsAfterFailure",
+			Up:   up(targetIDs[1], multicastsetup.FPort, []byte{multicastsetup.McClassCSessionCID, 0x01, 0x10, 0x00, 0x00}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY, ReceivedFragments: 2, MissingFragments: 1},
+				{EndDeviceIDs: targetIDs[1], State: ttnpb.FUOTA_END_DEVICE_FAILED},
+			},
+		},
+		{
+			Name: "InvalidPayload",
+			Up:   up(targetIDs[0], fragmentation.FPort, []byte{0xff}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY, ReceivedFragments: 2, MissingFragments: 1},
+				{EndDeviceIDs: targetIDs[1], State: ttnpb.FUOTA_END_DEVICE_FAILED},
+			},
+		},
+		{
+			Name: "OtherEndDevice",
+			Up: up(ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: applicationIDs,
+				DeviceID:               "test-dev-3",
+			}, multicastsetup.FPort, []byte{multicastsetup.McGroupSetupCID, 0x05}),
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: targetIDs[0], State: ttnpb.FUOTA_END_DEVICE_READY, ReceivedFragments: 2, MissingFragments: 1},
+				{EndDeviceIDs: targetIDs[1], State: ttnpb.FUOTA_END_DEVICE_FAILED},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			if !a.So(p.HandleUp(ctx, nil, assoc, tc.Up), should.BeNil) {
+				t.FailNow()
+			}
+			session, err := sessions.Get(ctx, multicastIDs, nil)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(session.EndDevices, should.Resemble, tc.EndDevices)
+		})
+	}
+}
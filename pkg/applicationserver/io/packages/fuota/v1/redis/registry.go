@@ -0,0 +1,243 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements a Redis-backed session registry for the FUOTA application package.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+)
+
+// appendImplicitSessionGetPaths appends implicit ttnpb.FUOTASession get paths to paths.
+func appendImplicitSessionGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+	), paths...)
+}
+
+func applySessionFieldMask(dst, src *ttnpb.FUOTASession, paths ...string) (*ttnpb.FUOTASession, error) {
+	if dst == nil {
+		dst = &ttnpb.FUOTASession{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// SessionRegistry is a Redis registry for FUOTA sessions.
+// Sessions are stored by the unique identifier of the multicast end device, and indexed by the unique identifiers
+// of the end devices that take part in the session.
+type SessionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *SessionRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *SessionRegistry) endDeviceKey(uid string) string {
+	return r.Redis.Key("end-devices", uid)
+}
+
+// Get implements fuotav1.SessionRegistry.
+func (r *SessionRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get fuota session").End()
+
+	pb := &ttnpb.FUOTASession{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applySessionFieldMask(nil, pb, appendImplicitSessionGetPaths(paths...)...)
+}
+
+// GetByEndDeviceID implements fuotav1.SessionRegistry.
+func (r *SessionRegistry) GetByEndDeviceID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get fuota session by end device id").End()
+
+	uid, err := r.Redis.Get(r.endDeviceKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pb := &ttnpb.FUOTASession{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(uid)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applySessionFieldMask(nil, pb, appendImplicitSessionGetPaths(paths...)...)
+}
+
+func endDeviceUIDs(ctx context.Context, pb *ttnpb.FUOTASession) []string {
+	if pb == nil {
+		return nil
+	}
+	uids := make([]string, 0, len(pb.EndDevices))
+	for _, status := range pb.EndDevices {
+		uids = append(uids, unique.ID(ctx, status.EndDeviceIDs))
+	}
+	return uids
+}
+
+// Set implements fuotav1.SessionRegistry.
+func (r *SessionRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error)) (*ttnpb.FUOTASession, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	defer trace.StartRegion(ctx, "set fuota session").End()
+
+	var pb *ttnpb.FUOTASession
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		cmd := ttnredis.GetProto(tx, uk)
+		stored := &ttnpb.FUOTASession{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+		storedUIDs := endDeviceUIDs(ctx, stored)
+
+		gets = appendImplicitSessionGetPaths(gets...)
+
+		var err error
+		if stored != nil {
+			pb, err = applySessionFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applySessionFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				for _, devUID := range storedUIDs {
+					p.Del(r.endDeviceKey(devUID))
+				}
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.FUOTASession{}
+			}
+
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(append(sets[:0:0], sets...),
+				"updated_at",
+			)
+
+			updated := &ttnpb.FUOTASession{}
+			if stored == nil {
+				if err := ttnpb.RequireFields(sets,
+					"ids.application_ids",
+					"ids.device_id",
+				); err != nil {
+					return errInvalidFieldmask.WithCause(err)
+				}
+
+				pb.CreatedAt = pb.UpdatedAt
+				sets = append(sets, "created_at")
+
+				updated, err = applySessionFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+				if updated.ApplicationID != ids.ApplicationID || updated.DeviceID != ids.DeviceID {
+					return errInvalidIdentifiers.New()
+				}
+			} else {
+				if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+					return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+				}
+				if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+					return errReadOnlyField.WithAttributes("field", "ids.device_id")
+				}
+				updated, err = applySessionFieldMask(stored, pb, sets...)
+				if err != nil {
+					return err
+				}
+			}
+			if err := updated.ValidateFields(sets...); err != nil {
+				return err
+			}
+
+			removed := make(map[string]struct{})
+			for _, devUID := range storedUIDs {
+				removed[devUID] = struct{}{}
+			}
+			added := endDeviceUIDs(ctx, updated)
+			for _, devUID := range added {
+				delete(removed, devUID)
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				if _, err := ttnredis.SetProto(p, uk, updated, 0); err != nil {
+					return err
+				}
+				for devUID := range removed {
+					p.Del(r.endDeviceKey(devUID))
+				}
+				for _, devUID := range added {
+					p.Set(r.endDeviceKey(devUID), uid, 0)
+				}
+				return nil
+			}
+
+			pb, err = applySessionFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
@@ -0,0 +1,142 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	fuotav1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fuota/v1/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ fuotav1.SessionRegistry = &SessionRegistry{}
+
+func TestSessionRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "fuota_test", "sessions")
+	defer flush()
+	defer cl.Close()
+
+	reg := &SessionRegistry{
+		Redis: cl,
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "mc1",
+	}
+	dev1 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+	dev2 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev2",
+	}
+	firmware := &ttnpb.FUOTAFirmware{
+		Bucket: "firmware",
+		Path:   "v1.bin",
+	}
+
+	_, err := reg.Get(ctx, ids, []string{"firmware"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	pb, err := reg.Set(ctx, ids, []string{"firmware", "end_devices"}, func(pb *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		a.So(pb, should.BeNil)
+		return &ttnpb.FUOTASession{
+			EndDeviceIdentifiers: ids,
+			Firmware:             firmware,
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{EndDeviceIDs: dev1},
+				{EndDeviceIDs: dev2},
+			},
+		}, []string{"ids", "firmware", "end_devices"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.EndDeviceIdentifiers, should.Resemble, ids)
+	a.So(pb.Firmware, should.Resemble, firmware)
+	a.So(pb.EndDevices, should.HaveLength, 2)
+	a.So(pb.CreatedAt, should.NotBeZeroValue)
+	a.So(pb.UpdatedAt, should.Equal, pb.CreatedAt)
+
+	pb, err = reg.Get(ctx, ids, []string{"firmware"})
+	if a.So(err, should.BeNil) {
+		a.So(pb.Firmware, should.Resemble, firmware)
+		a.So(pb.EndDevices, should.BeEmpty)
+	}
+
+	for _, dev := range []ttnpb.EndDeviceIdentifiers{dev1, dev2} {
+		pb, err = reg.GetByEndDeviceID(ctx, dev, []string{"firmware"})
+		if a.So(err, should.BeNil) {
+			a.So(pb.EndDeviceIdentifiers, should.Resemble, ids)
+			a.So(pb.Firmware, should.Resemble, firmware)
+		}
+	}
+
+	pb, err = reg.Set(ctx, ids, []string{"end_devices"}, func(pb *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		if a.So(pb, should.NotBeNil) {
+			a.So(pb.EndDevices, should.HaveLength, 2)
+		}
+		return &ttnpb.FUOTASession{
+			EndDevices: []ttnpb.FUOTAEndDeviceStatus{
+				{
+					EndDeviceIDs: dev1,
+					State:        ttnpb.FUOTA_END_DEVICE_READY,
+				},
+			},
+		}, []string{"end_devices"}, nil
+	})
+	if a.So(err, should.BeNil) {
+		a.So(pb.EndDevices, should.Resemble, []ttnpb.FUOTAEndDeviceStatus{
+			{
+				EndDeviceIDs: dev1,
+				State:        ttnpb.FUOTA_END_DEVICE_READY,
+			},
+		})
+		a.So(pb.UpdatedAt, should.HappenAfter, pb.CreatedAt)
+	}
+
+	_, err = reg.GetByEndDeviceID(ctx, dev2, nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = reg.Set(ctx, ids, nil, func(pb *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		return &ttnpb.FUOTASession{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceID:               "mc2",
+			},
+		}, []string{"ids.device_id"}, nil
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = reg.Set(ctx, ids, nil, func(pb *ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error) {
+		return nil, nil, nil
+	})
+	a.So(err, should.BeNil)
+
+	_, err = reg.Get(ctx, ids, nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = reg.GetByEndDeviceID(ctx, dev1, nil)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,31 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fuotav1
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// SessionRegistry is a registry for FUOTA sessions.
+type SessionRegistry interface {
+	// Get returns the session of the multicast end device by its identifiers.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error)
+	// GetByEndDeviceID returns the session that the end device with the given identifiers takes part in.
+	GetByEndDeviceID(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.FUOTASession, error)
+	// Set creates, updates or deletes the session of the multicast end device by its identifiers.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.FUOTASession) (*ttnpb.FUOTASession, []string, error)) (*ttnpb.FUOTASession, error)
+}
@@ -0,0 +1,46 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoIdentifiers          = errors.DefineInvalidArgument("no_identifiers", "no application or end device identifiers")
+	errConflictingIdentifiers = errors.DefineInvalidArgument("conflicting_identifiers", "application and end device identifiers cannot be used together")
+)
+
+// GetStoredApplicationUp implements ttnpb.ApplicationUpStorageServer.
+func (p *storagePackage) GetStoredApplicationUp(req *ttnpb.GetStoredApplicationUpRequest, srv ttnpb.ApplicationUpStorage_GetStoredApplicationUpServer) error {
+	ctx := srv.Context()
+	var appIDs ttnpb.ApplicationIdentifiers
+	switch {
+	case req.ApplicationIDs != nil && req.EndDeviceIDs != nil:
+		return errConflictingIdentifiers.New()
+	case req.ApplicationIDs != nil:
+		appIDs = *req.ApplicationIDs
+	case req.EndDeviceIDs != nil:
+		appIDs = req.EndDeviceIDs.ApplicationIdentifiers
+	default:
+		return errNoIdentifiers.New()
+	}
+	if err := rights.RequireApplication(ctx, appIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	return p.storage.Range(ctx, req, srv.Send)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements a Redis-backed storage for the Storage Integration.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	payloadKey = "payload"
+
+	rangeBatchSize = 256
+)
+
+var errInvalidPayload = errors.DefineCorruption("invalid_payload", "invalid payload of stream entry `{id}`")
+
+// ApplicationUpStorage is a Redis storage for upstream application messages.
+// Messages are stored in a stream per end device and a stream per application.
+type ApplicationUpStorage struct {
+	Redis     *ttnredis.Client
+	Retention storage.RetentionConfig
+}
+
+func (s *ApplicationUpStorage) deviceKey(uid string) string {
+	return s.Redis.Key("devices", uid)
+}
+
+func (s *ApplicationUpStorage) applicationKey(uid string) string {
+	return s.Redis.Key("applications", uid)
+}
+
+// Store implements storage.Storage.
+func (s *ApplicationUpStorage) Store(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	defer trace.StartRegion(ctx, "store application up").End()
+
+	payload, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	_, err = s.Redis.Pipelined(func(p redis.Pipeliner) error {
+		for _, stream := range []struct {
+			key   string
+			limit int64
+		}{
+			{
+				key:   s.deviceKey(unique.ID(ctx, up.EndDeviceIdentifiers)),
+				limit: s.Retention.DeviceLimit,
+			},
+			{
+				key:   s.applicationKey(unique.ID(ctx, up.ApplicationIdentifiers)),
+				limit: s.Retention.ApplicationLimit,
+			},
+		} {
+			p.XAdd(&redis.XAddArgs{
+				Stream:       stream.key,
+				MaxLenApprox: stream.limit,
+				Values: map[string]interface{}{
+					payloadKey: payload,
+				},
+			})
+			if s.Retention.TTL > 0 {
+				p.PExpire(stream.key, s.Retention.TTL)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+func streamID(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// streamIDTime returns the time at which the stream entry with the given ID was added.
+func streamIDTime(id string) (time.Time, error) {
+	ms, err := strconv.ParseInt(strings.SplitN(id, "-", 2)[0], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), nil
+}
+
+// Range implements storage.Storage.
+func (s *ApplicationUpStorage) Range(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest, f func(*ttnpb.ApplicationUp) error) error {
+	defer trace.StartRegion(ctx, "range application up").End()
+
+	var key string
+	if req.EndDeviceIDs != nil {
+		key = s.deviceKey(unique.ID(ctx, req.EndDeviceIDs))
+	} else {
+		key = s.applicationKey(unique.ID(ctx, req.ApplicationIDs))
+	}
+
+	// The stream ID is the time at which the message was stored, which is unrelated to the time at which the
+	// message was received. Therefore the stream query is only bounded by the retention, and the time range of the
+	// request is matched against the reception time of each message.
+	low, high := "-", "+"
+	var notBefore time.Time
+	if s.Retention.TTL > 0 {
+		notBefore = time.Now().Add(-s.Retention.TTL)
+		low = streamID(notBefore)
+	}
+	reverse := req.Order == "-received_at"
+
+	var limit uint32
+	if req.Limit != nil {
+		limit = req.Limit.Value
+	}
+	var n uint32
+	var lastID string
+	for {
+		var cmd *redis.XMessageSliceCmd
+		if reverse {
+			cmd = s.Redis.XRevRangeN(key, high, low, rangeBatchSize)
+		} else {
+			cmd = s.Redis.XRangeN(key, low, high, rangeBatchSize)
+		}
+		msgs, err := cmd.Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for _, msg := range msgs {
+			if msg.ID == lastID {
+				continue
+			}
+			if !notBefore.IsZero() {
+				if t, err := streamIDTime(msg.ID); err == nil && t.Before(notBefore) {
+					continue
+				}
+			}
+			payload, ok := msg.Values[payloadKey].(string)
+			if !ok {
+				return errInvalidPayload.WithAttributes("id", msg.ID)
+			}
+			up := &ttnpb.ApplicationUp{}
+			if err := ttnredis.UnmarshalProto(payload, up); err != nil {
+				return errInvalidPayload.WithAttributes("id", msg.ID).WithCause(err)
+			}
+			if !storage.Match(req, up) {
+				continue
+			}
+			if err := f(up); err != nil {
+				return err
+			}
+			n++
+			if limit > 0 && n >= limit {
+				return nil
+			}
+		}
+		if len(msgs) < rangeBatchSize {
+			return nil
+		}
+		lastID = msgs[len(msgs)-1].ID
+		if reverse {
+			high = lastID
+		} else {
+			low = lastID
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/storage"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestApplicationUpStorage(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "storage_test")
+	defer flush()
+	defer cl.Close()
+
+	s := &ApplicationUpStorage{
+		Redis: cl,
+		Retention: storage.RetentionConfig{
+			TTL:              time.Hour,
+			DeviceLimit:      100,
+			ApplicationLimit: 100,
+		},
+	}
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "app1"}
+	dev1IDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "dev1"}
+	dev2IDs := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appIDs, DeviceID: "dev2"}
+
+	// The reception times are after the time at which the messages are stored, to verify that the messages are
+	// filtered on reception time and not on the time at which they were added to the stream.
+	start := time.Now().UTC().Add(time.Minute)
+	var ups []*ttnpb.ApplicationUp
+	for i, ids := range []ttnpb.EndDeviceIdentifiers{dev1IDs, dev2IDs, dev1IDs, dev1IDs} {
+		receivedAt := start.Add(time.Duration(i) * time.Second)
+		up := &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ids,
+			ReceivedAt:           &receivedAt,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      uint32(i + 1),
+					FRMPayload: []byte{byte(i)},
+				},
+			},
+		}
+		if i == 3 {
+			up.Up = &ttnpb.ApplicationUp_DownlinkQueued{
+				DownlinkQueued: &ttnpb.ApplicationDownlink{
+					FPort: 1,
+				},
+			}
+		}
+		if !a.So(s.Store(ctx, up), should.BeNil) {
+			t.FailNow()
+		}
+		ups = append(ups, up)
+	}
+
+	collect := func(req *ttnpb.GetStoredApplicationUpRequest) []*ttnpb.ApplicationUp {
+		var res []*ttnpb.ApplicationUp
+		if !a.So(s.Range(ctx, req, func(up *ttnpb.ApplicationUp) error {
+			res = append(res, up)
+			return nil
+		}), should.BeNil) {
+			t.FailNow()
+		}
+		return res
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Request  *ttnpb.GetStoredApplicationUpRequest
+		Expected []*ttnpb.ApplicationUp
+	}{
+		{
+			Name: "Application",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+			},
+			Expected: ups,
+		},
+		{
+			Name: "Device",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &dev1IDs,
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[0], ups[2], ups[3]},
+		},
+		{
+			Name: "DeviceDescending",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				EndDeviceIDs: &dev1IDs,
+				Order:        "-received_at",
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[3], ups[2], ups[0]},
+		},
+		{
+			Name: "Type",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				Type:           "downlink_queued",
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[3]},
+		},
+		{
+			Name: "FPort",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				FPort:          &pbtypes.UInt32Value{Value: 2},
+			},
+			Expected: []*ttnpb.ApplicationUp{ups[1]},
+		},
+		{
+			Name: "Limit",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				Limit:          &pbtypes.UInt32Value{Value: 2},
+			},
+			Expected: ups[:2],
+		},
+		{
+			Name: "After",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				After:          ups[2].ReceivedAt,
+			},
+			Expected: ups[2:],
+		},
+		{
+			Name: "AfterBefore",
+			Request: &ttnpb.GetStoredApplicationUpRequest{
+				ApplicationIDs: &appIDs,
+				After:          ups[1].ReceivedAt,
+				Before:         ups[2].ReceivedAt,
+			},
+			Expected: ups[1:3],
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a.So(collect(tc.Request), should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage implements the Storage Integration application package.
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// PackageName is the name of the Storage Integration application package.
+const PackageName = "storage-integration"
+
+// Storage stores upstream application messages.
+type Storage interface {
+	// Store persists the given upstream message.
+	Store(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Range calls f for each stored upstream message that matches the request, in the requested order.
+	// Range stops iterating if f returns an error, and returns that error.
+	Range(ctx context.Context, req *ttnpb.GetStoredApplicationUpRequest, f func(*ttnpb.ApplicationUp) error) error
+}
+
+// RetentionConfig configures how long and how many upstream messages are retained.
+type RetentionConfig struct {
+	TTL              time.Duration `name:"ttl" description:"Time to retain upstream messages"`
+	DeviceLimit      int64         `name:"device-limit" description:"Maximum number of upstream messages to retain per end device"`
+	ApplicationLimit int64         `name:"application-limit" description:"Maximum number of upstream messages to retain per application"`
+}
+
+// Config contains the Storage Integration configuration.
+type Config struct {
+	Storage   Storage         `name:"-"`
+	Retention RetentionConfig `name:"retention" description:"Retention configuration of stored upstream messages"`
+}
+
+// UpType returns the type of the upstream message, as used by ttnpb.GetStoredApplicationUpRequest.
+// UpType returns an empty string for unknown message types.
+func UpType(up *ttnpb.ApplicationUp) string {
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message"
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept"
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack"
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack"
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent"
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed"
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued"
+	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
+		return "downlink_queue_invalidated"
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved"
+	case *ttnpb.ApplicationUp_ServiceData:
+		return "service_data"
+	default:
+		return ""
+	}
+}
+
+// Match returns whether the upstream message matches the type, FPort and time filters of the request.
+// The limit and order of the request are not taken into account.
+func Match(req *ttnpb.GetStoredApplicationUpRequest, up *ttnpb.ApplicationUp) bool {
+	if req.Type != "" && req.Type != UpType(up) {
+		return false
+	}
+	if req.FPort != nil {
+		msg := up.GetUplinkMessage()
+		if msg == nil || msg.FPort != req.FPort.Value {
+			return false
+		}
+	}
+	if req.After != nil || req.Before != nil {
+		if up.ReceivedAt == nil {
+			return false
+		}
+		if req.After != nil && up.ReceivedAt.Before(*req.After) {
+			return false
+		}
+		if req.Before != nil && up.ReceivedAt.After(*req.Before) {
+			return false
+		}
+	}
+	return true
+}
+
+type storagePackage struct {
+	ctx     context.Context
+	server  io.Server
+	storage Storage
+}
+
+var errNoStorage = errors.DefineFailedPrecondition("no_storage", "no storage configured")
+
+// New returns a new Storage Integration application package.
+func New(ctx context.Context, server io.Server, conf Config) (packages.ApplicationPackageHandler, error) {
+	if conf.Storage == nil {
+		return nil, errNoStorage.New()
+	}
+	return &storagePackage{
+		ctx:     log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/storage"),
+		server:  server,
+		storage: conf.Storage,
+	}, nil
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *storagePackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name: PackageName,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *storagePackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationUpStorageServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *storagePackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationUpStorageHandler(p.ctx, s, conn)
+}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *storagePackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+	if UpType(up) == "" {
+		return nil
+	}
+	// Storage errors are not returned, as that would prevent the other application packages from handling the message.
+	if err := p.storage.Store(ctx, up); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to store upstream message")
+	}
+	return nil
+}
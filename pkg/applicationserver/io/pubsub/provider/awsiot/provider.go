@@ -0,0 +1,244 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsiot implements the AWS IoT Core provider using the mqtt driver.
+package awsiot
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// signingName is the service name used to sign requests to the AWS IoT Core data plane.
+	signingName = "iotdevicegateway"
+	// endpointType is the type of the AWS IoT Core endpoint that is discovered if no endpoint address is given.
+	endpointType = "iot:Data-ATS"
+
+	// maxTopicLength is the maximum length of an AWS IoT Core topic name in bytes.
+	maxTopicLength = 256
+	// maxTopicLevels is the maximum number of levels of an AWS IoT Core topic name.
+	maxTopicLevels = 8
+	// reservedTopicPrefix is the prefix of the topics reserved by AWS IoT Core.
+	reservedTopicPrefix = "$"
+)
+
+// endpointURL returns the MQTT over WebSocket URL of the given endpoint address.
+// It is a variable so that it can be replaced in tests.
+var endpointURL = func(address string) string {
+	return fmt.Sprintf("wss://%s:443/mqtt", address)
+}
+
+// tlsEndpointURL returns the MQTT over TLS URL of the given endpoint address.
+// It is a variable so that it can be replaced in tests.
+var tlsEndpointURL = func(address string) string {
+	return fmt.Sprintf("mqtts://%s:8883", address)
+}
+
+// rootCAs are the certificate authorities that are used to verify the endpoint certificate.
+// If nil, the system certificate pool is used. It is a variable so that it can be replaced in tests.
+var rootCAs *x509.CertPool
+
+type impl struct {
+}
+
+var (
+	errEndpointDiscovery = errors.DefineUnavailable("endpoint_discovery", "discover AWS IoT Core endpoint")
+	errNoEndpoint        = errors.DefineNotFound("no_endpoint", "no AWS IoT Core endpoint found")
+	errSignRequest       = errors.Define("sign_request", "sign AWS IoT Core request")
+	errInvalidTopic      = errors.DefineInvalidArgument("invalid_topic", "invalid AWS IoT Core topic `{topic}`")
+	errClientCertificate = errors.DefineInvalidArgument("client_certificate", "invalid AWS IoT Core client certificate")
+)
+
+func newSession(settings *ttnpb.ApplicationPubSub_AWSIoTProvider) (*session.Session, *credentials.Credentials, error) {
+	config := aws.NewConfig().WithRegion(settings.Region)
+	if accessKey := settings.GetAccessKey(); accessKey != nil {
+		config = config.WithCredentials(credentials.NewStaticCredentials(
+			accessKey.AccessKeyID,
+			accessKey.SecretAccessKey,
+			accessKey.SessionToken,
+		))
+	}
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	creds := sess.Config.Credentials
+	if assumeRole := settings.GetAssumeRole(); assumeRole != nil {
+		creds = stscreds.NewCredentials(sess, assumeRole.ARN, func(p *stscreds.AssumeRoleProvider) {
+			if assumeRole.ExternalID != "" {
+				p.ExternalID = aws.String(assumeRole.ExternalID)
+			}
+			if assumeRole.SessionDuration != nil {
+				p.Duration = *assumeRole.SessionDuration
+			}
+		})
+		sess = sess.Copy(aws.NewConfig().WithCredentials(creds))
+	}
+	return sess, creds, nil
+}
+
+// clientTLSConfig returns the TLS configuration for mutual TLS with the client certificate in the settings.
+// It returns nil if no client certificate is set.
+func clientTLSConfig(settings *ttnpb.ApplicationPubSub_AWSIoTProvider) (*tls.Config, error) {
+	if len(settings.TLSClientCert) == 0 && len(settings.TLSClientKey) == 0 {
+		return nil, nil
+	}
+	cert, err := tls.X509KeyPair(settings.TLSClientCert, settings.TLSClientKey)
+	if err != nil {
+		return nil, errClientCertificate.WithCause(err)
+	}
+	return &tls.Config{
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{cert},
+	}, nil
+}
+
+func discoverEndpoint(ctx context.Context, sess *session.Session) (string, error) {
+	res, err := iot.New(sess).DescribeEndpointWithContext(ctx, &iot.DescribeEndpointInput{
+		EndpointType: aws.String(endpointType),
+	})
+	if err != nil {
+		return "", errEndpointDiscovery.WithCause(err)
+	}
+	if res.EndpointAddress == nil || *res.EndpointAddress == "" {
+		return "", errNoEndpoint.New()
+	}
+	return *res.EndpointAddress, nil
+}
+
+// signedHeadersProvider returns a mqtt.HTTPHeadersProvider that signs the WebSocket upgrade request
+// with AWS Signature Version 4. The headers are signed on every (re)connect, since signatures expire.
+func signedHeadersProvider(creds *credentials.Credentials, region, address string) mqtt.HTTPHeadersProvider {
+	signer := v4.NewSigner(creds)
+	return func(ctx context.Context) (http.Header, error) {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://%s/mqtt", address), nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		if _, err := signer.Sign(req, nil, signingName, region, time.Now()); err != nil {
+			return nil, errSignRequest.WithCause(err)
+		}
+		return req.Header, nil
+	}
+}
+
+func validateTopic(topic string) error {
+	if topic == "" {
+		return nil
+	}
+	if len(topic) > maxTopicLength ||
+		strings.Count(topic, "/") >= maxTopicLevels ||
+		strings.HasPrefix(topic, reservedTopicPrefix) {
+		return errInvalidTopic.WithAttributes("topic", topic)
+	}
+	return nil
+}
+
+func validateTopics(topics provider.Topics) error {
+	for _, message := range []*ttnpb.ApplicationPubSub_Message{
+		topics.GetUplinkMessage(),
+		topics.GetJoinAccept(),
+		topics.GetDownlinkAck(),
+		topics.GetDownlinkNack(),
+		topics.GetDownlinkSent(),
+		topics.GetDownlinkFailed(),
+		topics.GetDownlinkQueued(),
+		topics.GetLocationSolved(),
+		topics.GetServiceData(),
+		topics.GetDownlinkPush(),
+		topics.GetDownlinkReplace(),
+	} {
+		if message == nil {
+			continue
+		}
+		if err := validateTopic(strings.Trim(topics.GetBaseTopic()+"/"+message.Topic, "/")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OpenConnection implements provider.Provider using the MQTT driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	pb, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AWSIoT)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	settings := pb.AWSIoT
+
+	var topics provider.Topics = target
+	if def := settings.GetDefault(); def != nil {
+		topics = &defaultIntegrationTopics{stackName: def.StackName}
+	}
+	if err := validateTopics(topics); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := clientTLSConfig(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, creds, err := newSession(settings)
+	if err != nil {
+		return nil, err
+	}
+	address := settings.EndpointAddress
+	if address == "" {
+		if address, err = discoverEndpoint(ctx, sess); err != nil {
+			return nil, err
+		}
+	}
+	ctx = log.NewContextWithField(ctx, "endpoint_address", address)
+
+	mqttSettings := mqtt.Settings{
+		// AWS IoT Core disconnects clients that connect with a client ID that is already in use.
+		ClientID: fmt.Sprintf("ttn-lw-as-%s", random.String(16)),
+		// AWS IoT Core does not support QoS 2.
+		PublishQoS:   1,
+		SubscribeQoS: 1,
+	}
+	if tlsConfig != nil {
+		// Clients that authenticate with an X.509 client certificate connect using MQTT over TLS.
+		mqttSettings.URL = tlsEndpointURL(address)
+		mqttSettings.TLS = tlsConfig
+	} else {
+		mqttSettings.URL = endpointURL(address)
+		mqttSettings.HTTPHeadersProvider = signedHeadersProvider(creds, settings.Region, address)
+	}
+	return mqtt.OpenConnection(ctx, mqttSettings, topics)
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AWSIoT{}, impl{})
+}
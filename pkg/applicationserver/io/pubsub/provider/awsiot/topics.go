@@ -0,0 +1,98 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiot
+
+import (
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultIntegrationTopics are the topics of the default AWS IoT Core integration.
+// The topics are namespaced by the name of the CloudFormation stack of the integration, so that the rules of the
+// stack only act on the messages of the stack:
+//
+//	lorawan/{stack_name}/uplink
+//	lorawan/{stack_name}/downlink/push
+//	...
+type defaultIntegrationTopics struct {
+	stackName string
+}
+
+func (t *defaultIntegrationTopics) message(topic string) *ttnpb.ApplicationPubSub_Message {
+	return &ttnpb.ApplicationPubSub_Message{
+		Topic: topic,
+	}
+}
+
+// GetBaseTopic implements provider.Topics.
+func (t *defaultIntegrationTopics) GetBaseTopic() string {
+	return fmt.Sprintf("lorawan/%s", t.stackName)
+}
+
+// GetUplinkMessage implements provider.Topics.
+func (t *defaultIntegrationTopics) GetUplinkMessage() *ttnpb.ApplicationPubSub_Message {
+	return t.message("uplink")
+}
+
+// GetJoinAccept implements provider.Topics.
+func (t *defaultIntegrationTopics) GetJoinAccept() *ttnpb.ApplicationPubSub_Message {
+	return t.message("join")
+}
+
+// GetDownlinkAck implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkAck() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/ack")
+}
+
+// GetDownlinkNack implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkNack() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/nack")
+}
+
+// GetDownlinkSent implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkSent() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/sent")
+}
+
+// GetDownlinkFailed implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkFailed() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/failed")
+}
+
+// GetDownlinkQueued implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkQueued() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/queued")
+}
+
+// GetLocationSolved implements provider.Topics.
+func (t *defaultIntegrationTopics) GetLocationSolved() *ttnpb.ApplicationPubSub_Message {
+	return t.message("location/solved")
+}
+
+// GetServiceData implements provider.Topics.
+func (t *defaultIntegrationTopics) GetServiceData() *ttnpb.ApplicationPubSub_Message {
+	return t.message("service/data")
+}
+
+// GetDownlinkPush implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkPush() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/push")
+}
+
+// GetDownlinkReplace implements provider.Topics.
+func (t *defaultIntegrationTopics) GetDownlinkReplace() *ttnpb.ApplicationPubSub_Message {
+	return t.message("downlink/replace")
+}
@@ -0,0 +1,373 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+var (
+	errNilProducer      = errors.DefineInvalidArgument("nil_producer", "producer is nil")
+	errNilConsumerGroup = errors.DefineInvalidArgument("nil_consumer_group", "consumer group is nil")
+)
+
+// OpenTopic returns a *pubsub.Topic that publishes to the given topic name with the given Kafka producer.
+func OpenTopic(producer sarama.SyncProducer, topicName string) (*pubsub.Topic, error) {
+	dt, err := openDriverTopic(producer, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopic(producer sarama.SyncProducer, topicName string) (driver.Topic, error) {
+	if producer == nil {
+		return nil, errNilProducer.New()
+	}
+	dt := &topic{
+		producer: producer,
+		topic:    topicName,
+	}
+	return dt, nil
+}
+
+var errPublishFailed = errors.Define("publish_failed", "publish to Kafka topic failed")
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.producer == nil {
+		return errNilProducer.New()
+	}
+	pms := make([]*sarama.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		pm, err := encodeMessage(t.topic, msg)
+		if err != nil {
+			return err
+		}
+		pms = append(pms, pm)
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := t.producer.SendMessages(pms); err != nil {
+		return errPublishFailed.WithCause(err)
+	}
+	return nil
+}
+
+// encodeMessage converts the driver message to a Kafka message.
+// The metadata is sent as message headers. The message key can be set with provider.WithMessageKey.
+func encodeMessage(topicName string, dm *driver.Message) (*sarama.ProducerMessage, error) {
+	pm := &sarama.ProducerMessage{
+		Topic: topicName,
+		Value: sarama.ByteEncoder(dm.Body),
+	}
+	if len(dm.Metadata) > 0 {
+		keys := make([]string, 0, len(dm.Metadata))
+		for k := range dm.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pm.Headers = make([]sarama.RecordHeader, 0, len(keys))
+		for _, k := range keys {
+			pm.Headers = append(pm.Headers, sarama.RecordHeader{
+				Key:   []byte(k),
+				Value: []byte(dm.Metadata[k]),
+			})
+		}
+	}
+	if dm.BeforeSend != nil {
+		asFunc := func(i interface{}) bool {
+			switch v := i.(type) {
+			case *provider.MessageKey:
+				if len(*v) > 0 {
+					pm.Key = sarama.ByteEncoder(*v)
+				}
+				return true
+			case **sarama.ProducerMessage:
+				*v = pm
+				return true
+			default:
+				return false
+			}
+		}
+		if err := dm.BeforeSend(asFunc); err != nil {
+			return nil, err
+		}
+	}
+	return pm, nil
+}
+
+func decodeMessage(cm *consumerMessage) *driver.Message {
+	asFunc := func(i interface{}) bool {
+		p, ok := i.(**sarama.ConsumerMessage)
+		if !ok {
+			return false
+		}
+		*p = cm.message
+		return true
+	}
+	dm := &driver.Message{
+		Body:   cm.message.Value,
+		AckID:  cm,
+		AsFunc: asFunc,
+	}
+	if len(cm.message.Headers) > 0 {
+		dm.Metadata = make(map[string]string, len(cm.message.Headers))
+		for _, h := range cm.message.Headers {
+			dm.Metadata[string(h.Key)] = string(h.Value)
+		}
+	}
+	return dm
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(*sarama.SyncProducer)
+	if !ok {
+		return false
+	}
+	*p = t.producer
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+// The producer is shared by the topics of the connection and is closed when the connection shuts down.
+func (*topic) Close() error { return nil }
+
+// consumerMessage is a message that is consumed in a consumer group session.
+type consumerMessage struct {
+	session sarama.ConsumerGroupSession
+	message *sarama.ConsumerMessage
+}
+
+// consumerGroupHandler implements sarama.ConsumerGroupHandler.
+// The messages of the claims are dispatched to the channels by topic.
+type consumerGroupHandler struct {
+	msgChs map[string]chan *consumerMessage
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (consumerGroupHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (consumerGroupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (h consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	msgCh, ok := h.msgChs[claim.Topic()]
+	if !ok {
+		return nil
+	}
+	for {
+		select {
+		case <-session.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			select {
+			case <-session.Context().Done():
+				return nil
+			case msgCh <- &consumerMessage{session: session, message: msg}:
+			}
+		}
+	}
+}
+
+// consumer consumes the topics of a connection as a single member of the consumer group.
+type consumer struct {
+	group  sarama.ConsumerGroup
+	msgChs map[string]chan *consumerMessage
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+// subscriptionQueueSize is the size of the subscription channel buffer.
+const subscriptionQueueSize = 16
+
+// openConsumer starts consuming the given topic names as a member of the given consumer group.
+func openConsumer(ctx context.Context, group sarama.ConsumerGroup, topicNames ...string) (*consumer, error) {
+	if group == nil {
+		return nil, errNilConsumerGroup.New()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := &consumer{
+		group:  group,
+		msgChs: make(map[string]chan *consumerMessage, len(topicNames)),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	for _, name := range topicNames {
+		c.msgChs[name] = make(chan *consumerMessage, subscriptionQueueSize)
+	}
+	go c.consume(ctx)
+	return c, nil
+}
+
+var errConsumeFailed = errors.Define("consume_failed", "consume from Kafka topic failed")
+
+// consume joins the consumer group until the context is done or consuming fails.
+// The consumer group session ends on rebalancing, after which the consumer group is joined again.
+func (c *consumer) consume(ctx context.Context) {
+	defer close(c.done)
+	topicNames := make([]string, 0, len(c.msgChs))
+	for name := range c.msgChs {
+		topicNames = append(topicNames, name)
+	}
+	sort.Strings(topicNames)
+	handler := consumerGroupHandler{
+		msgChs: c.msgChs,
+	}
+	for {
+		if err := c.group.Consume(ctx, topicNames, handler); err != nil {
+			c.err = errConsumeFailed.WithCause(err)
+			return
+		}
+		if err := ctx.Err(); err != nil {
+			c.err = err
+			return
+		}
+	}
+}
+
+// subscription returns a *pubsub.Subscription that receives the messages of the given topic name.
+func (c *consumer) subscription(topicName string) *pubsub.Subscription {
+	ds := &subscription{
+		consumer: c,
+		msgCh:    c.msgChs[topicName],
+	}
+	return pubsub.NewSubscription(ds, nil, nil)
+}
+
+var errCloseFailed = errors.Define("close_failed", "close Kafka consumer group failed")
+
+// Close stops consuming and leaves the consumer group.
+func (c *consumer) Close() error {
+	c.cancel()
+	err := c.group.Close()
+	<-c.done
+	if err != nil {
+		return errCloseFailed.WithCause(err)
+	}
+	return nil
+}
+
+type subscription struct {
+	consumer *consumer
+	msgCh    <-chan *consumerMessage
+}
+
+// ReceiveBatch implements driver.Subscription.
+// We always return one message at a time, since the messages are consumed one by one from the consumer group claims.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.consumer == nil {
+		return nil, errNilConsumerGroup.New()
+	}
+	if maxMessages <= 0 {
+		return nil, nil
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.consumer.done:
+		return nil, s.consumer.err
+	case msg := <-s.msgCh:
+		return []*driver.Message{decodeMessage(msg)}, nil
+	}
+}
+
+// SendAcks implements driver.Subscription.
+// Acknowledged messages are marked as consumed. The consumer group commits the offsets periodically.
+func (*subscription) SendAcks(_ context.Context, ids []driver.AckID) error {
+	for _, id := range ids {
+		cm := id.(*consumerMessage)
+		cm.session.MarkMessage(cm.message, "")
+	}
+	return nil
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	p, ok := i.(*sarama.ConsumerGroup)
+	if !ok {
+		return false
+	}
+	*p = s.consumer.group
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+// The consumer is shared by the subscriptions of the connection and is closed when the connection shuts down.
+func (*subscription) Close() error { return nil }
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	if errors.Resemble(err, errNilProducer) || errors.Resemble(err, errNilConsumerGroup) {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case sarama.ErrOutOfBrokers, sarama.ErrClosedClient, sarama.ErrClosedConsumerGroup:
+		return gcerrors.NotFound
+	default:
+		return gcerrors.Unknown
+	}
+}
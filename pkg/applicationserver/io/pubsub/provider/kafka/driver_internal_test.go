@@ -0,0 +1,121 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub/driver"
+)
+
+func TestEncodeMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		message *driver.Message
+		key     sarama.Encoder
+		headers []sarama.RecordHeader
+	}{
+		{
+			name: "Body",
+			message: &driver.Message{
+				Body: []byte("foobar"),
+			},
+		},
+		{
+			name: "Key",
+			message: &driver.Message{
+				Body:       []byte("foobar"),
+				BeforeSend: provider.WithMessageKey([]byte("dev1")),
+			},
+			key: sarama.ByteEncoder("dev1"),
+		},
+		{
+			name: "EmptyKey",
+			message: &driver.Message{
+				Body:       []byte("foobar"),
+				BeforeSend: provider.WithMessageKey(nil),
+			},
+		},
+		{
+			name: "Metadata",
+			message: &driver.Message{
+				Body: []byte("foobar"),
+				Metadata: map[string]string{
+					"foo": "bar",
+					"bar": "baz",
+				},
+			},
+			headers: []sarama.RecordHeader{
+				{Key: []byte("bar"), Value: []byte("baz")},
+				{Key: []byte("foo"), Value: []byte("bar")},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			pm, err := encodeMessage("app1.ps1.uplink.message", tc.message)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(pm.Topic, should.Equal, "app1.ps1.uplink.message")
+			a.So(pm.Value, should.Resemble, sarama.ByteEncoder("foobar"))
+			a.So(pm.Key, should.Resemble, tc.key)
+			a.So(pm.Headers, should.Resemble, tc.headers)
+		})
+	}
+}
+
+func TestTopicName(t *testing.T) {
+	for _, tc := range []struct {
+		parts    []string
+		expected string
+		ok       bool
+	}{
+		{
+			parts:    []string{"app1.ps1", "uplink.message"},
+			expected: "app1.ps1.uplink.message",
+			ok:       true,
+		},
+		{
+			parts:    []string{"app1.ps1.", ".uplink.message"},
+			expected: "app1.ps1.uplink.message",
+			ok:       true,
+		},
+		{
+			parts:    []string{"", "uplink_message"},
+			expected: "uplink_message",
+			ok:       true,
+		},
+		{
+			parts: []string{"", ""},
+		},
+		{
+			parts: []string{"app1/ps1", "uplink/message"},
+		},
+	} {
+		a := assertions.New(t)
+		name, err := topicName(tc.parts...)
+		if tc.ok {
+			a.So(err, should.BeNil)
+			a.So(name, should.Equal, tc.expected)
+		} else {
+			a.So(err, should.NotBeNil)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Apache Kafka provider using the kafka driver.
+package kafka
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/xdg/scram"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+const clientID = "ttn-lw-as"
+
+// kafkaVersion is the minimum Kafka version of the brokers.
+// Kafka 1.0 supports message headers, consumer groups and SASL/SCRAM authentication.
+var kafkaVersion = sarama.V1_0_0_0
+
+type impl struct {
+}
+
+type connection struct {
+	producer sarama.SyncProducer
+	consumer *consumer
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	var consumerErr error
+	if c.consumer != nil {
+		consumerErr = c.consumer.Close()
+	}
+	if err := c.producer.Close(); err != nil {
+		return err
+	}
+	return consumerErr
+}
+
+var (
+	errConnectFailed        = errors.Define("connect_failed", "connection to Kafka brokers failed")
+	errInvalidTopic         = errors.DefineInvalidArgument("invalid_topic", "invalid Kafka topic `{topic}`")
+	errUnknownSASLMechanism = errors.DefineInvalidArgument("unknown_sasl_mechanism", "unknown SASL mechanism `{mechanism}`")
+	errNoConsumerGroup      = errors.DefineInvalidArgument("no_consumer_group", "no consumer group")
+)
+
+// pubSubIdentifiers are the identifiers of the pub/sub from which the default consumer group is derived.
+type pubSubIdentifiers interface {
+	GetApplicationID() string
+	GetPubSubID() string
+}
+
+// defaultConsumerGroup returns the consumer group of the pub/sub if it does not specify a consumer group.
+// Each pub/sub has its own consumer group, so that pub/subs consuming the same topics all receive the messages.
+func defaultConsumerGroup(target provider.Target) (string, error) {
+	ids, ok := target.(pubSubIdentifiers)
+	if !ok || ids.GetApplicationID() == "" || ids.GetPubSubID() == "" {
+		return "", errNoConsumerGroup.New()
+	}
+	return fmt.Sprintf("%s.%s.%s", clientID, ids.GetApplicationID(), ids.GetPubSubID()), nil
+}
+
+// OpenConnection implements provider.Provider using the Kafka driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	provider, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	config, err := newConfig(provider.Kafka)
+	if err != nil {
+		return nil, err
+	}
+	groupID := provider.Kafka.ConsumerGroup
+	if groupID == "" && (target.GetDownlinkPush() != nil || target.GetDownlinkReplace() != nil) {
+		if groupID, err = defaultConsumerGroup(target); err != nil {
+			return nil, err
+		}
+	}
+	settings := Settings{
+		Brokers:       provider.Kafka.Brokers,
+		ConsumerGroup: groupID,
+		Config:        config,
+	}
+	return OpenConnection(ctx, settings, target)
+}
+
+func newConfig(settings *ttnpb.ApplicationPubSub_KafkaProvider) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	config.ClientID = clientID
+	config.Version = kafkaVersion
+	// The synchronous producer requires successes to be returned.
+	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	// Messages are partitioned by their key, which preserves the order of messages with the same key.
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+
+	if settings.UseTLS {
+		tlsConfig, err := createTLSConfig(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+
+	if sasl := settings.SASL; sasl != nil {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.User = sasl.Username
+		config.Net.SASL.Password = sasl.Password
+		switch sasl.Mechanism {
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_PLAIN:
+			config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: sha256.New}
+			}
+		case ttnpb.ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512:
+			config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{HashGeneratorFcn: sha512.New}
+			}
+		default:
+			return nil, errUnknownSASLMechanism.WithAttributes("mechanism", sasl.Mechanism)
+		}
+	}
+	return config, nil
+}
+
+// scramClient implements sarama.SCRAMClient.
+type scramClient struct {
+	scram.HashGeneratorFcn
+	conversation *scram.ClientConversation
+}
+
+// Begin implements sarama.SCRAMClient.
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.HashGeneratorFcn.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+// Step implements sarama.SCRAMClient.
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+// Done implements sarama.SCRAMClient.
+func (c *scramClient) Done() bool {
+	return c.conversation.Done()
+}
+
+// Settings configure the Kafka clients.
+type Settings struct {
+	Brokers       []string
+	ConsumerGroup string
+	Config        *sarama.Config
+}
+
+// OpenConnection opens a Kafka connection using the given settings.
+// The topic names are the base topic and the message topic, joined by a dot.
+// The subscribed topics are consumed together as a single member of the consumer group.
+func OpenConnection(ctx context.Context, settings Settings, topics provider.Topics) (_ *provider.Connection, err error) {
+	producer, err := sarama.NewSyncProducer(settings.Brokers, settings.Config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	pc := &connection{
+		producer: producer,
+	}
+	conn := &provider.Connection{
+		ProviderConnection: pc,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: topics.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: topics.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: topics.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: topics.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: topics.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: topics.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: topics.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: topics.GetLocationSolved(),
+		},
+		{
+			topic:   &conn.Topics.ServiceData,
+			message: topics.GetServiceData(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		name, err := topicName(topics.GetBaseTopic(), t.message.GetTopic())
+		if err != nil {
+			return nil, err
+		}
+		if *t.topic, err = OpenTopic(producer, name); err != nil {
+			return nil, err
+		}
+	}
+	subscriptions := make(map[**pubsub.Subscription]string, 2)
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      topics.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      topics.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		name, err := topicName(topics.GetBaseTopic(), s.message.GetTopic())
+		if err != nil {
+			return nil, err
+		}
+		subscriptions[s.subscription] = name
+	}
+	if len(subscriptions) == 0 {
+		return conn, nil
+	}
+	if settings.ConsumerGroup == "" {
+		return nil, errNoConsumerGroup.New()
+	}
+	group, err := sarama.NewConsumerGroup(settings.Brokers, settings.ConsumerGroup, settings.Config)
+	if err != nil {
+		return nil, errConnectFailed.WithCause(err)
+	}
+	topicNames := make([]string, 0, len(subscriptions))
+	for _, name := range subscriptions {
+		topicNames = append(topicNames, name)
+	}
+	if pc.consumer, err = openConsumer(ctx, group, topicNames...); err != nil {
+		group.Close()
+		return nil, err
+	}
+	for subscription, name := range subscriptions {
+		*subscription = pc.consumer.subscription(name)
+	}
+	return conn, nil
+}
+
+var topicNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,249}$`)
+
+// topicName joins the non-empty parts with a dot and validates the resulting Kafka topic name.
+func topicName(parts ...string) (string, error) {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.Trim(part, "."); part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	name := strings.Join(nonEmpty, ".")
+	if !topicNameRegex.MatchString(name) {
+		return "", errInvalidTopic.WithAttributes("topic", name)
+	}
+	return name, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
@@ -0,0 +1,280 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub"
+)
+
+const timeout = 10 * time.Second
+
+func TestOpenConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	const (
+		consumerGroup = "ttn-lw-as.app1.ps1"
+		pushTopic     = "app1.ps1.downlink.push"
+		replaceTopic  = "app1.ps1.downlink.replace"
+	)
+	uplinkTopics := []string{
+		"app1.ps1.uplink.message",
+		"app1.ps1.join.accept",
+		"app1.ps1.downlink.ack",
+		"app1.ps1.downlink.nack",
+		"app1.ps1.downlink.sent",
+		"app1.ps1.downlink.failed",
+		"app1.ps1.downlink.queued",
+		"app1.ps1.location.solved",
+		"app1.ps1.service.data",
+	}
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+
+	metadata := sarama.NewMockMetadataResponse(t).
+		SetBroker(broker.Addr(), broker.BrokerID()).
+		SetController(broker.BrokerID()).
+		SetLeader(pushTopic, 0, broker.BrokerID()).
+		SetLeader(replaceTopic, 0, broker.BrokerID())
+	for _, topic := range uplinkTopics {
+		metadata = metadata.SetLeader(topic, 0, broker.BrokerID())
+	}
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": metadata,
+		"ProduceRequest":  sarama.NewMockProduceResponse(t).SetVersion(3),
+		"FindCoordinatorRequest": sarama.NewMockFindCoordinatorResponse(t).
+			SetCoordinator(sarama.CoordinatorGroup, consumerGroup, broker),
+		"JoinGroupRequest": sarama.NewMockJoinGroupResponse(t).
+			SetMemberId("member1").
+			SetLeaderId("member0"),
+		"SyncGroupRequest": sarama.NewMockSyncGroupResponse(t).
+			SetMemberAssignment(&sarama.ConsumerGroupMemberAssignment{
+				Topics: map[string][]int32{
+					pushTopic:    {0},
+					replaceTopic: {0},
+				},
+			}),
+		"HeartbeatRequest": sarama.NewMockHeartbeatResponse(t),
+		"OffsetFetchRequest": sarama.NewMockOffsetFetchResponse(t).
+			SetOffset(consumerGroup, pushTopic, 0, 0, "", sarama.ErrNoError).
+			SetOffset(consumerGroup, replaceTopic, 0, 0, "", sarama.ErrNoError),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetVersion(1).
+			SetOffset(pushTopic, 0, sarama.OffsetOldest, 0).
+			SetOffset(pushTopic, 0, sarama.OffsetNewest, 1).
+			SetOffset(replaceTopic, 0, sarama.OffsetOldest, 0).
+			SetOffset(replaceTopic, 0, sarama.OffsetNewest, 1),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 1).
+			SetVersion(4).
+			SetMessage(pushTopic, 0, 0, sarama.ByteEncoder("foobar")).
+			SetHighWaterMark(pushTopic, 0, 1).
+			SetMessage(replaceTopic, 0, 0, sarama.ByteEncoder("bazqux")).
+			SetHighWaterMark(replaceTopic, 0, 1),
+		"OffsetCommitRequest": sarama.NewMockOffsetCommitResponse(t),
+		"LeaveGroupRequest":   sarama.NewMockLeaveGroupResponse(t),
+	})
+
+	pb := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+		Provider: &ttnpb.ApplicationPubSub_Kafka{
+			Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{},
+		},
+		BaseTopic: "app1.ps1",
+		DownlinkPush: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.push",
+		},
+		DownlinkReplace: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.replace",
+		},
+		UplinkMessage: &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink.message",
+		},
+		JoinAccept: &ttnpb.ApplicationPubSub_Message{
+			Topic: "join.accept",
+		},
+		DownlinkAck: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.ack",
+		},
+		DownlinkNack: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.nack",
+		},
+		DownlinkSent: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.sent",
+		},
+		DownlinkFailed: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.failed",
+		},
+		DownlinkQueued: &ttnpb.ApplicationPubSub_Message{
+			Topic: "downlink.queued",
+		},
+		LocationSolved: &ttnpb.ApplicationPubSub_Message{
+			Topic: "location.solved",
+		},
+		ServiceData: &ttnpb.ApplicationPubSub_Message{
+			Topic: "service.data",
+		},
+	}
+
+	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Kafka{},
+	})
+	a.So(impl, should.NotBeNil)
+	a.So(err, should.BeNil)
+
+	// Invalid attributes - no brokers provided.
+	{
+		conn, err := impl.OpenConnection(ctx, pb)
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	pb.Provider = &ttnpb.ApplicationPubSub_Kafka{
+		Kafka: &ttnpb.ApplicationPubSub_KafkaProvider{
+			Brokers: []string{broker.Addr()},
+		},
+	}
+
+	// Invalid attributes - invalid topic name.
+	{
+		pb := *pb
+		pb.UplinkMessage = &ttnpb.ApplicationPubSub_Message{
+			Topic: "uplink/message",
+		}
+		conn, err := impl.OpenConnection(ctx, &pb)
+		a.So(conn, should.BeNil)
+		a.So(err, should.NotBeNil)
+	}
+
+	// Valid attributes - connection established.
+	conn, err := impl.OpenConnection(ctx, pb)
+	a.So(conn, should.NotBeNil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Shutdown(ctx)
+
+	t.Run("Upstream", func(t *testing.T) {
+		for _, tc := range []struct {
+			name  string
+			topic *pubsub.Topic
+		}{
+			{
+				name:  "ValidUplink",
+				topic: conn.Topics.UplinkMessage,
+			},
+			{
+				name:  "ValidJoinAccept",
+				topic: conn.Topics.JoinAccept,
+			},
+			{
+				name:  "ValidDownlinkAck",
+				topic: conn.Topics.DownlinkAck,
+			},
+			{
+				name:  "ValidDownlinkNack",
+				topic: conn.Topics.DownlinkNack,
+			},
+			{
+				name:  "ValidDownlinkSent",
+				topic: conn.Topics.DownlinkSent,
+			},
+			{
+				name:  "ValidDownlinkFailed",
+				topic: conn.Topics.DownlinkFailed,
+			},
+			{
+				name:  "ValidDownlinkQueued",
+				topic: conn.Topics.DownlinkQueued,
+			},
+			{
+				name:  "ValidLocationSolved",
+				topic: conn.Topics.LocationSolved,
+			},
+			{
+				name:  "ValidServiceData",
+				topic: conn.Topics.ServiceData,
+			},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				a := assertions.New(t)
+
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+
+				err := tc.topic.Send(ctx, &pubsub.Message{
+					Body:       []byte("foobar"),
+					BeforeSend: provider.WithMessageKey([]byte("dev1")),
+				})
+				a.So(err, should.BeNil)
+			})
+		}
+
+		var produceRequests int
+		for _, rr := range broker.History() {
+			if _, ok := rr.Request.(*sarama.ProduceRequest); ok {
+				produceRequests++
+			}
+		}
+		a.So(produceRequests, should.Equal, len(uplinkTopics))
+	})
+
+	t.Run("Downstream", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		msg, err := conn.Subscriptions.Push.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.Body, should.Resemble, []byte("foobar"))
+		msg.Ack()
+
+		msg, err = conn.Subscriptions.Replace.Receive(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.Body, should.Resemble, []byte("bazqux"))
+		msg.Ack()
+
+		// The push and replace topics are consumed by a single member of the default consumer group.
+		var joinGroupRequests []*sarama.JoinGroupRequest
+		for _, rr := range broker.History() {
+			if req, ok := rr.Request.(*sarama.JoinGroupRequest); ok {
+				joinGroupRequests = append(joinGroupRequests, req)
+			}
+		}
+		if a.So(joinGroupRequests, should.HaveLength, 1) {
+			a.So(joinGroupRequests[0].GroupId, should.Equal, consumerGroup)
+		}
+	})
+}
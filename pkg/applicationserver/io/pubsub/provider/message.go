@@ -0,0 +1,29 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+// MessageKey is the key of a published message.
+// Providers that partition messages, such as Apache Kafka, use the key to preserve the order of messages with the same key.
+type MessageKey []byte
+
+// WithMessageKey returns a pubsub.Message BeforeSend hook that sets the message key.
+// Providers that do not support message keys ignore the key.
+func WithMessageKey(key []byte) func(func(interface{}) bool) error {
+	return func(asFunc func(interface{}) bool) error {
+		k := MessageKey(key)
+		asFunc(&k)
+		return nil
+	}
+}
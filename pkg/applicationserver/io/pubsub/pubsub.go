@@ -147,7 +147,8 @@ func (i *integration) handleUp(ctx context.Context) {
 				continue
 			}
 			err = topic.Send(ctx, &pubsub.Message{
-				Body: buf,
+				Body:       buf,
+				BeforeSend: provider.WithMessageKey([]byte(up.ApplicationUp.DeviceID)),
 			})
 			if err != nil {
 				logger.WithError(err).Warn("Failed to publish upstream message")
@@ -0,0 +1,278 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errBodyTemplate        = errors.DefineInvalidArgument("body_template", "invalid body template")
+	errExecuteBodyTemplate = errors.DefineInvalidArgument("execute_body_template", "execute body template")
+	errFieldMaskPath       = errors.DefineInvalidArgument("field_mask_path", "invalid field mask path `{path}` for message `{message}`")
+	errBodySize            = errors.DefineResourceExhausted("body_size", "rendered body exceeds `{limit}` bytes")
+	errBodyTemplateAction  = errors.DefineInvalidArgument("body_template_action", "action `{action}` not allowed in body template")
+	errBodyTemplateDepth   = errors.DefineInvalidArgument("body_template_depth", "body template nesting exceeds depth `{depth}`")
+	errBodyTemplateTimeout = errors.DefineDeadlineExceeded("body_template_timeout", "body template not rendered within `{timeout}`")
+)
+
+const (
+	// maxBodySize is the maximum size of a rendered body.
+	maxBodySize = 1 << 20
+	// maxBodyTemplateDepth is the maximum nesting depth of if, with and range actions in a body template.
+	maxBodyTemplateDepth = 8
+	// bodyTemplateTimeout is the time in which a body template must be rendered.
+	bodyTemplateTimeout = 100 * time.Millisecond
+	// bodyTemplateCacheSize is the number of parsed body templates to cache.
+	bodyTemplateCacheSize = 4096
+)
+
+// bodyTemplateFuncs are the functions available in body templates.
+var bodyTemplateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		buf, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(buf), nil
+	},
+}
+
+// parseBodyTemplate parses the body template.
+// Templates can not be defined or invoked, and range actions can not be nested, so that the rendering time of a
+// template is bounded by the size of the upstream message.
+func parseBodyTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("body").Funcs(bodyTemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, errBodyTemplate.WithCause(err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Name() != tmpl.Name() {
+			return nil, errBodyTemplateAction.WithAttributes("action", "define")
+		}
+	}
+	if tmpl.Tree == nil {
+		return tmpl, nil
+	}
+	if err := checkBodyTemplateNode(tmpl.Tree.Root, 0, false); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// checkBodyTemplateNode checks that the node and its children only contain allowed actions.
+func checkBodyTemplateNode(node parse.Node, depth int, inRange bool) error {
+	switch node := node.(type) {
+	case *parse.ListNode:
+		if node == nil {
+			return nil
+		}
+		for _, n := range node.Nodes {
+			if err := checkBodyTemplateNode(n, depth, inRange); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *parse.TemplateNode:
+		return errBodyTemplateAction.WithAttributes("action", "template")
+	case *parse.RangeNode:
+		if inRange {
+			return errBodyTemplateAction.WithAttributes("action", "nested range")
+		}
+		return checkBodyTemplateBranch(&node.BranchNode, depth+1, true)
+	case *parse.IfNode:
+		return checkBodyTemplateBranch(&node.BranchNode, depth+1, inRange)
+	case *parse.WithNode:
+		return checkBodyTemplateBranch(&node.BranchNode, depth+1, inRange)
+	default:
+		return nil
+	}
+}
+
+func checkBodyTemplateBranch(node *parse.BranchNode, depth int, inRange bool) error {
+	if depth > maxBodyTemplateDepth {
+		return errBodyTemplateDepth.WithAttributes("depth", maxBodyTemplateDepth)
+	}
+	if err := checkBodyTemplateNode(node.List, depth, inRange); err != nil {
+		return err
+	}
+	return checkBodyTemplateNode(node.ElseList, depth, inRange)
+}
+
+// bodyTemplateKey identifies the message of a webhook.
+type bodyTemplateKey struct {
+	webhookUID, message string
+}
+
+type cachedBodyTemplate struct {
+	text string
+	tmpl *template.Template
+}
+
+// bodyTemplateCache caches the parsed body templates per webhook message.
+type bodyTemplateCache struct {
+	cache gcache.Cache
+}
+
+func newBodyTemplateCache(size int) *bodyTemplateCache {
+	return &bodyTemplateCache{
+		cache: gcache.New(size).LRU().Build(),
+	}
+}
+
+// get returns the parsed body template of the webhook message.
+// The template is parsed again if the text of the template changed since it was cached.
+func (c *bodyTemplateCache) get(key bodyTemplateKey, text string) (*template.Template, error) {
+	if v, err := c.cache.Get(key); err == nil {
+		if cached := v.(*cachedBodyTemplate); cached.text == text {
+			return cached.tmpl, nil
+		}
+	}
+	tmpl, err := parseBodyTemplate(text)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(key, &cachedBodyTemplate{
+		text: text,
+		tmpl: tmpl,
+	})
+	return tmpl, nil
+}
+
+// limitedWriter is a writer that fails when more than limit bytes are written to it, or when it is closed.
+type limitedWriter struct {
+	w            io.Writer
+	limit, count int
+	closed       uint32
+}
+
+// Write implements io.Writer.
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if atomic.LoadUint32(&l.closed) != 0 {
+		return 0, errBodyTemplateTimeout.WithAttributes("timeout", bodyTemplateTimeout)
+	}
+	if l.count+len(p) > l.limit {
+		return 0, errBodySize.WithAttributes("limit", l.limit)
+	}
+	l.count += len(p)
+	return l.w.Write(p)
+}
+
+// close makes subsequent writes fail, which stops the execution of the template.
+func (l *limitedWriter) close() {
+	atomic.StoreUint32(&l.closed, 1)
+}
+
+// renderBody executes the body template with the JSON representation of the upstream message.
+// The rendered body is limited to maxBodySize bytes, and the template must be rendered within bodyTemplateTimeout.
+func renderBody(ctx context.Context, tmpl *template.Template, msg *ttnpb.ApplicationUp) ([]byte, error) {
+	buf, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, bodyTemplateTimeout)
+	defer cancel()
+	var body bytes.Buffer
+	w := &limitedWriter{w: &body, limit: maxBodySize}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tmpl.Execute(w, data)
+	}()
+	select {
+	case <-ctx.Done():
+		w.close()
+		return nil, errBodyTemplateTimeout.WithAttributes("timeout", bodyTemplateTimeout)
+	case err := <-errCh:
+		if err != nil {
+			if errors.Resemble(err, errBodySize) {
+				return nil, err
+			}
+			return nil, errExecuteBodyTemplate.WithCause(err)
+		}
+	}
+	return body.Bytes(), nil
+}
+
+// filterUp returns a copy of the upstream message with only the fields in the given paths.
+// If no paths are given, the message is returned as is.
+func filterUp(msg *ttnpb.ApplicationUp, paths []string) (*ttnpb.ApplicationUp, error) {
+	if len(paths) == 0 {
+		return msg, nil
+	}
+	filtered := &ttnpb.ApplicationUp{}
+	if err := filtered.SetFields(msg, paths...); err != nil {
+		return nil, err
+	}
+	return filtered, nil
+}
+
+// validateMessages validates the body templates and field masks of the webhook messages in the given paths.
+func validateMessages(hook *ttnpb.ApplicationWebhook, paths []string) error {
+	for _, m := range []struct {
+		name string
+		cfg  *ttnpb.ApplicationWebhook_Message
+	}{
+		{"uplink_message", hook.UplinkMessage},
+		{"join_accept", hook.JoinAccept},
+		{"downlink_ack", hook.DownlinkAck},
+		{"downlink_nack", hook.DownlinkNack},
+		{"downlink_sent", hook.DownlinkSent},
+		{"downlink_failed", hook.DownlinkFailed},
+		{"downlink_queued", hook.DownlinkQueued},
+		{"location_solved", hook.LocationSolved},
+		{"service_data", hook.ServiceData},
+	} {
+		if m.cfg == nil || !ttnpb.HasAnyField(paths, m.name) {
+			continue
+		}
+		if m.cfg.BodyTemplate != "" {
+			if _, err := parseBodyTemplate(m.cfg.BodyTemplate); err != nil {
+				return err
+			}
+		}
+		for _, path := range m.cfg.FieldMask.Paths {
+			// The upstream message can only contain the message type of the webhook message.
+			valid := ttnpb.ContainsField(path, ttnpb.ApplicationUpFieldPathsNested) &&
+				(path == "up" || !strings.HasPrefix(path, "up.") ||
+					path == "up."+m.name || strings.HasPrefix(path, "up."+m.name+"."))
+			if !valid {
+				return errFieldMaskPath.WithAttributes(
+					"path", path,
+					"message", m.name,
+				)
+			}
+		}
+	}
+	return nil
+}
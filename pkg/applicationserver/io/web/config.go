@@ -17,6 +17,7 @@ package web
 import (
 	"context"
 	"net/url"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -72,3 +73,29 @@ type DownlinksConfig struct {
 	PublicAddress    string `name:"public-address" description:"Public address of the HTTP webhooks frontend"`
 	PublicTLSAddress string `name:"public-tls-address" description:"Public address of the HTTPS webhooks frontend"`
 }
+
+// RetryConfig defines the retry policy of webhook deliveries.
+type RetryConfig struct {
+	MaxAttempts    uint32        `name:"max-attempts" description:"Maximum number of delivery attempts before the delivery is marked as failed"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Backoff after the first failed delivery attempt"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum backoff between delivery attempts"`
+}
+
+// Backoff returns the backoff after the given number of failed delivery attempts.
+// The backoff doubles with every attempt, up to MaxBackoff.
+func (c RetryConfig) Backoff(attempts uint32) time.Duration {
+	backoff := c.InitialBackoff
+	for i := uint32(1); i < attempts && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+	return backoff
+}
+
+// HealthConfig defines the configuration of the webhook health tracking.
+type HealthConfig struct {
+	FailureThreshold uint32        `name:"failure-threshold" description:"Number of consecutive failed requests after which the webhook is suspended (0 is disabled)"`
+	SuspendInterval  time.Duration `name:"suspend-interval" description:"Interval in which a suspended webhook is probed"`
+}
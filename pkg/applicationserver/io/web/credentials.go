@@ -0,0 +1,255 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	signatureTimestampHeader = "X-Webhook-Timestamp"
+	signatureHeader          = "X-Webhook-Signature"
+)
+
+type clientCertificateKeyType struct{}
+
+var clientCertificateKey clientCertificateKeyType
+
+func withClientCertificate(ctx context.Context, cert *tls.Certificate) context.Context {
+	return context.WithValue(ctx, clientCertificateKey, cert)
+}
+
+func clientCertificateFromContext(ctx context.Context) (*tls.Certificate, bool) {
+	cert, ok := ctx.Value(clientCertificateKey).(*tls.Certificate)
+	return cert, ok
+}
+
+// encryptSecret encrypts the value of the secret with the given key.
+// If the key ID is empty, the secret is stored as plaintext.
+func encryptSecret(ctx context.Context, keyVault crypto.KeyVault, keyID string, secret *ttnpb.Secret) error {
+	if secret == nil {
+		return nil
+	}
+	if keyID == "" {
+		log.FromContext(ctx).Warn("No encryption key defined, storing as plaintext")
+		secret.KeyID = ""
+		return nil
+	}
+	value, err := keyVault.Encrypt(ctx, secret.Value, keyID)
+	if err != nil {
+		return err
+	}
+	secret.Value = value
+	secret.KeyID = keyID
+	return nil
+}
+
+// decryptSecret returns the decrypted value of the secret.
+func decryptSecret(ctx context.Context, keyVault crypto.KeyVault, secret *ttnpb.Secret) ([]byte, error) {
+	if secret.KeyID == "" {
+		return secret.Value, nil
+	}
+	return keyVault.Decrypt(ctx, secret.Value, secret.KeyID)
+}
+
+// signRequest signs the request with the given secret.
+// The signature is the HMAC-SHA256 of the timestamp, a dot and the body.
+func signRequest(req *http.Request, secret []byte, timestamp time.Time) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	req.Header.Set(signatureTimestampHeader, ts)
+	req.Header.Set(signatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// credentials are the parsed credentials of a webhook.
+type credentials struct {
+	signingSecret []byte
+	certificate   *tls.Certificate
+}
+
+// credentialsPaths are the paths of the webhook that contain credentials.
+var credentialsPaths = []string{
+	"client_certificate",
+	"client_private_key",
+	"signing_secret",
+}
+
+const (
+	credentialsCacheSize = 4096
+	credentialsCacheTTL  = time.Minute
+)
+
+// CredentialsSink is a Sink that adds the credentials of the webhook to the requests.
+// If the webhook has a signing secret, the request is signed. If the webhook has a client certificate,
+// the certificate is presented by the HTTPClientSink for mutual TLS.
+//
+// The credentials are decrypted and parsed once and cached per webhook. The cached credentials of a webhook are
+// invalidated when the credentials are set through the registry returned by WrapRegistry. As other instances may
+// set the webhook too, the credentials are cached for a limited time.
+type CredentialsSink struct {
+	// Align for sync/atomic.
+	generation uint64
+
+	Target   Sink
+	Registry WebhookRegistry
+	KeyVault crypto.KeyVault
+
+	cacheOnce sync.Once
+	cache     gcache.Cache
+}
+
+var errClientCertificate = errors.DefineInvalidArgument("client_certificate", "invalid client certificate")
+
+func (s *CredentialsSink) credentialsCache() gcache.Cache {
+	s.cacheOnce.Do(func() {
+		s.cache = gcache.New(credentialsCacheSize).LRU().Expiration(credentialsCacheTTL).Build()
+	})
+	return s.cache
+}
+
+// loadCredentials loads the credentials of the webhook from the registry, and decrypts and parses them.
+func (s *CredentialsSink) loadCredentials(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (*credentials, error) {
+	hook, err := s.Registry.Get(ctx, ids, credentialsPaths)
+	if err != nil {
+		return nil, err
+	}
+	creds := &credentials{}
+	if hook.SigningSecret != nil && len(hook.SigningSecret.Value) > 0 {
+		creds.signingSecret, err = decryptSecret(ctx, s.KeyVault, hook.SigningSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(hook.ClientCertificate) > 0 && hook.ClientPrivateKey != nil {
+		key, err := decryptSecret(ctx, s.KeyVault, hook.ClientPrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(hook.ClientCertificate, key)
+		if err != nil {
+			return nil, errClientCertificate.WithCause(err)
+		}
+		creds.certificate = &cert
+	}
+	return creds, nil
+}
+
+func (s *CredentialsSink) credentials(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (*credentials, error) {
+	cache := s.credentialsCache()
+	uid := unique.ID(ctx, ids)
+	if v, err := cache.Get(uid); err == nil {
+		return v.(*credentials), nil
+	}
+	// NOTE: The credentials are only cached if no credentials are invalidated while loading, so that credentials
+	// that were loaded before the webhook was set are not cached.
+	generation := atomic.LoadUint64(&s.generation)
+	creds, err := s.loadCredentials(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if atomic.LoadUint64(&s.generation) == generation {
+		cache.Set(uid, creds)
+	}
+	return creds, nil
+}
+
+// invalidate removes the cached credentials of the webhook.
+func (s *CredentialsSink) invalidate(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) {
+	atomic.AddUint64(&s.generation, 1)
+	s.credentialsCache().Remove(unique.ID(ctx, ids))
+}
+
+// WrapRegistry returns a WebhookRegistry that invalidates the cached credentials of the webhooks of which the
+// credentials are set, or which are deleted, in the given registry.
+func (s *CredentialsSink) WrapRegistry(registry WebhookRegistry) WebhookRegistry {
+	return &credentialsInvalidatingRegistry{
+		WebhookRegistry: registry,
+		sink:            s,
+	}
+}
+
+type credentialsInvalidatingRegistry struct {
+	WebhookRegistry
+	sink *CredentialsSink
+}
+
+// Set implements WebhookRegistry.
+func (r *credentialsInvalidatingRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	var invalidate bool
+	hook, err := r.WebhookRegistry.Set(ctx, ids, paths, func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		hook, sets, err := f(stored)
+		invalidate = err == nil && (hook == nil || ttnpb.HasAnyField(sets, credentialsPaths...))
+		return hook, sets, err
+	})
+	if invalidate {
+		r.sink.invalidate(ctx, ids)
+	}
+	return hook, err
+}
+
+// Process adds the credentials of the webhook to the request and processes it with the target.
+// The request context must contain the webhook identifiers.
+func (s *CredentialsSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	ids, ok := ctx.Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers)
+	if !ok {
+		return errNoWebhookID.New()
+	}
+	creds, err := s.credentials(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if creds.signingSecret != nil {
+		if err := signRequest(req, creds.signingSecret, time.Now()); err != nil {
+			return err
+		}
+	}
+	if creds.certificate != nil {
+		req = req.WithContext(withClientCertificate(ctx, creds.certificate))
+	}
+	return s.Target.Process(req)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func generateClientCertificate(t *testing.T) (certPEM, keyPEM []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %s", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+type receivedRequest struct {
+	header   http.Header
+	body     []byte
+	peerCert *x509.Certificate
+}
+
+func TestCredentialsSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	reqCh := make(chan receivedRequest, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received := receivedRequest{
+			header: r.Header,
+			body:   body,
+		}
+		if len(r.TLS.PeerCertificates) > 0 {
+			received.peerCert = r.TLS.PeerCertificates[0]
+		}
+		reqCh <- received
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAnyClientCert,
+	}
+	closedCh := make(chan struct{}, 4)
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateClosed {
+			closedCh <- struct{}{}
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	certPEM, keyPEM := generateClientCertificate(t)
+	registry, closeRegistry := newWebhookRegistry(ctx, t, server.URL, func(hook *ttnpb.ApplicationWebhook) []string {
+		hook.SigningSecret = &ttnpb.Secret{
+			Value: []byte("signing-secret"),
+		}
+		hook.ClientCertificate = certPEM
+		hook.ClientPrivateKey = &ttnpb.Secret{
+			Value: keyPEM,
+		}
+		return []string{
+			"client_certificate",
+			"client_private_key",
+			"signing_secret",
+		}
+	})
+	defer closeRegistry()
+
+	sink := &web.CredentialsSink{
+		Target: &web.HTTPClientSink{
+			Client:     server.Client(),
+			MaxClients: 1,
+		},
+		Registry: registry,
+	}
+	wrappedRegistry := sink.WrapRegistry(registry)
+	w := web.NewWebhooks(ctx, nil, wrappedRegistry, sink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+
+	assertReceived := func(secret string, certPEM []byte) {
+		t.Helper()
+		sendUplink(ctx, t, sub, 42)
+
+		var received receivedRequest
+		select {
+		case received = <-reqCh:
+		case <-time.After(timeout):
+			t.Fatal("Expected request but nothing received")
+		}
+
+		timestamp := received.header.Get("X-Webhook-Timestamp")
+		a.So(timestamp, should.NotBeEmpty)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(timestamp + "."))
+		mac.Write(received.body)
+		a.So(received.header.Get("X-Webhook-Signature"), should.Equal, hex.EncodeToString(mac.Sum(nil)))
+
+		if a.So(received.peerCert, should.NotBeNil) {
+			block, _ := pem.Decode(certPEM)
+			a.So(received.peerCert.Raw, should.Resemble, block.Bytes)
+		}
+	}
+
+	assertReceived("signing-secret", certPEM)
+	// The credentials are cached.
+	assertReceived("signing-secret", certPEM)
+
+	// Setting the credentials invalidates the cached credentials.
+	newCertPEM, newKeyPEM := generateClientCertificate(t)
+	_, err := wrappedRegistry.Set(ctx, ids, nil, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		hook.SigningSecret = &ttnpb.Secret{
+			Value: []byte("new-signing-secret"),
+		}
+		hook.ClientCertificate = newCertPEM
+		hook.ClientPrivateKey = &ttnpb.Secret{
+			Value: newKeyPEM,
+		}
+		return hook, credentialsPaths, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+	assertReceived("new-signing-secret", newCertPEM)
+
+	// The client of the previous certificate is evicted, which closes its idle connection.
+	select {
+	case <-closedCh:
+	case <-time.After(timeout):
+		t.Fatal("Expected idle connection of evicted client to be closed")
+	}
+}
+
+var credentialsPaths = []string{
+	"client_certificate",
+	"client_private_key",
+	"signing_secret",
+}
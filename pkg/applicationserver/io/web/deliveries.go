@@ -0,0 +1,310 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// DeliveryQueue is a persistent queue of webhook deliveries.
+type DeliveryQueue interface {
+	// Add adds the new delivery to the queue. The delivery becomes available at the given time.
+	// If the queue is full, the delivery is rejected.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, at time.Time) error
+	// Postpone adds the delivery that is being processed back to the queue. The delivery becomes available at the
+	// given time. As it replaces the delivery that is being processed, the delivery is not rejected if the queue is full.
+	Postpone(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, at time.Time) error
+	// Pop calls f on the earliest delivery in the queue that is available, if such is available,
+	// otherwise it blocks until it is or until the context deadline is reached.
+	// The delivery is removed from the queue when f returns without error. If f returns an error, or if the process
+	// stops before f returns, the delivery becomes available again after a timeout.
+	Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error
+	// Run dispatches the deliveries in the queue until the context is done.
+	Run(ctx context.Context) error
+	// AddFailed adds the delivery to the failed deliveries of the webhook.
+	AddFailed(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error
+	// ListFailed returns the failed deliveries of the webhook, most recent first, and the total number of failed deliveries.
+	ListFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, page uint32) ([]*ttnpb.ApplicationWebhookDelivery, uint64, error)
+	// RemoveFailed removes the failed deliveries with the given identifiers and returns them.
+	// If no delivery identifiers are given, all failed deliveries of the webhook are removed.
+	RemoveFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error)
+}
+
+// PersistentSink is a ControllableSink that persists requests in a DeliveryQueue.
+// Failed deliveries are retried with exponential backoff. When the maximum number of attempts is reached,
+// the delivery is added to the failed deliveries of the webhook. If the delivery cannot be postponed or added to the
+// failed deliveries, it stays in the queue and it is attempted again.
+// Secret headers are not persisted; they are restored from the webhook in the Registry when the request is delivered.
+type PersistentSink struct {
+	Target   Sink
+	Queue    DeliveryQueue
+	Registry WebhookRegistry
+	Workers  int
+	Retry    RetryConfig
+}
+
+type secretHeadersKeyType struct{}
+
+var secretHeadersKey secretHeadersKeyType
+
+// withSecretHeaders returns a context with the names of the request headers that contain secrets.
+func withSecretHeaders(ctx context.Context, names ...string) context.Context {
+	secrets := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		secrets[http.CanonicalHeaderKey(name)] = struct{}{}
+	}
+	return context.WithValue(ctx, secretHeadersKey, secrets)
+}
+
+func secretHeadersFromContext(ctx context.Context) map[string]struct{} {
+	secrets, _ := ctx.Value(secretHeadersKey).(map[string]struct{})
+	return secrets
+}
+
+const (
+	deliveryPopTimeout           = 5 * time.Second
+	deliveryQueueRestartInterval = time.Second
+)
+
+// Run runs the queue and starts concurrent workers to process deliveries from the queue.
+// If Target is a ControllableSink, this method runs the target.
+// This method blocks until the queue, the target (if controllable) and all workers are done.
+func (s *PersistentSink) Run(ctx context.Context) error {
+	if s.Workers < 1 {
+		s.Workers = 1
+	}
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			err := s.Queue.Run(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			// The queue fails on Redis errors, so restart it.
+			log.FromContext(ctx).WithError(err).Warn("Delivery queue failed, restart")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(deliveryQueueRestartInterval):
+			}
+		}
+	}()
+	if controllable, ok := s.Target.(ControllableSink); ok {
+		wg.Add(1)
+		go func() {
+			if err := controllable.Run(ctx); err != nil && !errors.IsCanceled(err) {
+				log.FromContext(ctx).WithError(err).Error("Target sink failed")
+			}
+			wg.Done()
+		}()
+	}
+	for i := 0; i < s.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				default:
+				}
+				popCtx, cancel := context.WithTimeout(ctx, deliveryPopTimeout)
+				err := s.Queue.Pop(popCtx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+					if ctx.Err() != nil {
+						// The sink stopped while the worker was blocked in Pop; add the delivery back to the queue
+						// so that it is delivered when the sink runs again.
+						return s.Queue.Postpone(ctx, delivery, time.Now().UTC())
+					}
+					return s.deliver(ctx, delivery)
+				})
+				cancel()
+				if err != nil && !errors.IsCanceled(err) && !errors.IsDeadlineExceeded(err) {
+					log.FromContext(ctx).WithError(err).Warn("Failed to pop delivery")
+					select {
+					case <-ctx.Done():
+					case <-time.After(deliveryPopTimeout):
+					}
+				}
+			}
+		}()
+	}
+	<-ctx.Done()
+	wg.Wait()
+	return ctx.Err()
+}
+
+var (
+	errNoWebhookID     = errors.DefineInternal("no_webhook_id", "no webhook identifiers in request context")
+	errDeliveryAttempt = errors.DefineUnavailable("delivery_attempt", "delivery attempt failed: `{message}`")
+)
+
+// errorDetails returns the details of the error of a delivery attempt.
+func errorDetails(err error) *ttnpb.ErrorDetails {
+	ttnErr, ok := errors.From(err)
+	if !ok {
+		ttnErr, _ = errors.From(errDeliveryAttempt.WithAttributes("message", err.Error()))
+	}
+	return ttnpb.ErrorDetailsToProto(ttnErr)
+}
+
+// Process adds the request to the queue.
+// This method returns when the request is persisted. The request context must contain the webhook identifiers.
+func (s *PersistentSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	ids, ok := ctx.Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers)
+	if !ok {
+		return errNoWebhookID.New()
+	}
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	secrets := secretHeadersFromContext(ctx)
+	headers := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		if _, ok := secrets[key]; ok {
+			continue
+		}
+		headers[key] = req.Header.Get(key)
+	}
+	now := time.Now().UTC()
+	deliveryID, err := ulid.New(ulid.Timestamp(now), rand.Reader)
+	if err != nil {
+		return err
+	}
+	return s.Queue.Add(ctx, &ttnpb.ApplicationWebhookDelivery{
+		ApplicationWebhookIdentifiers: ids,
+		DeliveryID:                    deliveryID.String(),
+		CreatedAt:                     now,
+		Method:                        req.Method,
+		URL:                           req.URL.String(),
+		Headers:                       headers,
+		Body:                          body,
+	}, now)
+}
+
+func (s *PersistentSink) deliver(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"application_id", delivery.ApplicationID,
+		"webhook_id", delivery.WebhookID,
+		"delivery_id", delivery.DeliveryID,
+		"attempts", delivery.Attempts,
+	))
+	method := delivery.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequest(method, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create request, drop delivery")
+		return nil
+	}
+	reqCtx, err := s.restoreWebhook(ctx, req, delivery)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.WithError(err).Debug("Webhook not found, drop delivery")
+			return nil
+		}
+		return s.retry(ctx, logger, delivery, err)
+	}
+	for key, value := range delivery.Headers {
+		req.Header.Set(key, value)
+	}
+	req = req.WithContext(withWebhookID(reqCtx, delivery.ApplicationWebhookIdentifiers))
+	if err := s.Target.Process(req); err != nil {
+		return s.retry(ctx, logger, delivery, err)
+	}
+	return nil
+}
+
+// retry records the failed attempt and queues the delivery for retry.
+// When the maximum number of attempts is reached, the delivery is added to the failed deliveries.
+// If the webhook is suspended, the delivery is not attempted and it is queued until the suspension expires.
+func (s *PersistentSink) retry(ctx context.Context, logger log.Interface, delivery *ttnpb.ApplicationWebhookDelivery, err error) error {
+	if errors.Resemble(err, errWebhookSuspended) {
+		if suspendedUntil, ok := s.suspendedUntil(ctx, delivery.ApplicationWebhookIdentifiers); ok {
+			logger.WithField("suspended_until", suspendedUntil).Debug("Webhook suspended, postpone delivery")
+			return s.Queue.Postpone(ctx, delivery, suspendedUntil)
+		}
+	}
+	now := time.Now().UTC()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.LastError = errorDetails(err)
+	if delivery.Attempts >= s.Retry.MaxAttempts {
+		logger.WithError(err).Warn("Delivery failed, add to failed deliveries")
+		return s.Queue.AddFailed(ctx, delivery)
+	}
+	backoff := s.Retry.Backoff(delivery.Attempts)
+	logger.WithError(err).WithField("backoff", backoff).Debug("Delivery attempt failed, retry")
+	return s.Queue.Postpone(ctx, delivery, now.Add(backoff))
+}
+
+// suspendedUntil returns the time until which the webhook is suspended, if it is suspended.
+func (s *PersistentSink) suspendedUntil(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (time.Time, bool) {
+	if s.Registry == nil {
+		return time.Time{}, false
+	}
+	hook, err := s.Registry.Get(ctx, ids, []string{"health"})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get webhook health")
+		return time.Time{}, false
+	}
+	if hook.Health == nil || hook.Health.SuspendedUntil == nil {
+		return time.Time{}, false
+	}
+	return *hook.Health.SuspendedUntil, true
+}
+
+// restoreWebhook sets the secret headers of the webhook on the request, and returns a context with the health of the
+// webhook. The headers of the delivery are set after the secret headers, as they take precedence.
+func (s *PersistentSink) restoreWebhook(ctx context.Context, req *http.Request, delivery *ttnpb.ApplicationWebhookDelivery) (context.Context, error) {
+	if s.Registry == nil {
+		return ctx, nil
+	}
+	hook, err := s.Registry.Get(ctx, delivery.ApplicationWebhookIdentifiers, []string{
+		"downlink_api_key",
+		"headers",
+		"health",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	if _, ok := delivery.Headers[downlinkPushHeader]; ok && hook.DownlinkAPIKey != "" {
+		req.Header.Set(downlinkKeyHeader, hook.DownlinkAPIKey)
+	}
+	return withWebhookHealth(ctx, hook.Health), nil
+}
@@ -0,0 +1,315 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRetryConfigBackoff(t *testing.T) {
+	a := assertions.New(t)
+	conf := web.RetryConfig{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+	}
+	for attempts, expected := range map[uint32]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	} {
+		a.So(conf.Backoff(attempts), should.Equal, expected)
+	}
+}
+
+var errTest = errors.DefineUnavailable("test", "test")
+
+type failingSink struct {
+	ch chan *http.Request
+}
+
+func (s *failingSink) Process(req *http.Request) error {
+	s.ch <- req
+	return errTest.New()
+}
+
+func TestDeliveryQueue(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+
+	claimIdle := 4 * test.Delay
+	queue1 := redis.NewDeliveryQueue(redisClient, 2, 10, "as", "test-1")
+	queue1.ClaimIdle = claimIdle
+	queue1.DispatchInterval = test.Delay
+	queue2 := redis.NewDeliveryQueue(redisClient, 2, 10, "as", "test-2")
+	queue2.ClaimIdle = claimIdle
+	if err := queue1.Init(); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+
+	newDelivery := func(id string) *ttnpb.ApplicationWebhookDelivery {
+		return &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIdentifiers: registeredApplicationID,
+				WebhookID:              registeredWebhookID,
+			},
+			DeliveryID: id,
+			URL:        "https://myapp.com/api/up",
+		}
+	}
+	pop := func(queue *redis.DeliveryQueue, f func(*ttnpb.ApplicationWebhookDelivery) error) (string, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		var id string
+		err := queue.Pop(ctx, func(_ context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+			id = delivery.DeliveryID
+			return f(delivery)
+		})
+		return id, err
+	}
+	ack := func(*ttnpb.ApplicationWebhookDelivery) error { return nil }
+
+	now := time.Now()
+	a.So(queue1.Add(ctx, newDelivery("1"), now), should.BeNil)
+	a.So(queue1.Add(ctx, newDelivery("2"), now), should.BeNil)
+	// New deliveries are rejected when the queue is full, but postponed deliveries are not.
+	a.So(errors.IsResourceExhausted(queue1.Add(ctx, newDelivery("3"), now)), should.BeTrue)
+	a.So(queue1.Postpone(ctx, newDelivery("3"), now), should.BeNil)
+
+	// The first consumer fails to process the delivery, so it stays pending.
+	id, err := pop(queue1, func(*ttnpb.ApplicationWebhookDelivery) error { return errTest.New() })
+	a.So(id, should.Equal, "1")
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// The second consumer reads the next delivery, as the pending delivery is not idle long enough to be claimed.
+	id, err = pop(queue2, ack)
+	a.So(id, should.Equal, "2")
+	a.So(err, should.BeNil)
+
+	// The second consumer claims the pending delivery of the first consumer.
+	time.Sleep(claimIdle)
+	id, err = pop(queue2, ack)
+	a.So(id, should.Equal, "1")
+	a.So(err, should.BeNil)
+
+	id, err = pop(queue2, ack)
+	a.So(id, should.Equal, "3")
+	a.So(err, should.BeNil)
+
+	// Processed deliveries are removed from the queue.
+	id, err = pop(queue1, ack)
+	a.So(id, should.BeEmpty)
+	a.So(err, should.BeNil)
+	a.So(queue1.Add(ctx, newDelivery("4"), time.Now().Add(test.Delay)), should.BeNil)
+	a.So(queue1.Add(ctx, newDelivery("5"), time.Now().Add(test.Delay)), should.BeNil)
+
+	// Deliveries that become available later are dispatched when they become available.
+	go queue1.Run(ctx)
+	for _, expected := range []string{"4", "5"} {
+		id, err = pop(queue1, ack)
+		a.So(id, should.Equal, expected)
+		a.So(err, should.BeNil)
+	}
+}
+
+func TestPersistentSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	registry, closeRegistry := newWebhookRegistry(ctx, t, "https://myapp.com/api", func(hook *ttnpb.ApplicationWebhook) []string {
+		hook.Headers = map[string]string{
+			"Authorization": "Basic c2VjcmV0",
+		}
+		hook.DownlinkAPIKey = "downlink-api-key"
+		return []string{
+			"downlink_api_key",
+			"headers",
+		}
+	})
+	defer closeRegistry()
+
+	target := &failingSink{
+		ch: make(chan *http.Request, 4),
+	}
+	queue := redis.NewDeliveryQueue(registry.Redis, 100, 10, "as", "test")
+	queue.DispatchInterval = test.Delay
+	if err := queue.Init(); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+	sink := &web.PersistentSink{
+		Target:   target,
+		Queue:    queue,
+		Registry: registry,
+		Workers:  1,
+		Retry: web.RetryConfig{
+			MaxAttempts:    2,
+			InitialBackoff: test.Delay,
+			MaxBackoff:     test.Delay,
+		},
+	}
+	go sink.Run(ctx)
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+	sendUplink(ctx, t, sub, 42)
+
+	var bodies [][]byte
+	for i := 0; i < 2; i++ {
+		select {
+		case req := <-target.ch:
+			a.So(req.URL.String(), should.Equal, "https://myapp.com/api/up")
+			a.So(req.Header.Get("Authorization"), should.Equal, "Basic c2VjcmV0")
+			a.So(req.Header.Get("X-Downlink-Apikey"), should.Equal, "downlink-api-key")
+			a.So(req.Header.Get("X-Downlink-Push"), should.NotBeEmpty)
+			a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+			buf := &bytes.Buffer{}
+			buf.ReadFrom(req.Body)
+			bodies = append(bodies, buf.Bytes())
+		case <-time.After(timeout):
+			t.Fatalf("Expected delivery attempt %d but nothing received", i+1)
+		}
+	}
+	a.So(bodies[1], should.Resemble, bodies[0])
+
+	var deliveries []*ttnpb.ApplicationWebhookDelivery
+	for i := 0; i < 32 && len(deliveries) == 0; i++ {
+		time.Sleep(test.Delay)
+		var err error
+		deliveries, _, err = queue.ListFailed(ctx, ids, 0, 0)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	if !a.So(deliveries, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(deliveries[0].Attempts, should.Equal, uint32(2))
+	a.So(deliveries[0].URL, should.Equal, "https://myapp.com/api/up")
+	a.So(deliveries[0].Body, should.Resemble, bodies[0])
+	a.So(deliveries[0].Headers, should.NotContainKey, "Authorization")
+	a.So(deliveries[0].Headers, should.NotContainKey, "X-Downlink-Apikey")
+	a.So(deliveries[0].Headers, should.ContainKey, "X-Downlink-Push")
+	a.So(deliveries[0].LastError, should.NotBeNil)
+	a.So(deliveries[0].LastAttemptAt, should.NotBeNil)
+
+	select {
+	case <-target.ch:
+		t.Fatal("Expected no more delivery attempts")
+	case <-time.After(timeout):
+	}
+}
+
+func TestPersistentSinkSuspended(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	registry, closeRegistry := newWebhookRegistry(ctx, t, "https://myapp.com/api", nil)
+	defer closeRegistry()
+
+	target := &failingSink{
+		ch: make(chan *http.Request, 4),
+	}
+	queue := redis.NewDeliveryQueue(registry.Redis, 100, 10, "as", "test")
+	queue.DispatchInterval = test.Delay
+	if err := queue.Init(); err != nil {
+		t.Fatalf("Failed to initialize delivery queue: %s", err)
+	}
+	suspendInterval := timeout
+	sink := &web.PersistentSink{
+		Target: &web.HealthSink{
+			Target:   target,
+			Registry: registry,
+			Config: web.HealthConfig{
+				FailureThreshold: 1,
+				SuspendInterval:  suspendInterval,
+			},
+		},
+		Queue:    queue,
+		Registry: registry,
+		Workers:  1,
+		Retry: web.RetryConfig{
+			MaxAttempts:    2,
+			InitialBackoff: test.Delay,
+			MaxBackoff:     test.Delay,
+		},
+	}
+	go sink.Run(ctx)
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+	sendUplink(ctx, t, sub, 42)
+
+	// The first attempt fails and suspends the webhook. The retry while the webhook is suspended does not count as an
+	// attempt; the delivery is attempted again when the suspension expires.
+	var attemptedAt []time.Time
+	for i := 0; i < 2; i++ {
+		select {
+		case <-target.ch:
+			attemptedAt = append(attemptedAt, time.Now())
+		case <-time.After(suspendInterval + timeout):
+			t.Fatalf("Expected delivery attempt %d but nothing received", i+1)
+		}
+	}
+	a.So(attemptedAt[1].Sub(attemptedAt[0]), should.BeGreaterThanOrEqualTo, suspendInterval-test.Delay)
+
+	var deliveries []*ttnpb.ApplicationWebhookDelivery
+	for i := 0; i < 32 && len(deliveries) == 0; i++ {
+		time.Sleep(test.Delay)
+		var err error
+		deliveries, _, err = queue.ListFailed(ctx, ids, 0, 0)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	if !a.So(deliveries, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(deliveries[0].Attempts, should.Equal, uint32(2))
+	a.So(deliveries[0].LastError, should.NotBeNil)
+	a.So(deliveries[0].LastError.Name, should.Equal, "test")
+}
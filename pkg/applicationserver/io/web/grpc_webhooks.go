@@ -16,10 +16,17 @@ package web
 
 import (
 	"context"
+	"crypto/tls"
 	"strconv"
+	"strings"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -38,18 +45,47 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 }
 
 type webhookRegistryRPC struct {
-	webhooks  WebhookRegistry
-	templates TemplateStore
+	webhooks        WebhookRegistry
+	templates       TemplateStore
+	deliveries      DeliveryQueue
+	keyVault        crypto.KeyVault
+	encryptionKeyID string
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// If deliveries is nil, the failed deliveries are not available.
+// The webhook secrets are encrypted with the key with the given ID. If the ID is empty, secrets are stored as plaintext.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore, deliveries DeliveryQueue, keyVault crypto.KeyVault, encryptionKeyID string) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:        webhooks,
+		templates:       templates,
+		deliveries:      deliveries,
+		keyVault:        keyVault,
+		encryptionKeyID: encryptionKeyID,
 	}
 }
 
+// decryptSecrets decrypts the secrets of the webhook.
+// If the caller is not allowed to read the secrets, the secrets are removed.
+func (s webhookRegistryRPC) decryptSecrets(ctx context.Context, webhook *ttnpb.ApplicationWebhook) error {
+	canRead := rights.RequireApplication(ctx, webhook.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC) == nil
+	for _, secret := range []**ttnpb.Secret{&webhook.SigningSecret, &webhook.ClientPrivateKey} {
+		if *secret == nil {
+			continue
+		}
+		if !canRead {
+			*secret = nil
+			continue
+		}
+		value, err := decryptSecret(ctx, s.keyVault, *secret)
+		if err != nil {
+			return err
+		}
+		(*secret).Value = value
+	}
+	return nil
+}
+
 func (s webhookRegistryRPC) GetFormats(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.ApplicationWebhookFormats, error) {
 	fs := make(map[string]string, len(formats))
 	for key, val := range formats {
@@ -72,7 +108,14 @@ func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWe
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	webhook, err := s.webhooks.Get(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptSecrets(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
@@ -83,6 +126,11 @@ func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplication
 	if err != nil {
 		return nil, err
 	}
+	for _, webhook := range webhooks {
+		if err := s.decryptSecrets(ctx, webhook); err != nil {
+			return nil, err
+		}
+	}
 	defer func() {
 		if err == nil {
 			setTotalHeader(ctx, uint64(len(webhooks)))
@@ -93,6 +141,32 @@ func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplication
 	}, nil
 }
 
+var webhookSecretPaths = []string{
+	"client_private_key",
+	"signing_secret",
+}
+
+// flattenSecretPaths replaces the sub-paths of secrets by the path of the secret.
+// Secrets are set as a whole, as the key ID depends on the value.
+func flattenSecretPaths(paths []string) []string {
+	res := make([]string, 0, len(paths))
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		for _, secretPath := range webhookSecretPaths {
+			if strings.HasPrefix(path, secretPath+".") {
+				path = secretPath
+			}
+		}
+		if !seen[path] {
+			res = append(res, path)
+			seen[path] = true
+		}
+	}
+	return res
+}
+
+var errHealthReadOnly = errors.DefineInvalidArgument("health_read_only", "health is read-only and can only be reset")
+
 func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWebhookRequest) (*ttnpb.ApplicationWebhook, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
 		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
@@ -101,9 +175,40 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...),
+	for _, path := range req.FieldMask.Paths {
+		if path == "health" && req.Health == nil {
+			continue
+		}
+		if path == "health" || strings.HasPrefix(path, "health.") {
+			return nil, errHealthReadOnly.New()
+		}
+	}
+	req.FieldMask.Paths = flattenSecretPaths(req.FieldMask.Paths)
+	if err := validateMessages(&req.ApplicationWebhook, req.FieldMask.Paths); err != nil {
+		return nil, err
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "client_certificate", "client_private_key") &&
+		len(req.ClientCertificate) > 0 && req.ClientPrivateKey != nil {
+		if _, err := tls.X509KeyPair(req.ClientCertificate, req.ClientPrivateKey.Value); err != nil {
+			return nil, errClientCertificate.WithCause(err)
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "signing_secret") {
+		if err := encryptSecret(ctx, s.keyVault, s.encryptionKeyID, req.SigningSecret); err != nil {
+			return nil, err
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "client_private_key") {
+		if err := encryptSecret(ctx, s.keyVault, s.encryptionKeyID, req.ClientPrivateKey); err != nil {
+			return nil, err
+		}
+	}
+	var resumed bool
+	webhook, err := s.webhooks.Set(ctx, req.ApplicationWebhookIdentifiers, appendImplicitWebhookGetPaths(req.FieldMask.Paths...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
+				resumed = ttnpb.HasAnyField(req.FieldMask.Paths, "health") &&
+					webhook.Health != nil && webhook.Health.SuspendedUntil != nil
 				return &req.ApplicationWebhook, req.FieldMask.Paths, nil
 			}
 			return &req.ApplicationWebhook, append(req.FieldMask.Paths,
@@ -112,6 +217,18 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 			), nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptSecrets(ctx, webhook); err != nil {
+		return nil, err
+	}
+	if resumed {
+		events.Publish(evtWebhookResume.NewWithIdentifiersAndData(ctx, req.ApplicationIdentifiers, &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: req.ApplicationWebhookIdentifiers,
+		}))
+	}
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*pbtypes.Empty, error) {
@@ -130,5 +247,66 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.deliveries != nil {
+		if _, err := s.deliveries.RemoveFailed(ctx, *req); err != nil {
+			return nil, err
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+var errFailedDeliveriesUnavailable = errors.DefineFailedPrecondition("failed_deliveries_unavailable", "failed deliveries unavailable")
+
+func (s webhookRegistryRPC) ListFailedDeliveries(ctx context.Context, req *ttnpb.ListApplicationWebhookFailedDeliveriesRequest) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errFailedDeliveriesUnavailable.New()
+	}
+	deliveries, total, err := s.deliveries.ListFailed(ctx, req.ApplicationWebhookIdentifiers, req.Limit, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	// The headers of the deliveries may contain credentials.
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		for _, delivery := range deliveries {
+			delivery.Headers = nil
+		}
+	}
+	setTotalHeader(ctx, total)
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayFailedDeliveries(ctx context.Context, req *ttnpb.ReplayApplicationWebhookFailedDeliveriesRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errFailedDeliveriesUnavailable.New()
+	}
+	deliveries, err := s.deliveries.RemoveFailed(ctx, req.ApplicationWebhookIdentifiers, req.DeliveryIDs...)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	for i, delivery := range deliveries {
+		delivery.Attempts = 0
+		if err := s.deliveries.Add(ctx, delivery, now); err != nil {
+			// Put back the deliveries that are not queued.
+			for _, delivery := range deliveries[i:] {
+				if err := s.deliveries.AddFailed(ctx, delivery); err != nil {
+					log.FromContext(ctx).WithError(err).WithField("delivery_id", delivery.DeliveryID).Warn("Failed to restore failed delivery")
+				}
+			}
+			return nil, err
+		}
+	}
 	return ttnpb.Empty, nil
 }
@@ -16,6 +16,7 @@ package web_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/types"
@@ -27,6 +28,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -56,7 +59,11 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	deliveries := redis.NewDeliveryQueue(redisClient, 100, 10, "as", "test")
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf},
+	})
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, deliveries, keyVault, "test")
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -137,6 +144,148 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(res.BaseURL, should.Equal, "http://localhost/test")
 	}
 
+	hookIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	// Set signing secret; assert encrypted at rest.
+	{
+		res, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: hookIDs,
+				SigningSecret: &ttnpb.Secret{
+					Value: []byte("signing-secret"),
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"signing_secret.value"},
+			},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(res.SigningSecret, should.NotBeNil) {
+			a.So(res.SigningSecret.Value, should.Resemble, []byte("signing-secret"))
+		}
+
+		stored, err := webhookReg.Get(ctx, hookIDs, []string{"signing_secret"})
+		if a.So(err, should.BeNil) && a.So(stored.SigningSecret, should.NotBeNil) {
+			a.So(stored.SigningSecret.KeyID, should.Equal, "test")
+			a.So(stored.SigningSecret.Value, should.NotResemble, []byte("signing-secret"))
+		}
+	}
+
+	// Get signing secret; assert decrypted.
+	{
+		res, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+			ApplicationWebhookIdentifiers: hookIDs,
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"signing_secret"},
+			},
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(res.SigningSecret, should.NotBeNil) {
+			a.So(res.SigningSecret.Value, should.Resemble, []byte("signing-secret"))
+		}
+	}
+
+	// Set invalid client certificate.
+	{
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: hookIDs,
+				ClientCertificate:             []byte("invalid"),
+				ClientPrivateKey: &ttnpb.Secret{
+					Value: []byte("invalid"),
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"client_certificate", "client_private_key"},
+			},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Set invalid message body template and field mask.
+	for _, msg := range []*ttnpb.ApplicationWebhook_Message{
+		{
+			BodyTemplate: "{{.uplink_message",
+		},
+		{
+			BodyTemplate: `{{define "loop"}}{{template "loop" .}}{{end}}{{template "loop" .}}`,
+		},
+		{
+			BodyTemplate: `{{template "body" .}}`,
+		},
+		{
+			BodyTemplate: `{{range .uplink_message.rx_metadata}}{{range $.uplink_message.rx_metadata}}{{end}}{{end}}`,
+		},
+		{
+			BodyTemplate: strings.Repeat("{{with .}}", 9) + strings.Repeat("{{end}}", 9),
+		},
+		{
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"up.join_accept"},
+			},
+		},
+		{
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"unknown"},
+			},
+		},
+	} {
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: hookIDs,
+				UplinkMessage:                 msg,
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"uplink_message"},
+			},
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	// Add failed deliveries.
+	for _, id := range []string{"delivery-1", "delivery-2"} {
+		err := deliveries.AddFailed(ctx, &ttnpb.ApplicationWebhookDelivery{
+			ApplicationWebhookIdentifiers: hookIDs,
+			DeliveryID:                    id,
+			URL:                           "http://localhost/test/up",
+			Attempts:                      5,
+		})
+		a.So(err, should.BeNil)
+	}
+
+	// List failed deliveries; assert most recent first.
+	{
+		res, err := client.ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: hookIDs,
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(res.Deliveries, should.HaveLength, 2) {
+			a.So(res.Deliveries[0].DeliveryID, should.Equal, "delivery-2")
+			a.So(res.Deliveries[1].DeliveryID, should.Equal, "delivery-1")
+		}
+	}
+
+	// Replay failed delivery.
+	{
+		_, err := client.ReplayFailedDeliveries(ctx, &ttnpb.ReplayApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: hookIDs,
+			DeliveryIDs:                   []string{"delivery-1"},
+		}, creds)
+		a.So(err, should.BeNil)
+	}
+
+	// List failed deliveries; assert one.
+	{
+		res, err := client.ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: hookIDs,
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(res.Deliveries, should.HaveLength, 1) {
+			a.So(res.Deliveries[0].DeliveryID, should.Equal, "delivery-2")
+		}
+	}
+
 	// Delete.
 	{
 		_, err := client.Delete(ctx, &ttnpb.ApplicationWebhookIdentifiers{
@@ -157,6 +306,15 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(res.Webhooks, should.BeEmpty)
 	}
+
+	// Check failed deliveries empty.
+	{
+		res, err := client.ListFailedDeliveries(ctx, &ttnpb.ListApplicationWebhookFailedDeliveriesRequest{
+			ApplicationWebhookIdentifiers: hookIDs,
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(res.Deliveries, should.BeEmpty)
+	}
 }
 
 func TestTemplateStoreRPC(t *testing.T) {
@@ -235,7 +393,7 @@ description: Bar`),
 			a.So(err, should.BeNil)
 
 			c := componenttest.NewComponent(t, &component.Config{})
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil, nil, "")})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
@@ -0,0 +1,277 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// HealthSink is a ControllableSink that tracks the health of the webhooks in the WebhookRegistry.
+// When the number of consecutive failed requests to a webhook reaches the failure threshold, the webhook is
+// suspended and requests are rejected. After the suspend interval, a single request is processed to probe the webhook:
+// if it succeeds, the webhook is resumed, otherwise the webhook is suspended again.
+// If Probe is set, the last request that is rejected or that fails while the webhook is suspended is retained, and it
+// is processed periodically to probe the webhook until it succeeds or until a newer request is retained. Probe should
+// not be set when the rejected requests are retried, as the retries probe the webhook.
+type HealthSink struct {
+	Target   Sink
+	Registry WebhookRegistry
+	Config   HealthConfig
+	Probe    bool
+
+	probesMu sync.Mutex
+	probes   map[string]*healthProbe
+}
+
+// healthProbe is a request that is processed to probe a suspended webhook.
+type healthProbe struct {
+	req *http.Request
+	at  time.Time
+}
+
+type webhookHealthKeyType struct{}
+
+var webhookHealthKey webhookHealthKeyType
+
+// withWebhookHealth returns a context with the health of the webhook, so that HealthSink does not retrieve it.
+func withWebhookHealth(ctx context.Context, health *ttnpb.ApplicationWebhookHealth) context.Context {
+	return context.WithValue(ctx, webhookHealthKey, health)
+}
+
+func webhookHealthFromContext(ctx context.Context) (*ttnpb.ApplicationWebhookHealth, bool) {
+	health, ok := ctx.Value(webhookHealthKey).(*ttnpb.ApplicationWebhookHealth)
+	return health, ok
+}
+
+var errWebhookSuspended = errors.DefineUnavailable("webhook_suspended", "webhook suspended until `{suspended_until}`")
+
+// Run processes the retained requests to probe the suspended webhooks every suspend interval.
+// This method blocks until the context is done.
+func (s *HealthSink) Run(ctx context.Context) error {
+	if !s.Probe || s.Config.SuspendInterval <= 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	ticker := time.NewTicker(s.Config.SuspendInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			s.processProbes(ctx)
+		}
+	}
+}
+
+// processProbes processes the retained requests of which the suspension of the webhook expired.
+func (s *HealthSink) processProbes(ctx context.Context) {
+	now := time.Now()
+	var reqs []*http.Request
+	s.probesMu.Lock()
+	for uid, probe := range s.probes {
+		if probe.at.After(now) {
+			continue
+		}
+		reqs = append(reqs, probe.req)
+		delete(s.probes, uid)
+	}
+	s.probesMu.Unlock()
+
+	wg := sync.WaitGroup{}
+	for _, req := range reqs {
+		req := req
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.Process(req); err != nil {
+				log.FromContext(ctx).WithError(err).Debug("Webhook probe failed")
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// retainProbe retains the request to probe the webhook when the suspension expires.
+// The request is not retained if its body cannot be read again.
+func (s *HealthSink) retainProbe(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, req *http.Request, suspendedUntil time.Time) {
+	if !s.Probe {
+		return
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to retain webhook probe")
+			return
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+	uid := unique.ID(ctx, ids)
+	s.probesMu.Lock()
+	if s.probes == nil {
+		s.probes = make(map[string]*healthProbe)
+	}
+	s.probes[uid] = &healthProbe{
+		req: req,
+		at:  suspendedUntil,
+	}
+	s.probesMu.Unlock()
+}
+
+// Process processes the request with the target, unless the webhook is suspended.
+// The request context must contain the webhook identifiers. If the request context contains the health of the
+// webhook, the health is not retrieved from the registry.
+// While the webhook is suspended according to the health, the request is rejected without accessing the registry.
+// Only when the suspension expired, the registry is updated to claim the probe of the webhook.
+func (s *HealthSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	ids, ok := ctx.Value(webhookIDKey).(ttnpb.ApplicationWebhookIdentifiers)
+	if !ok {
+		return errNoWebhookID.New()
+	}
+	health, ok := webhookHealthFromContext(ctx)
+	if !ok {
+		hook, err := s.Registry.Get(ctx, ids, []string{"health"})
+		if err != nil {
+			return err
+		}
+		health = hook.Health
+	}
+	if health != nil && health.SuspendedUntil != nil {
+		if suspendedUntil := *health.SuspendedUntil; time.Now().Before(suspendedUntil) {
+			s.retainProbe(ctx, ids, req, suspendedUntil)
+			return errWebhookSuspended.WithAttributes("suspended_until", suspendedUntil)
+		}
+		if suspendedUntil, err := s.claimProbe(ctx, ids); err != nil {
+			if errors.Resemble(err, errWebhookSuspended) {
+				s.retainProbe(ctx, ids, req, suspendedUntil)
+			}
+			return err
+		}
+	}
+	if err := s.Target.Process(req); err != nil {
+		if suspendedUntil, ok := s.reportFailure(ctx, ids, err); ok {
+			s.retainProbe(ctx, ids, req, suspendedUntil)
+		}
+		return err
+	}
+	if health != nil {
+		s.reportSuccess(ctx, ids)
+	}
+	return nil
+}
+
+// claimProbe returns an error if the webhook is suspended, with the time until which the webhook is suspended.
+// If the suspension expired, the suspension is extended so that concurrent requests are rejected while probing.
+func (s *HealthSink) claimProbe(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) (time.Time, error) {
+	var suspendedUntil time.Time
+	_, err := s.Registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		if hook == nil {
+			return nil, nil, errWebhookNotFound.New()
+		}
+		if hook.Health == nil || hook.Health.SuspendedUntil == nil {
+			return hook, nil, nil
+		}
+		now := time.Now().UTC()
+		if now.Before(*hook.Health.SuspendedUntil) {
+			suspendedUntil = *hook.Health.SuspendedUntil
+			return nil, nil, errWebhookSuspended.WithAttributes("suspended_until", suspendedUntil)
+		}
+		until := now.Add(s.Config.SuspendInterval)
+		hook.Health.SuspendedUntil = &until
+		return hook, []string{"health.suspended_until"}, nil
+	})
+	return suspendedUntil, err
+}
+
+// reportFailure tracks the failed request. If the webhook is suspended, it returns the time until which the webhook
+// is suspended.
+func (s *HealthSink) reportFailure(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, reqErr error) (time.Time, bool) {
+	var suspended bool
+	hook, err := s.Registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		if hook == nil {
+			return nil, nil, nil
+		}
+		if hook.Health == nil {
+			hook.Health = &ttnpb.ApplicationWebhookHealth{}
+		}
+		now := time.Now().UTC()
+		hook.Health.FailedAttempts++
+		hook.Health.LastFailedAttemptAt = &now
+		hook.Health.LastError = errorDetails(reqErr)
+		if hook.Health.FailedAttempts >= s.Config.FailureThreshold {
+			suspended = hook.Health.SuspendedUntil == nil
+			suspendedUntil := now.Add(s.Config.SuspendInterval)
+			hook.Health.SuspendedUntil = &suspendedUntil
+		}
+		return hook, []string{"health"}, nil
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update webhook health")
+		return time.Time{}, false
+	}
+	if hook == nil || hook.Health == nil || hook.Health.SuspendedUntil == nil {
+		return time.Time{}, false
+	}
+	if suspended {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"webhook_id", ids.WebhookID,
+			"suspended_until", *hook.Health.SuspendedUntil,
+		)).Info("Webhook suspended")
+		events.Publish(evtWebhookSuspend.NewWithIdentifiersAndData(ctx, ids.ApplicationIdentifiers, &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			Health:                        hook.Health,
+		}))
+	}
+	return *hook.Health.SuspendedUntil, true
+}
+
+func (s *HealthSink) reportSuccess(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) {
+	var resumed bool
+	_, err := s.Registry.Set(ctx, ids, []string{"health"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		if hook == nil {
+			return nil, nil, nil
+		}
+		if hook.Health == nil {
+			return hook, nil, nil
+		}
+		resumed = hook.Health.SuspendedUntil != nil
+		hook.Health = nil
+		return hook, []string{"health"}, nil
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update webhook health")
+		return
+	}
+	if resumed {
+		log.FromContext(ctx).WithField("webhook_id", ids.WebhookID).Info("Webhook resumed")
+		events.Publish(evtWebhookResume.NewWithIdentifiersAndData(ctx, ids.ApplicationIdentifiers, &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+		}))
+	}
+}
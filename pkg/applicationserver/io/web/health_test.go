@@ -0,0 +1,253 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type toggleSink struct {
+	ch   chan *http.Request
+	fail int32
+}
+
+func (s *toggleSink) Process(req *http.Request) error {
+	fail := atomic.LoadInt32(&s.fail) != 0
+	s.ch <- req
+	if fail {
+		return errTest.New()
+	}
+	return nil
+}
+
+// countingRegistry is a WebhookRegistry that counts the Get and Set calls.
+type countingRegistry struct {
+	web.WebhookRegistry
+	gets, sets int32
+}
+
+func (r *countingRegistry) Get(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string) (*ttnpb.ApplicationWebhook, error) {
+	atomic.AddInt32(&r.gets, 1)
+	return r.WebhookRegistry.Get(ctx, ids, paths)
+}
+
+func (r *countingRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error) {
+	atomic.AddInt32(&r.sets, 1)
+	return r.WebhookRegistry.Set(ctx, ids, paths, f)
+}
+
+func TestHealthSink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	registry, closeRegistry := newWebhookRegistry(ctx, t, "https://myapp.com/api", nil)
+	defer closeRegistry()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	target := &toggleSink{
+		ch:   make(chan *http.Request, 4),
+		fail: 1,
+	}
+	suspendInterval := timeout
+	sinkRegistry := &countingRegistry{WebhookRegistry: registry}
+	sink := &web.HealthSink{
+		Target:   target,
+		Registry: sinkRegistry,
+		Config: web.HealthConfig{
+			FailureThreshold: 2,
+			SuspendInterval:  suspendInterval,
+		},
+	}
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+
+	expectRequest := func(expected bool) {
+		select {
+		case <-target.ch:
+			if !expected {
+				t.Fatal("Expected no request, but request received")
+			}
+		case <-time.After(timeout / 2):
+			if expected {
+				t.Fatal("Expected request, but nothing received")
+			}
+		}
+	}
+	getHealth := func(f func(*ttnpb.ApplicationWebhookHealth) bool) *ttnpb.ApplicationWebhookHealth {
+		var health *ttnpb.ApplicationWebhookHealth
+		for i := 0; i < 32; i++ {
+			hook, err := registry.Get(ctx, ids, []string{"health"})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			health = hook.Health
+			if f(health) {
+				break
+			}
+			time.Sleep(test.Delay)
+		}
+		return health
+	}
+
+	// The first failure is tracked, but the webhook is not suspended.
+	sendUplink(ctx, t, sub, 42)
+	expectRequest(true)
+	health := getHealth(func(h *ttnpb.ApplicationWebhookHealth) bool { return h != nil })
+	if !a.So(health, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(health.FailedAttempts, should.Equal, uint32(1))
+	a.So(health.LastFailedAttemptAt, should.NotBeNil)
+	a.So(health.LastError, should.NotBeNil)
+	a.So(health.SuspendedUntil, should.BeNil)
+
+	// The second failure reaches the threshold and suspends the webhook.
+	sendUplink(ctx, t, sub, 42)
+	expectRequest(true)
+	health = getHealth(func(h *ttnpb.ApplicationWebhookHealth) bool { return h != nil && h.SuspendedUntil != nil })
+	a.So(health.FailedAttempts, should.Equal, uint32(2))
+	if !a.So(health.SuspendedUntil, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	// Requests are dropped while the webhook is suspended, without accessing the registry.
+	sets := atomic.LoadInt32(&sinkRegistry.sets)
+	for i := 0; i < 3; i++ {
+		sendUplink(ctx, t, sub, 42)
+	}
+	expectRequest(false)
+	a.So(atomic.LoadInt32(&sinkRegistry.sets), should.Equal, sets)
+
+	// After the suspend interval, a request probes the webhook and the webhook resumes on success.
+	time.Sleep(time.Until(*health.SuspendedUntil))
+	atomic.StoreInt32(&target.fail, 0)
+	sendUplink(ctx, t, sub, 42)
+	expectRequest(true)
+	health = getHealth(func(h *ttnpb.ApplicationWebhookHealth) bool { return h == nil })
+	a.So(health, should.BeNil)
+
+	sendUplink(ctx, t, sub, 42)
+	expectRequest(true)
+
+	// The health is retrieved with the webhook when the request is created.
+	a.So(atomic.LoadInt32(&sinkRegistry.gets), should.Equal, 0)
+}
+
+func TestHealthSinkProbe(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	registry, closeRegistry := newWebhookRegistry(ctx, t, "https://myapp.com/api", nil)
+	defer closeRegistry()
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	target := &toggleSink{
+		ch:   make(chan *http.Request, 4),
+		fail: 1,
+	}
+	suspendInterval := timeout
+	sink := &web.HealthSink{
+		Target:   target,
+		Registry: registry,
+		Config: web.HealthConfig{
+			FailureThreshold: 1,
+			SuspendInterval:  suspendInterval,
+		},
+		Probe: true,
+	}
+	go sink.Run(ctx)
+	w := web.NewWebhooks(ctx, nil, registry, sink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+
+	expectRequest := func(up *ttnpb.ApplicationUp, wait time.Duration) {
+		select {
+		case req := <-target.ch:
+			actualBody, err := ioutil.ReadAll(req.Body)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			expectedBody, err := formatters.JSON.FromUp(up)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(actualBody, should.Resemble, expectedBody)
+		case <-time.After(wait):
+			t.Fatal("Expected request, but nothing received")
+		}
+	}
+
+	// The failure suspends the webhook. The request that is rejected while the webhook is suspended is retained and
+	// it probes the webhook periodically, without new requests.
+	expectRequest(sendUplink(ctx, t, sub, 42), timeout)
+	for i := 0; i < 32; i++ {
+		hook, err := registry.Get(ctx, ids, []string{"health"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if hook.Health != nil && hook.Health.SuspendedUntil != nil {
+			break
+		}
+		time.Sleep(test.Delay)
+	}
+	probe := sendUplink(ctx, t, sub, 43)
+	for i := 0; i < 2; i++ {
+		expectRequest(probe, 2*suspendInterval+timeout)
+	}
+
+	// The probe resumes the webhook when it succeeds.
+	atomic.StoreInt32(&target.fail, 0)
+	expectRequest(probe, 2*suspendInterval+timeout)
+	var health *ttnpb.ApplicationWebhookHealth
+	for i := 0; i < 32; i++ {
+		hook, err := registry.Get(ctx, ids, []string{"health"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		if health = hook.Health; health == nil {
+			break
+		}
+		time.Sleep(test.Delay)
+	}
+	a.So(health, should.BeNil)
+
+	select {
+	case <-target.ch:
+		t.Fatal("Expected no more requests")
+	case <-time.After(2*suspendInterval + timeout):
+	}
+}
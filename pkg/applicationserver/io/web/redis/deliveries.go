@@ -0,0 +1,430 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DeliveryQueue is a Redis webhook delivery queue.
+//
+// The deliveries of all webhooks share one queue, so that the workers of all Application Server instances process
+// the deliveries in order of availability, regardless of the webhook. Retries of failing webhooks are postponed by
+// their backoff and do not hold up the deliveries of other webhooks. The failed deliveries are stored per webhook.
+//
+// Deliveries that are available are in a stream, which is read by a consumer group. Deliveries that become available
+// later wait in a sorted set, from which Run moves them to the stream when they become available. A delivery is
+// deleted from the stream when it is processed. Deliveries that are read but not processed, for example because the
+// consumer stopped, are claimed by another consumer when they are pending for longer than ClaimIdle.
+type DeliveryQueue struct {
+	Redis *ttnredis.Client
+	// MaxLen is the maximum number of deliveries in the queue. New deliveries are rejected when the queue is full.
+	// If zero, the number of deliveries is not limited.
+	MaxLen int64
+	// MaxFailed is the maximum number of failed deliveries that are kept per webhook.
+	// If zero, all failed deliveries are kept.
+	MaxFailed int64
+	// Group is the consumer group and ID is the consumer ID.
+	Group, ID string
+	// ClaimIdle is the duration after which pending deliveries of other consumers are claimed.
+	// This must be longer than the time it takes to deliver a request.
+	ClaimIdle time.Duration
+	// DispatchInterval is the maximum interval in which Run moves waiting deliveries to the stream.
+	DispatchInterval time.Duration
+}
+
+const (
+	// DefaultClaimIdle is the default duration after which pending deliveries of other consumers are claimed.
+	DefaultClaimIdle = 5 * time.Minute
+	// DefaultDispatchInterval is the default maximum interval in which waiting deliveries are moved to the stream.
+	DefaultDispatchInterval = time.Second
+)
+
+// NewDeliveryQueue returns a new webhook delivery queue.
+func NewDeliveryQueue(cl *ttnredis.Client, maxLen, maxFailed int64, group, id string) *DeliveryQueue {
+	return &DeliveryQueue{
+		Redis:            cl,
+		MaxLen:           maxLen,
+		MaxFailed:        maxFailed,
+		Group:            group,
+		ID:               id,
+		ClaimIdle:        DefaultClaimIdle,
+		DispatchInterval: DefaultDispatchInterval,
+	}
+}
+
+const (
+	payloadKey = "payload"
+
+	// claimCount is the number of pending deliveries that are inspected to claim one.
+	claimCount = 10
+)
+
+func (q *DeliveryQueue) readyKey() string {
+	return q.Redis.Key("queue", "ready")
+}
+
+func (q *DeliveryQueue) waitingKey() string {
+	return q.Redis.Key("queue", "waiting")
+}
+
+func (q *DeliveryQueue) failedKey(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return q.Redis.Key("failed", unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+// Init initializes the consumer group of the queue.
+// It must be called at least once before using the queue.
+func (q *DeliveryQueue) Init() error {
+	err := q.Redis.XGroupCreateMkStream(q.readyKey(), q.Group, "0").Err()
+	if err != nil && !ttnredis.IsConsumerGroupExistsErr(err) {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+var errQueueFull = errors.DefineResourceExhausted("queue_full", "webhook delivery queue is full with `{max}` deliveries")
+
+// Add implements web.DeliveryQueue.
+func (q *DeliveryQueue) Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, at time.Time) error {
+	if q.MaxLen > 0 {
+		var readyCmd, waitingCmd *redis.IntCmd
+		if _, err := q.Redis.Pipelined(func(p redis.Pipeliner) error {
+			readyCmd = p.XLen(q.readyKey())
+			waitingCmd = p.ZCard(q.waitingKey())
+			return nil
+		}); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if readyCmd.Val()+waitingCmd.Val() >= q.MaxLen {
+			return errQueueFull.WithAttributes("max", q.MaxLen)
+		}
+	}
+	return q.Postpone(ctx, delivery, at)
+}
+
+// Postpone implements web.DeliveryQueue.
+func (q *DeliveryQueue) Postpone(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, at time.Time) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	if !at.After(time.Now()) {
+		return ttnredis.ConvertError(q.Redis.XAdd(&redis.XAddArgs{
+			Stream: q.readyKey(),
+			Values: map[string]interface{}{
+				payloadKey: s,
+			},
+		}).Err())
+	}
+	return ttnredis.ConvertError(q.Redis.ZAdd(q.waitingKey(), &redis.Z{
+		Score:  float64(at.UnixNano()),
+		Member: s,
+	}).Err())
+}
+
+// dispatch moves the waiting deliveries that are available to the stream.
+// It returns the time at which the next waiting delivery becomes available, or zero if there is none.
+// If the waiting deliveries are modified concurrently, dispatch returns redis.TxFailedErr.
+func (q *DeliveryQueue) dispatch() (time.Time, error) {
+	var next time.Time
+	err := q.Redis.Watch(func(tx *redis.Tx) error {
+		ss, err := tx.ZRangeByScore(q.waitingKey(), &redis.ZRangeBy{
+			Min: "-inf",
+			Max: strconv.FormatInt(time.Now().UnixNano(), 10),
+		}).Result()
+		if err != nil {
+			return err
+		}
+		var nextCmd *redis.ZSliceCmd
+		_, err = tx.TxPipelined(func(p redis.Pipeliner) error {
+			if len(ss) > 0 {
+				members := make([]interface{}, 0, len(ss))
+				for _, s := range ss {
+					p.XAdd(&redis.XAddArgs{
+						Stream: q.readyKey(),
+						Values: map[string]interface{}{
+							payloadKey: s,
+						},
+					})
+					members = append(members, s)
+				}
+				p.ZRem(q.waitingKey(), members...)
+			}
+			nextCmd = p.ZRangeWithScores(q.waitingKey(), 0, 0)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if zs := nextCmd.Val(); len(zs) == 1 {
+			next = time.Unix(0, int64(zs[0].Score))
+		}
+		return nil
+	}, q.waitingKey())
+	if err != nil && err != redis.TxFailedErr {
+		return time.Time{}, ttnredis.ConvertError(err)
+	}
+	return next, err
+}
+
+// Run implements web.DeliveryQueue.
+// The consumer is not deleted when Run returns, so that the deliveries that it has pending are claimed by the other
+// consumers or by the consumer itself when it runs again.
+func (q *DeliveryQueue) Run(ctx context.Context) error {
+	if err := q.Init(); err != nil {
+		return err
+	}
+	for {
+		next, err := q.dispatch()
+		switch {
+		case err == redis.TxFailedErr:
+			continue
+		case err != nil:
+			return err
+		}
+		wait := q.DispatchInterval
+		if wait <= 0 {
+			wait = DefaultDispatchInterval
+		}
+		if !next.IsZero() {
+			if d := time.Until(next); d < wait {
+				wait = d
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// claim claims a delivery that is pending for longer than ClaimIdle.
+// It returns nil if there is no such delivery.
+func (q *DeliveryQueue) claim() (*redis.XMessage, error) {
+	if q.ClaimIdle <= 0 {
+		return nil, nil
+	}
+	pending, err := q.Redis.XPendingExt(&redis.XPendingExtArgs{
+		Stream: q.readyKey(),
+		Group:  q.Group,
+		Start:  "-",
+		End:    "+",
+		Count:  claimCount,
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	for _, p := range pending {
+		if p.Idle < q.ClaimIdle {
+			continue
+		}
+		// XCLAIM only claims the delivery if it is still idle, so only one consumer claims it.
+		msgs, err := q.Redis.XClaim(&redis.XClaimArgs{
+			Stream:   q.readyKey(),
+			Group:    q.Group,
+			Consumer: q.ID,
+			MinIdle:  q.ClaimIdle,
+			Messages: []string{p.ID},
+		}).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if len(msgs) == 1 {
+			return &msgs[0], nil
+		}
+	}
+	return nil, nil
+}
+
+// read reads a new delivery from the stream, blocking until the context deadline, if any.
+// It returns nil if there is no delivery.
+func (q *DeliveryQueue) read(ctx context.Context) (*redis.XMessage, error) {
+	var block time.Duration
+	if dl, ok := ctx.Deadline(); ok {
+		block = time.Until(dl)
+		switch {
+		case block <= 0:
+			block = time.Duration(-1)
+		case block < time.Millisecond:
+			// NOTE: Block is truncated to milliseconds and 0 blocks forever.
+			block = time.Millisecond
+		}
+	}
+	rets, err := q.Redis.XReadGroup(&redis.XReadGroupArgs{
+		Group:    q.Group,
+		Consumer: q.ID,
+		Streams:  []string{q.readyKey(), ">"},
+		Count:    1,
+		Block:    block,
+	}).Result()
+	if err != nil && err != redis.Nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	for _, ret := range rets {
+		for _, msg := range ret.Messages {
+			return &msg, nil
+		}
+	}
+	return nil, nil
+}
+
+// ack acknowledges the delivery and deletes it from the stream.
+func (q *DeliveryQueue) ack(id string) error {
+	_, err := q.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.XAck(q.readyKey(), q.Group, id)
+		p.XDel(q.readyKey(), id)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+var errInvalidPayload = errors.DefineCorruption("invalid_payload", "invalid delivery payload")
+
+// Pop implements web.DeliveryQueue.
+// Deliveries that are pending for longer than ClaimIdle are claimed before new deliveries are read.
+func (q *DeliveryQueue) Pop(ctx context.Context, f func(context.Context, *ttnpb.ApplicationWebhookDelivery) error) error {
+	msg, err := q.claim()
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		if msg, err = q.read(ctx); err != nil || msg == nil {
+			return err
+		}
+	}
+	s, ok := msg.Values[payloadKey].(string)
+	delivery := &ttnpb.ApplicationWebhookDelivery{}
+	if !ok {
+		err = errInvalidPayload.New()
+	} else if err = ttnredis.UnmarshalProto(s, delivery); err != nil {
+		err = errInvalidPayload.WithCause(err)
+	}
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("id", msg.ID).Warn("Drop invalid delivery")
+		return q.ack(msg.ID)
+	}
+	if err := f(ctx, delivery); err != nil {
+		return err
+	}
+	return q.ack(msg.ID)
+}
+
+// AddFailed implements web.DeliveryQueue.
+func (q *DeliveryQueue) AddFailed(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	k := q.failedKey(ctx, delivery.ApplicationWebhookIdentifiers)
+	_, err = q.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		if q.MaxFailed > 0 {
+			p.LTrim(k, 0, q.MaxFailed-1)
+		}
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// ListFailed implements web.DeliveryQueue.
+func (q *DeliveryQueue) ListFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, limit, page uint32) ([]*ttnpb.ApplicationWebhookDelivery, uint64, error) {
+	k := q.failedKey(ctx, ids)
+	start, stop := int64(0), int64(-1)
+	if limit > 0 {
+		if page == 0 {
+			page = 1
+		}
+		start = int64(limit) * int64(page-1)
+		stop = start + int64(limit) - 1
+	}
+	var (
+		lenCmd   *redis.IntCmd
+		rangeCmd *redis.StringSliceCmd
+	)
+	if _, err := q.Redis.Pipelined(func(p redis.Pipeliner) error {
+		lenCmd = p.LLen(k)
+		rangeCmd = p.LRange(k, start, stop)
+		return nil
+	}); err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	ss := rangeCmd.Val()
+	deliveries := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(ss))
+	for _, s := range ss {
+		delivery := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, uint64(lenCmd.Val()), nil
+}
+
+// RemoveFailed implements web.DeliveryQueue.
+func (q *DeliveryQueue) RemoveFailed(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, deliveryIDs ...string) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	k := q.failedKey(ctx, ids)
+	remove := make(map[string]struct{}, len(deliveryIDs))
+	for _, id := range deliveryIDs {
+		remove[id] = struct{}{}
+	}
+	var removed []*ttnpb.ApplicationWebhookDelivery
+	err := q.Redis.Watch(func(tx *redis.Tx) error {
+		ss, err := tx.LRange(k, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		removed = removed[:0]
+		var removedSs []string
+		for _, s := range ss {
+			delivery := &ttnpb.ApplicationWebhookDelivery{}
+			if err := ttnredis.UnmarshalProto(s, delivery); err != nil {
+				return err
+			}
+			if _, ok := remove[delivery.DeliveryID]; len(remove) > 0 && !ok {
+				continue
+			}
+			removed = append(removed, delivery)
+			removedSs = append(removedSs, s)
+		}
+		if len(removedSs) == 0 {
+			return nil
+		}
+		_, err = tx.TxPipelined(func(p redis.Pipeliner) error {
+			if len(remove) == 0 {
+				p.Del(k)
+				return nil
+			}
+			for _, s := range removedSs {
+				p.LRem(k, 1, s)
+			}
+			return nil
+		})
+		return err
+	}, k)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return removed, nil
+}
@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"testing"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
@@ -57,6 +60,66 @@ func devAddrPtr(addr types.DevAddr) *types.DevAddr {
 	return &addr
 }
 
+// newWebhookRegistry returns a WebhookRegistry in Redis with the registered webhook, which sends uplink messages in
+// JSON to the /up path of the base URL. If f is set, f changes the webhook and returns the paths of the changed fields.
+// The returned function flushes and closes Redis.
+func newWebhookRegistry(ctx context.Context, t *testing.T, baseURL string, f func(*ttnpb.ApplicationWebhook) []string) (*redis.WebhookRegistry, func()) {
+	t.Helper()
+	redisClient, flush := test.NewRedis(t, "web_test")
+	closeFn := func() {
+		flush()
+		redisClient.Close()
+	}
+	registry := &redis.WebhookRegistry{
+		Redis: redisClient,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		hook := &ttnpb.ApplicationWebhook{
+			ApplicationWebhookIdentifiers: ids,
+			BaseURL:                       baseURL,
+			Format:                        "json",
+			UplinkMessage:                 &ttnpb.ApplicationWebhook_Message{Path: "/up"},
+		}
+		paths := []string{
+			"base_url",
+			"format",
+			"ids",
+			"uplink_message",
+		}
+		if f != nil {
+			paths = append(paths, f(hook)...)
+		}
+		return hook, paths, nil
+	})
+	if err != nil {
+		closeFn()
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+	return registry, closeFn
+}
+
+// sendUplink sends an uplink message of the registered device with the given FPort to the subscription.
+func sendUplink(ctx context.Context, t *testing.T, sub *io.Subscription, fPort uint32) *ttnpb.ApplicationUp {
+	t.Helper()
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FRMPayload: []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	if err := sub.SendUp(ctx, up); err != nil {
+		t.Fatalf("Failed to send uplink message: %s", err)
+	}
+	return up
+}
+
 type mockRegisterer struct {
 	context.Context
 	ttnpb.ApplicationWebhookRegistryServer
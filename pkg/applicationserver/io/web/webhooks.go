@@ -17,6 +17,8 @@ package web
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -26,11 +28,13 @@ import (
 
 	stdio "io"
 
+	"github.com/bluele/gcache"
 	"github.com/gorilla/mux"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/version"
 	ttnweb "go.thethings.network/lorawan-stack/v3/pkg/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
@@ -52,15 +56,64 @@ type ControllableSink interface {
 }
 
 // HTTPClientSink contains an HTTP client to make outgoing requests.
+// Requests with a client certificate in the context are performed by a client that presents the certificate.
+// Up to MaxClients clients with client certificates are kept; the idle connections of the least recently used
+// client are closed when the limit is reached.
 type HTTPClientSink struct {
 	*http.Client
+	MaxClients int
+
+	clientsMu sync.Mutex
+	clients   gcache.Cache
+}
+
+const defaultMaxClients = 256
+
+// client returns the HTTP client to perform the request with.
+// The clients with client certificates are cached by the fingerprint of the certificate.
+func (s *HTTPClientSink) client(req *http.Request) *http.Client {
+	cert, ok := clientCertificateFromContext(req.Context())
+	if !ok || len(cert.Certificate) == 0 {
+		return s.Client
+	}
+	fingerprint := sha256.Sum256(cert.Certificate[0])
+	s.clientsMu.Lock()
+	defer s.clientsMu.Unlock()
+	if s.clients == nil {
+		size := s.MaxClients
+		if size <= 0 {
+			size = defaultMaxClients
+		}
+		s.clients = gcache.New(size).LRU().
+			EvictedFunc(func(_, value interface{}) {
+				value.(*http.Client).CloseIdleConnections()
+			}).
+			Build()
+	}
+	if client, err := s.clients.Get(fingerprint); err == nil {
+		return client.(*http.Client)
+	}
+	var transport *http.Transport
+	if t, ok := s.Client.Transport.(*http.Transport); ok {
+		transport = t.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{*cert}
+	client := *s.Client
+	client.Transport = transport
+	s.clients.Set(fingerprint, &client)
+	return &client
 }
 
 var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
 
 // Process uses the HTTP client to perform the request.
 func (s *HTTPClientSink) Process(req *http.Request) error {
-	res, err := s.Do(req)
+	res, err := s.client(req).Do(req)
 	if err != nil {
 		return err
 	}
@@ -142,22 +195,24 @@ type Webhooks interface {
 }
 
 type webhooks struct {
-	ctx       context.Context
-	server    io.Server
-	registry  WebhookRegistry
-	target    Sink
-	downlinks DownlinksConfig
+	ctx           context.Context
+	server        io.Server
+	registry      WebhookRegistry
+	target        Sink
+	downlinks     DownlinksConfig
+	bodyTemplates *bodyTemplateCache
 }
 
 // NewWebhooks returns a new Webhooks.
 func NewWebhooks(ctx context.Context, server io.Server, registry WebhookRegistry, target Sink, downlinks DownlinksConfig) Webhooks {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/web")
 	return &webhooks{
-		ctx:       ctx,
-		server:    server,
-		registry:  registry,
-		target:    target,
-		downlinks: downlinks,
+		ctx:           ctx,
+		server:        server,
+		registry:      registry,
+		target:        target,
+		downlinks:     downlinks,
+		bodyTemplates: newBodyTemplateCache(bodyTemplateCacheSize),
 	}
 }
 
@@ -229,6 +284,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"downlink_sent",
 			"format",
 			"headers",
+			"health",
 			"join_accept",
 			"location_solved",
 			"service_data",
@@ -264,26 +320,29 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 }
 
 func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook) (*http.Request, error) {
-	var cfg *ttnpb.ApplicationWebhook_Message
+	var (
+		cfg  *ttnpb.ApplicationWebhook_Message
+		name string
+	)
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_UplinkMessage:
-		cfg = hook.UplinkMessage
+		cfg, name = hook.UplinkMessage, "uplink_message"
 	case *ttnpb.ApplicationUp_JoinAccept:
-		cfg = hook.JoinAccept
+		cfg, name = hook.JoinAccept, "join_accept"
 	case *ttnpb.ApplicationUp_DownlinkAck:
-		cfg = hook.DownlinkAck
+		cfg, name = hook.DownlinkAck, "downlink_ack"
 	case *ttnpb.ApplicationUp_DownlinkNack:
-		cfg = hook.DownlinkNack
+		cfg, name = hook.DownlinkNack, "downlink_nack"
 	case *ttnpb.ApplicationUp_DownlinkSent:
-		cfg = hook.DownlinkSent
+		cfg, name = hook.DownlinkSent, "downlink_sent"
 	case *ttnpb.ApplicationUp_DownlinkFailed:
-		cfg = hook.DownlinkFailed
+		cfg, name = hook.DownlinkFailed, "downlink_failed"
 	case *ttnpb.ApplicationUp_DownlinkQueued:
-		cfg = hook.DownlinkQueued
+		cfg, name = hook.DownlinkQueued, "downlink_queued"
 	case *ttnpb.ApplicationUp_LocationSolved:
-		cfg = hook.LocationSolved
+		cfg, name = hook.LocationSolved, "location_solved"
 	case *ttnpb.ApplicationUp_ServiceData:
-		cfg = hook.ServiceData
+		cfg, name = hook.ServiceData, "service_data"
 	}
 	if cfg == nil {
 		return nil, nil
@@ -318,11 +377,31 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	if !ok {
 		return nil, errFormatNotFound.WithAttributes("format", hook.Format)
 	}
-	buf, err := format.FromUp(msg)
+	up, err := filterUp(msg, cfg.FieldMask.Paths)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, finalURL.String(), bytes.NewReader(buf))
+	var buf []byte
+	if cfg.BodyTemplate != "" {
+		tmpl, err := w.bodyTemplates.get(bodyTemplateKey{
+			webhookUID: unique.ID(ctx, hook.ApplicationWebhookIdentifiers),
+			message:    name,
+		}, cfg.BodyTemplate)
+		if err != nil {
+			return nil, err
+		}
+		if buf, err = renderBody(ctx, tmpl, up); err != nil {
+			return nil, err
+		}
+	} else if buf, err = format.FromUp(up); err != nil {
+		return nil, err
+	}
+	method := cfg.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	reqCtx := withWebhookHealth(withWebhookID(w.ctx, hook.ApplicationWebhookIdentifiers), hook.Health)
+	req, err := http.NewRequestWithContext(reqCtx, method, finalURL.String(), bytes.NewReader(buf))
 	if err != nil {
 		return nil, err
 	}
@@ -334,9 +413,22 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 		req.Header.Set(downlinkPushHeader, w.createDownlinkURL(ctx, hook.ApplicationWebhookIdentifiers, msg.EndDeviceIdentifiers, "push"))
 		req.Header.Set(downlinkReplaceHeader, w.createDownlinkURL(ctx, hook.ApplicationWebhookIdentifiers, msg.EndDeviceIdentifiers, "replace"))
 	}
-	req.Header.Set("Content-Type", format.ContentType)
+	// The content type of rendered body templates can be overridden with the webhook headers.
+	if cfg.BodyTemplate == "" || req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", format.ContentType)
+	}
 	req.Header.Set("User-Agent", userAgent)
-	return req, nil
+	// The downlink API key and the headers of the webhook may contain credentials.
+	secrets := make([]string, 0, len(hook.Headers)+1)
+	for key, value := range hook.Headers {
+		if req.Header.Get(key) == value {
+			secrets = append(secrets, key)
+		}
+	}
+	if hook.DownlinkAPIKey != "" {
+		secrets = append(secrets, downlinkKeyHeader)
+	}
+	return req.WithContext(withSecretHeaders(req.Context(), secrets...)), nil
 }
 
 var errWebhookNotFound = errors.DefineNotFound("webhook_not_found", "webhook not found")
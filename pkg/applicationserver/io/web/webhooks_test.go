@@ -140,6 +140,16 @@ func TestWebhooks(t *testing.T) {
 				testSink := &mockSink{
 					ch: make(chan *http.Request, 1),
 				}
+				// NOTE: The delivery queue uses a dedicated namespace, so that the workers of the persistent sink of
+				// the previous test case, which may still be blocked in Pop, do not consume the deliveries of this test case.
+				deliveriesRedisClient, deliveriesFlush := test.NewRedis(t, "web_test")
+				defer deliveriesFlush()
+				defer deliveriesRedisClient.Close()
+				deliveries := redis.NewDeliveryQueue(deliveriesRedisClient, 100, 10, "as", "test")
+				deliveries.DispatchInterval = test.Delay
+				if err := deliveries.Init(); err != nil {
+					t.Fatalf("Failed to initialize delivery queue: %s", err)
+				}
 				for _, sink := range []web.Sink{
 					testSink,
 					&web.QueuedSink{
@@ -156,6 +166,12 @@ func TestWebhooks(t *testing.T) {
 						Queue:   make(chan *http.Request, 4),
 						Workers: 1,
 					},
+					&web.PersistentSink{
+						Target:   testSink,
+						Queue:    deliveries,
+						Registry: registry,
+						Workers:  1,
+					},
 				} {
 					t.Run(fmt.Sprintf("%T", sink), func(t *testing.T) {
 						ctx, cancel := context.WithCancel(ctx)
@@ -470,6 +486,160 @@ func TestWebhooks(t *testing.T) {
 	})
 }
 
+func TestWebhookMessageBody(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	registry, closeRegistry := newWebhookRegistry(ctx, t, "https://myapp.com/api/ttn/v3", func(hook *ttnpb.ApplicationWebhook) []string {
+		hook.Headers = map[string]string{
+			"Content-Type": "text/plain",
+		}
+		hook.UplinkMessage = &ttnpb.ApplicationWebhook_Message{
+			Path:         "write",
+			Method:       http.MethodPut,
+			BodyTemplate: `up,device={{.end_device_ids.device_id}} f_cnt={{.uplink_message.f_cnt}}i,session={{json .uplink_message.session_key_id}}`,
+		}
+		hook.JoinAccept = &ttnpb.ApplicationWebhook_Message{
+			Path:   "join",
+			Method: http.MethodPatch,
+			FieldMask: types.FieldMask{
+				Paths: []string{"end_device_ids.device_id", "up.join_accept.session_key_id"},
+			},
+		}
+		return []string{
+			"headers",
+			"join_accept",
+		}
+	})
+	defer closeRegistry()
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+
+	testSink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, testSink, web.DownlinksConfig{})
+	sub := w.NewSubscription()
+
+	expectedJoinAcceptBody, err := formatters.JSON.FromUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			DeviceID: registeredDeviceID.DeviceID,
+		},
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				SessionKeyID: []byte{0x22},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to format join-accept: %s", err)
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		Message     *ttnpb.ApplicationUp
+		Method      string
+		URL         string
+		ContentType string
+		Body        []byte
+	}{
+		{
+			Name: "UplinkMessage/Template",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						SessionKeyID: []byte{0x11},
+						FPort:        42,
+						FCnt:         42,
+						FRMPayload:   []byte{0x1, 0x2, 0x3},
+					},
+				},
+			},
+			Method:      http.MethodPut,
+			URL:         "https://myapp.com/api/ttn/v3/write",
+			ContentType: "text/plain",
+			Body:        []byte(`up,device=foo-device f_cnt=42i,session="EQ=="`),
+		},
+		{
+			Name: "JoinAccept/FieldMask",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				CorrelationIDs:       []string{"test"},
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{
+						SessionKeyID:   []byte{0x22},
+						PendingSession: true,
+					},
+				},
+			},
+			Method:      http.MethodPatch,
+			URL:         "https://myapp.com/api/ttn/v3/join",
+			ContentType: "application/json",
+			Body:        expectedJoinAcceptBody,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			if err := sub.SendUp(ctx, tc.Message); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var req *http.Request
+			select {
+			case req = <-testSink.ch:
+			case <-time.After(timeout):
+				t.Fatal("Expected message but nothing received")
+			}
+			a.So(req.Method, should.Equal, tc.Method)
+			a.So(req.URL.String(), should.Equal, tc.URL)
+			a.So(req.Header.Get("Content-Type"), should.Equal, tc.ContentType)
+			body, err := ioutil.ReadAll(req.Body)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(body), should.Equal, string(tc.Body))
+		})
+	}
+
+	t.Run("UplinkMessage/TemplateChange", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := registry.Set(ctx, ids, []string{"uplink_message"}, func(hook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			hook.UplinkMessage.BodyTemplate = `{{.uplink_message.frm_payload}}`
+			return hook, []string{"uplink_message"}, nil
+		})
+		if err != nil {
+			t.Fatalf("Failed to set webhook in registry: %s", err)
+		}
+		// The body of the first message exceeds the maximum size, so the message is dropped.
+		for _, payload := range [][]byte{make([]byte, 1<<20), {0x1, 0x2, 0x3}} {
+			if err := sub.SendUp(ctx, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: registeredDeviceID,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      42,
+						FRMPayload: payload,
+					},
+				},
+			}); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+		var req *http.Request
+		select {
+		case req = <-testSink.ch:
+		case <-time.After(timeout):
+			t.Fatal("Expected message but nothing received")
+		}
+		body, err := ioutil.ReadAll(req.Body)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(string(body), should.Equal, "AQID")
+	})
+}
+
 type mockSink struct {
 	Component *component.Component
 	Server    io.Server
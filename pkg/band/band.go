@@ -121,6 +121,47 @@ type Beacon struct {
 	ComputeFrequency func(beaconTime float64) uint64
 }
 
+// BeaconLayout is the layout of the beacon frame.
+type BeaconLayout struct {
+	// TimeOffset is the offset of the Time field, which equals the length of the first RFU field.
+	TimeOffset int
+	// InfoDescOffset is the offset of the InfoDesc field, which is the first byte of the GwSpecific field.
+	InfoDescOffset int
+	// Length is the length of the beacon frame.
+	Length int
+}
+
+// beaconRFULengths are the lengths of the first and the second RFU field of the beacon frame by spreading factor.
+var beaconRFULengths = map[uint32][2]int{
+	8:  {1, 3},
+	9:  {2, 0},
+	10: {3, 1},
+	12: {5, 3},
+}
+
+// BeaconLayout returns the layout of the beacon frame, which depends on the spreading factor of the beacon data rate.
+// This method returns false if the band does not define a LoRa beacon data rate with a known layout.
+func (b Band) BeaconLayout() (BeaconLayout, bool) {
+	dr, ok := b.DataRates[b.Beacon.DataRateIndex]
+	if !ok {
+		return BeaconLayout{}, false
+	}
+	lora := dr.Rate.GetLoRa()
+	if lora == nil {
+		return BeaconLayout{}, false
+	}
+	rfu, ok := beaconRFULengths[lora.SpreadingFactor]
+	if !ok {
+		return BeaconLayout{}, false
+	}
+	// The beacon frame consists of RFU | Time (4) | CRC (2) | GwSpecific (7) | RFU | CRC (2).
+	return BeaconLayout{
+		TimeOffset:     rfu[0],
+		InfoDescOffset: rfu[0] + 6,
+		Length:         rfu[0] + 6 + 7 + rfu[1] + 2,
+	}, true
+}
+
 // ChMaskCntlPair pairs a ChMaskCntl with a mask.
 type ChMaskCntlPair struct {
 	Cntl uint8
@@ -155,3 +155,38 @@ func TestFindSubBand(t *testing.T) {
 		})
 	}
 }
+
+func TestBeaconLayout(t *testing.T) {
+	for _, tc := range []struct {
+		bandID string
+		layout band.BeaconLayout
+	}{
+		{
+			bandID: band.EU_863_870,
+			layout: band.BeaconLayout{TimeOffset: 2, InfoDescOffset: 8, Length: 17},
+		},
+		{
+			bandID: band.US_902_928,
+			layout: band.BeaconLayout{TimeOffset: 5, InfoDescOffset: 11, Length: 23},
+		},
+		{
+			bandID: band.CN_470_510,
+			layout: band.BeaconLayout{TimeOffset: 3, InfoDescOffset: 9, Length: 19},
+		},
+		{
+			bandID: band.IN_865_867,
+			layout: band.BeaconLayout{TimeOffset: 1, InfoDescOffset: 7, Length: 19},
+		},
+	} {
+		t.Run(tc.bandID, func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := band.GetByID(tc.bandID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			layout, ok := b.BeaconLayout()
+			a.So(ok, should.BeTrue)
+			a.So(layout, should.Resemble, tc.layout)
+		})
+	}
+}
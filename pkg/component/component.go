@@ -131,7 +131,7 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 
 	ctx = log.NewContext(ctx, logger)
 
-	keyVault, err := config.KeyVault.KeyVault()
+	keyVault, err := config.KeyVault.KeyVault(ctx)
 	if err != nil {
 		return nil, err
 	}
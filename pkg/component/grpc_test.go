@@ -62,6 +62,7 @@ func (as *asImplementation) Subscribe(id *ttnpb.ApplicationIdentifiers, stream t
 }
 
 type gsImplementation struct {
+	ttnpb.UnimplementedGsServer
 	*component.Component
 }
 
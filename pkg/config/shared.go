@@ -31,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"gocloud.dev/blob"
 )
 
@@ -120,11 +121,24 @@ type Cache struct {
 	Redis   redis.Config `name:"redis"`
 }
 
+// RedisEventsStore represents configuration for the event history of the Redis events backend.
+type RedisEventsStore struct {
+	Enable      bool          `name:"enable" description:"Enable storing historical events"`
+	TTL         time.Duration `name:"ttl" description:"How long historical events are retained"`
+	EntityCount int64         `name:"entity-count" description:"How many historical events are retained per entity"`
+}
+
+// RedisEvents represents configuration for the Redis events backend.
+type RedisEvents struct {
+	redis.Config `name:",squash"`
+	Store        RedisEventsStore `name:"store"`
+}
+
 // Events represents configuration for the events system.
 type Events struct {
-	Backend string       `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
-	Redis   redis.Config `name:"redis"`
-	Cloud   CloudEvents  `name:"cloud"`
+	Backend string      `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
+	Redis   RedisEvents `name:"redis"`
+	Cloud   CloudEvents `name:"cloud"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
@@ -140,15 +154,24 @@ type KeyVaultCache struct {
 	TTL  time.Duration `name:"ttl" description:"Cache elements time to live. No expiration mechanism is used if TTL is 0"`
 }
 
+// KeyVaultFile represents the configuration for the file key vault.
+type KeyVaultFile struct {
+	Path           string        `name:"path" description:"Path of the file with secrets sealed by the master key"`
+	MasterKey      []byte        `name:"master-key" description:"Master key (AES-128) that seals the secrets in the file"`
+	ReloadInterval time.Duration `name:"reload-interval" description:"Interval to check the file for changes. Reloading is disabled if the interval is 0"`
+}
+
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
+	Provider string            `name:"provider" description:"Provider (static, file)"`
 	Cache    KeyVaultCache     `name:"cache"`
 	Static   map[string][]byte `name:"static"`
+	File     KeyVaultFile      `name:"file"`
 }
 
 // KeyVault returns an initialized crypto.KeyVault based on the configuration.
-func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
+// The context is used to stop background reloading, if configured.
+func (v KeyVault) KeyVault(ctx context.Context) (crypto.KeyVault, error) {
 	vault := cryptoutil.EmptyKeyVault
 	switch v.Provider {
 	case "static":
@@ -156,6 +179,21 @@ func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
 		kv.Separator = ":"
 		kv.ReplaceOldNew = []string{":", "_"}
 		vault = kv
+	case "file":
+		var masterKey types.AES128Key
+		if err := masterKey.UnmarshalBinary(v.File.MasterKey); err != nil {
+			return nil, err
+		}
+		kv, err := cryptoutil.NewFileKeyVault(v.File.Path, masterKey)
+		if err != nil {
+			return nil, err
+		}
+		kv.Separator = ":"
+		kv.ReplaceOldNew = []string{":", "_"}
+		if v.File.ReloadInterval > 0 {
+			go kv.Watch(ctx, v.File.ReloadInterval)
+		}
+		vault = kv
 	}
 	if v.Cache.Size > 0 {
 		vault = cryptoutil.NewCacheKeyVault(vault, v.Cache.TTL, v.Cache.Size)
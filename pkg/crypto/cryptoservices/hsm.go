@@ -0,0 +1,298 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// HSM is a hardware security module that stores root keys and performs cryptographic operations with them.
+// Keys are referenced by label and never leave the HSM.
+// Implementations are typically backed by a PKCS #11 token.
+type HSM interface {
+	// Wrap wraps the plaintext key with the key with the given label using the RFC 3394 Wrap algorithm.
+	Wrap(ctx context.Context, plaintext []byte, label string) ([]byte, error)
+	// Unwrap unwraps the ciphertext with the key with the given label using the RFC 3394 Unwrap algorithm.
+	Unwrap(ctx context.Context, ciphertext []byte, label string) ([]byte, error)
+	// EncryptECB encrypts the data with the key with the given label using AES-128 in ECB mode.
+	// The length of the data must be a multiple of the block size.
+	EncryptECB(ctx context.Context, data []byte, label string) ([]byte, error)
+	// DecryptECB decrypts the data with the key with the given label using AES-128 in ECB mode.
+	// The length of the data must be a multiple of the block size.
+	DecryptECB(ctx context.Context, data []byte, label string) ([]byte, error)
+	// CMAC computes the AES-CMAC of the data with the key with the given label.
+	CMAC(ctx context.Context, data []byte, label string) ([]byte, error)
+}
+
+// HSMKeyLabeler returns the labels of the root keys of end devices in an HSM.
+type HSMKeyLabeler interface {
+	NwkKeyLabel(ctx context.Context, dev *ttnpb.EndDevice) (string, error)
+	AppKeyLabel(ctx context.Context, dev *ttnpb.EndDevice) (string, error)
+}
+
+type defaultHSMKeyLabeler struct{}
+
+// NwkKeyLabel implements HSMKeyLabeler.
+func (defaultHSMKeyLabeler) NwkKeyLabel(ctx context.Context, dev *ttnpb.EndDevice) (string, error) {
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return "", errNoDevEUI.New()
+	}
+	return fmt.Sprintf("nwk:%s", *dev.DevEUI), nil
+}
+
+// AppKeyLabel implements HSMKeyLabeler.
+func (defaultHSMKeyLabeler) AppKeyLabel(ctx context.Context, dev *ttnpb.EndDevice) (string, error) {
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return "", errNoDevEUI.New()
+	}
+	return fmt.Sprintf("app:%s", *dev.DevEUI), nil
+}
+
+// DefaultHSMKeyLabeler labels the NwkKey and AppKey of an end device as nwk:<DevEUI> and app:<DevEUI> respectively.
+var DefaultHSMKeyLabeler HSMKeyLabeler = defaultHSMKeyLabeler{}
+
+type hsm struct {
+	hsm     HSM
+	labeler HSMKeyLabeler
+}
+
+// NewHSM returns a network and application service that performs cryptographic operations in the given HSM.
+// The root keys of end devices are not exposed.
+func NewHSM(h HSM, labeler HSMKeyLabeler) NetworkApplication {
+	return &hsm{
+		hsm:     h,
+		labeler: labeler,
+	}
+}
+
+func reverse(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[len(in)-1-i] = b
+	}
+	return out
+}
+
+var errInvalidHSMOutput = errors.DefineCorruption("invalid_hsm_output", "invalid HSM output")
+
+func (d *hsm) encryptBlock(ctx context.Context, block []byte, label string) (types.AES128Key, error) {
+	res, err := d.hsm.EncryptECB(ctx, block, label)
+	if err != nil {
+		return types.AES128Key{}, err
+	}
+	var key types.AES128Key
+	if err := key.UnmarshalBinary(res); err != nil {
+		return types.AES128Key{}, errInvalidHSMOutput.WithCause(err)
+	}
+	return key, nil
+}
+
+func (d *hsm) mic(ctx context.Context, payload []byte, label string) ([4]byte, error) {
+	res, err := d.hsm.CMAC(ctx, payload, label)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	if len(res) < 4 {
+		return [4]byte{}, errInvalidHSMOutput.New()
+	}
+	var mic [4]byte
+	copy(mic[:], res)
+	return mic, nil
+}
+
+func (d *hsm) getNwkKeyLabel(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion) (string, error) {
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		return d.labeler.NwkKeyLabel(ctx, dev)
+	default:
+		return d.labeler.AppKeyLabel(ctx, dev)
+	}
+}
+
+// deriveDeviceKey derives the JSIntKey (0x06) or JSEncKey (0x05) from the NwkKey.
+func (d *hsm) deriveDeviceKey(ctx context.Context, dev *ttnpb.EndDevice, t byte) (types.AES128Key, error) {
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return types.AES128Key{}, errNoDevEUI.New()
+	}
+	label, err := d.labeler.NwkKeyLabel(ctx, dev)
+	if err != nil {
+		return types.AES128Key{}, err
+	}
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:9], reverse(dev.DevEUI[:]))
+	return d.encryptBlock(ctx, buf, label)
+}
+
+var errInvalidPayloadSize = errors.DefineInvalidArgument("invalid_payload_size", "invalid payload size `{size}`")
+
+func (d *hsm) JoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([4]byte, error) {
+	if len(payload) != 19 {
+		return [4]byte{}, errInvalidPayloadSize.WithAttributes("size", len(payload))
+	}
+	label, err := d.getNwkKeyLabel(ctx, dev, version)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return d.mic(ctx, payload, label)
+}
+
+func (d *hsm) JoinAcceptMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, joinReqType byte, dn types.DevNonce, payload []byte) ([4]byte, error) {
+	if dev.JoinEUI == nil {
+		return [4]byte{}, errNoJoinEUI.New()
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return [4]byte{}, errNoDevEUI.New()
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		jsIntKey, err := d.deriveDeviceKey(ctx, dev, 0x06)
+		if err != nil {
+			return [4]byte{}, err
+		}
+		return crypto.ComputeJoinAcceptMIC(jsIntKey, joinReqType, *dev.JoinEUI, dn, payload)
+	default:
+		if n := len(payload); n != 13 && n != 29 {
+			return [4]byte{}, errInvalidPayloadSize.WithAttributes("size", len(payload))
+		}
+		label, err := d.labeler.AppKeyLabel(ctx, dev)
+		if err != nil {
+			return [4]byte{}, err
+		}
+		return d.mic(ctx, payload, label)
+	}
+}
+
+func (d *hsm) EncryptJoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([]byte, error) {
+	if n := len(payload); n != 16 && n != 32 {
+		return nil, errInvalidPayloadSize.WithAttributes("size", len(payload))
+	}
+	label, err := d.getNwkKeyLabel(ctx, dev, version)
+	if err != nil {
+		return nil, err
+	}
+	// The join-accept message is encrypted with AES decrypt, so that the end device only needs AES encrypt.
+	return d.hsm.DecryptECB(ctx, payload, label)
+}
+
+func (d *hsm) EncryptRejoinAccept(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([]byte, error) {
+	if version.Compare(ttnpb.MAC_V1_1) < 0 {
+		panic("This statement is unreachable. Please version check.")
+	}
+	if dev.JoinEUI == nil {
+		return nil, errNoJoinEUI.New()
+	}
+	jsEncKey, err := d.deriveDeviceKey(ctx, dev, 0x05)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.EncryptJoinAccept(jsEncKey, payload)
+}
+
+// deriveSKey derives a LoRaWAN 1.1 session key from the root key with the given label.
+func (d *hsm) deriveSKey(ctx context.Context, label string, t byte, jn types.JoinNonce, joinEUI types.EUI64, dn types.DevNonce) (types.AES128Key, error) {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:4], reverse(jn[:]))
+	copy(buf[4:12], reverse(joinEUI[:]))
+	copy(buf[12:14], reverse(dn[:]))
+	return d.encryptBlock(ctx, buf, label)
+}
+
+// deriveLegacySKey derives a LoRaWAN 1.0 session key from the root key with the given label.
+func (d *hsm) deriveLegacySKey(ctx context.Context, label string, t byte, jn types.JoinNonce, nid types.NetID, dn types.DevNonce) (types.AES128Key, error) {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:4], reverse(jn[:]))
+	copy(buf[4:7], reverse(nid[:]))
+	copy(buf[7:9], reverse(dn[:]))
+	return d.encryptBlock(ctx, buf, label)
+}
+
+func (d *hsm) DeriveNwkSKeys(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (NwkSKeys, error) {
+	if dev.JoinEUI == nil {
+		return NwkSKeys{}, errNoJoinEUI.New()
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return NwkSKeys{}, errNoDevEUI.New()
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		label, err := d.labeler.NwkKeyLabel(ctx, dev)
+		if err != nil {
+			return NwkSKeys{}, err
+		}
+		var keys NwkSKeys
+		for _, k := range []struct {
+			t   byte
+			key *types.AES128Key
+		}{
+			{t: 0x01, key: &keys.FNwkSIntKey},
+			{t: 0x03, key: &keys.SNwkSIntKey},
+			{t: 0x04, key: &keys.NwkSEncKey},
+		} {
+			if *k.key, err = d.deriveSKey(ctx, label, k.t, jn, *dev.JoinEUI, dn); err != nil {
+				return NwkSKeys{}, err
+			}
+		}
+		return keys, nil
+
+	default:
+		label, err := d.labeler.AppKeyLabel(ctx, dev)
+		if err != nil {
+			return NwkSKeys{}, err
+		}
+		nwkSKey, err := d.deriveLegacySKey(ctx, label, 0x01, jn, nid, dn)
+		if err != nil {
+			return NwkSKeys{}, err
+		}
+		return NwkSKeys{
+			FNwkSIntKey: nwkSKey,
+		}, nil
+	}
+}
+
+func (d *hsm) GetNwkKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	return nil, nil
+}
+
+func (d *hsm) DeriveAppSKey(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, jn types.JoinNonce, dn types.DevNonce, nid types.NetID) (types.AES128Key, error) {
+	if dev.JoinEUI == nil {
+		return types.AES128Key{}, errNoJoinEUI.New()
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return types.AES128Key{}, errNoDevEUI.New()
+	}
+	label, err := d.labeler.AppKeyLabel(ctx, dev)
+	if err != nil {
+		return types.AES128Key{}, err
+	}
+	switch {
+	case version.Compare(ttnpb.MAC_V1_1) >= 0:
+		return d.deriveSKey(ctx, label, 0x02, jn, *dev.JoinEUI, dn)
+	default:
+		return d.deriveLegacySKey(ctx, label, 0x02, jn, nid, dn)
+	}
+}
+
+func (d *hsm) GetAppKey(ctx context.Context, dev *ttnpb.EndDevice) (*types.AES128Key, error) {
+	return nil, nil
+}
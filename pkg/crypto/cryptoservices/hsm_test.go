@@ -0,0 +1,154 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHSM(t *testing.T) {
+	ctx := test.Context()
+	nwkKey := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	appKey := types.AES128Key{0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+			DevEUI:  eui64Ptr(types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+		},
+	}
+
+	memSvc := NewMemory(&nwkKey, &appKey)
+	hsmSvc := NewHSM(NewSoftHSM(map[string]types.AES128Key{
+		"nwk:4242FFFFFFFFFFFF": nwkKey,
+		"app:4242FFFFFFFFFFFF": appKey,
+	}), DefaultHSMKeyLabeler)
+
+	jn := types.JoinNonce{0x1, 0x2, 0x3}
+	dn := types.DevNonce{0x1, 0x2}
+	nid := types.NetID{0x1, 0x2, 0x3}
+
+	for _, version := range []ttnpb.MACVersion{
+		ttnpb.MAC_V1_0,
+		ttnpb.MAC_V1_0_1,
+		ttnpb.MAC_V1_0_2,
+		ttnpb.MAC_V1_0_3,
+		ttnpb.MAC_V1_1,
+	} {
+		t.Run(fmt.Sprintf("%v", version), func(t *testing.T) {
+			a := assertions.New(t)
+
+			expectedMIC, err := memSvc.JoinRequestMIC(ctx, dev, version, bytes.Repeat([]byte{0x1}, 19))
+			a.So(err, should.BeNil)
+			mic, err := hsmSvc.JoinRequestMIC(ctx, dev, version, bytes.Repeat([]byte{0x1}, 19))
+			a.So(err, should.BeNil)
+			a.So(mic, should.Resemble, expectedMIC)
+
+			expectedMIC, err = memSvc.JoinAcceptMIC(ctx, dev, version, 0xff, dn, bytes.Repeat([]byte{0x1}, 13))
+			a.So(err, should.BeNil)
+			mic, err = hsmSvc.JoinAcceptMIC(ctx, dev, version, 0xff, dn, bytes.Repeat([]byte{0x1}, 13))
+			a.So(err, should.BeNil)
+			a.So(mic, should.Resemble, expectedMIC)
+
+			expectedPayload, err := memSvc.EncryptJoinAccept(ctx, dev, version, bytes.Repeat([]byte{0x1}, 32))
+			a.So(err, should.BeNil)
+			payload, err := hsmSvc.EncryptJoinAccept(ctx, dev, version, bytes.Repeat([]byte{0x1}, 32))
+			a.So(err, should.BeNil)
+			a.So(payload, should.Resemble, expectedPayload)
+
+			if version.Compare(ttnpb.MAC_V1_1) >= 0 {
+				expectedPayload, err := memSvc.EncryptRejoinAccept(ctx, dev, version, bytes.Repeat([]byte{0x1}, 16))
+				a.So(err, should.BeNil)
+				payload, err := hsmSvc.EncryptRejoinAccept(ctx, dev, version, bytes.Repeat([]byte{0x1}, 16))
+				a.So(err, should.BeNil)
+				a.So(payload, should.Resemble, expectedPayload)
+			}
+
+			expectedNwkSKeys, err := memSvc.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			nwkSKeys, err := hsmSvc.DeriveNwkSKeys(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(nwkSKeys, should.Resemble, expectedNwkSKeys)
+
+			expectedAppSKey, err := memSvc.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			appSKey, err := hsmSvc.DeriveAppSKey(ctx, dev, version, jn, dn, nid)
+			a.So(err, should.BeNil)
+			a.So(appSKey, should.Resemble, expectedAppSKey)
+		})
+	}
+
+	t.Run("RootKeys", func(t *testing.T) {
+		a := assertions.New(t)
+		key, err := hsmSvc.GetNwkKey(ctx, dev)
+		a.So(err, should.BeNil)
+		a.So(key, should.BeNil)
+		key, err = hsmSvc.GetAppKey(ctx, dev)
+		a.So(err, should.BeNil)
+		a.So(key, should.BeNil)
+	})
+
+	t.Run("UnknownDevice", func(t *testing.T) {
+		a := assertions.New(t)
+		dev := &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				JoinEUI: eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+				DevEUI:  eui64Ptr(types.EUI64{0x43, 0x43, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}),
+			},
+		}
+		_, err := hsmSvc.JoinRequestMIC(ctx, dev, ttnpb.MAC_V1_1, bytes.Repeat([]byte{0x1}, 19))
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+}
+
+func TestSoftHSM(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	h := NewSoftHSM(map[string]types.AES128Key{
+		"kek1": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+
+	plaintext := []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	ciphertext := []byte{0x1f, 0xa6, 0x8b, 0x0a, 0x81, 0x12, 0xb4, 0x47, 0xae, 0xf3, 0x4b, 0xd8, 0xfb, 0x5a, 0x7b, 0x82, 0x9d, 0x3e, 0x86, 0x23, 0x71, 0xd2, 0xcf, 0xe5}
+
+	wrapped, err := h.Wrap(ctx, plaintext, "kek1")
+	a.So(err, should.BeNil)
+	a.So(wrapped, should.Resemble, ciphertext)
+
+	unwrapped, err := h.Unwrap(ctx, ciphertext, "kek1")
+	a.So(err, should.BeNil)
+	a.So(unwrapped, should.Resemble, plaintext)
+
+	encrypted, err := h.EncryptECB(ctx, plaintext, "kek1")
+	a.So(err, should.BeNil)
+	decrypted, err := h.DecryptECB(ctx, encrypted, "kek1")
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, plaintext)
+
+	_, err = h.EncryptECB(ctx, plaintext[:15], "kek1")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = h.Wrap(ctx, plaintext, "kek2")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
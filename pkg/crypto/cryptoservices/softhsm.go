@@ -0,0 +1,118 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices
+
+import (
+	"context"
+	"crypto/aes"
+
+	"github.com/jacobsa/crypto/cmac"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errHSMKeyNotFound = errors.DefineNotFound("hsm_key_not_found", "key with label `{label}` not found in HSM")
+	errInvalidECBSize = errors.DefineInvalidArgument("invalid_ecb_size", "data size `{size}` is not a multiple of the block size")
+)
+
+// SoftHSM is an HSM that keeps keys in memory.
+// This implementation does not provide any security as keys are stored in the clear; it is intended for testing.
+type SoftHSM struct {
+	keys map[string]types.AES128Key
+}
+
+// NewSoftHSM returns a SoftHSM with the given keys by label.
+func NewSoftHSM(keys map[string]types.AES128Key) *SoftHSM {
+	return &SoftHSM{
+		keys: keys,
+	}
+}
+
+func (h *SoftHSM) key(label string) (types.AES128Key, error) {
+	key, ok := h.keys[label]
+	if !ok {
+		return types.AES128Key{}, errHSMKeyNotFound.WithAttributes("label", label)
+	}
+	return key, nil
+}
+
+// Wrap implements HSM.
+func (h *SoftHSM) Wrap(ctx context.Context, plaintext []byte, label string) ([]byte, error) {
+	key, err := h.key(label)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.WrapKey(plaintext, key[:])
+}
+
+// Unwrap implements HSM.
+func (h *SoftHSM) Unwrap(ctx context.Context, ciphertext []byte, label string) ([]byte, error) {
+	key, err := h.key(label)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.UnwrapKey(ciphertext, key[:])
+}
+
+func (h *SoftHSM) ecb(data []byte, label string, decrypt bool) ([]byte, error) {
+	if len(data)%aes.BlockSize != 0 {
+		return nil, errInvalidECBSize.WithAttributes("size", len(data))
+	}
+	key, err := h.key(label)
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, len(data))
+	for i := 0; i < len(data); i += aes.BlockSize {
+		if decrypt {
+			cipher.Decrypt(res[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		} else {
+			cipher.Encrypt(res[i:i+aes.BlockSize], data[i:i+aes.BlockSize])
+		}
+	}
+	return res, nil
+}
+
+// EncryptECB implements HSM.
+func (h *SoftHSM) EncryptECB(ctx context.Context, data []byte, label string) ([]byte, error) {
+	return h.ecb(data, label, false)
+}
+
+// DecryptECB implements HSM.
+func (h *SoftHSM) DecryptECB(ctx context.Context, data []byte, label string) ([]byte, error) {
+	return h.ecb(data, label, true)
+}
+
+// CMAC implements HSM.
+func (h *SoftHSM) CMAC(ctx context.Context, data []byte, label string) ([]byte, error) {
+	key, err := h.key(label)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := cmac.New(key[:])
+	if err != nil {
+		return nil, err
+	}
+	if _, err := hash.Write(data); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
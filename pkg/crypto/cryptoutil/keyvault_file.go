@@ -0,0 +1,197 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errReadKeyVaultFile  = errors.DefineUnavailable("read_key_vault_file", "read key vault file `{name}`")
+	errParseKeyVaultFile = errors.DefineCorruption("parse_key_vault_file", "parse key vault file `{name}`")
+	errUnsealSecret      = errors.DefineCorruption("unseal_secret", "unseal secret with ID `{id}`")
+	errWriteKeyVaultFile = errors.Define("write_key_vault_file", "write key vault file `{name}`")
+)
+
+// FileKeyVault is a KeyVault that uses secrets from a file.
+// The file contains a JSON object that maps labels and IDs to base64 encoded secrets. The secrets are sealed with the
+// master key using crypto.Encrypt.
+// The file is reloaded by Reload or Watch when its modification time or size changes.
+type FileKeyVault struct {
+	ComponentPrefixKEKLabeler
+	name      string
+	masterKey types.AES128Key
+
+	mu      sync.RWMutex
+	modTime time.Time
+	size    int64
+	mem     *MemKeyVault
+}
+
+// NewFileKeyVault returns a FileKeyVault that reads the secrets from the file with the given name.
+func NewFileKeyVault(name string, masterKey types.AES128Key) (*FileKeyVault, error) {
+	v := &FileKeyVault{
+		name:      name,
+		masterKey: masterKey,
+	}
+	fi, err := os.Stat(name)
+	if err != nil {
+		return nil, errReadKeyVaultFile.WithAttributes("name", name).WithCause(err)
+	}
+	if err := v.load(fi); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (v *FileKeyVault) load(fi os.FileInfo) error {
+	raw, err := ioutil.ReadFile(v.name)
+	if err != nil {
+		return errReadKeyVaultFile.WithAttributes("name", v.name).WithCause(err)
+	}
+	var sealed map[string][]byte
+	if err := json.Unmarshal(raw, &sealed); err != nil {
+		return errParseKeyVaultFile.WithAttributes("name", v.name).WithCause(err)
+	}
+	m := make(map[string][]byte, len(sealed))
+	for id, ciphertext := range sealed {
+		plaintext, err := crypto.Decrypt(v.masterKey, ciphertext)
+		if err != nil {
+			return errUnsealSecret.WithAttributes("id", id).WithCause(err)
+		}
+		m[id] = plaintext
+	}
+	v.mem = NewMemKeyVault(m)
+	v.modTime, v.size = fi.ModTime(), fi.Size()
+	return nil
+}
+
+// Reload reloads the file if its modification time or size changed.
+// If reloading fails, the previously loaded secrets are kept.
+func (v *FileKeyVault) Reload(ctx context.Context) error {
+	fi, err := os.Stat(v.name)
+	if err != nil {
+		return errReadKeyVaultFile.WithAttributes("name", v.name).WithCause(err)
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if fi.ModTime().Equal(v.modTime) && fi.Size() == v.size {
+		return nil
+	}
+	if err := v.load(fi); err != nil {
+		return err
+	}
+	log.FromContext(ctx).WithField("name", v.name).Debug("Reloaded key vault file")
+	return nil
+}
+
+// Watch reloads the file every interval until the context is done.
+func (v *FileKeyVault) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := v.Reload(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).WithField("name", v.name).Warn("Failed to reload key vault file")
+			}
+		}
+	}
+}
+
+func (v *FileKeyVault) vault(context.Context) *MemKeyVault {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.mem
+}
+
+// Wrap implements KeyVault.
+func (v *FileKeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	return v.vault(ctx).Wrap(ctx, plaintext, kekLabel)
+}
+
+// Unwrap implements KeyVault.
+func (v *FileKeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	return v.vault(ctx).Unwrap(ctx, ciphertext, kekLabel)
+}
+
+// Encrypt implements KeyVault.
+func (v *FileKeyVault) Encrypt(ctx context.Context, plaintext []byte, id string) ([]byte, error) {
+	return v.vault(ctx).Encrypt(ctx, plaintext, id)
+}
+
+// Decrypt implements KeyVault.
+func (v *FileKeyVault) Decrypt(ctx context.Context, ciphertext []byte, id string) ([]byte, error) {
+	return v.vault(ctx).Decrypt(ctx, ciphertext, id)
+}
+
+// GetCertificate implements KeyVault.
+func (v *FileKeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
+	return v.vault(ctx).GetCertificate(ctx, id)
+}
+
+// ExportCertificate implements KeyVault.
+func (v *FileKeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	return v.vault(ctx).ExportCertificate(ctx, id)
+}
+
+// WriteFileKeyVault seals the given secrets with the master key and writes them to the file with the given name in the
+// format read by FileKeyVault.
+// The file is written to a temporary file first and then renamed, so that readers never observe a partial write.
+func WriteFileKeyVault(name string, masterKey types.AES128Key, secrets map[string][]byte) error {
+	sealed := make(map[string][]byte, len(secrets))
+	for id, plaintext := range secrets {
+		ciphertext, err := crypto.Encrypt(masterKey, plaintext)
+		if err != nil {
+			return err
+		}
+		sealed[id] = ciphertext
+	}
+	raw, err := json.MarshalIndent(sealed, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(name), filepath.Base(name)+".*")
+	if err != nil {
+		return errWriteKeyVaultFile.WithAttributes("name", name).WithCause(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(raw); err != nil {
+		f.Close()
+		return errWriteKeyVaultFile.WithAttributes("name", name).WithCause(err)
+	}
+	if err := f.Close(); err != nil {
+		return errWriteKeyVaultFile.WithAttributes("name", name).WithCause(err)
+	}
+	if err := os.Rename(f.Name(), name); err != nil {
+		return errWriteKeyVaultFile.WithAttributes("name", name).WithCause(err)
+	}
+	return nil
+}
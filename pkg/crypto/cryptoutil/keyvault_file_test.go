@@ -0,0 +1,128 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil_test
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFileKeyVault(t *testing.T) {
+	a := assertions.New(t)
+
+	dir, err := ioutil.TempDir("", "keyvault")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "keys.json")
+
+	masterKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	// Non-existing file.
+	{
+		_, err := cryptoutil.NewFileKeyVault(name, masterKey)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+	}
+
+	if err := cryptoutil.WriteFileKeyVault(name, masterKey, map[string][]byte{
+		"kek1": kek,
+	}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Wrong master key.
+	{
+		_, err := cryptoutil.NewFileKeyVault(name, types.AES128Key{})
+		a.So(errors.IsDataLoss(err), should.BeTrue)
+	}
+
+	v, err := cryptoutil.NewFileKeyVault(name, masterKey)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Existing KEK.
+	{
+		actual, err := v.Wrap(test.Context(), plaintext, "kek1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, ciphertext)
+	}
+	{
+		actual, err := v.Unwrap(test.Context(), ciphertext, "kek1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+
+	// Non-existing KEK.
+	{
+		_, err := v.Wrap(test.Context(), plaintext, "kek2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Reload on change.
+	if err := cryptoutil.WriteFileKeyVault(name, masterKey, map[string][]byte{
+		"kek2": kek,
+	}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(name, future, future); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	{
+		_, err := v.Wrap(test.Context(), plaintext, "kek2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+	a.So(v.Reload(test.Context()), should.BeNil)
+	{
+		actual, err := v.Wrap(test.Context(), plaintext, "kek2")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, ciphertext)
+	}
+	{
+		_, err := v.Wrap(test.Context(), plaintext, "kek1")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Keep secrets if reload fails.
+	if err := ioutil.WriteFile(name, []byte("{"), 0600); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if err := os.Chtimes(name, future.Add(time.Minute), future.Add(time.Minute)); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(errors.IsDataLoss(v.Reload(test.Context())), should.BeTrue)
+	{
+		actual, err := v.Unwrap(test.Context(), ciphertext, "kek2")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+}
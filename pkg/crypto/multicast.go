@@ -0,0 +1,73 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/aes"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+func deriveMcKey(key types.AES128Key, t byte, addr *types.DevAddr) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = t
+	if addr != nil {
+		copy(buf[1:5], reverse(addr[:]))
+	}
+	block, _ := aes.NewCipher(key[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
+// DeriveMcRootKey derives the Remote Multicast Setup McRootKey from the LoRaWAN 1.1 AppKey.
+func DeriveMcRootKey(appKey types.AES128Key) types.AES128Key {
+	return deriveMcKey(appKey, 0x20, nil)
+}
+
+// DeriveLegacyMcRootKey derives the Remote Multicast Setup McRootKey from the LoRaWAN 1.0 GenAppKey.
+func DeriveLegacyMcRootKey(genAppKey types.AES128Key) types.AES128Key {
+	return deriveMcKey(genAppKey, 0x00, nil)
+}
+
+// DeriveMcKEKey derives the Remote Multicast Setup McKEKey from the McRootKey.
+func DeriveMcKEKey(mcRootKey types.AES128Key) types.AES128Key {
+	return deriveMcKey(mcRootKey, 0x00, nil)
+}
+
+// DeriveMcAppSKey derives the multicast group McAppSKey from the McKey.
+func DeriveMcAppSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMcKey(mcKey, 0x01, &mcAddr)
+}
+
+// DeriveMcNwkSKey derives the multicast group McNwkSKey from the McKey.
+func DeriveMcNwkSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMcKey(mcKey, 0x02, &mcAddr)
+}
+
+// EncryptMcKey encrypts the McKey with the McKEKey, as sent in the Remote Multicast Setup McGroupSetupReq.
+// The end device obtains the McKey by encrypting the encrypted McKey with the McKEKey,
+// so encryption is an AES decrypt operation.
+func EncryptMcKey(mcKEKey, mcKey types.AES128Key) (encrypted types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Decrypt(encrypted[:], mcKey[:])
+	return
+}
+
+// DecryptMcKey decrypts the encrypted McKey with the McKEKey.
+func DecryptMcKey(mcKEKey, encrypted types.AES128Key) (mcKey types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Encrypt(mcKey[:], encrypted[:])
+	return
+}
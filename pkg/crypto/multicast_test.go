@@ -0,0 +1,51 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMulticastKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	key := types.AES128Key{0xBE, 0xC4, 0x99, 0xC6, 0x9E, 0x9C, 0x93, 0x9E, 0x41, 0x3B, 0x66, 0x39, 0x61, 0x63, 0x6C, 0x61}
+	mcKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10}
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+
+	mcRootKey := DeriveMcRootKey(key)
+	a.So(mcRootKey, should.Equal, types.AES128Key{0x68, 0xDA, 0x7B, 0x67, 0x98, 0x4F, 0x39, 0x4D, 0x0F, 0x53, 0xBE, 0xDE, 0xD1, 0x48, 0x40, 0x53})
+
+	legacyMcRootKey := DeriveLegacyMcRootKey(key)
+	a.So(legacyMcRootKey, should.Equal, types.AES128Key{0xC3, 0x0C, 0xCE, 0x7A, 0x52, 0x52, 0x6E, 0xEE, 0x58, 0x23, 0x79, 0xC2, 0x23, 0x08, 0xC6, 0x31})
+
+	mcKEKey := DeriveMcKEKey(mcRootKey)
+	a.So(mcKEKey, should.Equal, types.AES128Key{0x00, 0x02, 0x64, 0x46, 0x5A, 0xE7, 0x84, 0xEA, 0xE9, 0x36, 0xA2, 0x7C, 0xCE, 0x21, 0x6B, 0xD9})
+
+	encrypted := EncryptMcKey(mcKEKey, mcKey)
+	a.So(encrypted, should.Equal, types.AES128Key{0x91, 0x14, 0xA0, 0x23, 0xDB, 0x94, 0x45, 0x53, 0xF6, 0xC7, 0xAF, 0xC8, 0xE5, 0xF4, 0xCB, 0x8D})
+	a.So(DecryptMcKey(mcKEKey, encrypted), should.Equal, mcKey)
+
+	mcAppSKey := DeriveMcAppSKey(mcKey, mcAddr)
+	a.So(mcAppSKey, should.Equal, types.AES128Key{0x95, 0xCB, 0x45, 0x18, 0xEE, 0x37, 0x56, 0x06, 0x73, 0x5B, 0xBA, 0xCB, 0xDC, 0xE8, 0x37, 0xFA})
+
+	mcNwkSKey := DeriveMcNwkSKey(mcKey, mcAddr)
+	a.So(mcNwkSKey, should.Equal, types.AES128Key{0xC3, 0xF6, 0xB3, 0x88, 0xBA, 0xD6, 0xC0, 0x00, 0xB2, 0x32, 0x91, 0xAD, 0x52, 0xC1, 0x1C, 0x7B})
+}
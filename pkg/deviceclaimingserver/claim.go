@@ -0,0 +1,480 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+var (
+	errNotAuthorized              = errors.DefinePermissionDenied("not_authorized", "application `{application_uid}` is not authorized for claiming")
+	errNoClaimAuthenticationCode  = errors.DefineFailedPrecondition("no_claim_authentication_code", "end device has no claim authentication code")
+	errClaimAuthenticationCode    = errors.DefinePermissionDenied("claim_authentication_code", "invalid claim authentication code")
+	errClaimAuthenticationExpired = errors.DefinePermissionDenied("claim_authentication_code_validity", "claim authentication code is not valid at this time")
+	errSameApplication            = errors.DefineFailedPrecondition("same_application", "end device is already in application `{application_uid}`")
+	errTargetDeviceExists         = errors.DefineAlreadyExists("target_device_exists", "end device `{device_uid}` already exists")
+	errTransfer                   = errors.DefineAborted("transfer", "transfer end device")
+)
+
+var (
+	// isTransferPaths are the paths of the end device that are transferred in the Identity Server.
+	isTransferPaths = []string{
+		"attributes",
+		"description",
+		"locations",
+		"name",
+		"picture",
+		"service_profile_id",
+		"version_ids",
+	}
+	// jsTransferPaths are the paths of the end device that are transferred in the Join Server.
+	jsTransferPaths = []string{
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"net_id",
+		"network_server_kek_label",
+		"resets_join_nonces",
+		"root_keys",
+	}
+	// nsTransferPaths are the paths of the end device that are transferred in the Network Server.
+	// The session and MAC state are not transferred; the end device has to rejoin.
+	nsTransferPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"multicast",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	// asTransferPaths are the paths of the end device that are transferred in the Application Server.
+	asTransferPaths = []string{
+		"formatters",
+		"skip_payload_crypto_override",
+	}
+	// nsSessionPaths are the paths of the session of the end device in the Network Server.
+	// The session is read to restore the source end device if the transfer fails.
+	nsSessionPaths = []string{
+		"session.dev_addr",
+		"session.keys.f_nwk_s_int_key.key",
+		"session.keys.nwk_s_enc_key.key",
+		"session.keys.s_nwk_s_int_key.key",
+		"session.keys.session_key_id",
+		"session.last_conf_f_cnt_down",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
+		"session.started_at",
+	}
+)
+
+// endDevice is an end device as it is registered in the cluster.
+// The registry flags indicate in which registries the end device is registered.
+type endDevice struct {
+	ids        ttnpb.EndDeviceIdentifiers
+	is, js     *ttnpb.EndDevice
+	ns, as     *ttnpb.EndDevice
+	isRegistry bool
+	jsRegistry bool
+	nsRegistry bool
+	asRegistry bool
+	// nsSession indicates whether the session in the Network Server is set on creation.
+	nsSession bool
+}
+
+// nsSetPaths returns the paths to set in the Network Server on creation.
+func (dev *endDevice) nsSetPaths() []string {
+	if !dev.nsSession || dev.ns.Session == nil {
+		return nsTransferPaths
+	}
+	paths := append(nsTransferPaths[:0:0], nsTransferPaths...)
+	for _, p := range nsSessionPaths {
+		switch p {
+		case "session.keys.nwk_s_enc_key.key", "session.keys.s_nwk_s_int_key.key":
+			// The Network Server derives these keys from the FNwkSIntKey for LoRaWAN 1.0.x end devices.
+			if dev.ns.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+				continue
+			}
+		case "session.started_at":
+			if dev.ns.Session.StartedAt.IsZero() {
+				continue
+			}
+		}
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+func (dcs *DeviceClaimingServer) sourceCallOpt(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (grpc.CallOption, error) {
+	auth, err := dcs.authorizedApplications.Get(ctx, ids, []string{"api_key"})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, ids))
+		}
+		return nil, err
+	}
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		ID:            ids.ApplicationID,
+		AuthType:      "Bearer",
+		AuthValue:     auth.APIKey,
+		AllowInsecure: dcs.AllowInsecureForCredentials(),
+	}), nil
+}
+
+func validateAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string) error {
+	if code == nil || code.Value == "" {
+		return errNoClaimAuthenticationCode.New()
+	}
+	if subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) != 1 {
+		return errClaimAuthenticationCode.New()
+	}
+	now := time.Now()
+	if code.ValidFrom != nil && now.Before(*code.ValidFrom) ||
+		code.ValidTo != nil && now.After(*code.ValidTo) {
+		return errClaimAuthenticationExpired.New()
+	}
+	return nil
+}
+
+// getEndDevice gets the source end device from the cluster.
+func (dcs *DeviceClaimingServer) getEndDevice(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) (*endDevice, error) {
+	dev := &endDevice{
+		ids:        ids,
+		isRegistry: true,
+		jsRegistry: true,
+		nsSession:  true,
+	}
+
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	dev.is, err = ttnpb.NewEndDeviceRegistryClient(isConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask: pbtypes.FieldMask{
+			Paths: append(isTransferPaths,
+				"application_server_address",
+				"join_server_address",
+				"network_server_address",
+			),
+		},
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	jsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	dev.js, err = ttnpb.NewJsEndDeviceRegistryClient(jsConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask: pbtypes.FieldMask{
+			Paths: jsTransferPaths,
+		},
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	if dev.is.NetworkServerAddress != "" {
+		nsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		dev.ns, err = ttnpb.NewNsEndDeviceRegistryClient(nsConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ids,
+			FieldMask: pbtypes.FieldMask{
+				Paths: append(nsSessionPaths, nsTransferPaths...),
+			},
+		}, callOpt)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		dev.nsRegistry = dev.ns != nil
+	}
+
+	if dev.is.ApplicationServerAddress != "" {
+		asConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		dev.as, err = ttnpb.NewAsEndDeviceRegistryClient(asConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ids,
+			FieldMask: pbtypes.FieldMask{
+				Paths: asTransferPaths,
+			},
+		}, callOpt)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		dev.asRegistry = dev.as != nil
+	}
+
+	return dev, nil
+}
+
+// deleteEndDevice deletes the end device from the registries it is registered in, in reverse order of creation.
+func (dcs *DeviceClaimingServer) deleteEndDevice(ctx context.Context, dev *endDevice, callOpt grpc.CallOption) error {
+	if dev.asRegistry {
+		asConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Delete(ctx, &dev.ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if dev.nsRegistry {
+		nsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Delete(ctx, &dev.ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if dev.jsRegistry {
+		jsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Delete(ctx, &dev.ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if dev.isRegistry {
+		isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Delete(ctx, &dev.ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// createEndDevice creates the end device in the cluster.
+// The returned end device has the registry flags set of the registries that were written to, also on failure.
+func (dcs *DeviceClaimingServer) createEndDevice(ctx context.Context, dev *endDevice, callOpt grpc.CallOption) (*endDevice, error) {
+	created := &endDevice{
+		ids: dev.ids,
+	}
+
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return created, err
+	}
+	isDev := *dev.is
+	isDev.EndDeviceIdentifiers = dev.ids
+	if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: isDev,
+	}, callOpt); err != nil {
+		return created, err
+	}
+	created.isRegistry = true
+
+	jsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+	if err != nil {
+		return created, err
+	}
+	jsDev := *dev.js
+	jsDev.EndDeviceIdentifiers = dev.ids
+	jsDev.NetworkServerAddress = dev.is.NetworkServerAddress
+	jsDev.ApplicationServerAddress = dev.is.ApplicationServerAddress
+	// The Join Server creates the end device on Set. If the request fails, the end device may have been written.
+	created.jsRegistry = true
+	if _, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: jsDev,
+		FieldMask: pbtypes.FieldMask{
+			Paths: append(jsTransferPaths,
+				"application_server_address",
+				"network_server_address",
+			),
+		},
+	}, callOpt); err != nil {
+		return created, err
+	}
+
+	if dev.nsRegistry {
+		nsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return created, err
+		}
+		nsDev := *dev.ns
+		nsDev.EndDeviceIdentifiers = dev.ids
+		created.nsRegistry = true
+		if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: nsDev,
+			FieldMask: pbtypes.FieldMask{
+				Paths: dev.nsSetPaths(),
+			},
+		}, callOpt); err != nil {
+			return created, err
+		}
+	}
+
+	if dev.asRegistry {
+		asConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return created, err
+		}
+		asDev := *dev.as
+		asDev.EndDeviceIdentifiers = dev.ids
+		created.asRegistry = true
+		if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: asDev,
+			FieldMask: pbtypes.FieldMask{
+				Paths: asTransferPaths,
+			},
+		}, callOpt); err != nil {
+			return created, err
+		}
+	}
+
+	return created, nil
+}
+
+// checkTargetEndDevice checks that the target end device does not exist.
+func (dcs *DeviceClaimingServer) checkTargetEndDevice(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) error {
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewEndDeviceRegistryClient(isConn).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+	}, callOpt)
+	if err == nil {
+		return errTargetDeviceExists.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// claim transfers the end device identified by the JoinEUI and DevEUI to the target application.
+// The source end device is read and deleted with the API key of the authorized source application.
+// The target end device is created with the credentials of the caller.
+func (dcs *DeviceClaimingServer) claim(ctx context.Context, joinEUI, devEUI types.EUI64, authenticationCode string, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	targetCallOpt, err := rpcmetadata.WithForwardedAuth(ctx, dcs.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, err := ttnpb.NewEndDeviceRegistryClient(isConn).GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEUI: joinEUI,
+		DevEUI:  devEUI,
+	}, dcs.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	if sourceIDs.ApplicationID == req.TargetApplicationIDs.ApplicationID {
+		return nil, errSameApplication.WithAttributes("application_uid", unique.ID(ctx, req.TargetApplicationIDs))
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"source_device_uid", unique.ID(ctx, sourceIDs),
+		"target_application_uid", unique.ID(ctx, req.TargetApplicationIDs),
+	))
+
+	sourceCallOpt, err := dcs.sourceCallOpt(ctx, sourceIDs.ApplicationIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	source, err := dcs.getEndDevice(ctx, *sourceIDs, sourceCallOpt)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAuthenticationCode(source.js.ClaimAuthenticationCode, authenticationCode); err != nil {
+		return nil, err
+	}
+
+	targetIDs := *sourceIDs
+	targetIDs.ApplicationIdentifiers = req.TargetApplicationIDs
+	if req.TargetDeviceID != "" {
+		targetIDs.DeviceID = req.TargetDeviceID
+	}
+	target := &endDevice{
+		ids:        targetIDs,
+		is:         &ttnpb.EndDevice{},
+		js:         &ttnpb.EndDevice{},
+		ns:         source.ns,
+		as:         source.as,
+		nsRegistry: source.nsRegistry && req.TargetNetworkServerAddress != "",
+		asRegistry: source.asRegistry && req.TargetApplicationServerAddress != "",
+	}
+	if err := target.is.SetFields(source.is, append(isTransferPaths, "join_server_address")...); err != nil {
+		return nil, err
+	}
+	target.is.NetworkServerAddress = req.TargetNetworkServerAddress
+	target.is.ApplicationServerAddress = req.TargetApplicationServerAddress
+	if err := target.js.SetFields(source.js, jsTransferPaths...); err != nil {
+		return nil, err
+	}
+	if req.TargetNetID != nil {
+		target.js.NetID = req.TargetNetID
+	}
+	if req.TargetNetworkServerKEKLabel != "" {
+		target.js.NetworkServerKEKLabel = req.TargetNetworkServerKEKLabel
+	}
+	if req.TargetApplicationServerKEKLabel != "" {
+		target.js.ApplicationServerKEKLabel = req.TargetApplicationServerKEKLabel
+	}
+	if req.TargetApplicationServerID != "" {
+		target.js.ApplicationServerID = req.TargetApplicationServerID
+	}
+	if req.InvalidateAuthenticationCode {
+		target.js.ClaimAuthenticationCode = nil
+	}
+
+	if err := dcs.checkTargetEndDevice(ctx, targetIDs, targetCallOpt); err != nil {
+		return nil, err
+	}
+
+	// The end device is deleted from the source application first, since the EUIs are unique in the cluster.
+	if err := dcs.deleteEndDevice(ctx, source, sourceCallOpt); err != nil {
+		return nil, errTransfer.WithCause(err)
+	}
+	if created, err := dcs.createEndDevice(ctx, target, targetCallOpt); err != nil {
+		logger.WithError(err).Warn("Failed to create end device in target application, restore source end device")
+		// Only the registries that were written to are rolled back, so that existing end devices are left intact.
+		if err := dcs.deleteEndDevice(ctx, created, targetCallOpt); err != nil {
+			logger.WithError(err).Warn("Failed to delete partially created end device in target application")
+		}
+		if _, err := dcs.createEndDevice(ctx, source, sourceCallOpt); err != nil {
+			logger.WithError(err).Error("Failed to restore source end device")
+		}
+		return nil, errTransfer.WithCause(err)
+	}
+	logger.Info("Claimed end device")
+	return &targetIDs, nil
+}
@@ -0,0 +1,88 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func timePtr(t time.Time) *time.Time { return &t }
+
+func TestValidateAuthenticationCode(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		Name           string
+		Code           *ttnpb.EndDeviceAuthenticationCode
+		Value          string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NoCode",
+			Value:          "ABCD",
+			ErrorAssertion: errors.IsFailedPrecondition,
+		},
+		{
+			Name: "Mismatch",
+			Code: &ttnpb.EndDeviceAuthenticationCode{
+				Value: "ABCD",
+			},
+			Value:          "ABCE",
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "NotYetValid",
+			Code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:     "ABCD",
+				ValidFrom: timePtr(now.Add(time.Hour)),
+			},
+			Value:          "ABCD",
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "Expired",
+			Code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:   "ABCD",
+				ValidTo: timePtr(now.Add(-time.Hour)),
+			},
+			Value:          "ABCD",
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "Valid",
+			Code: &ttnpb.EndDeviceAuthenticationCode{
+				Value:     "ABCD",
+				ValidFrom: timePtr(now.Add(-time.Hour)),
+				ValidTo:   timePtr(now.Add(time.Hour)),
+			},
+			Value: "ABCD",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := validateAuthenticationCode(tc.Code, tc.Value)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deviceclaimingserver provides end device claiming services.
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// DeviceClaimingServer implements the Device Claiming Server component.
+//
+// The Device Claiming Server exposes the EndDeviceClaimingServer service.
+type DeviceClaimingServer struct {
+	*component.Component
+	ctx context.Context
+
+	authorizedApplications AuthorizedApplicationRegistry
+
+	grpc struct {
+		endDeviceClaimingServer *endDeviceClaimingServer
+	}
+}
+
+var errNoAuthorizedApplicationRegistry = errors.DefineFailedPrecondition("no_authorized_application_registry", "no authorized application registry")
+
+// New returns a new *DeviceClaimingServer.
+func New(c *component.Component, conf *Config) (*DeviceClaimingServer, error) {
+	if conf.AuthorizedApplications == nil {
+		return nil, errNoAuthorizedApplicationRegistry.New()
+	}
+	dcs := &DeviceClaimingServer{
+		Component:              c,
+		ctx:                    log.NewContextWithField(c.Context(), "namespace", "deviceclaimingserver"),
+		authorizedApplications: conf.AuthorizedApplications,
+	}
+	dcs.grpc.endDeviceClaimingServer = &endDeviceClaimingServer{DCS: dcs}
+
+	c.RegisterGRPC(dcs)
+	return dcs, nil
+}
+
+// Context returns the context of the Device Claiming Server.
+func (dcs *DeviceClaimingServer) Context() context.Context {
+	return dcs.ctx
+}
+
+// Roles returns the roles that the Device Claiming Server fulfills.
+func (dcs *DeviceClaimingServer) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER}
+}
+
+// RegisterServices registers services provided by dcs at s.
+func (dcs *DeviceClaimingServer) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterEndDeviceClaimingServerServer(s, dcs.grpc.endDeviceClaimingServer)
+}
+
+// RegisterHandlers registers gRPC handlers.
+func (dcs *DeviceClaimingServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterEndDeviceClaimingServerHandler(dcs.Context(), s, conn)
+}
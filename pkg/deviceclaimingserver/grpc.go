@@ -0,0 +1,151 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+type endDeviceClaimingServer struct {
+	DCS *DeviceClaimingServer
+}
+
+var (
+	errQRCode            = errors.DefineInvalidArgument("qr_code", "invalid QR code")
+	errQRCodeData        = errors.DefineInvalidArgument("qr_code_data", "QR code does not contain authenticated end device identifiers")
+	errAPIKeyApplication = errors.DefineInvalidArgument("api_key_application", "API key is not an API key of application `{application_uid}`")
+	errAPIKeyRights      = errors.DefinePermissionDenied("api_key_rights", "API key does not have the rights required for claiming")
+)
+
+// claimRights are the rights that an API key of an authorized application must have.
+var claimRights = []ttnpb.Right{
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	if err := rights.RequireApplication(ctx, req.TargetApplicationIDs,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+
+	var (
+		joinEUI, devEUI    types.EUI64
+		authenticationCode string
+	)
+	if authIDs := req.GetAuthenticatedIdentifiers(); authIDs != nil {
+		joinEUI, devEUI, authenticationCode = authIDs.JoinEUI, authIDs.DevEUI, authIDs.AuthenticationCode
+	} else {
+		data, err := qrcode.Parse(req.GetQRCode())
+		if err != nil {
+			return nil, errQRCode.WithCause(err)
+		}
+		authIDs, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+		if !ok {
+			return nil, errQRCodeData.New()
+		}
+		joinEUI, devEUI, authenticationCode = authIDs.AuthenticatedEndDeviceIdentifiers()
+	}
+
+	ids, err := s.DCS.claim(ctx, joinEUI, devEUI, authenticationCode, req)
+	if err != nil {
+		events.Publish(evtClaimEndDeviceFail.NewWithIdentifiersAndData(ctx, req.TargetApplicationIDs, err))
+		return nil, err
+	}
+	events.Publish(evtClaimEndDeviceSuccess.NewWithIdentifiersAndData(ctx, ids, nil))
+	return ids, nil
+}
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+
+	cc, err := s.DCS.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	authInfo, err := ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     req.APIKey,
+		AllowInsecure: s.DCS.AllowInsecureForCredentials(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	apiKey := authInfo.GetAPIKey()
+	if apiKey == nil {
+		return nil, errAPIKeyApplication.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIdentifiers))
+	}
+	if appIDs := apiKey.EntityIDs.GetApplicationIDs(); appIDs == nil || appIDs.ApplicationID != req.ApplicationID {
+		return nil, errAPIKeyApplication.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIdentifiers))
+	}
+	if !ttnpb.RightsFrom(apiKey.Rights...).Implied().IncludesAll(claimRights...) {
+		return nil, errAPIKeyRights.New()
+	}
+
+	_, err = s.DCS.authorizedApplications.Set(ctx, req.ApplicationIdentifiers, nil,
+		func(*ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error) {
+			return req, []string{
+				"api_key",
+				"application_ids",
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtAuthorizeApplication.NewWithIdentifiersAndData(ctx, req.ApplicationIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	_, err := s.DCS.authorizedApplications.Set(ctx, *ids, nil,
+		func(*ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error) {
+			return nil, nil, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtUnauthorizeApplication.NewWithIdentifiersAndData(ctx, *ids, nil))
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,606 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var (
+	sourceAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"}
+	targetAppIDs = ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"}
+
+	sourceDevIDs = ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: sourceAppIDs,
+		DeviceID:               "source-dev",
+		JoinEUI:                &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		DevEUI:                 &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	targetDevIDs = ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: targetAppIDs,
+		DeviceID:               "target-dev",
+		JoinEUI:                sourceDevIDs.JoinEUI,
+		DevEUI:                 sourceDevIDs.DevEUI,
+	}
+)
+
+const (
+	sourceAPIKey       = "source-key"
+	targetAPIKey       = "target-key"
+	limitedAPIKey      = "limited-key"
+	authenticationCode = "BEEF1234"
+)
+
+// apiKeys are the API keys known to the mock Identity Server, with the application they belong to and their rights.
+var apiKeys = map[string]struct {
+	ids    ttnpb.ApplicationIdentifiers
+	rights []ttnpb.Right
+}{
+	sourceAPIKey: {
+		ids:    sourceAppIDs,
+		rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+	},
+	targetAPIKey: {
+		ids:    targetAppIDs,
+		rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+	},
+	limitedAPIKey: {
+		ids:    sourceAppIDs,
+		rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+	},
+}
+
+var errPermissionDenied = errors.DefinePermissionDenied("permission_denied", "permission denied")
+
+func requireAPIKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if key, ok := apiKeys[md.AuthValue]; !ok || key.ids != ids {
+		return errPermissionDenied.New()
+	}
+	return nil
+}
+
+// mockRegistry is an in-memory end device registry. The caller must use an API key of the application of the end device.
+type mockRegistry struct {
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+	// setErr returns the error to return on Set, if any.
+	setErr func(ttnpb.EndDeviceIdentifiers) error
+}
+
+func newMockRegistry(devs ...*ttnpb.EndDevice) *mockRegistry {
+	r := &mockRegistry{
+		devices: make(map[string]*ttnpb.EndDevice),
+	}
+	for _, dev := range devs {
+		r.devices[unique.ID(test.Context(), dev.EndDeviceIdentifiers)] = dev
+	}
+	return r
+}
+
+func (r *mockRegistry) device(ids ttnpb.EndDeviceIdentifiers) *ttnpb.EndDevice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.devices[unique.ID(test.Context(), ids)]
+}
+
+func (r *mockRegistry) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := requireAPIKey(ctx, req.ApplicationIdentifiers); err != nil {
+		return nil, err
+	}
+	dev := r.device(req.EndDeviceIdentifiers)
+	if dev == nil {
+		return nil, errNotFound.New()
+	}
+	res := &ttnpb.EndDevice{}
+	if err := res.SetFields(dev, append(req.FieldMask.Paths, "ids")...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *mockRegistry) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := requireAPIKey(ctx, req.EndDevice.ApplicationIdentifiers); err != nil {
+		return nil, err
+	}
+	if r.setErr != nil {
+		if err := r.setErr(req.EndDevice.EndDeviceIdentifiers); err != nil {
+			return nil, err
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, req.EndDevice.EndDeviceIdentifiers)
+	dev, ok := r.devices[uid]
+	if !ok {
+		dev = &ttnpb.EndDevice{EndDeviceIdentifiers: req.EndDevice.EndDeviceIdentifiers}
+	}
+	if err := dev.SetFields(&req.EndDevice, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	r.devices[uid] = dev
+	return dev, nil
+}
+
+func (r *mockRegistry) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := requireAPIKey(ctx, ids.ApplicationIdentifiers); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, *ids)
+	if _, ok := r.devices[uid]; !ok {
+		return nil, errNotFound.New()
+	}
+	delete(r.devices, uid)
+	return ttnpb.Empty, nil
+}
+
+var (
+	errNotFound      = errors.DefineNotFound("not_found", "not found")
+	errAlreadyExists = errors.DefineAlreadyExists("already_exists", "already exists")
+)
+
+type mockJsEndDeviceRegistry struct {
+	ttnpb.JsEndDeviceRegistryServer
+	*mockRegistry
+}
+
+func (r *mockJsEndDeviceRegistry) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return r.mockRegistry.Get(ctx, req)
+}
+
+func (r *mockJsEndDeviceRegistry) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return r.mockRegistry.Set(ctx, req)
+}
+
+func (r *mockJsEndDeviceRegistry) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	return r.mockRegistry.Delete(ctx, ids)
+}
+
+// mockIdentityServer is a mock Identity Server with an end device registry, entity access and application access.
+type mockIdentityServer struct {
+	ttnpb.EndDeviceRegistryServer
+	test.MockApplicationAccessServer
+	*mockRegistry
+}
+
+func (is *mockIdentityServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return is.mockRegistry.Get(ctx, req)
+}
+
+func (is *mockIdentityServer) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	return is.mockRegistry.Delete(ctx, ids)
+}
+
+func (is *mockIdentityServer) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if is.device(req.EndDevice.EndDeviceIdentifiers) != nil {
+		return nil, errAlreadyExists.New()
+	}
+	return is.mockRegistry.Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: req.EndDevice,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"application_server_address",
+				"attributes",
+				"description",
+				"join_server_address",
+				"locations",
+				"name",
+				"network_server_address",
+				"picture",
+				"service_profile_id",
+				"version_ids",
+			},
+		},
+	})
+}
+
+func (is *mockIdentityServer) GetIdentifiersForEUIs(ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	is.mu.Lock()
+	defer is.mu.Unlock()
+	for _, dev := range is.devices {
+		if dev.JoinEUI.Equal(req.JoinEUI) && dev.DevEUI.Equal(req.DevEUI) {
+			ids := dev.EndDeviceIdentifiers
+			return &ids, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (is *mockIdentityServer) AuthInfo(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.AuthInfoResponse, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	key, ok := apiKeys[md.AuthValue]
+	if !ok {
+		return nil, errPermissionDenied.New()
+	}
+	return &ttnpb.AuthInfoResponse{
+		AccessMethod: &ttnpb.AuthInfoResponse_APIKey{
+			APIKey: &ttnpb.AuthInfoResponse_APIKeyAccess{
+				APIKey: ttnpb.APIKey{
+					Rights: key.rights,
+				},
+				EntityIDs: *key.ids.EntityIdentifiers(),
+			},
+		},
+	}, nil
+}
+
+func (is *mockIdentityServer) ListRights(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	key, ok := apiKeys[md.AuthValue]
+	if !ok || key.ids != *ids {
+		return &ttnpb.Rights{}, nil
+	}
+	return ttnpb.RightsFrom(key.rights...).Implied(), nil
+}
+
+// mockAuthorizedApplicationRegistry is an in-memory AuthorizedApplicationRegistry.
+type mockAuthorizedApplicationRegistry struct {
+	mu   sync.Mutex
+	auth map[string]*ttnpb.AuthorizeApplicationRequest
+}
+
+func (r *mockAuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, _ []string) (*ttnpb.AuthorizeApplicationRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pb, ok := r.auth[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return pb, nil
+}
+
+func (r *mockAuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, _ []string, f func(*ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error)) (*ttnpb.AuthorizeApplicationRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	pb, _, err := f(r.auth[uid])
+	if err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		delete(r.auth, uid)
+		return nil, nil
+	}
+	r.auth[uid] = pb
+	return pb, nil
+}
+
+type testCluster struct {
+	is         *mockIdentityServer
+	js, ns, as *mockRegistry
+	dcs        ttnpb.EndDeviceClaimingServerClient
+}
+
+func startTestCluster(ctx context.Context, t *testing.T) (*testCluster, func()) {
+	t.Helper()
+	tc := &testCluster{
+		is: &mockIdentityServer{
+			mockRegistry: newMockRegistry(&ttnpb.EndDevice{
+				EndDeviceIdentifiers:     sourceDevIDs,
+				Name:                     "Source device",
+				Attributes:               map[string]string{"foo": "bar"},
+				JoinServerAddress:        "js.example.com",
+				NetworkServerAddress:     "ns.example.com",
+				ApplicationServerAddress: "as.example.com",
+			}),
+		},
+		js: newMockRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: sourceDevIDs,
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{
+					Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+			},
+			ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+				Value: authenticationCode,
+			},
+		}),
+		ns: newMockRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: sourceDevIDs,
+			FrequencyPlanID:      test.EUFrequencyPlanID,
+			LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+			LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+			SupportsJoin:         true,
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				SessionKeys: ttnpb.SessionKeys{
+					FNwkSIntKey: &ttnpb.KeyEnvelope{
+						Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					},
+				},
+				LastFCntUp: 42,
+			},
+		}),
+		as: newMockRegistry(&ttnpb.EndDevice{
+			EndDeviceIdentifiers: sourceDevIDs,
+			Formatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			},
+		}),
+	}
+
+	newPeer := func(srv interface{}, registrators ...interface{}) cluster.Peer {
+		peer, err := test.NewGRPCServerPeer(ctx, srv, registrators...)
+		if err != nil {
+			t.Fatalf("Failed to create peer: %s", err)
+		}
+		return peer
+	}
+	isPeer := newPeer(tc.is,
+		ttnpb.RegisterEndDeviceRegistryServer,
+		ttnpb.RegisterEntityAccessServer,
+		ttnpb.RegisterApplicationAccessServer,
+	)
+	jsPeer := newPeer(&mockJsEndDeviceRegistry{mockRegistry: tc.js}, ttnpb.RegisterJsEndDeviceRegistryServer)
+	nsPeer := newPeer(tc.ns, ttnpb.RegisterNsEndDeviceRegistryServer)
+	asPeer := newPeer(tc.as, ttnpb.RegisterAsEndDeviceRegistryServer)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				AllowInsecureForCredentials: true,
+			},
+		},
+	}, component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+		return &test.MockCluster{
+			JoinFunc: test.ClusterJoinNilFunc,
+			GetPeerFunc: func(_ context.Context, role ttnpb.ClusterRole, _ ttnpb.Identifiers) (cluster.Peer, error) {
+				switch role {
+				case ttnpb.ClusterRole_ACCESS, ttnpb.ClusterRole_ENTITY_REGISTRY:
+					return isPeer, nil
+				case ttnpb.ClusterRole_JOIN_SERVER:
+					return jsPeer, nil
+				case ttnpb.ClusterRole_NETWORK_SERVER:
+					return nsPeer, nil
+				case ttnpb.ClusterRole_APPLICATION_SERVER:
+					return asPeer, nil
+				}
+				return nil, fmt.Errorf("no peer with role %s", role)
+			},
+			AuthFunc: func() grpc.CallOption {
+				return grpc.EmptyCallOption{}
+			},
+		}, nil
+	}))
+	_, err := New(c, &Config{
+		AuthorizedApplications: &mockAuthorizedApplicationRegistry{
+			auth: make(map[string]*ttnpb.AuthorizeApplicationRequest),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create Device Claiming Server: %s", err)
+	}
+	componenttest.StartComponent(t, c)
+	tc.dcs = ttnpb.NewEndDeviceClaimingServerClient(c.LoopbackConn())
+	return tc, c.Close
+}
+
+func callOpt(key string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     key,
+		AllowInsecure: true,
+	})
+}
+
+func claimRequest(code string) *ttnpb.ClaimEndDeviceRequest {
+	return &ttnpb.ClaimEndDeviceRequest{
+		SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+				JoinEUI:            *sourceDevIDs.JoinEUI,
+				DevEUI:             *sourceDevIDs.DevEUI,
+				AuthenticationCode: code,
+			},
+		},
+		TargetApplicationIDs:           targetAppIDs,
+		TargetDeviceID:                 targetDevIDs.DeviceID,
+		TargetNetworkServerAddress:     "ns.example.com",
+		TargetApplicationServerAddress: "as.example.com",
+	}
+}
+
+func TestAuthorizeApplication(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	tc, stop := startTestCluster(ctx, t)
+	defer stop()
+
+	_, err := tc.dcs.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 sourceAPIKey,
+	}, callOpt(limitedAPIKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = tc.dcs.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 targetAPIKey,
+	}, callOpt(sourceAPIKey))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = tc.dcs.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 limitedAPIKey,
+	}, callOpt(sourceAPIKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = tc.dcs.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIdentifiers: sourceAppIDs,
+		APIKey:                 sourceAPIKey,
+	}, callOpt(sourceAPIKey))
+	a.So(err, should.BeNil)
+
+	_, err = tc.dcs.UnauthorizeApplication(ctx, &sourceAppIDs, callOpt(limitedAPIKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = tc.dcs.UnauthorizeApplication(ctx, &sourceAppIDs, callOpt(sourceAPIKey))
+	a.So(err, should.BeNil)
+
+	// The source application is no longer authorized, so claiming fails.
+	_, err = tc.dcs.Claim(ctx, claimRequest(authenticationCode), callOpt(targetAPIKey))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	a.So(tc.is.device(sourceDevIDs), should.NotBeNil)
+	a.So(tc.is.device(targetDevIDs), should.BeNil)
+}
+
+func TestClaim(t *testing.T) {
+	for _, tt := range []struct {
+		Name               string
+		AuthenticationCode string
+		NsSetErr           func(ttnpb.EndDeviceIdentifiers) error
+		TargetExists       bool
+		ErrorAssertion     func(error) bool
+	}{
+		{
+			Name:               "Success",
+			AuthenticationCode: authenticationCode,
+		},
+		{
+			Name:               "InvalidAuthenticationCode",
+			AuthenticationCode: "BEEF4321",
+			ErrorAssertion:     errors.IsPermissionDenied,
+		},
+		{
+			Name:               "RollbackOnFailure",
+			AuthenticationCode: authenticationCode,
+			NsSetErr: func(ids ttnpb.EndDeviceIdentifiers) error {
+				if ids.ApplicationIdentifiers == targetAppIDs {
+					return errPermissionDenied.New()
+				}
+				return nil
+			},
+			ErrorAssertion: errors.IsAborted,
+		},
+		{
+			Name:               "TargetExists",
+			AuthenticationCode: authenticationCode,
+			TargetExists:       true,
+			ErrorAssertion:     errors.IsAlreadyExists,
+		},
+	} {
+		t.Run(tt.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+			tc, stop := startTestCluster(ctx, t)
+			defer stop()
+			tc.ns.setErr = tt.NsSetErr
+			if tt.TargetExists {
+				for _, reg := range []*mockRegistry{tc.is.mockRegistry, tc.js, tc.ns, tc.as} {
+					reg.devices[unique.ID(ctx, targetDevIDs)] = &ttnpb.EndDevice{
+						EndDeviceIdentifiers: targetDevIDs,
+						Name:                 "Existing device",
+					}
+				}
+			}
+
+			_, err := tc.dcs.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+				ApplicationIdentifiers: sourceAppIDs,
+				APIKey:                 sourceAPIKey,
+			}, callOpt(sourceAPIKey))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			// Claiming requires rights on the target application.
+			_, err = tc.dcs.Claim(ctx, claimRequest(tt.AuthenticationCode), callOpt(sourceAPIKey))
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+			ids, err := tc.dcs.Claim(ctx, claimRequest(tt.AuthenticationCode), callOpt(targetAPIKey))
+			if tt.ErrorAssertion != nil {
+				a.So(tt.ErrorAssertion(err), should.BeTrue)
+				// The source end device is intact and the target end device is left as it was.
+				for _, reg := range []*mockRegistry{tc.is.mockRegistry, tc.js, tc.ns, tc.as} {
+					a.So(reg.device(sourceDevIDs), should.NotBeNil)
+					if tt.TargetExists {
+						if a.So(reg.device(targetDevIDs), should.NotBeNil) {
+							a.So(reg.device(targetDevIDs).Name, should.Equal, "Existing device")
+						}
+					} else {
+						a.So(reg.device(targetDevIDs), should.BeNil)
+					}
+				}
+				a.So(tc.is.device(sourceDevIDs).Name, should.Equal, "Source device")
+				a.So(tc.js.device(sourceDevIDs).ClaimAuthenticationCode.Value, should.Equal, authenticationCode)
+				if nsDev := tc.ns.device(sourceDevIDs); a.So(nsDev, should.NotBeNil) {
+					a.So(nsDev.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+					if a.So(nsDev.Session, should.NotBeNil) {
+						a.So(nsDev.Session.DevAddr, should.Equal, types.DevAddr{0x42, 0xff, 0xff, 0xff})
+						a.So(nsDev.Session.LastFCntUp, should.Equal, uint32(42))
+					}
+				}
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(*ids, should.Resemble, targetDevIDs)
+
+			// The source end device is deleted and the target end device is created with the transferred fields.
+			for _, reg := range []*mockRegistry{tc.is.mockRegistry, tc.js, tc.ns, tc.as} {
+				a.So(reg.device(sourceDevIDs), should.BeNil)
+				a.So(reg.device(targetDevIDs), should.NotBeNil)
+			}
+			if isDev := tc.is.device(targetDevIDs); isDev != nil {
+				a.So(isDev.Name, should.Equal, "Source device")
+				a.So(isDev.Attributes, should.Resemble, map[string]string{"foo": "bar"})
+				a.So(isDev.JoinServerAddress, should.Equal, "js.example.com")
+				a.So(isDev.NetworkServerAddress, should.Equal, "ns.example.com")
+				a.So(isDev.ApplicationServerAddress, should.Equal, "as.example.com")
+			}
+			if jsDev := tc.js.device(targetDevIDs); jsDev != nil {
+				a.So(jsDev.RootKeys, should.Resemble, &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					},
+				})
+				a.So(jsDev.NetworkServerAddress, should.Equal, "ns.example.com")
+				a.So(jsDev.ApplicationServerAddress, should.Equal, "as.example.com")
+			}
+			if nsDev := tc.ns.device(targetDevIDs); nsDev != nil {
+				a.So(nsDev.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+				a.So(nsDev.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_3)
+				a.So(nsDev.SupportsJoin, should.BeTrue)
+				a.So(nsDev.Session, should.BeNil)
+			}
+			if asDev := tc.as.device(targetDevIDs); asDev != nil {
+				a.So(asDev.Formatters.UpFormatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP)
+			}
+
+			// The end device cannot be claimed again, since the EUIs are now in the target application.
+			_, err = tc.dcs.Claim(ctx, claimRequest(tt.AuthenticationCode), callOpt(targetAPIKey))
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		})
+	}
+}
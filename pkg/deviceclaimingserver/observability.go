@@ -0,0 +1,40 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtClaimEndDeviceSuccess = events.Define(
+		"dcs.end_device.claim.success", "claim end device",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+	)
+	evtClaimEndDeviceFail = events.Define(
+		"dcs.end_device.claim.fail", "fail to claim end device",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithErrorDataType(),
+	)
+	evtAuthorizeApplication = events.Define(
+		"dcs.application.authorize", "authorize application for claiming",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC),
+	)
+	evtUnauthorizeApplication = events.Define(
+		"dcs.application.unauthorize", "unauthorize application for claiming",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC),
+	)
+)
@@ -0,0 +1,127 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides Redis implementations of the Device Claiming Server registries.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+func applyAuthorizationFieldMask(dst, src *ttnpb.AuthorizeApplicationRequest, paths ...string) (*ttnpb.AuthorizeApplicationRequest, error) {
+	if dst == nil {
+		dst = &ttnpb.AuthorizeApplicationRequest{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// AuthorizedApplicationRegistry is a Redis authorized application registry.
+type AuthorizedApplicationRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *AuthorizedApplicationRegistry) appKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the authorization by the application identifiers.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.AuthorizeApplicationRequest, error) {
+	defer trace.StartRegion(ctx, "get authorized application").End()
+
+	pb := &ttnpb.AuthorizeApplicationRequest{}
+	if err := ttnredis.GetProto(r.Redis, r.appKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applyAuthorizationFieldMask(nil, pb, paths...)
+}
+
+// Set creates, updates or deletes the authorization by the application identifiers.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, gets []string, f func(*ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error)) (*ttnpb.AuthorizeApplicationRequest, error) {
+	defer trace.StartRegion(ctx, "set authorized application").End()
+
+	uk := r.appKey(unique.ID(ctx, ids))
+
+	var pb *ttnpb.AuthorizeApplicationRequest
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		cmd := ttnredis.GetProto(tx, uk)
+		stored := &ttnpb.AuthorizeApplicationRequest{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		if stored != nil {
+			pb, err = applyAuthorizationFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyAuthorizationFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.AuthorizeApplicationRequest{}
+			}
+			updated, err := applyAuthorizationFieldMask(stored, pb, sets...)
+			if err != nil {
+				return err
+			}
+			updated.ApplicationIdentifiers = ids
+			if err := updated.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, uk, updated, 0)
+				return err
+			}
+			pb, err = applyAuthorizationFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
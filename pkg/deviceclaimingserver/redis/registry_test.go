@@ -0,0 +1,72 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestAuthorizedApplicationRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "deviceclaimingserver_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := &AuthorizedApplicationRegistry{
+		Redis: cl,
+	}
+
+	ids := ttnpb.ApplicationIdentifiers{ApplicationID: "app1"}
+
+	_, err := reg.Get(ctx, ids, []string{"api_key"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	pb, err := reg.Set(ctx, ids, []string{"api_key"}, func(pb *ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error) {
+		a.So(pb, should.BeNil)
+		return &ttnpb.AuthorizeApplicationRequest{
+			ApplicationIdentifiers: ids,
+			APIKey:                 "test-key",
+		}, []string{"api_key", "application_ids"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.APIKey, should.Equal, "test-key")
+
+	pb, err = reg.Get(ctx, ids, []string{"api_key", "application_ids"})
+	if a.So(err, should.BeNil) {
+		a.So(pb, should.Resemble, &ttnpb.AuthorizeApplicationRequest{
+			ApplicationIdentifiers: ids,
+			APIKey:                 "test-key",
+		})
+	}
+
+	_, err = reg.Set(ctx, ids, nil, func(pb *ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error) {
+		a.So(pb, should.NotBeNil)
+		return nil, nil, nil
+	})
+	a.So(err, should.BeNil)
+
+	_, err = reg.Get(ctx, ids, []string{"api_key"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,30 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// AuthorizedApplicationRegistry is a store for applications that are authorized for claiming.
+// The API key of an authorized application is used to transfer end devices out of the application.
+type AuthorizedApplicationRegistry interface {
+	// Get returns the authorization by the application identifiers.
+	Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.AuthorizeApplicationRequest, error)
+	// Set creates, updates or deletes the authorization by the application identifiers.
+	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string, f func(*ttnpb.AuthorizeApplicationRequest) (*ttnpb.AuthorizeApplicationRequest, []string, error)) (*ttnpb.AuthorizeApplicationRequest, error)
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	grpc_runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -34,7 +35,12 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-const workersPerCPU = 2
+const (
+	workersPerCPU = 2
+
+	// historyBufferSize is the number of live events that are buffered while historical events are sent.
+	historyBufferSize = 1024
+)
 
 // NewEventsServer returns a new EventsServer on the given PubSub.
 func NewEventsServer(ctx context.Context, pubsub events.PubSub) *EventsServer {
@@ -94,7 +100,26 @@ func (srv *EventsServer) subscribe() {
 	})
 }
 
-var errNoIdentifiers = errors.DefineInvalidArgument("no_identifiers", "no identifiers")
+var (
+	errNoIdentifiers   = errors.DefineInvalidArgument("no_identifiers", "no identifiers")
+	errHistoryOverflow = errors.DefineResourceExhausted("history_overflow", "more than `{count}` live events while sending historical events")
+)
+
+// bufferedHandler buffers live events while historical events are sent, and records whether live events are dropped
+// because the buffer is full.
+type bufferedHandler struct {
+	ch      events.Channel
+	dropped uint32
+}
+
+// Notify implements the events.Handler interface.
+func (h *bufferedHandler) Notify(evt events.Event) {
+	select {
+	case h.ch <- evt:
+	default:
+		atomic.StoreUint32(&h.dropped, 1)
+	}
+}
 
 // Stream implements the EventsServer interface.
 func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Events_StreamServer) error {
@@ -109,13 +134,31 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 
 	srv.subscribe()
 
-	ch := make(events.Channel, 8)
-	handler := events.ContextHandler(ctx, ch)
+	historical := req.Tail > 0 || req.After != nil
+	store, hasStore := srv.pubsub.(events.Store)
+
+	chSize := 8
+	if historical && hasStore {
+		// Buffer live events while historical events are fetched.
+		chSize = historyBufferSize
+	}
+	ch := make(events.Channel, chSize)
+	liveHandler := &bufferedHandler{ch: ch}
+	handler := events.ContextHandler(ctx, liveHandler)
 	srv.filter.Subscribe(ctx, req, handler)
 	defer srv.filter.Unsubscribe(ctx, req, handler)
 
-	if req.Tail > 0 || req.After != nil {
-		warning.Add(ctx, "Historical events not implemented")
+	var history []events.Event
+	if historical {
+		if hasStore {
+			var err error
+			history, err = store.FetchHistory(ctx, req.Identifiers, req.After, int(req.Tail))
+			if err != nil {
+				return err
+			}
+		} else {
+			warning.Add(ctx, "Historical events not implemented")
+		}
 	}
 
 	if err := stream.SendHeader(metadata.MD{}); err != nil {
@@ -137,11 +180,52 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 		return err
 	}
 
+	// Live events that were published while fetching the history may also be part of the history. These events are
+	// buffered by the time the history is sent, so only the buffered events are deduplicated.
+	sent := make(map[string]struct{}, len(history))
+	for _, evt := range history {
+		sent[evt.UniqueID()] = struct{}{}
+		isVisible, err := rightsutil.EventIsVisible(ctx, evt)
+		if err != nil {
+			return err
+		}
+		if !isVisible {
+			continue
+		}
+		proto, err := events.Proto(evt)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(proto); err != nil {
+			return err
+		}
+	}
+
+	// The stream fails if live events were dropped while the history was sent, as the client would otherwise miss
+	// these events without noticing.
+	if historical && hasStore && atomic.LoadUint32(&liveHandler.dropped) != 0 {
+		return errHistoryOverflow.WithAttributes("count", historyBufferSize)
+	}
+
+	buffered := len(ch)
+	if buffered == 0 {
+		sent = nil
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case evt := <-ch:
+			if buffered > 0 {
+				_, ok := sent[evt.UniqueID()]
+				if buffered--; buffered == 0 {
+					sent = nil
+				}
+				if ok {
+					continue
+				}
+			}
 			isVisible, err := rightsutil.EventIsVisible(ctx, evt)
 			if err != nil {
 				return err
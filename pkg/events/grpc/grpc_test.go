@@ -0,0 +1,217 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/events/grpc"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+)
+
+type mockStore struct {
+	events.PubSub
+	fetchHistory func(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error)
+}
+
+func (s *mockStore) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+	return s.fetchHistory(ctx, ids, after, tail)
+}
+
+type mockStream struct {
+	*test.MockServerStream
+	sendCh chan *ttnpb.Event
+}
+
+func (s *mockStream) Send(evt *ttnpb.Event) error {
+	s.sendCh <- evt
+	return nil
+}
+
+var timeout = (1 << 5) * test.Delay
+
+func TestStreamHistory(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}
+	ctx = rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL),
+		},
+	})
+
+	evtHistory := events.New(ctx, "test.history", "history event", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO))
+	evtBoth := events.New(ctx, "test.both", "event in history and live", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO))
+	evtLive := events.New(ctx, "test.live", "live event", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO))
+	evtLater := events.New(ctx, "test.later", "later live event", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO))
+
+	type fetchHistoryRequest struct {
+		ids   []*ttnpb.EntityIdentifiers
+		after *time.Time
+		tail  int
+	}
+	fetchHistoryCh := make(chan fetchHistoryRequest, 1)
+
+	pubsub := events.NewPubSub(events.DefaultBufferSize)
+	store := &mockStore{
+		PubSub: pubsub,
+		fetchHistory: func(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+			fetchHistoryCh <- fetchHistoryRequest{ids: ids, after: after, tail: tail}
+			// Publish events while the history is fetched, one of which is also part of the history.
+			pubsub.Publish(evtBoth)
+			pubsub.Publish(evtLive)
+			time.Sleep(timeout / 4)
+			return []events.Event{evtHistory, evtBoth}, nil
+		},
+	}
+	srv := NewEventsServer(test.Context(), store)
+
+	stream := &mockStream{
+		MockServerStream: &test.MockServerStream{
+			MockStream: &test.MockStream{
+				ContextFunc: func() context.Context { return ctx },
+			},
+			SendHeaderFunc: func(metadata.MD) error { return nil },
+		},
+		sendCh: make(chan *ttnpb.Event),
+	}
+	req := &ttnpb.StreamEventsRequest{
+		Identifiers: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()},
+		Tail:        10,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Stream(req, stream)
+	}()
+
+	select {
+	case fetchReq := <-fetchHistoryCh:
+		a.So(fetchReq.ids, should.Resemble, req.Identifiers)
+		a.So(fetchReq.after, should.BeNil)
+		a.So(fetchReq.tail, should.Equal, 10)
+	case <-time.After(timeout):
+		t.Fatal("History not fetched")
+	}
+
+	expectEvent := func(name, uniqueID string) {
+		t.Helper()
+		select {
+		case evt := <-stream.sendCh:
+			a.So(evt.Name, should.Equal, name)
+			if uniqueID != "" {
+				a.So(evt.UniqueID, should.Equal, uniqueID)
+			}
+		case <-time.After(timeout):
+			t.Fatalf("Did not receive event %q", name)
+		}
+	}
+	expectEvent("events.stream.start", "")
+	expectEvent("test.history", evtHistory.UniqueID())
+	expectEvent("test.both", evtBoth.UniqueID())
+	expectEvent("test.live", evtLive.UniqueID())
+
+	pubsub.Publish(evtLater)
+	expectEvent("test.later", evtLater.UniqueID())
+
+	// Once the buffered events are sent, live events are no longer deduplicated.
+	pubsub.Publish(evtBoth)
+	expectEvent("test.both", evtBoth.UniqueID())
+
+	select {
+	case evt := <-stream.sendCh:
+		t.Fatalf("Received unexpected event %q", evt.Name)
+	case <-time.After(test.Delay):
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		a.So(err, should.Equal, context.Canceled)
+	case <-time.After(timeout):
+		t.Fatal("Stream did not return")
+	}
+}
+
+func TestStreamHistoryOverflow(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}
+	ctx = rights.NewContext(ctx, rights.Rights{
+		ApplicationRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, appIDs): ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_ALL),
+		},
+	})
+
+	evtHistory := events.New(ctx, "test.history", "history event", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO))
+
+	pubsub := events.NewPubSub(events.DefaultBufferSize)
+	store := &mockStore{
+		PubSub: pubsub,
+		fetchHistory: func(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+			// Publish more live events than can be buffered while the history is fetched.
+			for i := 0; i < 16; i++ {
+				for j := 0; j < 128; j++ {
+					pubsub.Publish(events.New(ctx, "test.live", "live event", events.WithIdentifiers(appIDs), events.WithVisibility(ttnpb.RIGHT_APPLICATION_INFO)))
+				}
+				time.Sleep(test.Delay)
+			}
+			return []events.Event{evtHistory}, nil
+		},
+	}
+	srv := NewEventsServer(test.Context(), store)
+
+	stream := &mockStream{
+		MockServerStream: &test.MockServerStream{
+			MockStream: &test.MockStream{
+				ContextFunc: func() context.Context { return ctx },
+			},
+			SendHeaderFunc: func(metadata.MD) error { return nil },
+		},
+		sendCh: make(chan *ttnpb.Event, 2),
+	}
+	req := &ttnpb.StreamEventsRequest{
+		Identifiers: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()},
+		Tail:        10,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Stream(req, stream)
+	}()
+
+	// The stream fails instead of silently dropping the live events that do not fit in the buffer.
+	select {
+	case err := <-errCh:
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	case <-time.After(32 * timeout):
+		t.Fatal("Stream did not return")
+	}
+}
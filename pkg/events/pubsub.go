@@ -15,10 +15,13 @@
 package events
 
 import (
+	"context"
 	"runtime/trace"
 	"sync"
+	"time"
 
 	"github.com/gobwas/glob"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
 // PubSub interface combines the Publisher and Subscriber interfaces.
@@ -27,6 +30,15 @@ type PubSub interface {
 	Subscriber
 }
 
+// Store extends the PubSub interface with a method to fetch historical events.
+type Store interface {
+	PubSub
+	// FetchHistory fetches the historical events of the given identifiers in chronological order.
+	// If after is not nil, only events published after that time are returned.
+	// If tail is greater than zero, only the last tail events are returned.
+	FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]Event, error)
+}
+
 // Publisher interface lets you publish events.
 type Publisher interface {
 	// Publish emits an event on the default event pubsub.
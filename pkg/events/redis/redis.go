@@ -20,7 +20,6 @@ import (
 	"encoding/json"
 	"sync"
 
-	"github.com/go-redis/redis/v7"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -42,7 +41,7 @@ func WrapPubSub(ctx context.Context, wrapped events.PubSub, taskStarter componen
 		taskStarter:  taskStarter,
 		ctx:          ctx,
 		cancel:       cancel,
-		client:       ttnRedisClient.Client,
+		client:       ttnRedisClient,
 		eventChannel: eventChannel,
 	}
 }
@@ -60,7 +59,7 @@ type PubSub struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
 	eventChannel string
-	client       *redis.Client
+	client       *ttnredis.Client
 	subOnce      sync.Once
 }
 
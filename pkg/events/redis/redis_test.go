@@ -112,3 +112,75 @@ func TestRedisPubSub(t *testing.T) {
 		},
 	})
 }
+
+func TestRedisStore(t *testing.T) {
+	test.RunTest(t, test.TestConfig{
+		Timeout: 4 * timeout,
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			ctx = events.ContextWithCorrelationID(ctx, t.Name())
+
+			conf := redisConfig()
+			conf.RootNamespace = append(conf.RootNamespace, t.Name())
+
+			taskStarter := component.StartTaskFunc(component.DefaultStartTask)
+			store := redis.NewStore(ctx, taskStarter, conf, redis.StoreConfig{
+				TTL:         time.Minute,
+				EntityCount: 10,
+			})
+			defer store.Close(ctx)
+
+			appID := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+			devID := &ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: *appID, DeviceID: "test-dev"}
+			dev2ID := &ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: *appID, DeviceID: "test-dev-2"}
+			gtwID := &ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+
+			start := time.Now()
+			store.Publish(events.New(ctx, "redis.test.evt0", "redis test event 0", events.WithIdentifiers(appID)))
+			time.Sleep(test.Delay)
+			store.Publish(events.New(ctx, "redis.test.evt1", "redis test event 1", events.WithIdentifiers(devID)))
+			time.Sleep(test.Delay)
+			store.Publish(events.New(ctx, "redis.test.evt2", "redis test event 2", events.WithIdentifiers(gtwID)))
+			time.Sleep(test.Delay)
+			store.Publish(events.New(ctx, "redis.test.evt3", "redis test event 3", events.WithIdentifiers(devID)))
+			time.Sleep(test.Delay)
+			store.Publish(events.New(ctx, "redis.test.evt4", "redis test event 4", events.WithIdentifiers(dev2ID)))
+
+			names := func(evts []events.Event) []string {
+				var names []string
+				for _, evt := range evts {
+					names = append(names, evt.Name())
+				}
+				return names
+			}
+
+			evts, err := store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appID.EntityIdentifiers()}, nil, 0)
+			if a.So(err, should.BeNil) {
+				a.So(names(evts), should.Resemble, []string{"redis.test.evt0", "redis.test.evt1", "redis.test.evt3", "redis.test.evt4"})
+			}
+
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{devID.EntityIdentifiers()}, nil, 0)
+			if a.So(err, should.BeNil) {
+				a.So(names(evts), should.Resemble, []string{"redis.test.evt1", "redis.test.evt3"})
+			}
+
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{devID.EntityIdentifiers()}, nil, 1)
+			if a.So(err, should.BeNil) {
+				a.So(names(evts), should.Resemble, []string{"redis.test.evt3"})
+			}
+
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{
+				appID.EntityIdentifiers(),
+				gtwID.EntityIdentifiers(),
+			}, nil, 3)
+			if a.So(err, should.BeNil) {
+				a.So(names(evts), should.Resemble, []string{"redis.test.evt2", "redis.test.evt3", "redis.test.evt4"})
+			}
+
+			after := start.Add(test.Delay / 2)
+			evts, err = store.FetchHistory(ctx, []*ttnpb.EntityIdentifiers{appID.EntityIdentifiers()}, &after, 0)
+			if a.So(err, should.BeNil) {
+				a.So(names(evts), should.Resemble, []string{"redis.test.evt1", "redis.test.evt3", "redis.test.evt4"})
+			}
+		},
+	})
+}
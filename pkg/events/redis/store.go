@@ -0,0 +1,192 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const eventField = "event"
+
+// StoreConfig is the configuration of the event history of the Store.
+type StoreConfig struct {
+	// TTL is the time that historical events are retained.
+	TTL time.Duration
+	// EntityCount is the maximum number of historical events that are retained per entity.
+	EntityCount int64
+}
+
+// WrapStore wraps an existing PubSub, publishes all events received from Redis to that PubSub and
+// keeps a bounded history of the events per entity.
+func WrapStore(ctx context.Context, wrapped events.PubSub, taskStarter component.TaskStarter, conf ttnredis.Config, storeConf StoreConfig) *Store {
+	return &Store{
+		PubSub: WrapPubSub(ctx, wrapped, taskStarter, conf),
+		config: storeConf,
+	}
+}
+
+// NewStore creates a new Store that publishes and subscribes to Redis and keeps a bounded history
+// of the events per entity.
+func NewStore(ctx context.Context, taskStarter component.TaskStarter, conf ttnredis.Config, storeConf StoreConfig) *Store {
+	return WrapStore(ctx, events.NewPubSub(events.DefaultBufferSize), taskStarter, conf, storeConf)
+}
+
+// Store is a PubSub with Redis backend that also stores historical events.
+type Store struct {
+	*PubSub
+
+	config StoreConfig
+}
+
+func (s *Store) historyKey(ctx context.Context, ids *ttnpb.EntityIdentifiers) string {
+	return s.client.Key("history", strings.Replace(ids.EntityType(), " ", "_", -1), unique.ID(ctx, ids))
+}
+
+// historyKeys returns the history keys of the given identifiers.
+// If withApplications is set, the history keys of the applications of end devices are also returned.
+func (s *Store) historyKeys(ctx context.Context, ids []*ttnpb.EntityIdentifiers, withApplications bool) []string {
+	keys := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	add := func(ids *ttnpb.EntityIdentifiers) {
+		k := s.historyKey(ctx, ids)
+		if _, ok := seen[k]; ok {
+			return
+		}
+		seen[k] = struct{}{}
+		keys = append(keys, k)
+	}
+	for _, entityIDs := range ids {
+		add(entityIDs)
+		if !withApplications {
+			continue
+		}
+		if devIDs := entityIDs.GetDeviceIDs(); devIDs != nil {
+			add(devIDs.ApplicationIdentifiers.EntityIdentifiers())
+		}
+	}
+	return keys
+}
+
+// Publish an event to Redis and store it in the history of its identifiers.
+// End device events are also stored in the history of the application.
+func (s *Store) Publish(evt events.Event) {
+	logger := log.FromContext(s.ctx)
+	b, err := json.Marshal(evt)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal event to JSON")
+		return
+	}
+	_, err = s.client.Pipelined(func(p redis.Pipeliner) error {
+		for _, k := range s.historyKeys(evt.Context(), evt.Identifiers(), true) {
+			p.XAdd(&redis.XAddArgs{
+				Stream:       k,
+				MaxLenApprox: s.config.EntityCount,
+				Values: map[string]interface{}{
+					eventField: b,
+				},
+			})
+			if s.config.TTL > 0 {
+				p.PExpire(k, s.config.TTL)
+			}
+		}
+		p.Publish(s.eventChannel, b)
+		return nil
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to publish event")
+	}
+}
+
+func streamID(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// FetchHistory implements events.Store.
+func (s *Store) FetchHistory(ctx context.Context, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int) ([]events.Event, error) {
+	var notBefore time.Time
+	if s.config.TTL > 0 {
+		notBefore = time.Now().Add(-s.config.TTL)
+	}
+	if after != nil && after.After(notBefore) {
+		notBefore = *after
+	}
+	low := "-"
+	if !notBefore.IsZero() {
+		low = streamID(notBefore)
+	}
+	count := int64(tail)
+	if count <= 0 {
+		count = s.config.EntityCount
+	}
+
+	var cmds []*redis.XMessageSliceCmd
+	_, err := s.client.Pipelined(func(p redis.Pipeliner) error {
+		for _, k := range s.historyKeys(ctx, ids, false) {
+			if count > 0 {
+				cmds = append(cmds, p.XRevRangeN(k, "+", low, count))
+			} else {
+				cmds = append(cmds, p.XRevRange(k, "+", low))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+
+	var evts []events.Event
+	seen := make(map[string]struct{})
+	for _, cmd := range cmds {
+		for _, msg := range cmd.Val() {
+			payload, ok := msg.Values[eventField].(string)
+			if !ok {
+				continue
+			}
+			evt, err := events.UnmarshalJSON([]byte(payload))
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to unmarshal event from JSON")
+				continue
+			}
+			if _, ok := seen[evt.UniqueID()]; ok {
+				continue
+			}
+			seen[evt.UniqueID()] = struct{}{}
+			if evt.Time().Before(notBefore) {
+				continue
+			}
+			evts = append(evts, evt)
+		}
+	}
+	sort.SliceStable(evts, func(i, j int) bool {
+		return evts[i].Time().Before(evts[j].Time())
+	})
+	if tail > 0 && len(evts) > tail {
+		evts = evts[len(evts)-tail:]
+	}
+	return evts, nil
+}
@@ -0,0 +1,263 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	silenceThresholdAttribute    = "gs-silence-threshold"
+	statusIntervalAttribute      = "gs-status-interval"
+	disconnectThresholdAttribute = "gs-disconnect-threshold"
+)
+
+var (
+	timeNow   func() time.Time                       = time.Now
+	timeAfter func(d time.Duration) <-chan time.Time = time.After
+)
+
+var errAlertThreshold = errors.DefineInvalidArgument("alert_threshold", "invalid alert threshold `{value}` in attribute `{attribute}`")
+
+// alertThresholds are the thresholds of a gateway after which alerts are raised. A zero threshold disables the alert.
+type alertThresholds struct {
+	Silence       time.Duration
+	MissedStatus  time.Duration
+	Disconnection time.Duration
+}
+
+// newAlertThresholds returns the alert thresholds of the gateway.
+// The thresholds in the gateway attributes take precedence over the configured thresholds.
+func newAlertThresholds(conf AlertsConfig, gtw *ttnpb.Gateway) (alertThresholds, error) {
+	missedStatusCount := conf.MissedStatusCount
+	if missedStatusCount < 1 {
+		missedStatusCount = 1
+	}
+	res := alertThresholds{
+		Silence:       conf.SilenceThreshold,
+		MissedStatus:  conf.StatusInterval * time.Duration(missedStatusCount),
+		Disconnection: conf.DisconnectThreshold,
+	}
+	for attribute, dst := range map[string]*time.Duration{
+		silenceThresholdAttribute:    &res.Silence,
+		statusIntervalAttribute:      &res.MissedStatus,
+		disconnectThresholdAttribute: &res.Disconnection,
+	} {
+		value, ok := gtw.GetAttributes()[attribute]
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return alertThresholds{}, errAlertThreshold.WithAttributes("attribute", attribute, "value", value).WithCause(err)
+		}
+		if d < 0 {
+			return alertThresholds{}, errAlertThreshold.WithAttributes("attribute", attribute, "value", value)
+		}
+		if attribute == statusIntervalAttribute {
+			d *= time.Duration(missedStatusCount)
+		}
+		*dst = d
+	}
+	return res, nil
+}
+
+const (
+	alertSilent       = "silent"
+	alertMissedStatus = "missed_status"
+	alertDisconnected = "disconnected"
+)
+
+var alertEvents = map[string]events.Builder{
+	alertSilent:       evtGatewaySilent,
+	alertMissedStatus: evtGatewayMissedStatus,
+	alertDisconnected: evtGatewayDisconnected,
+}
+
+// localAlertRegistry is the GatewayAlertRegistry that is used when no registry is configured.
+// The alerts are only known to this Gateway Server.
+type localAlertRegistry struct {
+	alerts sync.Map
+}
+
+func (r *localAlertRegistry) key(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) string {
+	return fmt.Sprintf("%s:%s", unique.ID(ctx, ids), alert)
+}
+
+// Raise implements GatewayAlertRegistry.
+func (r *localAlertRegistry) Raise(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) error {
+	r.alerts.Store(r.key(ctx, ids, alert), struct{}{})
+	return nil
+}
+
+// Resolve implements GatewayAlertRegistry.
+func (r *localAlertRegistry) Resolve(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) (bool, error) {
+	_, ok := r.alerts.LoadAndDelete(r.key(ctx, ids, alert))
+	return ok, nil
+}
+
+// raiseAlert publishes the alert event and notifies the gateway collaborators.
+func (gs *GatewayServer) raiseAlert(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string, stats *ttnpb.GatewayConnectionStats, lastSeen time.Time) {
+	msg := &ttnpb.GatewayAlert{
+		GatewayIdentifiers: ids,
+		Alert:              alert,
+		LastSeenAt:         &lastSeen,
+		ConnectionStats:    stats,
+	}
+	events.Publish(alertEvents[alert].NewWithIdentifiersAndData(ctx, ids, msg))
+	gs.notifyAlert(ctx, msg)
+}
+
+// resolveAlert publishes the resolved alert event and notifies the gateway collaborators.
+func (gs *GatewayServer) resolveAlert(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string, stats *ttnpb.GatewayConnectionStats, lastSeen time.Time) {
+	msg := &ttnpb.GatewayAlert{
+		GatewayIdentifiers: ids,
+		Alert:              alert,
+		Resolved:           true,
+		LastSeenAt:         &lastSeen,
+		ConnectionStats:    stats,
+	}
+	events.Publish(evtGatewayAlertResolved.NewWithIdentifiersAndData(ctx, ids, msg))
+	gs.notifyAlert(ctx, msg)
+}
+
+// notifyAlert requests the Identity Server to notify the collaborators of the gateway of the alert.
+func (gs *GatewayServer) notifyAlert(ctx context.Context, alert *ttnpb.GatewayAlert) {
+	logger := log.FromContext(ctx).WithField("alert", alert.Alert)
+	cc, err := gs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, &alert.GatewayIdentifiers)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get Identity Server peer for alert notification")
+		return
+	}
+	if _, err := ttnpb.NewGatewayAlertNotifierClient(cc).Notify(ctx, alert, gs.WithClusterAuth()); err != nil {
+		logger.WithError(err).Warn("Failed to send alert notification")
+	}
+}
+
+func lastSeen(conn *io.Connection) time.Time {
+	res := conn.ConnectTime()
+	if _, t, ok := conn.UpStats(); ok && t.After(res) {
+		res = t
+	}
+	if _, t, ok := conn.StatusStats(); ok && t.After(res) {
+		res = t
+	}
+	return res
+}
+
+// watchConnection raises alerts when the connected gateway is silent, misses status messages or does not reconnect
+// after a disconnect, and resolves the alerts when the gateway becomes active again.
+// The silence and missed status alerts are resolved when the gateway disconnects, as they are bound to the connection.
+func (gs *GatewayServer) watchConnection(conn connectionEntry) {
+	var (
+		ctx    = conn.Context()
+		logger = log.FromContext(ctx)
+		ids    = conn.Gateway().GatewayIdentifiers
+	)
+	thresholds, err := newAlertThresholds(gs.config.Alerts, conn.Gateway())
+	if err != nil {
+		logger.WithError(err).Warn("Invalid alert thresholds, use default thresholds")
+		thresholds, _ = newAlertThresholds(gs.config.Alerts, nil)
+	}
+	if active, err := gs.alertRegistry.Resolve(ctx, ids, alertDisconnected); err != nil {
+		logger.WithError(err).Warn("Failed to resolve gateway alert")
+	} else if active {
+		logger.WithField("alert", alertDisconnected).Info("Resolve gateway alert")
+		gs.resolveAlert(ctx, ids, alertDisconnected, conn.Stats(), conn.ConnectTime())
+	}
+
+	raised := make(map[string]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			// The connection context is done, so the alerts are resolved in the context of the Gateway Server.
+			resolveCtx := log.NewContext(gs.Context(), logger)
+			seen := lastSeen(conn.Connection)
+			for _, alert := range []string{alertSilent, alertMissedStatus} {
+				if !raised[alert] {
+					continue
+				}
+				logger.WithField("alert", alert).Info("Resolve gateway alert on disconnect")
+				gs.resolveAlert(resolveCtx, ids, alert, conn.Stats(), seen)
+			}
+			gs.watchDisconnect(ids, conn.Connection, thresholds.Disconnection)
+			return
+		case <-timeAfter(gs.config.Alerts.CheckInterval):
+			now := timeNow()
+			seen := lastSeen(conn.Connection)
+			statusAt := conn.ConnectTime()
+			if _, t, ok := conn.StatusStats(); ok {
+				statusAt = t
+			}
+			for alert, active := range map[string]bool{
+				alertSilent:       thresholds.Silence > 0 && now.Sub(seen) >= thresholds.Silence,
+				alertMissedStatus: thresholds.MissedStatus > 0 && now.Sub(statusAt) >= thresholds.MissedStatus,
+			} {
+				switch {
+				case active && !raised[alert]:
+					logger.WithField("alert", alert).Info("Raise gateway alert")
+					gs.raiseAlert(ctx, ids, alert, conn.Stats(), seen)
+				case !active && raised[alert]:
+					logger.WithField("alert", alert).Info("Resolve gateway alert")
+					gs.resolveAlert(ctx, ids, alert, conn.Stats(), seen)
+				}
+				raised[alert] = active
+			}
+		}
+	}
+}
+
+// watchDisconnect raises an alert when the gateway did not reconnect within the threshold after the disconnect.
+// The alert is stored in the alert registry, so that it is resolved by the Gateway Server the gateway reconnects to.
+func (gs *GatewayServer) watchDisconnect(ids ttnpb.GatewayIdentifiers, conn *io.Connection, threshold time.Duration) {
+	if threshold <= 0 {
+		return
+	}
+	ctx := gs.Context()
+	select {
+	case <-ctx.Done():
+		return
+	case <-timeAfter(threshold):
+	}
+	if _, ok := gs.GetConnection(ctx, ids); ok {
+		return
+	}
+	if gs.statsRegistry != nil {
+		// The gateway may have reconnected to another Gateway Server in the cluster.
+		stats, err := gs.statsRegistry.Get(ctx, ids)
+		if err == nil && stats.ConnectedAt != nil && stats.ConnectedAt.After(conn.ConnectTime()) {
+			return
+		}
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"alert", alertDisconnected,
+		"gateway_uid", unique.ID(ctx, ids),
+	))
+	if err := gs.alertRegistry.Raise(ctx, ids, alertDisconnected); err != nil {
+		logger.WithError(err).Warn("Failed to store gateway alert")
+	}
+	logger.Info("Raise gateway alert")
+	gs.raiseAlert(ctx, ids, alertDisconnected, conn.Stats(), lastSeen(conn))
+}
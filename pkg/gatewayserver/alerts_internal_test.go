@@ -0,0 +1,311 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	iomock "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var timeout = (1 << 5) * test.Delay
+
+func TestAlertThresholds(t *testing.T) {
+	conf := AlertsConfig{
+		SilenceThreshold:    15 * time.Minute,
+		StatusInterval:      30 * time.Second,
+		MissedStatusCount:   3,
+		DisconnectThreshold: 5 * time.Minute,
+	}
+	for _, tc := range []struct {
+		Name           string
+		Attributes     map[string]string
+		Expected       alertThresholds
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Default",
+			Expected: alertThresholds{
+				Silence:       15 * time.Minute,
+				MissedStatus:  90 * time.Second,
+				Disconnection: 5 * time.Minute,
+			},
+		},
+		{
+			Name: "Attributes",
+			Attributes: map[string]string{
+				"gs-silence-threshold":    "1h",
+				"gs-status-interval":      "1m",
+				"gs-disconnect-threshold": "0s",
+			},
+			Expected: alertThresholds{
+				Silence:       time.Hour,
+				MissedStatus:  3 * time.Minute,
+				Disconnection: 0,
+			},
+		},
+		{
+			Name: "InvalidDuration",
+			Attributes: map[string]string{
+				"gs-silence-threshold": "soon",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NegativeDuration",
+			Attributes: map[string]string{
+				"gs-status-interval": "-1m",
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			thresholds, err := newAlertThresholds(conf, &ttnpb.Gateway{
+				Attributes: tc.Attributes,
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(thresholds, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+type mockGatewayAlertNotifier struct {
+	alerts chan *ttnpb.GatewayAlert
+}
+
+func (m *mockGatewayAlertNotifier) Notify(ctx context.Context, req *ttnpb.GatewayAlert) (*pbtypes.Empty, error) {
+	m.alerts <- req
+	return ttnpb.Empty, nil
+}
+
+func TestNotifyAlert(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	srv := rpcserver.New(ctx)
+	notifier := &mockGatewayAlertNotifier{
+		alerts: make(chan *ttnpb.GatewayAlert, 1),
+	}
+	ttnpb.RegisterGatewayAlertNotifierServer(srv.Server, notifier)
+	isPeer := &test.MockPeer{}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				AllowInsecureForCredentials: true,
+			},
+		},
+	}, component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+		return &test.MockCluster{
+			JoinFunc: test.ClusterJoinNilFunc,
+			GetPeerFunc: func(_ context.Context, role ttnpb.ClusterRole, _ ttnpb.Identifiers) (cluster.Peer, error) {
+				if role != ttnpb.ClusterRole_ENTITY_REGISTRY {
+					return nil, fmt.Errorf("no peer with role %s", role)
+				}
+				return isPeer, nil
+			},
+			AuthFunc: func() grpc.CallOption {
+				return grpc.EmptyCallOption{}
+			},
+		}, nil
+	}))
+	conn, err := rpcserver.StartLoopback(ctx, srv.Server, rpcclient.DefaultDialOptions(ctx)...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	isPeer.ConnFunc = func() (*grpc.ClientConn, error) { return conn, nil }
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	gs := &GatewayServer{
+		Component: c,
+		ctx:       c.Context(),
+		config:    &Config{},
+	}
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+	lastSeen := time.Date(2020, time.October, 1, 12, 0, 0, 0, time.UTC)
+	gs.resolveAlert(ctx, ids, alertDisconnected, &ttnpb.GatewayConnectionStats{}, lastSeen)
+
+	select {
+	case alert := <-notifier.alerts:
+		a.So(alert, should.Resemble, &ttnpb.GatewayAlert{
+			GatewayIdentifiers: ids,
+			Alert:              alertDisconnected,
+			Resolved:           true,
+			LastSeenAt:         &lastSeen,
+			ConnectionStats:    &ttnpb.GatewayConnectionStats{},
+		})
+	case <-time.After(timeout):
+		t.Fatal("Expected alert notification")
+	}
+}
+
+func TestWatchConnection(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	evCh := make(chan events.Event, 4)
+	defer test.RedirectEvents(evCh)()
+
+	const (
+		checkInterval       = time.Minute
+		silenceThreshold    = 2 * time.Minute
+		disconnectThreshold = 5 * time.Minute
+	)
+	c := componenttest.NewComponent(t, &component.Config{})
+	conf := &Config{
+		Alerts: AlertsConfig{
+			CheckInterval:       checkInterval,
+			SilenceThreshold:    silenceThreshold,
+			DisconnectThreshold: disconnectThreshold,
+		},
+	}
+	alertRegistry := &localAlertRegistry{}
+	gs := &GatewayServer{
+		Component:     c,
+		ctx:           c.Context(),
+		config:        conf,
+		alertRegistry: alertRegistry,
+	}
+	// otherGS is another Gateway Server in the cluster that shares the alert registry.
+	otherGS := &GatewayServer{
+		Component:     c,
+		ctx:           c.Context(),
+		config:        conf,
+		alertRegistry: alertRegistry,
+	}
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	connect := func() *io.Connection {
+		conn, err := io.NewConnection(ctx, &iomock.Frontend{}, &ttnpb.Gateway{
+			GatewayIdentifiers: ids,
+			FrequencyPlanID:    test.EUFrequencyPlanID,
+		}, fps, false, nil, false)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return conn
+	}
+
+	conn := connect()
+	clock := test.NewMockClock(conn.ConnectTime())
+	afterCh := make(chan time.Duration)
+	defer func(now func() time.Time, after func(time.Duration) <-chan time.Time) {
+		timeNow, timeAfter = now, after
+	}(timeNow, timeAfter)
+	timeNow = clock.Now
+	timeAfter = func(d time.Duration) <-chan time.Time {
+		ch := clock.After(d)
+		afterCh <- d
+		return ch
+	}
+
+	expectAfter := func(expected time.Duration) {
+		select {
+		case d := <-afterCh:
+			a.So(d, should.Equal, expected)
+		case <-time.After(timeout):
+			t.Fatalf("Expected timer of %v", expected)
+		}
+	}
+	expectEvent := func(name, alert string) {
+		select {
+		case ev := <-evCh:
+			a.So(ev.Name(), should.Equal, name)
+			a.So(ev.Identifiers(), should.Resemble, []*ttnpb.EntityIdentifiers{ids.EntityIdentifiers()})
+			if data, ok := ev.Data().(*ttnpb.GatewayAlert); a.So(ok, should.BeTrue) {
+				a.So(data.Alert, should.Equal, alert)
+			}
+		case <-time.After(timeout):
+			t.Fatalf("Expected event %q", name)
+		}
+	}
+	expectNoEvent := func() {
+		select {
+		case ev := <-evCh:
+			t.Fatalf("Expected no event, got %q", ev.Name())
+		default:
+		}
+	}
+
+	// The gateway is silent after the silence threshold, until it sends an uplink message.
+	go gs.watchConnection(connectionEntry{Connection: conn})
+	expectAfter(checkInterval)
+	clock.Add(checkInterval)
+	expectAfter(checkInterval)
+	expectNoEvent()
+	clock.Add(checkInterval)
+	expectEvent("gs.gateway.alert.silent", alertSilent)
+	expectAfter(checkInterval)
+	if err := conn.HandleUp(&ttnpb.UplinkMessage{ReceivedAt: clock.Now()}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	clock.Add(checkInterval)
+	expectEvent("gs.gateway.alert.resolved", alertSilent)
+	expectAfter(checkInterval)
+
+	// The silence alert is resolved when the silent gateway disconnects.
+	clock.Add(checkInterval)
+	expectEvent("gs.gateway.alert.silent", alertSilent)
+	expectAfter(checkInterval)
+
+	// The gateway is disconnected after the disconnect threshold, until it reconnects.
+	conn.Disconnect(context.Canceled)
+	expectEvent("gs.gateway.alert.resolved", alertSilent)
+	expectAfter(disconnectThreshold)
+	expectNoEvent()
+	clock.Add(disconnectThreshold)
+	expectEvent("gs.gateway.alert.disconnected", alertDisconnected)
+
+	// The disconnect alert is resolved by the Gateway Server that the gateway reconnects to.
+	conn = connect()
+	go otherGS.watchConnection(connectionEntry{Connection: conn})
+	expectEvent("gs.gateway.alert.resolved", alertDisconnected)
+	expectAfter(checkInterval)
+
+	// The gateway reconnects within the disconnect threshold.
+	conn.Disconnect(context.Canceled)
+	expectAfter(disconnectThreshold)
+	otherGS.connections.Store(unique.ID(ctx, ids), connectionEntry{Connection: connect()})
+	clock.Add(disconnectThreshold)
+	time.Sleep(test.Delay)
+	expectNoEvent()
+}
@@ -15,9 +15,12 @@
 package gatewayserver
 
 import (
+	"sort"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -37,6 +40,57 @@ type BasicStationConfig struct {
 	ListenTLS               string `name:"listen-tls" description:"Address for the Basic Station frontend to listen on (with TLS)"`
 }
 
+// HistoryConfig defines the configuration of the gateway connection history.
+type HistoryConfig struct {
+	Registry      GatewayConnectionHistoryRegistry `name:"-"`
+	FlushInterval time.Duration                    `name:"flush-interval" description:"Interval in which the history of connected gateways is written"`
+	Retention     map[string]string                `name:"retention" description:"Retention of the history per resolution (resolution=retention). The history is disabled if empty"`
+}
+
+var errHistoryRetention = errors.DefineInvalidArgument("history_retention", "invalid history retention `{resolution}={retention}`")
+
+// Levels parses the configured retention and returns the history levels sorted by resolution.
+func (c HistoryConfig) Levels() ([]history.Level, error) {
+	levels := make([]history.Level, 0, len(c.Retention))
+	for resolution, retention := range c.Retention {
+		res, err := time.ParseDuration(resolution)
+		if err != nil {
+			return nil, errHistoryRetention.WithAttributes("resolution", resolution, "retention", retention).WithCause(err)
+		}
+		ret, err := time.ParseDuration(retention)
+		if err != nil {
+			return nil, errHistoryRetention.WithAttributes("resolution", resolution, "retention", retention).WithCause(err)
+		}
+		if res <= 0 || ret < res {
+			return nil, errHistoryRetention.WithAttributes("resolution", resolution, "retention", retention)
+		}
+		levels = append(levels, history.Level{
+			Resolution: res,
+			Retention:  ret,
+		})
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Resolution < levels[j].Resolution })
+	return levels, nil
+}
+
+// AlertsConfig defines the configuration of the gateway silence and disconnect alerts.
+// The thresholds can be overridden per gateway with the gs-silence-threshold, gs-status-interval and
+// gs-disconnect-threshold gateway attributes.
+type AlertsConfig struct {
+	Registry GatewayAlertRegistry `name:"-"`
+
+	CheckInterval       time.Duration `name:"check-interval" description:"Interval in which connected gateways are checked for silence (0 is disabled)"`
+	SilenceThreshold    time.Duration `name:"silence-threshold" description:"Time without uplink and status messages after which a connected gateway is considered silent (0 is disabled)"`
+	StatusInterval      time.Duration `name:"status-interval" description:"Expected interval of status messages of gateways (0 is disabled)"`
+	MissedStatusCount   int           `name:"missed-status-count" description:"Number of expected status messages a gateway can miss before an alert is raised"`
+	DisconnectThreshold time.Duration `name:"disconnect-threshold" description:"Time after which a disconnected gateway that did not reconnect raises an alert (0 is disabled)"`
+}
+
+// BeaconsConfig defines the configuration of the class B beacons.
+type BeaconsConfig struct {
+	Enable bool `name:"enable" description:"Transmit class B beacons on gateways that are synchronized with GPS"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways         bool          `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
@@ -45,12 +99,19 @@ type Config struct {
 	Stats                             GatewayConnectionStatsRegistry `name:"-"`
 	UpdateConnectionStatsDebounceTime time.Duration                  `name:"update-connection-stats-debounce-time" description:"Time before repeated refresh of the gateway connection stats"`
 
+	History HistoryConfig `name:"history" description:"Gateway connection history configuration"`
+
+	Alerts AlertsConfig `name:"alerts" description:"Gateway silence and disconnect alerts configuration"`
+
+	Beacons BeaconsConfig `name:"beacons" description:"Class B beacons configuration"`
+
 	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 
-	MQTT         config.MQTT        `name:"mqtt"`
-	MQTTV2       config.MQTT        `name:"mqtt-v2"`
-	UDP          UDPConfig          `name:"udp"`
-	BasicStation BasicStationConfig `name:"basic-station"`
+	MQTT           config.MQTT        `name:"mqtt"`
+	MQTTV2         config.MQTT        `name:"mqtt-v2"`
+	MQTTChirpStack config.MQTT        `name:"mqtt-chirpstack"`
+	UDP            UDPConfig          `name:"udp"`
+	BasicStation   BasicStationConfig `name:"basic-station"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
@@ -16,9 +16,11 @@ package gatewayserver_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -53,4 +55,31 @@ func TestConfig(t *testing.T) {
 		_, err := conf.ForwardDevAddrPrefixes()
 		a.So(err, should.NotBeNil)
 	}
+
+	{
+		conf := gatewayserver.HistoryConfig{
+			Retention: map[string]string{
+				"1h": "720h",
+				"5m": "48h",
+			},
+		}
+		levels, err := conf.Levels()
+		a.So(err, should.BeNil)
+		a.So(levels, should.Resemble, []history.Level{
+			{Resolution: 5 * time.Minute, Retention: 48 * time.Hour},
+			{Resolution: time.Hour, Retention: 720 * time.Hour},
+		})
+	}
+
+	for _, retention := range []map[string]string{
+		{"invalid": "48h"},
+		{"5m": "invalid"},
+		{"1h": "5m"},
+	} {
+		conf := gatewayserver.HistoryConfig{
+			Retention: retention,
+		}
+		_, err := conf.Levels()
+		a.So(err, should.NotBeNil)
+	}
 }
@@ -40,6 +40,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
@@ -79,6 +80,12 @@ type GatewayServer struct {
 
 	statsRegistry                     GatewayConnectionStatsRegistry
 	updateConnectionStatsDebounceTime time.Duration
+
+	historyRegistry      GatewayConnectionHistoryRegistry
+	historyFlushInterval time.Duration
+	historyLevels        []history.Level
+
+	alertRegistry GatewayAlertRegistry
 }
 
 func (gs *GatewayServer) getRegistry(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (ttnpb.GatewayRegistryClient, error) {
@@ -146,10 +153,25 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		upstreamHandlers:                  make(map[string]upstream.Handler),
 		statsRegistry:                     conf.Stats,
 		updateConnectionStatsDebounceTime: conf.UpdateConnectionStatsDebounceTime,
+		historyRegistry:                   conf.History.Registry,
+		historyFlushInterval:              conf.History.FlushInterval,
+		alertRegistry:                     conf.Alerts.Registry,
 	}
 	for _, opt := range opts {
 		opt(gs)
 	}
+	if gs.historyRegistry != nil {
+		if gs.historyFlushInterval <= 0 {
+			gs.historyFlushInterval = time.Minute
+		}
+		if gs.historyLevels, err = conf.History.Levels(); err != nil {
+			return nil, err
+		}
+	}
+
+	if gs.alertRegistry == nil {
+		gs.alertRegistry = &localAlertRegistry{}
+	}
 
 	// Setup forwarding table.
 	for name, prefix := range gs.forward {
@@ -220,6 +242,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			Format: mqtt.NewProtobufV2(gs.ctx),
 			Config: conf.MQTTV2,
 		},
+		{
+			Format: mqtt.NewChirpStack(gs.ctx),
+			Config: conf.MQTTChirpStack,
+		},
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
@@ -425,6 +451,7 @@ var errNewConnection = errors.DefineAborted("new_connection", "new connection fr
 type connectionEntry struct {
 	*io.Connection
 	upstreamDone chan struct{}
+	history      *history.Collector
 }
 
 // Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
@@ -459,6 +486,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		FieldMask: pbtypes.FieldMask{
 			Paths: []string{
 				"antennas",
+				"attributes",
 				"downlink_path_constraint",
 				"enforce_duty_cycle",
 				"frequency_plan_id",
@@ -495,7 +523,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 
 	ids = gtw.GatewayIdentifiers
 
-	conn, err := io.NewConnection(ctx, frontend, gtw, gs.FrequencyPlans, gtw.EnforceDutyCycle, gtw.ScheduleAnytimeDelay)
+	conn, err := io.NewConnection(ctx, frontend, gtw, gs.FrequencyPlans, gtw.EnforceDutyCycle, gtw.ScheduleAnytimeDelay, gs.config.Beacons.Enable)
 	if err != nil {
 		return nil, err
 	}
@@ -503,6 +531,9 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		Connection:   conn,
 		upstreamDone: make(chan struct{}),
 	}
+	if gs.historyRegistry != nil {
+		connEntry.history = history.NewCollector(conn.ConnectTime())
+	}
 	for existing, exists := gs.connections.LoadOrStore(uid, connEntry); exists; existing, exists = gs.connections.LoadOrStore(uid, connEntry) {
 		existingConnEntry := existing.(connectionEntry)
 		logger.Warn("Disconnect existing connection")
@@ -519,6 +550,12 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	if gs.statsRegistry != nil {
 		go gs.updateConnStats(connEntry)
 	}
+	if connEntry.history != nil {
+		go gs.updateConnHistory(connEntry)
+	}
+	if gs.config.Alerts.CheckInterval > 0 {
+		go gs.watchConnection(connEntry)
+	}
 	if gtw.UpdateLocationFromStatus {
 		go gs.handleLocationUpdates(connEntry)
 	}
@@ -692,10 +729,16 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 			}
 			val = msg
 			registerReceiveUplink(ctx, gtw, msg.UplinkMessage, protocol)
+			if conn.history != nil {
+				conn.history.HandleUplink(msg.UplinkMessage)
+			}
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
 			val = msg
 			registerReceiveStatus(ctx, gtw, msg, protocol)
+			if conn.history != nil {
+				conn.history.HandleStatus(msg)
+			}
 		case msg := <-conn.TxAck():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:tx_ack:%s", events.NewCorrelationID()))
 			msg.CorrelationIDs = append(msg.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
@@ -788,6 +831,54 @@ func (gs *GatewayServer) updateConnStats(conn connectionEntry) {
 	}
 }
 
+func (gs *GatewayServer) updateConnHistory(conn connectionEntry) {
+	var (
+		ctx    = conn.Context()
+		logger = log.FromContext(ctx)
+		ids    = conn.Gateway().GatewayIdentifiers
+	)
+	period := &ttnpb.GatewayConnectionHistory_ConnectionPeriod{
+		ConnectedAt: conn.ConnectTime(),
+		Protocol:    conn.Frontend().Protocol(),
+	}
+	if err := gs.historyRegistry.SetConnectionPeriod(ctx, ids, period); err != nil {
+		logger.WithError(err).Error("Failed to set connection period")
+	}
+	flush := func(ctx context.Context, now time.Time) {
+		downlinks, _, _ := conn.DownStats()
+		bucket := conn.history.Flush(now, downlinks, conn.Stats().SubBands)
+		if err := gs.historyRegistry.Add(ctx, ids, bucket); err != nil {
+			logger.WithError(err).Error("Failed to update connection history")
+		}
+	}
+
+	// The history is flushed at the bucket boundaries, so that the history of a flush is credited to the right bucket.
+	next := history.NextFlush(conn.ConnectTime(), gs.historyFlushInterval, gs.historyLevels)
+	timer := time.NewTimer(time.Until(next))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// The connection context is done, so the remaining history is written with the Gateway Server context.
+			ctx := gs.Context()
+			now := time.Now()
+			for ; next.Before(now); next = history.NextFlush(next, gs.historyFlushInterval, gs.historyLevels) {
+				flush(ctx, next)
+			}
+			flush(ctx, now)
+			period.DisconnectedAt = &now
+			if err := gs.historyRegistry.SetConnectionPeriod(ctx, ids, period); err != nil {
+				logger.WithError(err).Error("Failed to set connection period")
+			}
+			return
+		case <-timer.C:
+			flush(ctx, next)
+			next = history.NextFlush(next, gs.historyFlushInterval, gs.historyLevels)
+			timer.Reset(time.Until(next))
+		}
+	}
+}
+
 const (
 	allowedLocationDelta = 0.00001
 )
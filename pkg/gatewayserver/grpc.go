@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -48,3 +49,30 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	}
 	return val.(connectionEntry).Stats(), nil
 }
+
+var (
+	errHistoryNotEnabled = errors.DefineUnimplemented("connection_history_not_enabled", "gateway connection history is not enabled")
+	errHistoryTimeRange  = errors.DefineInvalidArgument("connection_history_time_range", "end of the history is before its start")
+)
+
+// GetGatewayConnectionHistory returns the traffic, status and connection history of a gateway.
+func (gs *GatewayServer) GetGatewayConnectionHistory(ctx context.Context, req *ttnpb.GetGatewayConnectionHistoryRequest) (*ttnpb.GatewayConnectionHistory, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if gs.historyRegistry == nil {
+		return nil, errHistoryNotEnabled.New()
+	}
+
+	var start, end time.Time
+	if req.Start != nil {
+		start = *req.Start
+	}
+	if req.End != nil {
+		end = *req.End
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, errHistoryTimeRange.New()
+	}
+	return gs.historyRegistry.Get(ctx, req.GatewayIdentifiers, start, end, req.Resolution)
+}
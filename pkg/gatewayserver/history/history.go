@@ -0,0 +1,207 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package history implements the aggregation of the traffic, status and connection history of gateways.
+package history
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Level is a resolution in which the gateway connection history is stored, with its retention.
+type Level struct {
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+// NextFlush returns the time after t at which the history must be flushed, given the flush interval.
+// The history is flushed at multiples of the flush interval and at the bucket boundaries of all levels, so that the
+// history of a flush is always within a single bucket of each level.
+func NextFlush(t time.Time, interval time.Duration, levels []Level) time.Time {
+	next := t.Truncate(interval).Add(interval)
+	for _, level := range levels {
+		if boundary := t.Truncate(level.Resolution).Add(level.Resolution); boundary.Before(next) {
+			next = boundary
+		}
+	}
+	return next
+}
+
+const (
+	rssiHistogramStart    = -140
+	rssiHistogramBinWidth = 5
+	rssiHistogramBins     = 28
+
+	snrHistogramStart    = -25
+	snrHistogramBinWidth = 2.5
+	snrHistogramBins     = 16
+)
+
+func newDistribution(start, binWidth float32, bins int) *ttnpb.GatewayConnectionHistory_Distribution {
+	return &ttnpb.GatewayConnectionHistory_Distribution{
+		HistogramStart:    start,
+		HistogramBinWidth: binWidth,
+		Histogram:         make([]uint64, bins),
+	}
+}
+
+func addValue(d *ttnpb.GatewayConnectionHistory_Distribution, v float32) {
+	if d.Count == 0 || v < d.Min {
+		d.Min = v
+	}
+	if d.Count == 0 || v > d.Max {
+		d.Max = v
+	}
+	d.Count++
+	d.Mean += (v - d.Mean) / float32(d.Count)
+	if len(d.Histogram) == 0 {
+		return
+	}
+	bin := int(math.Floor(float64((v - d.HistogramStart) / d.HistogramBinWidth)))
+	if bin < 0 {
+		bin = 0
+	} else if bin >= len(d.Histogram) {
+		bin = len(d.Histogram) - 1
+	}
+	d.Histogram[bin]++
+}
+
+// mergeDistribution merges src into dst and returns the result.
+// The histogram of src is only merged if it has the same bins as the histogram of dst.
+func mergeDistribution(dst, src *ttnpb.GatewayConnectionHistory_Distribution) *ttnpb.GatewayConnectionHistory_Distribution {
+	if src == nil || src.Count == 0 {
+		return dst
+	}
+	if dst == nil || dst.Count == 0 {
+		res := *src
+		res.Histogram = append([]uint64(nil), src.Histogram...)
+		return &res
+	}
+	if src.Min < dst.Min {
+		dst.Min = src.Min
+	}
+	if src.Max > dst.Max {
+		dst.Max = src.Max
+	}
+	count := dst.Count + src.Count
+	dst.Mean = float32((float64(dst.Mean)*float64(dst.Count) + float64(src.Mean)*float64(src.Count)) / float64(count))
+	dst.Count = count
+	if dst.HistogramStart == src.HistogramStart &&
+		dst.HistogramBinWidth == src.HistogramBinWidth &&
+		len(dst.Histogram) == len(src.Histogram) {
+		for i, n := range src.Histogram {
+			dst.Histogram[i] += n
+		}
+	}
+	return dst
+}
+
+// MergeBucket merges the bucket src into dst. The start of dst is retained.
+// Counts and connected durations are summed, distributions are combined and the peak sub band utilization is kept.
+func MergeBucket(dst, src *ttnpb.GatewayConnectionHistory_Bucket) {
+	dst.ConnectedDuration += src.ConnectedDuration
+	dst.UplinkCount += src.UplinkCount
+	dst.DownlinkCount += src.DownlinkCount
+	dst.StatusCount += src.StatusCount
+	dst.RSSI = mergeDistribution(dst.RSSI, src.RSSI)
+	dst.SNR = mergeDistribution(dst.SNR, src.SNR)
+outer:
+	for _, sb := range src.SubBands {
+		for _, existing := range dst.SubBands {
+			if existing.MinFrequency != sb.MinFrequency || existing.MaxFrequency != sb.MaxFrequency {
+				continue
+			}
+			existing.DownlinkUtilizationLimit = sb.DownlinkUtilizationLimit
+			if sb.DownlinkUtilization > existing.DownlinkUtilization {
+				existing.DownlinkUtilization = sb.DownlinkUtilization
+			}
+			continue outer
+		}
+		sb := *sb
+		dst.SubBands = append(dst.SubBands, &sb)
+	}
+}
+
+// Collector collects the history of a gateway connection.
+// The collected history is taken as bucket with Flush.
+type Collector struct {
+	mu            sync.Mutex
+	bucket        *ttnpb.GatewayConnectionHistory_Bucket
+	lastFlush     time.Time
+	lastDownlinks uint64
+}
+
+// NewCollector returns a new Collector for a gateway connection that connected at the given time.
+func NewCollector(connectedAt time.Time) *Collector {
+	return &Collector{
+		bucket:    newBucket(connectedAt),
+		lastFlush: connectedAt,
+	}
+}
+
+func newBucket(start time.Time) *ttnpb.GatewayConnectionHistory_Bucket {
+	return &ttnpb.GatewayConnectionHistory_Bucket{
+		Start: start,
+		RSSI:  newDistribution(rssiHistogramStart, rssiHistogramBinWidth, rssiHistogramBins),
+		SNR:   newDistribution(snrHistogramStart, snrHistogramBinWidth, snrHistogramBins),
+	}
+}
+
+// HandleUplink collects the uplink message.
+// The RSSI and SNR are taken from the antenna with the best RSSI.
+func (c *Collector) HandleUplink(up *ttnpb.UplinkMessage) {
+	var best *ttnpb.RxMetadata
+	for _, md := range up.RxMetadata {
+		if best == nil || md.RSSI > best.RSSI {
+			best = md
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bucket.UplinkCount++
+	if best == nil {
+		return
+	}
+	addValue(c.bucket.RSSI, best.RSSI)
+	if _, ok := up.Settings.DataRate.Modulation.(*ttnpb.DataRate_LoRa); ok {
+		addValue(c.bucket.SNR, best.SNR)
+	}
+}
+
+// HandleStatus collects the gateway status message.
+func (c *Collector) HandleStatus(*ttnpb.GatewayStatus) {
+	c.mu.Lock()
+	c.bucket.StatusCount++
+	c.mu.Unlock()
+}
+
+// Flush returns the history collected since the previous flush, or since the connection if this is the first flush.
+// The downlink count is the total number of downlink messages of the connection and the sub bands are the current
+// sub band utilization statistics.
+func (c *Collector) Flush(now time.Time, downlinkCount uint64, subBands []*ttnpb.GatewayConnectionStats_SubBand) *ttnpb.GatewayConnectionHistory_Bucket {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bucket := c.bucket
+	bucket.ConnectedDuration = now.Sub(c.lastFlush)
+	bucket.DownlinkCount = downlinkCount - c.lastDownlinks
+	bucket.SubBands = subBands
+	c.bucket = newBucket(now)
+	c.lastFlush = now
+	c.lastDownlinks = downlinkCount
+	return bucket
+}
@@ -0,0 +1,170 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCollector(t *testing.T) {
+	a := assertions.New(t)
+
+	connectedAt := time.Unix(1600000000, 0)
+	c := history.NewCollector(connectedAt)
+
+	for _, md := range [][]*ttnpb.RxMetadata{
+		{{RSSI: -100, SNR: 5}, {RSSI: -80, SNR: 7.5}},
+		{{RSSI: -120, SNR: -10}},
+		nil,
+	} {
+		c.HandleUplink(&ttnpb.UplinkMessage{
+			RxMetadata: md,
+			Settings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{Bandwidth: 125000, SpreadingFactor: 7}},
+				},
+			},
+		})
+	}
+	c.HandleStatus(&ttnpb.GatewayStatus{})
+
+	subBands := []*ttnpb.GatewayConnectionStats_SubBand{
+		{MinFrequency: 868000000, MaxFrequency: 868600000, DownlinkUtilizationLimit: 0.01, DownlinkUtilization: 0.002},
+	}
+	bucket := c.Flush(connectedAt.Add(time.Minute), 3, subBands)
+	a.So(bucket.Start, should.Equal, connectedAt)
+	a.So(bucket.ConnectedDuration, should.Equal, time.Minute)
+	a.So(bucket.UplinkCount, should.Equal, 3)
+	a.So(bucket.DownlinkCount, should.Equal, 3)
+	a.So(bucket.StatusCount, should.Equal, 1)
+	a.So(bucket.SubBands, should.Resemble, subBands)
+	a.So(bucket.RSSI.Count, should.Equal, 2)
+	a.So(bucket.RSSI.Min, should.Equal, -120)
+	a.So(bucket.RSSI.Max, should.Equal, -80)
+	a.So(bucket.RSSI.Mean, should.Equal, -100)
+	a.So(bucket.RSSI.Histogram[4], should.Equal, 1)
+	a.So(bucket.RSSI.Histogram[12], should.Equal, 1)
+	a.So(bucket.SNR.Count, should.Equal, 2)
+	a.So(bucket.SNR.Min, should.Equal, -10)
+	a.So(bucket.SNR.Max, should.Equal, 7.5)
+
+	bucket = c.Flush(connectedAt.Add(90*time.Second), 5, nil)
+	a.So(bucket.Start, should.Equal, connectedAt.Add(time.Minute))
+	a.So(bucket.ConnectedDuration, should.Equal, 30*time.Second)
+	a.So(bucket.UplinkCount, should.Equal, 0)
+	a.So(bucket.DownlinkCount, should.Equal, 2)
+	a.So(bucket.StatusCount, should.Equal, 0)
+	a.So(bucket.RSSI.Count, should.Equal, 0)
+}
+
+func TestMergeBucket(t *testing.T) {
+	a := assertions.New(t)
+
+	start := time.Unix(1600000000, 0)
+	c1 := history.NewCollector(start)
+	c1.HandleUplink(&ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{{RSSI: -90}},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}},
+		},
+	})
+	dst := c1.Flush(start.Add(time.Minute), 1, []*ttnpb.GatewayConnectionStats_SubBand{
+		{MinFrequency: 868000000, MaxFrequency: 868600000, DownlinkUtilization: 0.004},
+	})
+
+	c2 := history.NewCollector(start.Add(time.Minute))
+	c2.HandleUplink(&ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{{RSSI: -110}},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}},
+		},
+	})
+	c2.HandleStatus(&ttnpb.GatewayStatus{})
+	src := c2.Flush(start.Add(2*time.Minute), 2, []*ttnpb.GatewayConnectionStats_SubBand{
+		{MinFrequency: 868000000, MaxFrequency: 868600000, DownlinkUtilization: 0.001},
+		{MinFrequency: 869400000, MaxFrequency: 869650000, DownlinkUtilization: 0.05},
+	})
+
+	history.MergeBucket(dst, src)
+	a.So(dst.Start, should.Equal, start)
+	a.So(dst.ConnectedDuration, should.Equal, 2*time.Minute)
+	a.So(dst.UplinkCount, should.Equal, 2)
+	a.So(dst.DownlinkCount, should.Equal, 3)
+	a.So(dst.StatusCount, should.Equal, 1)
+	a.So(dst.RSSI.Count, should.Equal, 2)
+	a.So(dst.RSSI.Min, should.Equal, -110)
+	a.So(dst.RSSI.Max, should.Equal, -90)
+	a.So(dst.RSSI.Mean, should.Equal, -100)
+	a.So(dst.SNR.Count, should.Equal, 0)
+	if a.So(dst.SubBands, should.HaveLength, 2) {
+		a.So(dst.SubBands[0].DownlinkUtilization, should.Equal, 0.004)
+		a.So(dst.SubBands[1].DownlinkUtilization, should.Equal, 0.05)
+	}
+}
+
+func TestNextFlush(t *testing.T) {
+	levels := []history.Level{
+		{Resolution: 5 * time.Minute},
+		{Resolution: 7 * time.Minute},
+	}
+	start := time.Unix(1600000200, 0).Truncate(10 * time.Minute)
+	sevenMinuteBoundary := start.Truncate(7 * time.Minute).Add(7 * time.Minute)
+	for _, tc := range []struct {
+		Name     string
+		Time     time.Time
+		Interval time.Duration
+		Expected time.Time
+	}{
+		{
+			Name:     "Interval",
+			Time:     start.Add(30 * time.Second),
+			Interval: time.Minute,
+			Expected: start.Add(time.Minute),
+		},
+		{
+			Name:     "IntervalBoundary",
+			Time:     start.Add(time.Minute),
+			Interval: time.Minute,
+			Expected: start.Add(2 * time.Minute),
+		},
+		{
+			Name:     "LevelBoundary",
+			Time:     start.Add(4 * time.Minute),
+			Interval: 2 * time.Minute,
+			Expected: start.Add(5 * time.Minute),
+		},
+		{
+			Name:     "UnalignedLevelBoundary",
+			Time:     sevenMinuteBoundary.Add(-time.Second),
+			Interval: 10 * time.Minute,
+			Expected: sevenMinuteBoundary,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			next := history.NextFlush(tc.Time, tc.Interval, levels)
+			a.So(next, should.Equal, tc.Expected)
+			for _, level := range levels {
+				a.So(next.Add(-time.Nanosecond).Truncate(level.Resolution), should.Equal, tc.Time.Truncate(level.Resolution))
+			}
+		})
+	}
+}
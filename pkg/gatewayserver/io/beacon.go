@@ -0,0 +1,216 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// BeaconPeriod is the period of class B beacons.
+	BeaconPeriod = 128 * time.Second
+	// BeaconPreambleLength is the number of preamble symbols of class B beacons.
+	BeaconPreambleLength = 10
+
+	// beaconDelay is the delay of the beacon transmission after the start of the beacon period.
+	beaconDelay = 1500 * time.Microsecond
+	// beaconScheduleAhead is the time before the beacon transmission in which the beacon gets scheduled.
+	beaconScheduleAhead = 5 * time.Second
+	// gpsSyncTTL is the time after the last uplink message with GPS time in which the gateway is considered
+	// synchronized with GPS.
+	gpsSyncTTL = 30 * time.Minute
+
+	beaconCorrelationIDPrefix = "gs:beacon:"
+)
+
+var errNoBeacon = errors.DefineFailedPrecondition("no_beacon", "no beacon defined in band `{band_id}`")
+
+// NextBeaconTime returns the start of the first beacon period after t as time.Duration since GPS epoch.
+func NextBeaconTime(t time.Time) time.Duration {
+	return gpstime.ToGPS(t)/BeaconPeriod*BeaconPeriod + BeaconPeriod
+}
+
+// IsBeacon returns whether the downlink message is a class B beacon scheduled by the Gateway Server.
+func IsBeacon(msg *ttnpb.DownlinkMessage) bool {
+	for _, id := range msg.GetCorrelationIDs() {
+		if strings.HasPrefix(id, beaconCorrelationIDPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// crc16 computes the CRC-16/CCITT checksum with polynomial 0x1021 and initial value 0 that is used in beacon frames.
+func crc16(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// putInt24 encodes v as 24-bit little endian two's complement integer.
+func putInt24(b []byte, v int32) {
+	b[0], b[1], b[2] = byte(v), byte(v>>8), byte(v>>16)
+}
+
+// beaconFrame returns the beacon frame of the beacon period that starts at the given time since GPS epoch.
+// If the location is known, the GwSpecific field contains the GPS coordinates of the first antenna of the gateway.
+func beaconFrame(layout band.BeaconLayout, beaconTime time.Duration, location *ttnpb.Location) []byte {
+	b := make([]byte, layout.Length)
+	binary.LittleEndian.PutUint32(b[layout.TimeOffset:], uint32(beaconTime/time.Second))
+	binary.LittleEndian.PutUint16(b[layout.TimeOffset+4:], crc16(b[:layout.TimeOffset+4]))
+	if location != nil {
+		// InfoDesc 0 indicates the GPS coordinates of the first antenna of the gateway.
+		b[layout.InfoDescOffset] = 0
+		lat := math.Min(math.Round(location.Latitude/90*(1<<23)), 1<<23-1)
+		lng := math.Min(math.Round(location.Longitude/180*(1<<23)), 1<<23-1)
+		putInt24(b[layout.InfoDescOffset+1:], int32(lat))
+		putInt24(b[layout.InfoDescOffset+4:], int32(lng))
+	}
+	binary.LittleEndian.PutUint16(b[layout.Length-2:], crc16(b[layout.InfoDescOffset:layout.Length-2]))
+	return b
+}
+
+// BeaconsEnabled returns whether class B beacons are transmitted when the gateway is synchronized with GPS.
+func (c *Connection) BeaconsEnabled() bool { return c.beacons }
+
+// IsGPSSynced returns whether the gateway recently reported GPS time and the clock is synchronized with the gateway
+// time.
+func (c *Connection) IsGPSSynced() bool {
+	lastGPSTime := atomic.LoadInt64(&c.lastGPSTime)
+	if lastGPSTime == 0 || time.Since(time.Unix(0, lastGPSTime)) > gpsSyncTTL {
+		return false
+	}
+	return c.scheduler.IsGatewayTimeSynced()
+}
+
+// ScheduleBeacon schedules and sends the class B beacon of the beacon period that starts at the given time since
+// GPS epoch.
+func (c *Connection) ScheduleBeacon(beaconTime time.Duration) error {
+	if c.gateway.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+		return errNotAllowed.New()
+	}
+	phy, err := band.GetByID(c.bandID)
+	if err != nil {
+		return err
+	}
+	layout, ok := phy.BeaconLayout()
+	if !ok || phy.Beacon.ComputeFrequency == nil {
+		return errNoBeacon.WithAttributes("band_id", c.bandID)
+	}
+	var location *ttnpb.Location
+	if c.gateway.LocationPublic && len(c.gateway.Antennas) > 0 && c.gateway.Antennas[0].Location.Source != ttnpb.SOURCE_UNKNOWN {
+		location = &c.gateway.Antennas[0].Location
+	}
+	payload := beaconFrame(layout, beaconTime, location)
+
+	t := gpstime.Parse(beaconTime + beaconDelay)
+	starts, ok := c.scheduler.TimeFromGatewayTime(t)
+	if !ok {
+		return errNoGPSSync.New()
+	}
+	frequency := phy.Beacon.ComputeFrequency(float64(beaconTime / time.Second))
+	settings := ttnpb.TxSettings{
+		DataRate:      phy.DataRates[phy.Beacon.DataRateIndex].Rate,
+		DataRateIndex: phy.Beacon.DataRateIndex,
+		CodingRate:    phy.Beacon.CodingRate,
+		Frequency:     frequency,
+		Timestamp:     uint32(time.Duration(starts) / time.Microsecond),
+		Downlink: &ttnpb.TxSettings_Downlink{
+			TxPower:            c.txPower(phy, c.gatewayFPs[c.gateway.FrequencyPlanID], frequency, 0),
+			InvertPolarization: phy.Beacon.InvertedPolarity,
+		},
+	}
+	em, err := c.scheduler.ScheduleAt(c.ctx, scheduling.Options{
+		PayloadSize: len(payload),
+		TxSettings:  settings,
+		RTTs:        c.rtts,
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if err != nil {
+		return err
+	}
+	// The beacon is transmitted at the absolute GPS time, as the concentrator timestamp is not precise enough.
+	settings.Timestamp = 0
+	settings.Time = &t
+	msg := &ttnpb.DownlinkMessage{
+		RawPayload: payload,
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &settings,
+		},
+		CorrelationIDs: []string{fmt.Sprintf("%s%s", beaconCorrelationIDPrefix, events.NewCorrelationID())},
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.downCh <- msg:
+	default:
+		return errBufferFull.New()
+	}
+	log.FromContext(c.ctx).WithFields(log.Fields(
+		"beacon_time", beaconTime,
+		"frequency", frequency,
+		"starts", em.Starts(),
+	)).Debug("Scheduled beacon")
+	return nil
+}
+
+// ScheduleBeacons schedules the class B beacons of the gateway until the given context or the connection context is
+// done. Beacons are only scheduled while the gateway is synchronized with GPS.
+// Frontends of which the gateways do not transmit beacons themselves call this method when beacons are enabled.
+func (c *Connection) ScheduleBeacons(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		beaconTime := NextBeaconTime(time.Now().Add(beaconScheduleAhead))
+		timer := time.NewTimer(time.Until(gpstime.Parse(beaconTime).Add(-beaconScheduleAhead)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-c.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if !c.IsGPSSynced() {
+			continue
+		}
+		if err := c.ScheduleBeacon(beaconTime); err != nil {
+			logger.WithError(err).Warn("Failed to schedule beacon")
+		}
+	}
+}
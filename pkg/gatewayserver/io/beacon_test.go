@@ -0,0 +1,77 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCRC16(t *testing.T) {
+	a := assertions.New(t)
+	a.So(crc16([]byte("123456789")), should.Equal, 0x31C3)
+	a.So(crc16(nil), should.Equal, 0)
+}
+
+func TestNextBeaconTime(t *testing.T) {
+	a := assertions.New(t)
+
+	start := gpstime.Parse(1000 * BeaconPeriod)
+	a.So(NextBeaconTime(start), should.Equal, 1001*BeaconPeriod)
+	a.So(NextBeaconTime(start.Add(-time.Nanosecond)), should.Equal, 1000*BeaconPeriod)
+	a.So(NextBeaconTime(start.Add(BeaconPeriod/2)), should.Equal, 1001*BeaconPeriod)
+}
+
+func TestIsBeacon(t *testing.T) {
+	a := assertions.New(t)
+	a.So(IsBeacon(&ttnpb.DownlinkMessage{CorrelationIDs: []string{"gs:beacon:test"}}), should.BeTrue)
+	a.So(IsBeacon(&ttnpb.DownlinkMessage{CorrelationIDs: []string{"ns:downlink:test"}}), should.BeFalse)
+	a.So(IsBeacon(&ttnpb.DownlinkMessage{}), should.BeFalse)
+}
+
+func TestBeaconFrame(t *testing.T) {
+	a := assertions.New(t)
+
+	phy, err := band.GetByID(band.EU_863_870)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	layout, ok := phy.BeaconLayout()
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+
+	beaconTime := 1000 * BeaconPeriod
+	frame := beaconFrame(layout, beaconTime, &ttnpb.Location{
+		Latitude:  45,
+		Longitude: -90,
+	})
+	if !a.So(frame, should.HaveLength, layout.Length) {
+		t.FailNow()
+	}
+	a.So(binary.LittleEndian.Uint32(frame[layout.TimeOffset:]), should.Equal, uint32(beaconTime/time.Second))
+	a.So(binary.LittleEndian.Uint16(frame[layout.TimeOffset+4:]), should.Equal, crc16(frame[:layout.TimeOffset+4]))
+	a.So(frame[layout.InfoDescOffset], should.Equal, 0)
+	a.So(frame[layout.InfoDescOffset+1:layout.InfoDescOffset+4], should.Resemble, []byte{0x00, 0x00, 0x40})
+	a.So(frame[layout.InfoDescOffset+4:layout.InfoDescOffset+7], should.Resemble, []byte{0x00, 0x00, 0xC0})
+	a.So(binary.LittleEndian.Uint16(frame[layout.Length-2:]), should.Equal, crc16(frame[layout.InfoDescOffset:layout.Length-2]))
+}
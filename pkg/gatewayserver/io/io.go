@@ -72,7 +72,8 @@ type Connection struct {
 	connectTime,
 	lastStatusTime,
 	lastUplinkTime,
-	lastDownlinkTime int64
+	lastDownlinkTime,
+	lastGPSTime int64
 	lastStatus atomic.Value
 
 	ctx       context.Context
@@ -85,6 +86,7 @@ type Connection struct {
 	fps        *frequencyplans.Store
 	scheduler  *scheduling.Scheduler
 	rtts       *rtts
+	beacons    bool
 
 	upCh     chan *ttnpb.GatewayUplinkMessage
 	downCh   chan *ttnpb.DownlinkMessage
@@ -107,7 +109,8 @@ var (
 )
 
 // NewConnection instantiates a new gateway connection.
-func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gateway, fps *frequencyplans.Store, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration) (*Connection, error) {
+// If beacons is true, class B beacons are transmitted when the gateway is synchronized with GPS.
+func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gateway, fps *frequencyplans.Store, enforceDutyCycle bool, scheduleAnytimeDelay *time.Duration, beacons bool) (*Connection, error) {
 	gatewayFPs := make(map[string]*frequencyplans.FrequencyPlan, len(gateway.FrequencyPlanIDs))
 	fp0ID := gateway.FrequencyPlanID
 	fp0, err := fps.GetByID(fp0ID)
@@ -149,6 +152,7 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		fps:         fps,
 		scheduler:   scheduler,
 		rtts:        newRTTs(maxRTTs, rttTTL),
+		beacons:     beacons,
 		upCh:        make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
 		downCh:      make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:    make(chan *ttnpb.GatewayStatus, bufferSize),
@@ -195,6 +199,9 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	}
 
 	for _, md := range up.RxMetadata {
+		if md.GPSTime != nil {
+			atomic.StoreInt64(&c.lastGPSTime, up.ReceivedAt.UnixNano())
+		}
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
 			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
@@ -317,6 +324,25 @@ func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (ttnpb.Gateway
 	return *fixed, nil, nil
 }
 
+// txPower returns the transmission power on the given frequency and antenna, taking the maximum EIRP of the band and
+// the frequency plan and the gain of the antenna into account.
+func (c *Connection) txPower(phy band.Band, fp *frequencyplans.FrequencyPlan, frequency uint64, antennaIndex uint32) float32 {
+	eirp := phy.DefaultMaxEIRP
+	if sb, ok := phy.FindSubBand(frequency); ok {
+		eirp = sb.MaxEIRP
+	}
+	if fp.MaxEIRP != nil {
+		eirp = *fp.MaxEIRP
+	}
+	if sb, ok := fp.FindSubBand(frequency); ok && sb.MaxEIRP != nil {
+		eirp = *sb.MaxEIRP
+	}
+	if int(antennaIndex) < len(c.gateway.Antennas) {
+		eirp -= c.gateway.Antennas[antennaIndex].Gain
+	}
+	return eirp
+}
+
 // SendDown sends the downlink message directly on the downlink channel.
 func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	select {
@@ -425,27 +451,14 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 				"data_rate_index", rx.dataRateIndex,
 			)
 		}
-		eirp := phy.DefaultMaxEIRP
-		if sb, ok := phy.FindSubBand(rx.frequency); ok {
-			eirp = sb.MaxEIRP
-		}
-		if fp.MaxEIRP != nil {
-			eirp = *fp.MaxEIRP
-		}
-		if sb, ok := fp.FindSubBand(rx.frequency); ok && sb.MaxEIRP != nil {
-			eirp = *sb.MaxEIRP
-		}
 		settings := ttnpb.TxSettings{
 			DataRateIndex: rx.dataRateIndex,
 			Frequency:     rx.frequency,
 			Downlink: &ttnpb.TxSettings_Downlink{
-				TxPower:      eirp,
+				TxPower:      c.txPower(phy, fp, rx.frequency, ids.AntennaIndex),
 				AntennaIndex: ids.AntennaIndex,
 			},
 		}
-		if int(ids.AntennaIndex) < len(c.gateway.Antennas) {
-			settings.Downlink.TxPower -= c.gateway.Antennas[ids.AntennaIndex].Gain
-		}
 		settings.DataRate = dr.Rate
 		if lora := dr.Rate.GetLoRa(); lora != nil {
 			settings.CodingRate = phy.LoRaCodingRate
@@ -631,10 +644,10 @@ func (c *Connection) FrequencyPlans() map[string]*frequencyplans.FrequencyPlan {
 // TODO: Handle mixed bands (https://github.com/TheThingsNetwork/lorawan-stack/issues/1394)
 func (c *Connection) BandID() string { return c.bandID }
 
-// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time and the
-// relative gateway time that corresponds to the given timestamp.
-func (c *Connection) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, concentrator scheduling.ConcentratorTime) {
-	c.scheduler.SyncWithGatewayConcentrator(timestamp, server, concentrator)
+// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time, the
+// relative gateway time and, if available, the absolute gateway time that correspond to the given timestamp.
+func (c *Connection) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, gateway *time.Time, concentrator scheduling.ConcentratorTime) {
+	c.scheduler.SyncWithGatewayConcentrator(timestamp, server, gateway, concentrator)
 }
 
 // TimeFromTimestampTime returns the concentrator time by the given timestamp.
@@ -643,6 +656,12 @@ func (c *Connection) TimeFromTimestampTime(timestamp uint32) (scheduling.Concent
 	return c.scheduler.TimeFromTimestampTime(timestamp)
 }
 
+// TimeFromGatewayTime returns the concentrator time by the given absolute gateway time.
+// This method returns false if the clock is not synced with the gateway time.
+func (c *Connection) TimeFromGatewayTime(t time.Time) (scheduling.ConcentratorTime, bool) {
+	return c.scheduler.TimeFromGatewayTime(t)
+}
+
 func (c *Connection) notifyStatsChanged() {
 	select {
 	case c.statsChangedCh <- struct{}{}:
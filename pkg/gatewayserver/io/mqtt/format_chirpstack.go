@@ -0,0 +1,355 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/common"
+	"github.com/brocaar/chirpstack-api/go/v3/gw"
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	chirpStackSourceToV3 = map[common.LocationSource]ttnpb.LocationSource{
+		common.LocationSource_GPS:               ttnpb.SOURCE_GPS,
+		common.LocationSource_CONFIG:            ttnpb.SOURCE_REGISTRY,
+		common.LocationSource_GEO_RESOLVER_TDOA: ttnpb.SOURCE_LORA_TDOA_GEOLOCATION,
+		common.LocationSource_GEO_RESOLVER_RSSI: ttnpb.SOURCE_LORA_RSSI_GEOLOCATION,
+		common.LocationSource_GEO_RESOLVER_WIFI: ttnpb.SOURCE_WIFI_RSSI_GEOLOCATION,
+	}
+
+	chirpStackTxAckStatusToV3 = map[gw.TxAckStatus]ttnpb.TxAcknowledgment_Result{
+		gw.TxAckStatus_OK:               ttnpb.TxAcknowledgment_SUCCESS,
+		gw.TxAckStatus_TOO_LATE:         ttnpb.TxAcknowledgment_TOO_LATE,
+		gw.TxAckStatus_TOO_EARLY:        ttnpb.TxAcknowledgment_TOO_EARLY,
+		gw.TxAckStatus_COLLISION_PACKET: ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		gw.TxAckStatus_COLLISION_BEACON: ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		gw.TxAckStatus_TX_FREQ:          ttnpb.TxAcknowledgment_TX_FREQ,
+		gw.TxAckStatus_TX_POWER:         ttnpb.TxAcknowledgment_TX_POWER,
+		gw.TxAckStatus_GPS_UNLOCKED:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+	}
+
+	// chirpStackTxAckErrorToV3 maps the errors of ChirpStack Gateway Bridge versions before 3.9.
+	chirpStackTxAckErrorToV3 = map[string]ttnpb.TxAcknowledgment_Result{
+		"":                 ttnpb.TxAcknowledgment_SUCCESS,
+		"TOO_LATE":         ttnpb.TxAcknowledgment_TOO_LATE,
+		"TOO_EARLY":        ttnpb.TxAcknowledgment_TOO_EARLY,
+		"COLLISION_PACKET": ttnpb.TxAcknowledgment_COLLISION_PACKET,
+		"COLLISION_BEACON": ttnpb.TxAcknowledgment_COLLISION_BEACON,
+		"TX_FREQ":          ttnpb.TxAcknowledgment_TX_FREQ,
+		"TX_POWER":         ttnpb.TxAcknowledgment_TX_POWER,
+		"GPS_UNLOCKED":     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+	}
+
+	errBadCRC = errors.DefineInvalidArgument("bad_crc", "bad CRC")
+)
+
+// chirpStackDownlinkIDLength is the length of downlink IDs. The downlink token is encoded in the last two bytes.
+const chirpStackDownlinkIDLength = 16
+
+type chirpStack struct {
+	topics.Layout
+	tokens sync.Map // Gateway ID to *io.DownlinkTokens.
+}
+
+// topicsByEUI implements euiTopicsFormat.
+func (*chirpStack) topicsByEUI() {}
+
+func (f *chirpStack) downlinkTokens(ids ttnpb.GatewayIdentifiers) *io.DownlinkTokens {
+	tokens, _ := f.tokens.LoadOrStore(ids.GatewayID, &io.DownlinkTokens{})
+	return tokens.(*io.DownlinkTokens)
+}
+
+// chirpStackContext returns the context that carries the concentrator timestamp.
+func chirpStackContext(timestamp uint32) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, timestamp)
+	return buf
+}
+
+func (f *chirpStack) FromDownlink(down *ttnpb.DownlinkMessage, ids ttnpb.GatewayIdentifiers) ([]byte, error) {
+	settings := down.GetScheduled()
+	if settings == nil {
+		return nil, errNotScheduled.New()
+	}
+	var gatewayID []byte
+	if ids.EUI != nil {
+		gatewayID = ids.EUI[:]
+	}
+	txInfo := &gw.DownlinkTXInfo{
+		GatewayId: gatewayID,
+		Frequency: uint32(settings.Frequency),
+	}
+	if settings.Downlink != nil {
+		txInfo.Power = int32(settings.Downlink.TxPower - eirpDelta)
+		txInfo.Antenna = settings.Downlink.AntennaIndex
+	}
+	switch dr := settings.DataRate.Modulation.(type) {
+	case *ttnpb.DataRate_LoRa:
+		txInfo.Modulation = common.Modulation_LORA
+		txInfo.ModulationInfo = &gw.DownlinkTXInfo_LoraModulationInfo{
+			LoraModulationInfo: &gw.LoRaModulationInfo{
+				Bandwidth:             dr.LoRa.Bandwidth / 1000,
+				SpreadingFactor:       dr.LoRa.SpreadingFactor,
+				CodeRate:              settings.CodingRate,
+				PolarizationInversion: settings.GetDownlink().GetInvertPolarization(),
+			},
+		}
+	case *ttnpb.DataRate_FSK:
+		txInfo.Modulation = common.Modulation_FSK
+		txInfo.ModulationInfo = &gw.DownlinkTXInfo_FskModulationInfo{
+			FskModulationInfo: &gw.FSKModulationInfo{
+				FrequencyDeviation: dr.FSK.BitRate / 2,
+				Datarate:           dr.FSK.BitRate,
+			},
+		}
+	default:
+		return nil, errModulation.New()
+	}
+	switch {
+	case settings.Time != nil:
+		txInfo.Timing = gw.DownlinkTiming_GPS_EPOCH
+		txInfo.TimingInfo = &gw.DownlinkTXInfo_GpsEpochTimingInfo{
+			GpsEpochTimingInfo: &gw.GPSEpochTimingInfo{
+				TimeSinceGpsEpoch: ptypes.DurationProto(gpstime.ToGPS(*settings.Time)),
+			},
+		}
+	case settings.Timestamp != 0:
+		// The transmission is delayed relative to the concentrator timestamp in the context.
+		txInfo.Timing = gw.DownlinkTiming_DELAY
+		txInfo.TimingInfo = &gw.DownlinkTXInfo_DelayTimingInfo{
+			DelayTimingInfo: &gw.DelayTimingInfo{
+				Delay: ptypes.DurationProto(0),
+			},
+		}
+		txInfo.Context = chirpStackContext(settings.Timestamp)
+	default:
+		txInfo.Timing = gw.DownlinkTiming_IMMEDIATELY
+		txInfo.TimingInfo = &gw.DownlinkTXInfo_ImmediatelyTimingInfo{
+			ImmediatelyTimingInfo: &gw.ImmediatelyTimingInfo{},
+		}
+	}
+
+	token := f.downlinkTokens(ids).Next(down.CorrelationIDs, time.Now())
+	downlinkID := make([]byte, chirpStackDownlinkIDLength)
+	binary.BigEndian.PutUint16(downlinkID[chirpStackDownlinkIDLength-2:], token)
+	return proto.Marshal(&gw.DownlinkFrame{
+		Token:      uint32(token),
+		DownlinkId: downlinkID,
+		GatewayId:  gatewayID,
+		Items: []*gw.DownlinkFrameItem{
+			{
+				PhyPayload: down.RawPayload,
+				TxInfo:     txInfo,
+			},
+		},
+	})
+}
+
+func (f *chirpStack) ToUplink(message []byte, ids ttnpb.GatewayIdentifiers) (*ttnpb.UplinkMessage, error) {
+	frame := &gw.UplinkFrame{}
+	if err := proto.Unmarshal(message, frame); err != nil {
+		return nil, err
+	}
+	txInfo, rxInfo := frame.TxInfo, frame.RxInfo
+	if txInfo == nil || rxInfo == nil {
+		return nil, errLoRaWANMetadata.New()
+	}
+	if rxInfo.CrcStatus == gw.CRCStatus_BAD_CRC {
+		return nil, errBadCRC.New()
+	}
+
+	settings := ttnpb.TxSettings{
+		Frequency: uint64(txInfo.Frequency),
+	}
+	if len(rxInfo.Context) == 4 {
+		settings.Timestamp = binary.BigEndian.Uint32(rxInfo.Context)
+	}
+	switch txInfo.Modulation {
+	case common.Modulation_LORA:
+		lora := txInfo.GetLoraModulationInfo()
+		if lora == nil {
+			return nil, errLoRaWANMetadata.New()
+		}
+		settings.DataRate = ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       lora.Bandwidth * 1000,
+					SpreadingFactor: lora.SpreadingFactor,
+				},
+			},
+		}
+		settings.CodingRate = lora.CodeRate
+	case common.Modulation_FSK:
+		fsk := txInfo.GetFskModulationInfo()
+		if fsk == nil {
+			return nil, errLoRaWANMetadata.New()
+		}
+		settings.DataRate = ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_FSK{
+				FSK: &ttnpb.FSKDataRate{
+					BitRate: fsk.Datarate,
+				},
+			},
+		}
+	default:
+		return nil, errModulation.WithAttributes("modulation", txInfo.Modulation)
+	}
+
+	md := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ids,
+		AntennaIndex:       rxInfo.Antenna,
+		ChannelIndex:       rxInfo.Channel,
+		Timestamp:          settings.Timestamp,
+		RSSI:               float32(rxInfo.Rssi),
+		ChannelRSSI:        float32(rxInfo.Rssi),
+		SNR:                float32(rxInfo.LoraSnr),
+	}
+	if rxInfo.Time != nil {
+		t, err := ptypes.Timestamp(rxInfo.Time)
+		if err != nil {
+			return nil, err
+		}
+		md.Time = &t
+		settings.Time = &t
+	}
+	if rxInfo.TimeSinceGpsEpoch != nil {
+		d, err := ptypes.Duration(rxInfo.TimeSinceGpsEpoch)
+		if err != nil {
+			return nil, err
+		}
+		t := gpstime.Parse(d)
+		md.GPSTime = &t
+	}
+	if loc := rxInfo.Location; loc != nil && (loc.Latitude != 0 || loc.Longitude != 0) {
+		md.Location = &ttnpb.Location{
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Altitude:  int32(loc.Altitude),
+			Accuracy:  int32(loc.Accuracy),
+			Source:    chirpStackSourceToV3[loc.Source],
+		}
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: frame.PhyPayload,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{md},
+	}, nil
+}
+
+func (f *chirpStack) ToStatus(message []byte, _ ttnpb.GatewayIdentifiers) (*ttnpb.GatewayStatus, error) {
+	stats := &gw.GatewayStats{}
+	if err := proto.Unmarshal(message, stats); err != nil {
+		return nil, err
+	}
+	status := &ttnpb.GatewayStatus{
+		Metrics: map[string]float32{
+			"rxin": float32(stats.RxPacketsReceived),
+			"rxok": float32(stats.RxPacketsReceivedOk),
+			"txin": float32(stats.TxPacketsReceived),
+			"txok": float32(stats.TxPacketsEmitted),
+		},
+		Versions: map[string]string{},
+	}
+	if stats.Time != nil {
+		t, err := ptypes.Timestamp(stats.Time)
+		if err != nil {
+			return nil, err
+		}
+		status.Time = t
+	}
+	if stats.Ip != "" {
+		status.IP = []string{stats.Ip}
+	}
+	if stats.ConfigVersion != "" {
+		status.Versions["config"] = stats.ConfigVersion
+	}
+	if loc := stats.Location; loc != nil && (loc.Latitude != 0 || loc.Longitude != 0) {
+		status.AntennaLocations = []*ttnpb.Location{
+			{
+				Latitude:  loc.Latitude,
+				Longitude: loc.Longitude,
+				Altitude:  int32(loc.Altitude),
+				Accuracy:  int32(loc.Accuracy),
+				Source:    chirpStackSourceToV3[loc.Source],
+			},
+		}
+	}
+	if len(stats.MetaData) > 0 {
+		status.Advanced = &pbtypes.Struct{
+			Fields: make(map[string]*pbtypes.Value, len(stats.MetaData)),
+		}
+		for k, v := range stats.MetaData {
+			status.Advanced.Fields[k] = &pbtypes.Value{
+				Kind: &pbtypes.Value_StringValue{StringValue: v},
+			}
+		}
+	}
+	return status, nil
+}
+
+func (f *chirpStack) ToTxAck(message []byte, ids ttnpb.GatewayIdentifiers) (*ttnpb.TxAcknowledgment, error) {
+	ack := &gw.DownlinkTXAck{}
+	if err := proto.Unmarshal(message, ack); err != nil {
+		return nil, err
+	}
+	txAck := &ttnpb.TxAcknowledgment{}
+	if len(ack.Items) > 0 {
+		// The first item that is not ignored is the one that the gateway attempted to transmit.
+		txAck.Result = ttnpb.TxAcknowledgment_UNKNOWN_ERROR
+		for _, item := range ack.Items {
+			if item.Status == gw.TxAckStatus_IGNORED {
+				continue
+			}
+			if result, ok := chirpStackTxAckStatusToV3[item.Status]; ok {
+				txAck.Result = result
+			}
+			break
+		}
+	} else if result, ok := chirpStackTxAckErrorToV3[ack.Error]; ok {
+		txAck.Result = result
+	} else {
+		txAck.Result = ttnpb.TxAcknowledgment_UNKNOWN_ERROR
+	}
+
+	token := uint16(ack.Token)
+	if len(ack.DownlinkId) == chirpStackDownlinkIDLength {
+		token = binary.BigEndian.Uint16(ack.DownlinkId[chirpStackDownlinkIDLength-2:])
+	}
+	if cids, _, ok := f.downlinkTokens(ids).Get(token, time.Now()); ok {
+		txAck.CorrelationIDs = cids
+	}
+	return txAck, nil
+}
+
+// NewChirpStack returns a format that uses the ChirpStack Gateway Bridge and ChirpStack Concentratord Protocol
+// Buffers marshaling and unmarshaling. The topics are identified by the gateway EUI.
+// ChirpStack Gateway Bridge version 3.9 or higher is required for Tx acknowledgments.
+func NewChirpStack(ctx context.Context) Format {
+	return &chirpStack{
+		Layout: topics.NewChirpStack(ctx),
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/brocaar/chirpstack-api/go/v3/common"
+	"github.com/brocaar/chirpstack-api/go/v3/gw"
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChirpStackDownlinkTxAck(t *testing.T) {
+	a := assertions.New(t)
+	format := mqtt.NewChirpStack(test.Context())
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "gateway-id",
+		EUI:       &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01},
+	}
+	pld := []byte{0x60, 0x70, 0x61, 0x61, 0x4a, 0x00, 0x02, 0x00, 0x01}
+
+	buf, err := format.FromDownlink(&ttnpb.DownlinkMessage{
+		RawPayload: pld,
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 12,
+						},
+					},
+				},
+				CodingRate: "4/5",
+				Frequency:  869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					TxPower:            16.15,
+					InvertPolarization: true,
+				},
+				Timestamp: 12000,
+			},
+		},
+		CorrelationIDs: []string{"test"},
+	}, ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	frame := &gw.DownlinkFrame{}
+	if !a.So(proto.Unmarshal(buf, frame), should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(frame.Items, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(frame.GatewayId, should.Resemble, ids.EUI[:])
+	a.So(frame.DownlinkId, should.HaveLength, 16)
+	a.So(frame.Items[0].PhyPayload, should.Resemble, pld)
+	a.So(frame.Items[0].TxInfo, should.Resemble, &gw.DownlinkTXInfo{
+		GatewayId:  ids.EUI[:],
+		Frequency:  869525000,
+		Power:      14,
+		Modulation: common.Modulation_LORA,
+		ModulationInfo: &gw.DownlinkTXInfo_LoraModulationInfo{
+			LoraModulationInfo: &gw.LoRaModulationInfo{
+				Bandwidth:             125,
+				SpreadingFactor:       12,
+				CodeRate:              "4/5",
+				PolarizationInversion: true,
+			},
+		},
+		Timing: gw.DownlinkTiming_DELAY,
+		TimingInfo: &gw.DownlinkTXInfo_DelayTimingInfo{
+			DelayTimingInfo: &gw.DelayTimingInfo{
+				Delay: ptypes.DurationProto(0),
+			},
+		},
+		Context: []byte{0x00, 0x00, 0x2e, 0xe0},
+	})
+
+	for _, tc := range []struct {
+		Name     string
+		Input    *gw.DownlinkTXAck
+		Expected *ttnpb.TxAcknowledgment
+	}{
+		{
+			Name: "Items",
+			Input: &gw.DownlinkTXAck{
+				DownlinkId: frame.DownlinkId,
+				Items: []*gw.DownlinkTXAckItem{
+					{Status: gw.TxAckStatus_OK},
+				},
+			},
+			Expected: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: []string{"test"},
+				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+			},
+		},
+		{
+			Name: "LegacyError",
+			Input: &gw.DownlinkTXAck{
+				Token: frame.Token,
+				Error: "TOO_LATE",
+			},
+			Expected: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: []string{"test"},
+				Result:         ttnpb.TxAcknowledgment_TOO_LATE,
+			},
+		},
+		{
+			Name: "UnknownToken",
+			Input: &gw.DownlinkTXAck{
+				Token: frame.Token + 1,
+				Items: []*gw.DownlinkTXAckItem{
+					{Status: gw.TxAckStatus_QUEUE_FULL},
+				},
+			},
+			Expected: &ttnpb.TxAcknowledgment{
+				Result: ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			buf, err := proto.Marshal(tc.Input)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ack, err := format.ToTxAck(buf, ids)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ack, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestChirpStackUplink(t *testing.T) {
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "gateway-id",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	rxContext := make([]byte, 4)
+	binary.BigEndian.PutUint32(rxContext, 1000)
+	validTXInfo := &gw.UplinkTXInfo{
+		Frequency:  868100000,
+		Modulation: common.Modulation_LORA,
+		ModulationInfo: &gw.UplinkTXInfo_LoraModulationInfo{
+			LoraModulationInfo: &gw.LoRaModulationInfo{
+				Bandwidth:       125,
+				SpreadingFactor: 7,
+				CodeRate:        "4/5",
+			},
+		},
+	}
+	validRXInfo := &gw.UplinkRXInfo{
+		Time:      &timestamp.Timestamp{Seconds: now.Unix()},
+		Rssi:      -42,
+		LoraSnr:   7.5,
+		Channel:   2,
+		Antenna:   1,
+		Context:   rxContext,
+		CrcStatus: gw.CRCStatus_CRC_OK,
+	}
+	pld := []byte{0x40, 0x01, 0x02, 0x03, 0x04, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04}
+
+	for _, tc := range []struct {
+		Name           string
+		Input          *gw.UplinkFrame
+		Expected       *ttnpb.UplinkMessage
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "Empty",
+			Input:          &gw.UplinkFrame{},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Valid",
+			Input: &gw.UplinkFrame{
+				PhyPayload: pld,
+				TxInfo:     validTXInfo,
+				RxInfo:     validRXInfo,
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: pld,
+				Settings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								Bandwidth:       125000,
+								SpreadingFactor: 7,
+							},
+						},
+					},
+					CodingRate: "4/5",
+					Frequency:  868100000,
+					Timestamp:  1000,
+					Time:       &now,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIdentifiers: ids,
+						AntennaIndex:       1,
+						ChannelIndex:       2,
+						Time:               &now,
+						Timestamp:          1000,
+						RSSI:               -42,
+						ChannelRSSI:        -42,
+						SNR:                7.5,
+					},
+				},
+			},
+		},
+		{
+			Name: "BadCRC",
+			Input: &gw.UplinkFrame{
+				PhyPayload: pld,
+				TxInfo:     validTXInfo,
+				RxInfo: &gw.UplinkRXInfo{
+					CrcStatus: gw.CRCStatus_BAD_CRC,
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnknownModulation",
+			Input: &gw.UplinkFrame{
+				PhyPayload: pld,
+				TxInfo: &gw.UplinkTXInfo{
+					Frequency:  868100000,
+					Modulation: common.Modulation_LR_FHSS,
+				},
+				RxInfo: validRXInfo,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			buf, err := proto.Marshal(tc.Input)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			res, err := mqtt.NewChirpStack(test.Context()).ToUplink(buf, ids)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(res, should.HaveEmptyDiff, tc.Expected)
+		})
+	}
+}
+
+func TestChirpStackStatus(t *testing.T) {
+	a := assertions.New(t)
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "gateway-id",
+	}
+	now := time.Unix(1600000000, 0).UTC()
+	buf, err := proto.Marshal(&gw.GatewayStats{
+		Time: &timestamp.Timestamp{Seconds: now.Unix()},
+		Location: &common.Location{
+			Latitude:  52.37,
+			Longitude: 4.89,
+			Altitude:  10,
+			Source:    common.LocationSource_GPS,
+		},
+		ConfigVersion:       "1.2.3",
+		RxPacketsReceived:   15,
+		RxPacketsReceivedOk: 14,
+		TxPacketsReceived:   5,
+		TxPacketsEmitted:    3,
+		Ip:                  "192.0.2.1",
+		MetaData: map[string]string{
+			"model": "test",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	status, err := mqtt.NewChirpStack(test.Context()).ToStatus(buf, ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(status, should.HaveEmptyDiff, &ttnpb.GatewayStatus{
+		Time: now,
+		Metrics: map[string]float32{
+			"rxin": 15,
+			"rxok": 14,
+			"txin": 5,
+			"txok": 3,
+		},
+		Versions: map[string]string{
+			"config": "1.2.3",
+		},
+		IP: []string{"192.0.2.1"},
+		AntennaLocations: []*ttnpb.Location{
+			{
+				Latitude:  52.37,
+				Longitude: 4.89,
+				Altitude:  10,
+				Source:    ttnpb.SOURCE_GPS,
+			},
+		},
+		Advanced: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"model": {Kind: &pbtypes.Value_StringValue{StringValue: "test"}},
+			},
+		},
+	})
+}
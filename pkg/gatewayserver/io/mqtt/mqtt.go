@@ -21,6 +21,7 @@ import (
 	"net"
 	"os"
 	"runtime/debug"
+	"strings"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttlog "github.com/TheThingsIndustries/mystique/pkg/log"
@@ -34,6 +35,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc/metadata"
 )
@@ -93,6 +95,14 @@ type connection struct {
 	mqtt    mqttnet.Conn
 	session session.Session
 	io      *io.Connection
+	topicID string
+}
+
+// euiTopicsFormat is a Format of which the topics are identified by the gateway EUI instead of the gateway UID.
+// Gateways using such format can authenticate with their EUI as username, in which case the gateway is resolved by EUI.
+type euiTopicsFormat interface {
+	Format
+	topicsByEUI()
 }
 
 func (*connection) Protocol() string            { return "mqtt" }
@@ -157,7 +167,7 @@ func (c *connection) setup(ctx context.Context) (err error) {
 					continue
 				}
 				logger.Info("Publish downlink message")
-				topicParts := c.format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GatewayIdentifiers))
+				topicParts := c.format.DownlinkTopic(c.topicID)
 				c.session.Publish(&packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
 					TopicParts: topicParts,
@@ -215,16 +225,34 @@ func (c *connection) setup(ctx context.Context) (err error) {
 }
 
 type topicAccess struct {
-	gtwUID string
-	reads  [][]string
-	writes [][]string
+	topicID string
+	reads   [][]string
+	writes  [][]string
 }
 
+var errNoGatewayEUI = errors.DefineFailedPrecondition("no_gateway_eui", "gateway `{gateway_uid}` has no EUI")
+
 func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
 	ids := ttnpb.GatewayIdentifiers{
 		GatewayID: info.Username,
 	}
-	if err := ids.ValidateContext(ctx); err != nil {
+	_, byEUI := c.format.(euiTopicsFormat)
+	if byEUI {
+		var eui types.EUI64
+		if err := eui.UnmarshalText([]byte(info.Username)); err == nil {
+			ids = ttnpb.GatewayIdentifiers{
+				EUI: &eui,
+			}
+		}
+	}
+	if ids.GatewayID != "" {
+		if err := ids.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, ids, err := c.server.FillGatewayContext(ctx, ids)
+	if err != nil {
 		return nil, err
 	}
 
@@ -237,11 +265,6 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	}
 	ctx = metadata.NewIncomingContext(ctx, md)
 
-	ctx, ids, err := c.server.FillGatewayContext(ctx, ids)
-	if err != nil {
-		return nil, err
-	}
-
 	uid := unique.ID(ctx, ids)
 	ctx = log.NewContextWithField(ctx, "gateway_uid", uid)
 	c.io, err = c.server.Connect(ctx, c, ids)
@@ -249,17 +272,28 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 
+	c.topicID = uid
+	if byEUI {
+		eui := c.io.Gateway().EUI
+		if eui == nil {
+			err := errNoGatewayEUI.WithAttributes("gateway_uid", uid)
+			c.io.Disconnect(err)
+			return nil, err
+		}
+		c.topicID = strings.ToLower(eui.String())
+	}
+
 	access := topicAccess{
-		gtwUID: uid,
+		topicID: c.topicID,
 		reads: [][]string{
-			c.format.DownlinkTopic(uid),
+			c.format.DownlinkTopic(c.topicID),
 		},
 		writes: [][]string{
-			c.format.BirthTopic(uid),
-			c.format.LastWillTopic(uid),
-			c.format.UplinkTopic(uid),
-			c.format.StatusTopic(uid),
-			c.format.TxAckTopic(uid),
+			c.format.BirthTopic(c.topicID),
+			c.format.LastWillTopic(c.topicID),
+			c.format.UplinkTopic(c.topicID),
+			c.format.StatusTopic(c.topicID),
+			c.format.TxAckTopic(c.topicID),
 		},
 	}
 	info.Metadata = access
@@ -271,7 +305,7 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
-	acceptedTopicParts := c.format.DownlinkTopic(access.gtwUID)
+	acceptedTopicParts := c.format.DownlinkTopic(access.topicID)
 	if !topic.MatchPath(acceptedTopicParts, topic.Split(requestedTopic)) {
 		return "", 0, errNotAuthorized.New()
 	}
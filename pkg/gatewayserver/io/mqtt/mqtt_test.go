@@ -35,6 +35,7 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
@@ -126,6 +127,71 @@ func TestAuthentication(t *testing.T) {
 	}
 }
 
+func TestChirpStackAuthentication(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "eui-0102030405060708", EUI: &eui}
+	is, isAddr := mock.NewIS(ctx)
+	is.Add(ctx, gtwIDs, registeredGatewayKey)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	gs := mock.NewServer(c)
+	gs.RegisterGateway(ctx, gtwIDs, &ttnpb.Gateway{
+		GatewayIdentifiers: gtwIDs,
+		FrequencyPlanID:    test.EUFrequencyPlanID,
+	})
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, NewChirpStack(ctx), "tcp")
+
+	for _, username := range []string{"0102030405060708", "eui-0102030405060708"} {
+		t.Run(username, func(t *testing.T) {
+			a := assertions.New(t)
+
+			clientOpts := mqtt.NewClientOptions()
+			clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+			clientOpts.SetUsername(username)
+			clientOpts.SetPassword(registeredGatewayKey)
+			client := mqtt.NewClient(clientOpts)
+			if token := client.Connect(); !token.WaitTimeout(timeout) {
+				t.Fatal("Connection timeout")
+			} else if !a.So(token.Error(), should.BeNil) {
+				t.FailNow()
+			}
+			defer client.Disconnect(uint(timeout / time.Millisecond))
+
+			// The topics are identified by the gateway EUI, regardless of the username.
+			if token := client.Subscribe("gateway/0102030405060708/command/down", 1, nil); !token.WaitTimeout(timeout) {
+				t.Fatal("Subscribe timeout")
+			} else if !a.So(token.Error(), should.BeNil) {
+				t.FailNow()
+			}
+		})
+	}
+}
+
 func TestTraffic(t *testing.T) {
 	a := assertions.New(t)
 
@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"context"
+)
+
+const topicChirpStack = "gateway"
+
+type chirpStack struct{}
+
+func (cs *chirpStack) BirthTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"state", "conn"})
+}
+
+func (cs *chirpStack) IsBirthTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == "state" && path[3] == "conn"
+}
+
+func (cs *chirpStack) LastWillTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"state", "conn"})
+}
+
+func (cs *chirpStack) IsLastWillTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == "state" && path[3] == "conn"
+}
+
+func (cs *chirpStack) UplinkTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"event", "up"})
+}
+
+func (cs *chirpStack) IsUplinkTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == "event" && path[3] == "up"
+}
+
+func (cs *chirpStack) StatusTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"event", "stats"})
+}
+
+func (cs *chirpStack) IsStatusTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == "event" && path[3] == "stats"
+}
+
+func (cs *chirpStack) TxAckTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"event", "ack"})
+}
+
+func (cs *chirpStack) IsTxAckTopic(path []string) bool {
+	return len(path) == 4 && path[0] == topicChirpStack && path[2] == "event" && path[3] == "ack"
+}
+
+func (cs *chirpStack) DownlinkTopic(eui string) []string {
+	return cs.createTopic(eui, []string{"command", "down"})
+}
+
+func (cs *chirpStack) createTopic(eui string, path []string) []string {
+	inTopicIdentifier := eui
+	return append([]string{topicChirpStack, inTopicIdentifier}, path...)
+}
+
+// NewChirpStack returns a topic layout that uses the ChirpStack Gateway Bridge topic structure.
+// The topics are identified by the gateway EUI in lowercase hex, which is the default of the ChirpStack Gateway Bridge,
+// i.e. gateway/<eui>/event/up.
+func NewChirpStack(ctx context.Context) Layout {
+	return &chirpStack{}
+}
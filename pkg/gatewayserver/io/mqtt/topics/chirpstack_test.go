@@ -0,0 +1,84 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChirpStackTopics(t *testing.T) {
+	ctx := test.Context()
+	cs := topics.NewChirpStack(ctx)
+	eui := "0102030405060708"
+	for _, tc := range []struct {
+		EUI      string
+		Func     func(string) []string
+		Expected []string
+		Is       func([]string) bool
+		IsNot    []func([]string) bool
+	}{
+		{
+			EUI:      eui,
+			Func:     cs.BirthTopic,
+			Expected: []string{"gateway", eui, "state", "conn"},
+			Is:       cs.IsBirthTopic,
+			IsNot:    []func([]string) bool{cs.IsUplinkTopic, cs.IsStatusTopic, cs.IsTxAckTopic},
+		},
+		{
+			EUI:      eui,
+			Func:     cs.UplinkTopic,
+			Expected: []string{"gateway", eui, "event", "up"},
+			Is:       cs.IsUplinkTopic,
+			IsNot:    []func([]string) bool{cs.IsBirthTopic, cs.IsStatusTopic, cs.IsTxAckTopic},
+		},
+		{
+			EUI:      eui,
+			Func:     cs.StatusTopic,
+			Expected: []string{"gateway", eui, "event", "stats"},
+			Is:       cs.IsStatusTopic,
+			IsNot:    []func([]string) bool{cs.IsBirthTopic, cs.IsUplinkTopic, cs.IsTxAckTopic},
+		},
+		{
+			EUI:      eui,
+			Func:     cs.TxAckTopic,
+			Expected: []string{"gateway", eui, "event", "ack"},
+			Is:       cs.IsTxAckTopic,
+			IsNot:    []func([]string) bool{cs.IsBirthTopic, cs.IsUplinkTopic, cs.IsStatusTopic},
+		},
+		{
+			EUI:      eui,
+			Func:     cs.DownlinkTopic,
+			Expected: []string{"gateway", eui, "command", "down"},
+			Is:       func([]string) bool { return true },
+			IsNot:    []func([]string) bool{cs.IsBirthTopic, cs.IsUplinkTopic, cs.IsStatusTopic, cs.IsTxAckTopic},
+		},
+	} {
+		t.Run(topic.Join(tc.Expected), func(t *testing.T) {
+			a := assertions.New(t)
+			actual := tc.Func(tc.EUI)
+			a.So(actual, should.Resemble, tc.Expected)
+			a.So(tc.Is(actual), should.BeTrue)
+			for _, isNot := range tc.IsNot {
+				a.So(isNot(actual), should.BeFalse)
+			}
+		})
+	}
+}
@@ -39,8 +39,8 @@ type Config struct {
 	// acknowledgement is received.
 	ConnectionExpires time.Duration `name:"connection-expires" description:"Time after which a connection of a gateway expires"`
 	// ScheduleLateTime defines the time in advance to the actual transmission the downlink message should be scheduled to
-	// the gateway.
-	ScheduleLateTime time.Duration `name:"schedule-late-time" description:"Time in advance to send downlink to the gateway when scheduling late"`
+	// the gateway. When round-trip times to the gateway are measured, the round-trip time is added.
+	ScheduleLateTime time.Duration `name:"schedule-late-time" description:"Time in advance to send downlink to the gateway when scheduling late, in addition to the measured round-trip time"`
 	// AddrChangeBlock defines the time to block traffic when the address changes.
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
@@ -0,0 +1,108 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// jitItem is a downlink message held in the jitQueue.
+type jitItem struct {
+	// starts and ends are the server times at which the transmission starts and ends.
+	starts, ends time.Time
+	// lead is the time in advance to starts at which the downlink message should be released to the gateway.
+	lead time.Duration
+	// latency is the estimated time it takes for a released downlink message to reach the gateway.
+	latency time.Duration
+	// release sends the downlink message to the gateway.
+	release func()
+	// expire is called when the downlink message can no longer be released in time.
+	expire func()
+}
+
+// jitQueue emulates the just-in-time queue of packet forwarders on the server side.
+// Packet forwarders without just-in-time queue hold one downlink message at a time, and a downlink message that is
+// received before the previous one is transmitted overwrites the previous one. The jitQueue holds downlink messages
+// and releases them one at a time in order of transmission, as late as possible and not before the previously released
+// downlink message is transmitted.
+type jitQueue struct {
+	mu        sync.Mutex
+	items     []*jitItem
+	timer     *time.Timer
+	busyUntil time.Time
+}
+
+func (q *jitQueue) releaseTime(item *jitItem) time.Time {
+	at := item.starts.Add(-item.lead)
+	if at.Before(q.busyUntil) {
+		at = q.busyUntil
+	}
+	return at
+}
+
+// schedule sets the timer to process the queue. The caller must hold the lock.
+func (q *jitQueue) schedule() {
+	if q.timer != nil {
+		q.timer.Stop()
+		q.timer = nil
+	}
+	if len(q.items) == 0 {
+		return
+	}
+	q.timer = time.AfterFunc(time.Until(q.releaseTime(q.items[0])), q.process)
+}
+
+// Add adds the item to the queue.
+func (q *jitQueue) Add(item *jitItem) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	i := sort.Search(len(q.items), func(i int) bool { return q.items[i].starts.After(item.starts) })
+	q.items = append(q.items, nil)
+	copy(q.items[i+1:], q.items[i:])
+	q.items[i] = item
+	q.schedule()
+}
+
+func (q *jitQueue) process() {
+	q.mu.Lock()
+	var release, expire []*jitItem
+	for len(q.items) > 0 {
+		now := time.Now()
+		item := q.items[0]
+		if q.releaseTime(item).After(now) {
+			break
+		}
+		q.items = q.items[1:]
+		// Only downlink messages that are held by a previously released downlink message expire; others are released
+		// as configured, even if the lead time does not cover the latency.
+		if q.busyUntil.After(item.starts.Add(-item.lead)) && now.Add(item.latency).After(item.starts) {
+			expire = append(expire, item)
+			continue
+		}
+		release = append(release, item)
+		q.busyUntil = item.ends
+	}
+	q.schedule()
+	q.mu.Unlock()
+
+	for _, item := range expire {
+		item.expire()
+	}
+	for _, item := range release {
+		item.release()
+	}
+}
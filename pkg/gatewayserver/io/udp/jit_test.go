@@ -0,0 +1,78 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestJITQueue(t *testing.T) {
+	a := assertions.New(t)
+
+	var q jitQueue
+	released := make(chan string, 4)
+	expired := make(chan string, 4)
+	add := func(name string, starts time.Time, duration time.Duration) {
+		q.Add(&jitItem{
+			starts:  starts,
+			ends:    starts.Add(duration),
+			lead:    2 * test.Delay,
+			latency: test.Delay / 2,
+			release: func() { released <- name },
+			expire:  func() { expired <- name },
+		})
+	}
+
+	now := time.Now()
+	// The second downlink message would be released before the first is transmitted, so it is held until then.
+	add("second", now.Add(12*test.Delay), 4*test.Delay)
+	add("first", now.Add(4*test.Delay), 6*test.Delay)
+	// The third downlink message can only be released after the second is transmitted, which is too late.
+	add("third", now.Add(15*test.Delay), 4*test.Delay)
+
+	for _, tc := range []struct {
+		Name     string
+		Earliest time.Time
+	}{
+		{
+			Name:     "first",
+			Earliest: now.Add(2 * test.Delay),
+		},
+		{
+			Name:     "second",
+			Earliest: now.Add(10 * test.Delay),
+		},
+	} {
+		select {
+		case name := <-released:
+			a.So(name, should.Equal, tc.Name)
+			a.So(time.Now(), should.HappenOnOrAfter, tc.Earliest)
+		case <-time.After(20 * test.Delay):
+			t.Fatalf("Expected %s downlink message to be released", tc.Name)
+		}
+	}
+	select {
+	case name := <-expired:
+		a.So(name, should.Equal, "third")
+		a.So(time.Now(), should.HappenOnOrAfter, now.Add(16*test.Delay))
+	case <-time.After(20 * test.Delay):
+		t.Fatal("Expected third downlink message to expire")
+	}
+}
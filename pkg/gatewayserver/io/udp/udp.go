@@ -28,7 +28,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -312,6 +314,10 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 	return nil
 }
 
+// scheduleLateRTTPercentile is the percentile of the measured round-trip times that is added to the time in advance to
+// send downlink to the gateway when scheduling late.
+const scheduleLateRTTPercentile = 90
+
 var (
 	errClaimDownlinkFailed = errors.DefineUnavailable("downlink_claim", "failed to claim downlink")
 	errDownlinkPathExpired = errors.DefineAborted("downlink_path_expired", "downlink path expired")
@@ -337,6 +343,11 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 		}
 		logger.Info("Downlink path unclaimed")
 	}()
+	if state.io.BeaconsEnabled() {
+		beaconCtx, cancelBeacons := context.WithCancel(ctx)
+		defer cancelBeacons()
+		go state.io.ScheduleBeacons(beaconCtx)
+	}
 	healthCheck := time.NewTicker(s.config.DownlinkPathExpires / 2)
 	defer healthCheck.Stop()
 	for {
@@ -352,6 +363,10 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
 				break
 			}
+			if io.IsBeacon(down) {
+				tx.NHdr = true
+				tx.Prea = io.BeaconPreambleLength
+			}
 			downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
 			logger := logger.WithField("remote_addr", downlinkPath.addr.String())
 			packet := encoding.Packet{
@@ -377,18 +392,45 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				write()
 				break
 			}
-			state.clockMu.RLock()
-			if !state.clock.IsSynced() {
+			var starts time.Time
+			if tx.Tmms != nil {
+				// The gateway is synchronized with GPS, so the server time is close to the absolute gateway time.
+				starts = gpstime.Parse(time.Duration(*tx.Tmms) * time.Millisecond)
+			} else {
+				state.clockMu.RLock()
+				if !state.clock.IsSynced() {
+					state.clockMu.RUnlock()
+					logger.Warn("Schedule late forced but no gateway clock available")
+					write()
+					break
+				}
+				starts = state.clock.ToServerTime(state.clock.FromTimestampTime(tx.Tmst))
 				state.clockMu.RUnlock()
-				logger.Warn("Schedule late forced but no gateway clock available")
-				write()
-				break
 			}
-			serverTime := state.clock.ToServerTime(state.clock.FromTimestampTime(tx.Tmst))
-			state.clockMu.RUnlock()
-			d := time.Until(serverTime.Add(-s.config.ScheduleLateTime))
-			logger.WithField("duration", d).Debug("Wait to schedule downlink message late")
-			time.AfterFunc(d, write)
+			item := &jitItem{
+				starts:  starts,
+				ends:    starts,
+				lead:    s.config.ScheduleLateTime,
+				release: write,
+				expire: func() {
+					logger.Warn("Downlink message expired in server-side queue")
+					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+				},
+			}
+			if settings := down.GetScheduled(); settings != nil {
+				if d, err := toa.Compute(len(down.RawPayload), *settings); err == nil {
+					item.ends = starts.Add(d)
+				}
+			}
+			if _, _, _, rtt, n := state.io.RTTStats(scheduleLateRTTPercentile, time.Now()); n > 0 {
+				item.lead += rtt
+				item.latency = rtt / 2
+			}
+			logger.WithFields(log.Fields(
+				"starts", item.starts,
+				"lead", item.lead,
+			)).Debug("Queue downlink message to schedule late")
+			state.jit.Add(item)
 		case <-healthCheck.C:
 			lastSeenPull := time.Unix(0, atomic.LoadInt64(&state.lastSeenPull))
 			if time.Since(lastSeenPull) > s.config.DownlinkPathExpires {
@@ -484,6 +526,8 @@ type state struct {
 	startHandleDownMu sync.RWMutex
 
 	tokens io.DownlinkTokens
+
+	jit jitQueue
 }
 
 func recoverUDPFrontend(ctx context.Context) error {
@@ -517,6 +517,9 @@ func TestTraffic(t *testing.T) {
 					expectedTime = expectedTime.Add(-tc.SyncClock)
 					expectedTime = expectedTime.Add(time.Duration(tc.Message.GetScheduled().Timestamp) * time.Microsecond)
 					expectedTime = expectedTime.Add(-testConfig.ScheduleLateTime)
+					if _, _, _, rtt, n := conn.RTTStats(90, time.Now()); n > 0 {
+						expectedTime = expectedTime.Add(-rtt)
+					}
 				}
 
 				// Read the response, taking care of expected time.
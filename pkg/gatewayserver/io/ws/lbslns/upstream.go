@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/util"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -129,6 +130,24 @@ func (conf TxConfirmation) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// parseGPSTime returns the time of the given Basic Station GPS time, which is the number of microseconds since GPS
+// epoch. This function returns nil if the GPS time is zero, i.e. when the gateway is not synchronized with GPS.
+func parseGPSTime(gpsTime int64) *time.Time {
+	if gpsTime == 0 {
+		return nil
+	}
+	t := gpstime.Parse(time.Duration(gpsTime) * time.Microsecond)
+	return &t
+}
+
+// formatGPSTime returns the Basic Station GPS time of the given time.
+func formatGPSTime(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return int64(gpstime.ToGPS(*t) / time.Microsecond)
+}
+
 // toUplinkMessage extracts fields from the Basics Station Join Request "jreq" message and converts them into an UplinkMessage for the network server.
 func (req *JoinRequest) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
 	var up ttnpb.UplinkMessage
@@ -170,6 +189,7 @@ func (req *JoinRequest) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandID str
 	rxMetadata := &ttnpb.RxMetadata{
 		GatewayIdentifiers: ids,
 		Time:               rxTime,
+		GPSTime:            parseGPSTime(req.RadioMetaData.UpInfo.GPSTime),
 		Timestamp:          timestamp,
 		RSSI:               req.RadioMetaData.UpInfo.RSSI,
 		ChannelRSSI:        req.RadioMetaData.UpInfo.RSSI,
@@ -243,11 +263,12 @@ func (req *JoinRequest) FromUplinkMessage(up *ttnpb.UplinkMessage, bandID string
 		DataRate:  dr,
 		Frequency: up.Settings.GetFrequency(),
 		UpInfo: UpInfo{
-			RCtx:   int64(rxMetadata.AntennaIndex),
-			XTime:  int64(rxMetadata.Timestamp),
-			RSSI:   rxMetadata.RSSI,
-			SNR:    rxMetadata.SNR,
-			RxTime: rxTime,
+			RCtx:    int64(rxMetadata.AntennaIndex),
+			XTime:   int64(rxMetadata.Timestamp),
+			GPSTime: formatGPSTime(rxMetadata.GPSTime),
+			RSSI:    rxMetadata.RSSI,
+			SNR:     rxMetadata.SNR,
+			RxTime:  rxTime,
 		},
 	}
 	return nil
@@ -328,6 +349,7 @@ func (updf *UplinkDataFrame) toUplinkMessage(ids ttnpb.GatewayIdentifiers, bandI
 	rxMetadata := &ttnpb.RxMetadata{
 		GatewayIdentifiers: ids,
 		Time:               rxTime,
+		GPSTime:            parseGPSTime(updf.RadioMetaData.UpInfo.GPSTime),
 		Timestamp:          timestamp,
 		RSSI:               updf.RadioMetaData.UpInfo.RSSI,
 		ChannelRSSI:        updf.RadioMetaData.UpInfo.RSSI,
@@ -396,11 +418,12 @@ func (updf *UplinkDataFrame) FromUplinkMessage(up *ttnpb.UplinkMessage, bandID s
 		DataRate:  dr,
 		Frequency: up.Settings.GetFrequency(),
 		UpInfo: UpInfo{
-			RCtx:   int64(rxMetadata.AntennaIndex),
-			XTime:  int64(rxMetadata.Timestamp),
-			RSSI:   rxMetadata.RSSI,
-			SNR:    rxMetadata.SNR,
-			RxTime: rxTime,
+			RCtx:    int64(rxMetadata.AntennaIndex),
+			XTime:   int64(rxMetadata.Timestamp),
+			GPSTime: formatGPSTime(rxMetadata.GPSTime),
+			RSSI:    rxMetadata.RSSI,
+			SNR:     rxMetadata.SNR,
+			RxTime:  rxTime,
 		},
 	}
 	return nil
@@ -431,7 +454,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 		"upstream_type", typ,
 	))
 
-	recordTime := func(refTime float64, xTime int64, server time.Time) {
+	recordTime := func(refTime float64, xTime, gpsTime int64, server time.Time) {
 		sec, nsec := math.Modf(refTime)
 		if sec != 0 {
 			ref := time.Unix(int64(sec), int64(nsec*1e9))
@@ -441,6 +464,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 			// The concentrator timestamp is the 32 LSB.
 			uint32(xTime&0xFFFFFFFF),
 			server,
+			parseGPSTime(gpsTime),
 			// The Basic Station epoch is the 48 LSB.
 			scheduling.ConcentratorTime(time.Duration(xTime&0xFFFFFFFFFF)*time.Microsecond),
 		)
@@ -448,7 +472,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 
 	switch typ {
 	case TypeUpstreamVersion:
-		ctx, msg, stat, err := f.GetRouterConfig(ctx, raw, conn.BandID(), conn.FrequencyPlans(), receivedAt)
+		ctx, msg, stat, err := f.GetRouterConfig(ctx, raw, conn.BandID(), conn.FrequencyPlans(), conn.BeaconsEnabled(), receivedAt)
 		logger = log.FromContext(ctx)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to generate router configuration")
@@ -485,7 +509,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 			ID: int32(jreq.UpInfo.XTime >> 48),
 		}
 		session.DataMu.Unlock()
-		recordTime(jreq.RefTime, jreq.UpInfo.XTime, receivedAt)
+		recordTime(jreq.RefTime, jreq.UpInfo.XTime, jreq.UpInfo.GPSTime, receivedAt)
 
 	case TypeUpstreamUplinkDataFrame:
 		var updf UplinkDataFrame
@@ -512,7 +536,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 			ID: int32(updf.UpInfo.XTime >> 48),
 		}
 		session.DataMu.Unlock()
-		recordTime(updf.RefTime, updf.UpInfo.XTime, receivedAt)
+		recordTime(updf.RefTime, updf.UpInfo.XTime, updf.UpInfo.GPSTime, receivedAt)
 
 	case TypeUpstreamTxConfirmation:
 		var txConf TxConfirmation
@@ -533,7 +557,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids ttnpb.GatewayIden
 			ID: int32(txConf.XTime >> 48),
 		}
 		session.DataMu.Unlock()
-		recordTime(txConf.RefTime, txConf.XTime, receivedAt)
+		recordTime(txConf.RefTime, txConf.XTime, txConf.GpsTime, receivedAt)
 		return nil, err
 
 	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell, TypeUpstreamTimeSync:
@@ -64,7 +64,8 @@ func (v Version) IsProduction() bool {
 }
 
 // GetRouterConfig gets router config for the particular version message.
-func (f *lbsLNS) GetRouterConfig(ctx context.Context, msg []byte, bandID string, fps map[string]*frequencyplans.FrequencyPlan, receivedAt time.Time) (context.Context, []byte, *ttnpb.GatewayStatus, error) {
+// If beacons is true, the router config instructs the Station to transmit class B beacons.
+func (f *lbsLNS) GetRouterConfig(ctx context.Context, msg []byte, bandID string, fps map[string]*frequencyplans.FrequencyPlan, beacons bool, receivedAt time.Time) (context.Context, []byte, *ttnpb.GatewayStatus, error) {
 	var version Version
 	if err := json.Unmarshal(msg, &version); err != nil {
 		return nil, nil, nil, err
@@ -73,6 +74,11 @@ func (f *lbsLNS) GetRouterConfig(ctx context.Context, msg []byte, bandID string,
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	if beacons {
+		if cfg.Beaconing, err = pfconfig.GetBeaconing(bandID); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to configure beacons")
+		}
+	}
 	routerCfg, err := cfg.MarshalJSON()
 	if err != nil {
 		return nil, nil, nil, err
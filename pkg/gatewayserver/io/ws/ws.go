@@ -31,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -263,7 +264,15 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 			case down := <-conn.Down():
 				dlTime := time.Now()
 
-				concentratorTime, ok := conn.TimeFromTimestampTime(down.GetScheduled().Timestamp)
+				var (
+					concentratorTime scheduling.ConcentratorTime
+					ok               bool
+				)
+				if scheduled := down.GetScheduled(); scheduled.Time != nil && scheduled.Timestamp == 0 {
+					concentratorTime, ok = conn.TimeFromGatewayTime(*scheduled.Time)
+				} else {
+					concentratorTime, ok = conn.TimeFromTimestampTime(scheduled.Timestamp)
+				}
 				if !ok {
 					logger.Warn("No clock synchronization")
 					continue
@@ -41,6 +41,26 @@ var (
 			ttnpb.RIGHT_GATEWAY_STATUS_READ,
 		),
 	)
+	evtGatewaySilent = events.Define(
+		"gs.gateway.alert.silent", "gateway silent",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+	evtGatewayMissedStatus = events.Define(
+		"gs.gateway.alert.missed_status", "gateway missing status messages",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+	evtGatewayDisconnected = events.Define(
+		"gs.gateway.alert.disconnected", "gateway disconnected without reconnect",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
+	evtGatewayAlertResolved = events.Define(
+		"gs.gateway.alert.resolved", "gateway alert resolved",
+		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
+		events.WithDataType(&ttnpb.GatewayAlert{}),
+	)
 	evtReceiveStatus = events.Define(
 		"gs.status.receive", "receive gateway status",
 		events.WithVisibility(ttnpb.RIGHT_GATEWAY_STATUS_READ),
@@ -0,0 +1,60 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayAlertRegistry implements the GatewayAlertRegistry interface.
+// The active alerts of a gateway are stored in a set.
+type GatewayAlertRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayAlertRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Raise marks the alert of a gateway as active.
+func (r *GatewayAlertRegistry) Raise(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "raise gateway alert").End()
+
+	if err := r.Redis.SAdd(r.key(uid), alert).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Resolve marks the alert of a gateway as resolved and returns whether the alert was active.
+// Only one caller observes an active alert, even if the alert is resolved concurrently.
+func (r *GatewayAlertRegistry) Resolve(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) (bool, error) {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "resolve gateway alert").End()
+
+	n, err := r.Redis.SRem(r.key(uid), alert).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return n > 0, nil
+}
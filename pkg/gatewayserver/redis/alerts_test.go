@@ -0,0 +1,67 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayAlertRegistry(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "gtw1"}
+	ids2 := ttnpb.GatewayIdentifiers{GatewayID: "gtw2"}
+	registry := &GatewayAlertRegistry{
+		Redis: cl,
+	}
+
+	t.Run("ResolveNonExisting", func(t *testing.T) {
+		active, err := registry.Resolve(ctx, ids, "disconnected")
+		a.So(err, should.BeNil)
+		a.So(active, should.BeFalse)
+	})
+
+	t.Run("RaiseAndResolve", func(t *testing.T) {
+		a.So(registry.Raise(ctx, ids, "disconnected"), should.BeNil)
+		a.So(registry.Raise(ctx, ids, "disconnected"), should.BeNil)
+
+		active, err := registry.Resolve(ctx, ids2, "disconnected")
+		a.So(err, should.BeNil)
+		a.So(active, should.BeFalse)
+
+		active, err = registry.Resolve(ctx, ids, "silent")
+		a.So(err, should.BeNil)
+		a.So(active, should.BeFalse)
+
+		active, err = registry.Resolve(ctx, ids, "disconnected")
+		a.So(err, should.BeNil)
+		a.So(active, should.BeTrue)
+
+		active, err = registry.Resolve(ctx, ids, "disconnected")
+		a.So(err, should.BeNil)
+		a.So(active, should.BeFalse)
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayConnectionHistoryRegistry implements the GatewayConnectionHistoryRegistry interface.
+// The history is stored in a sorted set of buckets per level, scored by the start of the bucket.
+// Buckets are downsampled to all levels when they are added, and trimmed to the retention of each level.
+type GatewayConnectionHistoryRegistry struct {
+	Redis  *ttnredis.Client
+	Levels []history.Level
+}
+
+func (r *GatewayConnectionHistoryRegistry) bucketsKey(uid string, resolution time.Duration) string {
+	return r.Redis.Key("uid", uid, "buckets", resolution.String())
+}
+
+func (r *GatewayConnectionHistoryRegistry) periodsKey(uid string) string {
+	return r.Redis.Key("uid", uid, "periods")
+}
+
+// levels returns the levels sorted by resolution.
+func (r *GatewayConnectionHistoryRegistry) levels() []history.Level {
+	levels := append(make([]history.Level, 0, len(r.Levels)), r.Levels...)
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Resolution < levels[j].Resolution })
+	return levels
+}
+
+func (r *GatewayConnectionHistoryRegistry) maxRetention() time.Duration {
+	var max time.Duration
+	for _, level := range r.Levels {
+		if level.Retention > max {
+			max = level.Retention
+		}
+	}
+	return max
+}
+
+func scoreString(score int64) string {
+	return strconv.FormatInt(score, 10)
+}
+
+func milliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// Add merges the bucket into the history of the gateway at all levels.
+func (r *GatewayConnectionHistoryRegistry) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, bucket *ttnpb.GatewayConnectionHistory_Bucket) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "add gateway connection history").End()
+
+	levels := r.levels()
+	ks := make([]string, 0, len(levels))
+	for _, level := range levels {
+		ks = append(ks, r.bucketsKey(uid, level.Resolution))
+	}
+	now := time.Now()
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		members := make([]string, len(levels))
+		for i, level := range levels {
+			start := bucket.Start.Truncate(level.Resolution)
+			score := scoreString(start.Unix())
+			stored, err := tx.ZRangeByScore(ks[i], &redis.ZRangeBy{
+				Min: score,
+				Max: score,
+			}).Result()
+			if err != nil {
+				return err
+			}
+			merged := &ttnpb.GatewayConnectionHistory_Bucket{
+				Start: start,
+			}
+			for _, s := range stored {
+				pb := &ttnpb.GatewayConnectionHistory_Bucket{}
+				if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+					return err
+				}
+				history.MergeBucket(merged, pb)
+			}
+			history.MergeBucket(merged, bucket)
+			if merged.ConnectedDuration > level.Resolution {
+				merged.ConnectedDuration = level.Resolution
+			}
+			members[i], err = ttnredis.MarshalProto(merged)
+			if err != nil {
+				return err
+			}
+		}
+		_, err := tx.TxPipelined(func(p redis.Pipeliner) error {
+			for i, level := range levels {
+				score := bucket.Start.Truncate(level.Resolution).Unix()
+				p.ZRemRangeByScore(ks[i], scoreString(score), scoreString(score))
+				p.ZAdd(ks[i], &redis.Z{
+					Score:  float64(score),
+					Member: members[i],
+				})
+				p.ZRemRangeByScore(ks[i], "-inf", "("+scoreString(now.Add(-level.Retention).Unix()))
+				p.PExpire(ks[i], level.Retention)
+			}
+			return nil
+		})
+		return err
+	}, ks...)
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// SetConnectionPeriod sets the connection period of the gateway that started at the time the gateway connected.
+func (r *GatewayConnectionHistoryRegistry) SetConnectionPeriod(ctx context.Context, ids ttnpb.GatewayIdentifiers, period *ttnpb.GatewayConnectionHistory_ConnectionPeriod) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set gateway connection period").End()
+
+	member, err := ttnredis.MarshalProto(period)
+	if err != nil {
+		return err
+	}
+	k := r.periodsKey(uid)
+	score := milliseconds(period.ConnectedAt)
+	retention := r.maxRetention()
+	_, err = r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.ZRemRangeByScore(k, scoreString(score), scoreString(score))
+		p.ZAdd(k, &redis.Z{
+			Score:  float64(score),
+			Member: member,
+		})
+		if retention > 0 {
+			p.ZRemRangeByScore(k, "-inf", "("+scoreString(milliseconds(time.Now().Add(-retention))))
+			p.PExpire(k, retention)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Get returns the history of the gateway between start and end, with the finest level that has a resolution equal
+// to or coarser than the given resolution. If there is no such level, the coarsest level is used.
+// If start is zero, the history is returned from the retention of the level. If end is zero, the history is returned
+// until now.
+func (r *GatewayConnectionHistoryRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers, start, end time.Time, resolution time.Duration) (*ttnpb.GatewayConnectionHistory, error) {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "get gateway connection history").End()
+
+	levels := r.levels()
+	if len(levels) == 0 {
+		return &ttnpb.GatewayConnectionHistory{}, nil
+	}
+	level := levels[len(levels)-1]
+	for _, l := range levels {
+		if l.Resolution >= resolution {
+			level = l
+			break
+		}
+	}
+	now := time.Now()
+	if start.IsZero() {
+		start = now.Add(-level.Retention)
+	}
+	if end.IsZero() {
+		end = now
+	}
+
+	res := &ttnpb.GatewayConnectionHistory{
+		Resolution: level.Resolution,
+	}
+	buckets, err := r.Redis.ZRangeByScore(r.bucketsKey(uid, level.Resolution), &redis.ZRangeBy{
+		Min: scoreString(start.Truncate(level.Resolution).Unix()),
+		Max: scoreString(end.Unix()),
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	for _, s := range buckets {
+		pb := &ttnpb.GatewayConnectionHistory_Bucket{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		res.Buckets = append(res.Buckets, pb)
+	}
+
+	periods, err := r.Redis.ZRangeByScore(r.periodsKey(uid), &redis.ZRangeBy{
+		Min: "-inf",
+		Max: scoreString(milliseconds(end)),
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	for _, s := range periods {
+		pb := &ttnpb.GatewayConnectionHistory_ConnectionPeriod{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		if pb.DisconnectedAt != nil && pb.DisconnectedAt.Before(start) {
+			continue
+		}
+		res.ConnectionPeriods = append(res.ConnectionPeriods, pb)
+	}
+	return res, nil
+}
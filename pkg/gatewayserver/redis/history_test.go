@@ -0,0 +1,117 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/history"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHistoryRegistry(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "gtw1",
+	}
+	registry := &GatewayConnectionHistoryRegistry{
+		Redis: cl,
+		Levels: []history.Level{
+			{Resolution: time.Minute, Retention: time.Hour},
+			{Resolution: time.Hour, Retention: 24 * time.Hour},
+		},
+	}
+
+	start := time.Now().Truncate(time.Hour).UTC()
+
+	t.Run("Empty", func(t *testing.T) {
+		res, err := registry.Get(ctx, ids, time.Time{}, time.Time{}, 0)
+		a.So(err, should.BeNil)
+		a.So(res.Resolution, should.Equal, time.Minute)
+		a.So(res.Buckets, should.BeEmpty)
+		a.So(res.ConnectionPeriods, should.BeEmpty)
+	})
+
+	t.Run("ConnectionPeriod", func(t *testing.T) {
+		period := &ttnpb.GatewayConnectionHistory_ConnectionPeriod{
+			ConnectedAt: start,
+			Protocol:    "dummy",
+		}
+		err := registry.SetConnectionPeriod(ctx, ids, period)
+		a.So(err, should.BeNil)
+
+		disconnectedAt := start.Add(2 * time.Minute)
+		period.DisconnectedAt = &disconnectedAt
+		err = registry.SetConnectionPeriod(ctx, ids, period)
+		a.So(err, should.BeNil)
+
+		res, err := registry.Get(ctx, ids, start, time.Time{}, 0)
+		a.So(err, should.BeNil)
+		if a.So(res.ConnectionPeriods, should.HaveLength, 1) {
+			a.So(res.ConnectionPeriods[0], should.Resemble, period)
+		}
+
+		res, err = registry.Get(ctx, ids, start.Add(3*time.Minute), time.Time{}, 0)
+		a.So(err, should.BeNil)
+		a.So(res.ConnectionPeriods, should.BeEmpty)
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			err := registry.Add(ctx, ids, &ttnpb.GatewayConnectionHistory_Bucket{
+				Start:             start.Add(time.Duration(i) * 30 * time.Second),
+				ConnectedDuration: 30 * time.Second,
+				UplinkCount:       uint64(i + 1),
+			})
+			a.So(err, should.BeNil)
+		}
+
+		res, err := registry.Get(ctx, ids, start, time.Time{}, 0)
+		a.So(err, should.BeNil)
+		a.So(res.Resolution, should.Equal, time.Minute)
+		if a.So(res.Buckets, should.HaveLength, 2) {
+			a.So(res.Buckets[0].Start, should.Equal, start)
+			a.So(res.Buckets[0].ConnectedDuration, should.Equal, time.Minute)
+			a.So(res.Buckets[0].UplinkCount, should.Equal, 3)
+			a.So(res.Buckets[1].Start, should.Equal, start.Add(time.Minute))
+			a.So(res.Buckets[1].ConnectedDuration, should.Equal, time.Minute)
+			a.So(res.Buckets[1].UplinkCount, should.Equal, 7)
+		}
+
+		res, err = registry.Get(ctx, ids, start, time.Time{}, 10*time.Minute)
+		a.So(err, should.BeNil)
+		a.So(res.Resolution, should.Equal, time.Hour)
+		if a.So(res.Buckets, should.HaveLength, 1) {
+			a.So(res.Buckets[0].Start, should.Equal, start)
+			a.So(res.Buckets[0].ConnectedDuration, should.Equal, 2*time.Minute)
+			a.So(res.Buckets[0].UplinkCount, should.Equal, 10)
+		}
+
+		res, err = registry.Get(ctx, ids, start, time.Time{}, 48*time.Hour)
+		a.So(err, should.BeNil)
+		a.So(res.Resolution, should.Equal, time.Hour)
+	})
+}
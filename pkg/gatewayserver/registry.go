@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -27,3 +28,23 @@ type GatewayConnectionStatsRegistry interface {
 	// Set sets or clears the connection stats for a gateway.
 	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats) error
 }
+
+// GatewayConnectionHistoryRegistry stores and downsamples the traffic, status and connection history of gateways.
+type GatewayConnectionHistoryRegistry interface {
+	// Add merges the bucket into the history of a gateway.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, bucket *ttnpb.GatewayConnectionHistory_Bucket) error
+	// SetConnectionPeriod sets the connection period of a gateway that started at the time the gateway connected.
+	SetConnectionPeriod(ctx context.Context, ids ttnpb.GatewayIdentifiers, period *ttnpb.GatewayConnectionHistory_ConnectionPeriod) error
+	// Get returns the history of a gateway between start and end, with the finest resolution that is equal to or
+	// coarser than the given resolution.
+	Get(ctx context.Context, ids ttnpb.GatewayIdentifiers, start, end time.Time, resolution time.Duration) (*ttnpb.GatewayConnectionHistory, error)
+}
+
+// GatewayAlertRegistry stores the active alerts of gateways that outlive a gateway connection, so that they can be
+// resolved by any Gateway Server the gateway reconnects to.
+type GatewayAlertRegistry interface {
+	// Raise marks the alert of a gateway as active.
+	Raise(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) error
+	// Resolve marks the alert of a gateway as resolved and returns whether the alert was active.
+	Resolve(ctx context.Context, ids ttnpb.GatewayIdentifiers, alert string) (bool, error)
+}
@@ -84,13 +84,13 @@ func (c *RolloverClock) SyncWithGatewayAbsolute(timestamp uint32, server, gatewa
 	return ct
 }
 
-// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time and the
-// relative gateway time that corresponds to the given timestamp.
-func (c *RolloverClock) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, concentrator ConcentratorTime) ConcentratorTime {
+// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time, the
+// relative gateway time and, if available, the absolute gateway time that correspond to the given timestamp.
+func (c *RolloverClock) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, gateway *time.Time, concentrator ConcentratorTime) ConcentratorTime {
 	c.absolute = concentrator
 	c.relative = timestamp
 	c.server = &server
-	c.gateway = nil
+	c.gateway = gateway
 	c.synced = true
 	return c.absolute
 }
@@ -254,7 +254,7 @@ func (s *Scheduler) syncWithUplinkToken(token *ttnpb.UplinkToken) bool {
 	if lastSync, ok := s.clock.SyncTime(); ok && lastSync.After(token.ServerTime) {
 		return false
 	}
-	s.clock.SyncWithGatewayConcentrator(token.Timestamp, token.ServerTime, ConcentratorTime(token.ConcentratorTime))
+	s.clock.SyncWithGatewayConcentrator(token.Timestamp, token.ServerTime, nil, ConcentratorTime(token.ConcentratorTime))
 	return true
 }
 
@@ -447,12 +447,12 @@ func (s *Scheduler) SyncWithGatewayAbsolute(timestamp uint32, server, gateway ti
 	return s.clock.SyncWithGatewayAbsolute(timestamp, server, gateway)
 }
 
-// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time and the
-// relative gateway time that corresponds to the given timestamp.
-func (s *Scheduler) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, concentrator ConcentratorTime) ConcentratorTime {
+// SyncWithGatewayConcentrator synchronizes the clock with the given concentrator timestamp, the server time, the
+// relative gateway time and, if available, the absolute gateway time that correspond to the given timestamp.
+func (s *Scheduler) SyncWithGatewayConcentrator(timestamp uint32, server time.Time, gateway *time.Time, concentrator ConcentratorTime) ConcentratorTime {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.clock.SyncWithGatewayConcentrator(timestamp, server, concentrator)
+	return s.clock.SyncWithGatewayConcentrator(timestamp, server, gateway, concentrator)
 }
 
 // IsGatewayTimeSynced reports whether scheduler clock is synchronized with gateway time.
@@ -485,6 +485,17 @@ func (s *Scheduler) TimeFromTimestampTime(t uint32) (ConcentratorTime, bool) {
 	return s.clock.FromTimestampTime(t), true
 }
 
+// TimeFromGatewayTime returns the concentrator time by the given absolute gateway time.
+// This method returns false if the clock is not synced with the gateway time.
+func (s *Scheduler) TimeFromGatewayTime(t time.Time) (ConcentratorTime, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.clock.IsSynced() {
+		return 0, false
+	}
+	return s.clock.FromGatewayTime(t)
+}
+
 // SubBandStats returns a map with the usage stats of each sub band.
 func (s *Scheduler) SubBandStats() []*ttnpb.GatewayConnectionStats_SubBand {
 	var res []*ttnpb.GatewayConnectionStats_SubBand
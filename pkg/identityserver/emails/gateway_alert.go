@@ -0,0 +1,48 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import "time"
+
+// GatewayAlert is the email that is sent to the collaborators of a gateway when an alert is raised or resolved.
+type GatewayAlert struct {
+	Data
+	Alert    string
+	Resolved bool
+	LastSeen time.Time
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (GatewayAlert) TemplateName() string { return "gateway_alert" }
+
+const gatewayAlertSubject = `{{if .Resolved}}Resolved{{else}}Alert{{end}}: your gateway {{.Entity.ID}} is {{if .Resolved}}no longer {{end}}{{.Alert}}`
+
+const gatewayAlertBody = `Dear {{.User.Name}},
+
+{{if .Resolved -}}
+Your gateway "{{.Entity.ID}}" on {{.Network.Name}} is no longer {{.Alert}}.
+{{- else -}}
+Your gateway "{{.Entity.ID}}" on {{.Network.Name}} is {{.Alert}}.
+{{- end}}
+{{if not .LastSeen.IsZero}}
+The gateway was last seen at {{.LastSeen.UTC.Format "2006-01-02 15:04:05 MST"}}.
+{{end}}
+You are receiving this because you are a collaborator of {{.Entity.Type}} "{{.Entity.ID}}".
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (GatewayAlert) DefaultTemplates() (subject, html, text string) {
+	return gatewayAlertSubject, "", gatewayAlertBody
+}
@@ -0,0 +1,141 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// gatewayAlertDescriptions are the descriptions of the gateway alerts that are used in emails.
+var gatewayAlertDescriptions = map[string]string{
+	"silent":        "silent",
+	"missed_status": "missing status messages",
+	"disconnected":  "disconnected",
+}
+
+// gatewayAlertContacts returns the users to notify of alerts of the gateway. These are the collaborators of the
+// gateway, directly or as member of an organization, that have the right to read the gateway status.
+func (is *IdentityServer) gatewayAlertContacts(ctx context.Context, ids ttnpb.GatewayIdentifiers) (users []*ttnpb.User, err error) {
+	canReadStatus := func(rights *ttnpb.Rights) bool {
+		return rights.Implied().IncludesAll(ttnpb.RIGHT_GATEWAY_STATUS_READ)
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		membershipStore := is.getMembershipStore(ctx, db)
+		memberRights, err := membershipStore.FindMembers(ctx, ids)
+		if err != nil {
+			return err
+		}
+		var userIDs []*ttnpb.UserIdentifiers
+		seen := make(map[string]bool)
+		addUser := func(ids *ttnpb.UserIdentifiers) {
+			if seen[ids.UserID] {
+				return
+			}
+			seen[ids.UserID] = true
+			userIDs = append(userIDs, ids)
+		}
+		for member, rights := range memberRights {
+			if !canReadStatus(rights) {
+				continue
+			}
+			if usrIDs := member.GetUserIDs(); usrIDs != nil {
+				addUser(usrIDs)
+				continue
+			}
+			orgIDs := member.GetOrganizationIDs()
+			if orgIDs == nil {
+				continue
+			}
+			orgMemberRights, err := membershipStore.FindMembers(ctx, *orgIDs)
+			if err != nil {
+				return err
+			}
+			for orgMember, orgRights := range orgMemberRights {
+				if usrIDs := orgMember.GetUserIDs(); usrIDs != nil && canReadStatus(orgRights) {
+					addUser(usrIDs)
+				}
+			}
+		}
+		userStore := store.GetUserStore(db)
+		for _, usrIDs := range userIDs {
+			usr, err := userStore.GetUser(ctx, usrIDs, &types.FieldMask{
+				Paths: []string{"name", "primary_email_address"},
+			})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if usr.PrimaryEmailAddress == "" {
+				continue
+			}
+			users = append(users, usr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+type gatewayAlertNotifier struct {
+	*IdentityServer
+}
+
+func (gan *gatewayAlertNotifier) Notify(ctx context.Context, req *ttnpb.GatewayAlert) (*types.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if err := req.ValidateFields(); err != nil {
+		return nil, err
+	}
+	users, err := gan.gatewayAlertContacts(ctx, req.GatewayIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+	var lastSeen time.Time
+	if req.LastSeenAt != nil {
+		lastSeen = *req.LastSeenAt
+	}
+	for _, usr := range users {
+		err := gan.SendEmail(ctx, func(data emails.Data) email.MessageData {
+			data.SetUser(usr)
+			data.SetEntity(req.GatewayIdentifiers.EntityIdentifiers())
+			return emails.GatewayAlert{
+				Data:     data,
+				Alert:    gatewayAlertDescriptions[req.Alert],
+				Resolved: req.Resolved,
+				LastSeen: lastSeen,
+			}
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Could not send gateway alert email")
+		}
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestGatewayAlertNotifierPermissionDenied(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		gatewayID := userGateways(&userID).Gateways[0].GatewayIdentifiers
+
+		reg := ttnpb.NewGatewayAlertNotifierClient(cc)
+
+		alert := &ttnpb.GatewayAlert{
+			GatewayIdentifiers: gatewayID,
+			Alert:              "silent",
+		}
+
+		_, err := reg.Notify(ctx, alert)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+		}
+
+		// Gateway collaborators can not send alert notifications; only cluster peers can.
+		_, err = reg.Notify(ctx, alert, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	})
+}
@@ -144,6 +144,8 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	}
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayAlertNotifier", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayAlertNotifier", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 
 	c.RegisterGRPC(is)
@@ -176,6 +178,7 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterEndDeviceRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterOAuthAuthorizationRegistryServer(s, &oauthRegistry{IdentityServer: is})
 	ttnpb.RegisterContactInfoRegistryServer(s, &contactInfoRegistry{IdentityServer: is})
+	ttnpb.RegisterGatewayAlertNotifierServer(s, &gatewayAlertNotifier{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
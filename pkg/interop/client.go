@@ -296,13 +296,10 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	sessionKeyID := []byte(interopAns.SessionKeyID)
 	if len(sessionKeyID) == 0 {
 		log.FromContext(ctx).Debug("Interop join-accept does not contain session key ID, generate random ID")
-		id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+		sessionKeyID, err = generateSessionKeyID()
 		if err != nil {
-			return nil, errGenerateSessionKeyID.New()
+			return nil, err
 		}
-		sessionKeyID = make([]byte, 0, len(generatedSessionKeyIDPrefix)+len(id))
-		sessionKeyID = append(sessionKeyID, generatedSessionKeyIDPrefix...)
-		sessionKeyID = append(sessionKeyID, id[:]...)
 	}
 	return &ttnpb.JoinResponse{
 		RawPayload: interopAns.PHYPayload,
@@ -317,6 +314,40 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	}, nil
 }
 
+// HomeNSRequest performs HomeNS request according to LoRaWAN Backend Interfaces specification.
+func (cl joinServerHTTPClient) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (*types.NetID, error) {
+	interopAns := &HomeNSAns{}
+	if err := cl.exchange(ctx, joinEUI, jsRPCPaths.homeNS, &HomeNSReq{
+		NsJsMessageHeader: NsJsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: cl.Protocol.BackendInterfacesVersion(),
+				MessageType:     MessageTypeHomeNSReq,
+			},
+			SenderID:   NetID(netID),
+			ReceiverID: EUI64(joinEUI),
+			SenderNSID: NetID(netID),
+		},
+		DevEUI: EUI64(devEUI),
+	}, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	hNetID := types.NetID(interopAns.HNetID)
+	return &hNetID, nil
+}
+
+func generateSessionKeyID() ([]byte, error) {
+	id, err := ulid.New(ulid.Timestamp(time.Now()), rand.Reader)
+	if err != nil {
+		return nil, errGenerateSessionKeyID.New()
+	}
+	sessionKeyID := make([]byte, 0, len(generatedSessionKeyIDPrefix)+len(id))
+	sessionKeyID = append(sessionKeyID, generatedSessionKeyIDPrefix...)
+	return append(sessionKeyID, id[:]...), nil
+}
+
 // GeneratedSessionKeyID returns whether the session key ID is generated locally and not by the Join Server.
 func GeneratedSessionKeyID(id []byte) bool {
 	return bytes.HasPrefix(id, generatedSessionKeyIDPrefix)
@@ -338,6 +369,7 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
+	HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (*types.NetID, error)
 }
 
 type prefixJoinServerClient struct {
@@ -346,7 +378,8 @@ type prefixJoinServerClient struct {
 }
 
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]*networkServerHTTPClient
 }
 
 var errUnknownProtocol = errors.DefineInvalidArgument("unknown_protocol", "unknown protocol")
@@ -381,6 +414,10 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -454,8 +491,75 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	nss := make(map[types.NetID]*networkServerHTTPClient, len(yamlConf.NetworkServers))
+	for _, nsConf := range yamlConf.NetworkServers {
+		nsConfEls := strings.Split(filepath.ToSlash(nsConf.File), "/")
+
+		fetcher := fetch.WithBasePath(fetcher, nsConfEls[:len(nsConfEls)-1]...)
+		nsFileBytes, err := fetcher.File(nsConfEls[len(nsConfEls)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths         `yaml:"paths"`
+			Protocol        JoinServerProtocol `yaml:"protocol"`
+			PassiveRoaming  struct {
+				Forwarding bool `yaml:"forwarding"`
+				Home       bool `yaml:"home"`
+			} `yaml:"passive-roaming"`
+			HandoverRoaming struct {
+				Serving bool `yaml:"serving"`
+				Home    bool `yaml:"home"`
+			} `yaml:"handover-roaming"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+		switch yamlNSConf.Protocol {
+		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+
+		tlsConf := fallbackTLS
+		if !yamlNSConf.TLS.IsZero() {
+			tlsConf, err = yamlNSConf.TLS.TLSConfig(fetcher)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var tr *http.Transport
+		if tlsConf != nil {
+			tr = &http.Transport{
+				TLSClientConfig: tlsConf,
+			}
+		}
+		for _, netID := range nsConf.NetIDs {
+			nss[netID] = &networkServerHTTPClient{
+				Client: http.Client{
+					Transport: tr,
+				},
+				NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.DNS, yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+				Protocol:       yamlNSConf.Protocol,
+				PassiveRoaming: PassiveRoamingAgreement{
+					NetID:      netID,
+					Forwarding: yamlNSConf.PassiveRoaming.Forwarding,
+					Home:       yamlNSConf.PassiveRoaming.Home,
+				},
+				HandoverRoaming: HandoverRoamingAgreement{
+					NetID:   netID,
+					Serving: yamlNSConf.HandoverRoaming.Serving,
+					Home:    yamlNSConf.HandoverRoaming.Home,
+				},
+			}
+		}
+	}
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
@@ -490,3 +594,84 @@ func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+// HomeNSRequest performs HomeNS request to Join Server associated with joinEUI and returns the NetID of the
+// Home Network Server of the end device.
+func (cl Client) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (*types.NetID, error) {
+	js, ok := cl.joinServer(joinEUI)
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return js.HomeNSRequest(ctx, netID, joinEUI, devEUI)
+}
+
+// PassiveRoamingAgreement returns the passive roaming agreement with the network identified by netID.
+func (cl Client) PassiveRoamingAgreement(netID types.NetID) (PassiveRoamingAgreement, bool) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return PassiveRoamingAgreement{}, false
+	}
+	return ns.PassiveRoaming, true
+}
+
+// PassiveRoamingNetID returns the NetID of the network with a passive roaming agreement that devAddr belongs to.
+func (cl Client) PassiveRoamingNetID(devAddr types.DevAddr) (types.NetID, bool) {
+	for netID := range cl.networkServers {
+		prefix, err := NetIDDevAddrPrefix(netID)
+		if err != nil {
+			continue
+		}
+		if devAddr.HasPrefix(prefix) {
+			return netID, true
+		}
+	}
+	return types.NetID{}, false
+}
+
+// PRStartRequest performs passive roaming start request to the Serving Network Server of the network identified by
+// netID.
+func (cl Client) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.PRStartRequest(ctx, req)
+}
+
+// XmitDataRequest performs data transmission request to the Forwarding Network Server of the network identified by
+// netID.
+func (cl Client) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.XmitDataRequest(ctx, req)
+}
+
+// HandoverRoamingAgreement returns the handover roaming agreement with the network identified by netID.
+func (cl Client) HandoverRoamingAgreement(netID types.NetID) (HandoverRoamingAgreement, bool) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return HandoverRoamingAgreement{}, false
+	}
+	return ns.HandoverRoaming, true
+}
+
+// ProfileRequest performs profile request to the Home Network Server of the network identified by netID.
+func (cl Client) ProfileRequest(ctx context.Context, netID types.NetID, req *ProfileReq) (*ProfileAns, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.ProfileRequest(ctx, req)
+}
+
+// HRStartRequest performs handover roaming start request to the Home Network Server of the network identified by
+// netID.
+func (cl Client) HRStartRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest, ulMetaData ULMetaData) (*ttnpb.JoinResponse, error) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.HRStartRequest(ctx, req, ulMetaData)
+}
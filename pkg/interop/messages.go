@@ -219,6 +219,242 @@ type HomeNSAns struct {
 	HNetID NetID
 }
 
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	ReceiverID   NetID
+	SenderNSID   *NetID `json:",omitempty"`
+	ReceiverNSID *NetID `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsNsMessageHeader) AnswerHeader() (NsNsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsNsMessageHeader{}, err
+	}
+	return NsNsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		ReceiverID:    h.SenderID,
+		SenderNSID:    h.ReceiverNSID,
+		ReceiverNSID:  h.SenderNSID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *float32 `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool     `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64   `json:",omitempty"`
+	DevAddr    *DevAddr `json:",omitempty"`
+	FPort      *uint8   `json:",omitempty"`
+	FCntDown   *uint32  `json:",omitempty"`
+	FCntUp     *uint32  `json:",omitempty"`
+	Confirmed  bool     `json:",omitempty"`
+	DataRate   *int     `json:",omitempty"`
+	ULFreq     *float64 `json:",omitempty"`
+	Margin     *int     `json:",omitempty"`
+	Battery    *int     `json:",omitempty"`
+	FNSULToken Buffer   `json:",omitempty"`
+	RecvTime   string
+	RFRegion   string `json:",omitempty"`
+	GWCnt      *int   `json:",omitempty"`
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64   `json:",omitempty"`
+	FPort          *uint8   `json:",omitempty"`
+	FCntDown       *uint32  `json:",omitempty"`
+	Confirmed      bool     `json:",omitempty"`
+	DLFreq1        *float64 `json:",omitempty"`
+	DLFreq2        *float64 `json:",omitempty"`
+	RXDelay1       int
+	ClassMode      string `json:",omitempty"`
+	DataRate1      *int   `json:",omitempty"`
+	DataRate2      *int   `json:",omitempty"`
+	FNSULToken     Buffer `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsNsMessageHeader
+	Result      Result
+	PHYPayload  Buffer       `json:",omitempty"`
+	DevEUI      *EUI64       `json:",omitempty"`
+	Lifetime    *uint32      `json:",omitempty"`
+	FNwkSIntKey *KeyEnvelope `json:",omitempty"`
+	NwkSKey     *KeyEnvelope `json:",omitempty"`
+	FCntUp      *uint32      `json:",omitempty"`
+	DLMetaData  *DLMetaData  `json:",omitempty"`
+}
+
+// PRStopReq is a passive roaming stop request message.
+type PRStopReq struct {
+	NsNsMessageHeader
+	DevEUI   EUI64
+	Lifetime *uint32 `json:",omitempty"`
+}
+
+// PRStopAns is an answer to a PRStopReq message.
+type PRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
+// XmitDataReq is a data transmission request message.
+type XmitDataReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer      `json:",omitempty"`
+	FRMPayload Buffer      `json:",omitempty"`
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsNsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"`
+	DLFreq2 *float64 `json:",omitempty"`
+}
+
+// RoamingActivationType is the type of roaming activation.
+type RoamingActivationType string
+
+// RoamingActivationType enum.
+const (
+	RoamingActivationTypePassive  RoamingActivationType = "Passive"
+	RoamingActivationTypeHandover RoamingActivationType = "Handover"
+)
+
+// DeviceProfile is the device profile of an end device.
+type DeviceProfile struct {
+	DeviceProfileID    string     `json:",omitempty"`
+	SupportsClassB     bool       `json:",omitempty"`
+	ClassBTimeout      *uint32    `json:",omitempty"`
+	PingSlotPeriod     *uint32    `json:",omitempty"`
+	PingSlotDR         *int       `json:",omitempty"`
+	PingSlotFreq       *float64   `json:",omitempty"`
+	SupportsClassC     bool       `json:",omitempty"`
+	ClassCTimeout      *uint32    `json:",omitempty"`
+	MACVersion         MACVersion `json:",omitempty"`
+	RegParamsRevision  string     `json:",omitempty"`
+	SupportsJoin       bool       `json:",omitempty"`
+	RXDelay1           *int       `json:",omitempty"`
+	RXDROffset1        *int       `json:",omitempty"`
+	RXDataRate2        *int       `json:",omitempty"`
+	RXFreq2            *float64   `json:",omitempty"`
+	FactoryPresetFreqs []float64  `json:",omitempty"`
+	MaxEIRP            *int       `json:",omitempty"`
+	MaxDutyCycle       *float64   `json:",omitempty"`
+	RFRegion           string     `json:",omitempty"`
+	Supports32bitFCnt  bool       `json:",omitempty"`
+}
+
+// ServiceProfile is the service profile of an end device.
+type ServiceProfile struct {
+	ServiceProfileID       string   `json:",omitempty"`
+	ULRate                 *int     `json:",omitempty"`
+	ULBucketSize           *int     `json:",omitempty"`
+	ULRatePolicy           string   `json:",omitempty"`
+	DLRate                 *int     `json:",omitempty"`
+	DLBucketSize           *int     `json:",omitempty"`
+	DLRatePolicy           string   `json:",omitempty"`
+	AddGWMetadata          bool     `json:",omitempty"`
+	DevStatusReqFreq       *int     `json:",omitempty"`
+	ReportDevStatusBattery bool     `json:",omitempty"`
+	ReportDevStatusMargin  bool     `json:",omitempty"`
+	DRMin                  *int     `json:",omitempty"`
+	DRMax                  *int     `json:",omitempty"`
+	ChannelMask            Buffer   `json:",omitempty"`
+	PRAllowed              bool     `json:",omitempty"`
+	HRAllowed              bool     `json:",omitempty"`
+	RAAllowed              bool     `json:",omitempty"`
+	NwkGeoLoc              bool     `json:",omitempty"`
+	TargetPER              *float64 `json:",omitempty"`
+	MinGWDiversity         *int     `json:",omitempty"`
+}
+
+// ProfileReq is a request for the profiles of an end device.
+type ProfileReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// ProfileAns is an answer to a ProfileReq message.
+type ProfileAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	DeviceProfile          *DeviceProfile        `json:",omitempty"`
+	DeviceProfileTimestamp string                `json:",omitempty"`
+	RoamingActivationType  RoamingActivationType `json:",omitempty"`
+	Lifetime               *uint32               `json:",omitempty"`
+}
+
+// HRStartReq is a handover roaming start request message.
+type HRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload             Buffer
+	ULMetaData             ULMetaData
+	MACVersion             MACVersion
+	DevAddr                DevAddr
+	DeviceProfile          *DeviceProfile `json:",omitempty"`
+	DeviceProfileTimestamp string         `json:",omitempty"`
+	DLSettings             Buffer
+	RxDelay                ttnpb.RxDelay
+	CFList                 Buffer `json:",omitempty"`
+}
+
+// HRStartAns is an answer to a HRStartReq message.
+type HRStartAns struct {
+	NsNsMessageHeader
+	Result         Result
+	PHYPayload     Buffer          `json:",omitempty"`
+	Lifetime       *uint32         `json:",omitempty"`
+	SNwkSIntKey    *KeyEnvelope    `json:",omitempty"`
+	FNwkSIntKey    *KeyEnvelope    `json:",omitempty"`
+	NwkSEncKey     *KeyEnvelope    `json:",omitempty"`
+	NwkSKey        *KeyEnvelope    `json:",omitempty"`
+	DeviceProfile  *DeviceProfile  `json:",omitempty"`
+	ServiceProfile *ServiceProfile `json:",omitempty"`
+	DLMetaData     *DLMetaData     `json:",omitempty"`
+}
+
+// HRStopReq is a handover roaming stop request message.
+type HRStopReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// HRStopAns is an answer to a HRStopReq message.
+type HRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
 // parseMessage parses the header and the message type of the request body.
 // This middleware sets the header in the context on the `headerKey` and the message on the `messageKey`.
 func parseMessage() echo.MiddlewareFunc {
@@ -258,6 +494,30 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &HomeNSReq{}
 			case MessageTypeHomeNSAns:
 				msg = &HomeNSAns{}
+			case MessageTypePRStartReq:
+				msg = &PRStartReq{}
+			case MessageTypePRStartAns:
+				msg = &PRStartAns{}
+			case MessageTypePRStopReq:
+				msg = &PRStopReq{}
+			case MessageTypePRStopAns:
+				msg = &PRStopAns{}
+			case MessageTypeProfileReq:
+				msg = &ProfileReq{}
+			case MessageTypeProfileAns:
+				msg = &ProfileAns{}
+			case MessageTypeHRStartReq:
+				msg = &HRStartReq{}
+			case MessageTypeHRStartAns:
+				msg = &HRStartAns{}
+			case MessageTypeHRStopReq:
+				msg = &HRStopReq{}
+			case MessageTypeHRStopAns:
+				msg = &HRStopAns{}
+			case MessageTypeXmitDataReq:
+				msg = &XmitDataReq{}
+			case MessageTypeXmitDataAns:
+				msg = &XmitDataAns{}
 			default:
 				return ErrMalformedMessage.New()
 			}
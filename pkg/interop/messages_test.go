@@ -431,6 +431,254 @@ func TestParseMessage(t *testing.T) {
 				return a.So(statusCode, should.Equal, http.StatusOK)
 			},
 		},
+		{
+			Name: "ValidPRStartReq",
+			Request: []byte(`{
+				"ProtocolVersion": "1.1",
+				"MessageType": "PRStartReq",
+				"SenderID": "000013",
+				"ReceiverID": "000042",
+				"TransactionID": 42,
+				"PHYPayload": "010203040506",
+				"ULMetaData": {
+					"DevAddr": "01020304",
+					"DataRate": 5,
+					"ULFreq": 868.1,
+					"RecvTime": "2020-09-01T12:00:00Z",
+					"RFRegion": "EU868",
+					"GWCnt": 1,
+					"GWInfo": [
+						{
+							"ID": "0102",
+							"RSSI": -42,
+							"SNR": 5.5,
+							"ULToken": "0102030405060708",
+							"DLAllowed": true
+						}
+					]
+				}
+			}`),
+			RequestHeaderAssertion: func(t *testing.T, header RawMessageHeader) bool {
+				a := assertions.New(t)
+				return a.So(header.MessageType, should.Equal, "PRStartReq")
+			},
+			RequestMessageAssertion: func(t *testing.T, msg interface{}) bool {
+				a := assertions.New(t)
+				dataRate, ulFreq, gwCnt := 5, 868.1, 1
+				rssi, snr := float32(-42), float32(5.5)
+				return a.So(msg, should.Resemble, &PRStartReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: "1.1",
+							MessageType:     MessageTypePRStartReq,
+							TransactionID:   42,
+						},
+						SenderID:   NetID{0x0, 0x0, 0x13},
+						ReceiverID: NetID{0x0, 0x0, 0x42},
+					},
+					PHYPayload: Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6},
+					ULMetaData: ULMetaData{
+						DevAddr:  &DevAddr{0x1, 0x2, 0x3, 0x4},
+						DataRate: &dataRate,
+						ULFreq:   &ulFreq,
+						RecvTime: "2020-09-01T12:00:00Z",
+						RFRegion: "EU868",
+						GWCnt:    &gwCnt,
+						GWInfo: []GWInfoElement{
+							{
+								ID:        Buffer{0x1, 0x2},
+								RSSI:      &rssi,
+								SNR:       &snr,
+								ULToken:   Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
+								DLAllowed: true,
+							},
+						},
+					},
+				})
+			},
+			ResponseAssertion: func(t *testing.T, statusCode int, data []byte) bool {
+				a := assertions.New(t)
+				return a.So(statusCode, should.Equal, http.StatusOK)
+			},
+		},
+		{
+			Name: "ValidXmitDataReq",
+			Request: []byte(`{
+				"ProtocolVersion": "1.1",
+				"MessageType": "XmitDataReq",
+				"SenderID": "000042",
+				"ReceiverID": "000013",
+				"PHYPayload": "010203040506",
+				"DLMetaData": {
+					"DLFreq1": 868.1,
+					"RXDelay1": 1,
+					"ClassMode": "A",
+					"DataRate1": 5,
+					"GWInfo": [
+						{
+							"ULToken": "0102030405060708"
+						}
+					]
+				}
+			}`),
+			RequestHeaderAssertion: func(t *testing.T, header RawMessageHeader) bool {
+				a := assertions.New(t)
+				return a.So(header.MessageType, should.Equal, "XmitDataReq")
+			},
+			RequestMessageAssertion: func(t *testing.T, msg interface{}) bool {
+				a := assertions.New(t)
+				dlFreq1, dataRate1 := 868.1, 5
+				return a.So(msg, should.Resemble, &XmitDataReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: "1.1",
+							MessageType:     MessageTypeXmitDataReq,
+						},
+						SenderID:   NetID{0x0, 0x0, 0x42},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+					PHYPayload: Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6},
+					DLMetaData: &DLMetaData{
+						DLFreq1:   &dlFreq1,
+						RXDelay1:  1,
+						ClassMode: "A",
+						DataRate1: &dataRate1,
+						GWInfo: []GWInfoElement{
+							{
+								ULToken: Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
+							},
+						},
+					},
+				})
+			},
+			ResponseAssertion: func(t *testing.T, statusCode int, data []byte) bool {
+				a := assertions.New(t)
+				return a.So(statusCode, should.Equal, http.StatusOK)
+			},
+		},
+		{
+			Name: "ValidProfileReq",
+			Request: []byte(`{
+				"ProtocolVersion": "1.1",
+				"MessageType": "ProfileReq",
+				"SenderID": "000013",
+				"ReceiverID": "000042",
+				"TransactionID": 42,
+				"DevEUI": "0102030405060708"
+			}`),
+			RequestHeaderAssertion: func(t *testing.T, header RawMessageHeader) bool {
+				a := assertions.New(t)
+				return a.So(header.MessageType, should.Equal, "ProfileReq")
+			},
+			RequestMessageAssertion: func(t *testing.T, msg interface{}) bool {
+				a := assertions.New(t)
+				return a.So(msg, should.Resemble, &ProfileReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: "1.1",
+							MessageType:     MessageTypeProfileReq,
+							TransactionID:   42,
+						},
+						SenderID:   NetID{0x0, 0x0, 0x13},
+						ReceiverID: NetID{0x0, 0x0, 0x42},
+					},
+					DevEUI: EUI64{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
+				})
+			},
+			ResponseAssertion: func(t *testing.T, statusCode int, data []byte) bool {
+				a := assertions.New(t)
+				return a.So(statusCode, should.Equal, http.StatusOK)
+			},
+		},
+		{
+			Name: "ValidHRStartReq",
+			Request: []byte(`{
+				"ProtocolVersion": "1.1",
+				"MessageType": "HRStartReq",
+				"SenderID": "000013",
+				"ReceiverID": "000042",
+				"TransactionID": 42,
+				"PHYPayload": "010203040506",
+				"ULMetaData": {
+					"DevEUI": "0102030405060708",
+					"RecvTime": "2020-09-01T12:00:00Z",
+					"RFRegion": "EU868"
+				},
+				"MACVersion": "1.0.3",
+				"DevAddr": "01020304",
+				"DLSettings": "FF",
+				"RxDelay": 5,
+				"CFList": "010203040506"
+			}`),
+			RequestHeaderAssertion: func(t *testing.T, header RawMessageHeader) bool {
+				a := assertions.New(t)
+				return a.So(header.MessageType, should.Equal, "HRStartReq")
+			},
+			RequestMessageAssertion: func(t *testing.T, msg interface{}) bool {
+				a := assertions.New(t)
+				devEUI := EUI64{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8}
+				return a.So(msg, should.Resemble, &HRStartReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: "1.1",
+							MessageType:     MessageTypeHRStartReq,
+							TransactionID:   42,
+						},
+						SenderID:   NetID{0x0, 0x0, 0x13},
+						ReceiverID: NetID{0x0, 0x0, 0x42},
+					},
+					PHYPayload: Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6},
+					ULMetaData: ULMetaData{
+						DevEUI:   &devEUI,
+						RecvTime: "2020-09-01T12:00:00Z",
+						RFRegion: "EU868",
+					},
+					MACVersion: MACVersion(ttnpb.MAC_V1_0_3),
+					DevAddr:    DevAddr{0x1, 0x2, 0x3, 0x4},
+					DLSettings: Buffer{0xff},
+					RxDelay:    ttnpb.RX_DELAY_5,
+					CFList:     Buffer{0x1, 0x2, 0x3, 0x4, 0x5, 0x6},
+				})
+			},
+			ResponseAssertion: func(t *testing.T, statusCode int, data []byte) bool {
+				a := assertions.New(t)
+				return a.So(statusCode, should.Equal, http.StatusOK)
+			},
+		},
+		{
+			Name: "ValidHRStopReq",
+			Request: []byte(`{
+				"ProtocolVersion": "1.1",
+				"MessageType": "HRStopReq",
+				"SenderID": "000042",
+				"ReceiverID": "000013",
+				"TransactionID": 42,
+				"DevEUI": "0102030405060708"
+			}`),
+			RequestHeaderAssertion: func(t *testing.T, header RawMessageHeader) bool {
+				a := assertions.New(t)
+				return a.So(header.MessageType, should.Equal, "HRStopReq")
+			},
+			RequestMessageAssertion: func(t *testing.T, msg interface{}) bool {
+				a := assertions.New(t)
+				return a.So(msg, should.Resemble, &HRStopReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: "1.1",
+							MessageType:     MessageTypeHRStopReq,
+							TransactionID:   42,
+						},
+						SenderID:   NetID{0x0, 0x0, 0x42},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+					DevEUI: EUI64{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8},
+				})
+			},
+			ResponseAssertion: func(t *testing.T, statusCode int, data []byte) bool {
+				a := assertions.New(t)
+				return a.So(statusCode, should.Equal, http.StatusOK)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			server := echo.New()
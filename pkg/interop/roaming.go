@@ -0,0 +1,238 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// NetIDFQDN constructs the Network Server FQDN using specified NetID under domain
+// according to LoRaWAN Backend Interfaces specification.
+// If domain is empty, LoRaAllianceNetIDDomain is used.
+func NetIDFQDN(netID types.NetID, domain string) string {
+	if domain == "" {
+		domain = LoRaAllianceNetIDDomain
+	}
+	return fmt.Sprintf("%s.%s", hex.EncodeToString(netID[:]), domain)
+}
+
+// NetIDDevAddrPrefix returns the DevAddr prefix of the network identified by netID.
+func NetIDDevAddrPrefix(netID types.NetID) (types.DevAddrPrefix, error) {
+	devAddr, err := types.NewDevAddr(netID, nil)
+	if err != nil {
+		return types.DevAddrPrefix{}, err
+	}
+	return types.DevAddrPrefix{
+		DevAddr: devAddr,
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}, nil
+}
+
+// PassiveRoamingAgreement is a passive roaming agreement with a partner network.
+type PassiveRoamingAgreement struct {
+	// NetID is the NetID of the partner network.
+	NetID types.NetID
+	// Forwarding indicates whether this network forwards uplink messages of end devices of the partner network to the
+	// partner network, i.e. whether this network acts as Forwarding Network Server.
+	Forwarding bool
+	// Home indicates whether end devices of this network may roam in the partner network, i.e. whether this network
+	// acts as Home Network Server for uplink messages forwarded by the partner network.
+	Home bool
+}
+
+// HandoverRoamingAgreement is a handover roaming agreement with a partner network.
+type HandoverRoamingAgreement struct {
+	// NetID is the NetID of the partner network.
+	NetID types.NetID
+	// Serving indicates whether this network takes over the MAC-layer control of end devices of the partner network,
+	// i.e. whether this network acts as Serving Network Server.
+	Serving bool
+	// Home indicates whether end devices of this network may be served by the partner network, i.e. whether this
+	// network acts as Home Network Server.
+	Home bool
+}
+
+type nsRPCPaths struct {
+	HNS string `yaml:"hns"`
+	SNS string `yaml:"sns"`
+	FNS string `yaml:"fns"`
+}
+
+func (p nsRPCPaths) hns() string {
+	if p.HNS == "" {
+		return "hns"
+	}
+	return p.HNS
+}
+
+func (p nsRPCPaths) sns() string {
+	if p.SNS == "" {
+		return "sns"
+	}
+	return p.SNS
+}
+
+func (p nsRPCPaths) fns() string {
+	if p.FNS == "" {
+		return "fns"
+	}
+	return p.FNS
+}
+
+type networkServerHTTPClient struct {
+	Client          http.Client
+	NewRequestFunc  func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error)
+	Protocol        JoinServerProtocol
+	PassiveRoaming  PassiveRoamingAgreement
+	HandoverRoaming HandoverRoamingAgreement
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, netID types.NetID, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	httpReq, err := cl.NewRequestFunc(netID, pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+func (cl networkServerHTTPClient) header(messageType MessageType, header NsNsMessageHeader) NsNsMessageHeader {
+	header.ProtocolVersion = cl.Protocol.BackendInterfacesVersion()
+	header.MessageType = messageType
+	header.ReceiverID = NetID(cl.PassiveRoaming.NetID)
+	return header
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	req.NsNsMessageHeader = cl.header(MessageTypePRStartReq, req.NsNsMessageHeader)
+	interopAns := &PRStartAns{}
+	if err := cl.exchange(ctx, cl.PassiveRoaming.NetID, nsRPCPaths.sns, req, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+// XmitDataRequest performs data transmission request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	req.NsNsMessageHeader = cl.header(MessageTypeXmitDataReq, req.NsNsMessageHeader)
+	// In handover roaming, the Serving Network Server passes the FRMPayload of uplink messages to the Home Network Server.
+	path := nsRPCPaths.fns
+	if len(req.FRMPayload) > 0 && req.ULMetaData != nil {
+		path = nsRPCPaths.hns
+	}
+	interopAns := &XmitDataAns{}
+	if err := cl.exchange(ctx, cl.PassiveRoaming.NetID, path, req, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+// ProfileRequest performs profile request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) ProfileRequest(ctx context.Context, req *ProfileReq) (*ProfileAns, error) {
+	req.NsNsMessageHeader = cl.header(MessageTypeProfileReq, req.NsNsMessageHeader)
+	interopAns := &ProfileAns{}
+	if err := cl.exchange(ctx, cl.HandoverRoaming.NetID, nsRPCPaths.hns, req, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+// HRStartRequest performs handover roaming start request according to LoRaWAN Backend Interfaces specification.
+// The join-request is passed to the Home Network Server, which returns the join-accept and the network session keys.
+// As the Home Network Server does not provide a session key ID, a random session key ID is generated.
+func (cl networkServerHTTPClient) HRStartRequest(ctx context.Context, req *ttnpb.JoinRequest, ulMetaData ULMetaData) (*ttnpb.JoinResponse, error) {
+	dlSettings, err := lorawan.MarshalDLSettings(req.DownlinkSettings)
+	if err != nil {
+		return nil, err
+	}
+	var cfList []byte
+	if req.CFList != nil {
+		cfList, err = lorawan.MarshalCFList(*req.CFList)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	interopAns := &HRStartAns{}
+	if err := cl.exchange(ctx, cl.HandoverRoaming.NetID, nsRPCPaths.hns, &HRStartReq{
+		NsNsMessageHeader: cl.header(MessageTypeHRStartReq, NsNsMessageHeader{
+			SenderID: NetID(req.NetID),
+		}),
+		PHYPayload: Buffer(req.RawPayload),
+		ULMetaData: ulMetaData,
+		MACVersion: MACVersion(req.SelectedMACVersion),
+		DevAddr:    DevAddr(req.DevAddr),
+		DLSettings: Buffer(dlSettings),
+		RxDelay:    req.RxDelay,
+		CFList:     Buffer(cfList),
+	}, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	if len(interopAns.PHYPayload) == 0 {
+		return nil, ErrMalformedMessage.New()
+	}
+
+	sessionKeyID, err := generateSessionKeyID()
+	if err != nil {
+		return nil, err
+	}
+	keys := ttnpb.SessionKeys{
+		SessionKeyID: sessionKeyID,
+		FNwkSIntKey:  (*ttnpb.KeyEnvelope)(interopAns.FNwkSIntKey),
+		SNwkSIntKey:  (*ttnpb.KeyEnvelope)(interopAns.SNwkSIntKey),
+		NwkSEncKey:   (*ttnpb.KeyEnvelope)(interopAns.NwkSEncKey),
+	}
+	if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		keys.FNwkSIntKey = (*ttnpb.KeyEnvelope)(interopAns.NwkSKey)
+		keys.SNwkSIntKey = keys.FNwkSIntKey
+		keys.NwkSEncKey = keys.FNwkSIntKey
+	}
+	return &ttnpb.JoinResponse{
+		RawPayload:  interopAns.PHYPayload,
+		SessionKeys: keys,
+	}, nil
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	if port == 0 {
+		port = defaultHTTPSPort
+	}
+	return func(netID types.NetID, pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		fqdn := fqdn // Create a new reference to fqdn to avoid mutating the variable in the outside scope.
+		if fqdn == "" {
+			fqdn = NetIDFQDN(netID, dns)
+		}
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
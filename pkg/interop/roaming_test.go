@@ -0,0 +1,75 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	. "go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNetIDFQDN(t *testing.T) {
+	for _, tc := range []struct {
+		NetID    types.NetID
+		Domain   string
+		Expected string
+	}{
+		{
+			NetID:    types.NetID{0x00, 0x00, 0x13},
+			Expected: "000013.netids.lora-alliance.org",
+		},
+		{
+			NetID:    types.NetID{0x60, 0x00, 0x2f},
+			Domain:   "netids.example.com",
+			Expected: "60002f.netids.example.com",
+		},
+	} {
+		a := assertions.New(t)
+		a.So(NetIDFQDN(tc.NetID, tc.Domain), should.Equal, tc.Expected)
+	}
+}
+
+func TestNetIDDevAddrPrefix(t *testing.T) {
+	a := assertions.New(t)
+
+	prefix, err := NetIDDevAddrPrefix(types.NetID{0x00, 0x00, 0x13})
+	if a.So(err, should.BeNil) {
+		a.So(prefix, should.Resemble, types.DevAddrPrefix{
+			DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00},
+			Length:  7,
+		})
+		a.So(types.DevAddr{0x26, 0x01, 0x02, 0x03}.HasPrefix(prefix), should.BeTrue)
+		a.So(types.DevAddr{0x28, 0x01, 0x02, 0x03}.HasPrefix(prefix), should.BeFalse)
+	}
+}
+
+func TestRFRegion(t *testing.T) {
+	a := assertions.New(t)
+
+	rfRegion, ok := RFRegion(band.EU_863_870)
+	a.So(ok, should.BeTrue)
+	a.So(rfRegion, should.Equal, "EU868")
+
+	bandID, ok := BandID("US915")
+	a.So(ok, should.BeTrue)
+	a.So(bandID, should.Equal, band.US_902_928)
+
+	_, ok = BandID("XX000")
+	a.So(ok, should.BeFalse)
+}
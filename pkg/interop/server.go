@@ -53,14 +53,21 @@ type JoinServer interface {
 
 // HomeNetworkServer represents a Home Network Server.
 type HomeNetworkServer interface {
+	ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error)
+	HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error)
 }
 
 // ServingNetworkServer represents a Serving Network Server.
+// In passive roaming, the Home Network Server is the Serving Network Server.
 type ServingNetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
+	HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error)
 }
 
 // ForwardingNetworkServer represents a Forwarding Network Server.
 type ForwardingNetworkServer interface {
+	PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
 }
 
 // ApplicationServer represents an Application Server.
@@ -81,6 +88,30 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*HomeNSAns, error)
 	return nil, errNotRegistered.New()
 }
 
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error) {
+	return nil, errNotRegistered.New()
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, errNotRegistered.New()
+}
+
 // Server is the server.
 type Server struct {
 	SenderClientCAs map[string][]*x509.Certificate
@@ -260,12 +291,17 @@ func (s *Server) RegisterAS(as ApplicationServer) {
 	s.as = as
 }
 
-func (s *Server) handleRequest(c echo.Context) error {
+func requestContext(c echo.Context) context.Context {
 	cid := fmt.Sprintf("interop:%s:%s", c.Request().URL.Path, c.Request().Header.Get(echo.HeaderXRequestID))
 	ctx := events.ContextWithCorrelationID(c.Request().Context(), cid)
 	if state := c.Request().TLS; state != nil {
 		ctx = auth.NewContextWithX509DN(ctx, state.PeerCertificates[0].Subject)
 	}
+	return ctx
+}
+
+func (s *Server) handleRequest(c echo.Context) error {
+	ctx := requestContext(c)
 
 	var ans interface{}
 	var err error
@@ -286,6 +322,28 @@ func (s *Server) handleRequest(c echo.Context) error {
 }
 
 func (s *Server) handleNsRequest(c echo.Context) error {
-	// TODO: Implement LoRaWAN roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
-	return echo.NewHTTPError(http.StatusNotFound)
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch req := c.Get(messageKey).(type) {
+	case *PRStartReq:
+		ans, err = s.sNS.PRStartRequest(ctx, req)
+	case *PRStopReq:
+		ans, err = s.fNS.PRStopRequest(ctx, req)
+	case *ProfileReq:
+		ans, err = s.hNS.ProfileRequest(ctx, req)
+	case *HRStartReq:
+		ans, err = s.hNS.HRStartRequest(ctx, req)
+	case *HRStopReq:
+		ans, err = s.sNS.HRStopRequest(ctx, req)
+	case *XmitDataReq:
+		ans, err = s.fNS.XmitDataRequest(ctx, req)
+	default:
+		return ErrMalformedMessage.New()
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
 }
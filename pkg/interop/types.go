@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -260,3 +261,33 @@ func (n *DevAddr) UnmarshalJSON(data []byte) error {
 	copy(n[:], buf)
 	return nil
 }
+
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "AU915",
+	band.CN_470_510: "CN470",
+	band.CN_779_787: "CN779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "IN865",
+	band.ISM_2400:   "ISM2400",
+	band.KR_920_923: "KR920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US915",
+}
+
+// RFRegion returns the RFRegion of the band with the given ID.
+func RFRegion(bandID string) (string, bool) {
+	rfRegion, ok := rfRegions[bandID]
+	return rfRegion, ok
+}
+
+// BandID returns the ID of the band of the given RFRegion.
+func BandID(rfRegion string) (string, bool) {
+	for bandID, r := range rfRegions {
+		if r == rfRegion {
+			return bandID, true
+		}
+	}
+	return "", false
+}
@@ -14,7 +14,11 @@
 
 package joinserver
 
-import "go.thethings.network/lorawan-stack/v3/pkg/types"
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
 
 // Config represents the JoinServer configuration.
 type Config struct {
@@ -23,4 +27,38 @@ type Config struct {
 	ApplicationActivationSettings ApplicationActivationSettingRegistry `name:"-"`
 	JoinEUIPrefixes               []types.EUI64Prefix                  `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
 	DeviceKEKLabel                string                               `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	HSMConfig                     HSMConfig                            `name:"hsm"`
+	// HSM is used for cryptographic operations on end devices without root keys in the registry, if no Crypto Server
+	// is available for the end device. Root keys are labeled with cryptoservices.DefaultHSMKeyLabeler.
+	// If HSM is nil, it is initialized from HSMConfig. Set HSM to inject an HSM that is not supported by HSMConfig.
+	HSM cryptoservices.HSM `name:"-"`
+}
+
+var errUnknownHSMProvider = errors.DefineInvalidArgument("unknown_hsm_provider", "unknown HSM provider `{provider}`")
+
+// HSMConfig represents the HSM configuration.
+type HSMConfig struct {
+	Provider string            `name:"provider" description:"Provider (soft). The HSM is disabled if no provider is set"`
+	Soft     map[string][]byte `name:"soft" description:"Keys (AES-128) by label of the software HSM. For testing only"`
+}
+
+// HSM returns an initialized cryptoservices.HSM based on the configuration.
+// HSM returns nil if no provider is set.
+func (c HSMConfig) HSM() (cryptoservices.HSM, error) {
+	switch c.Provider {
+	case "":
+		return nil, nil
+	case "soft":
+		keys := make(map[string]types.AES128Key, len(c.Soft))
+		for label, raw := range c.Soft {
+			var key types.AES128Key
+			if err := key.UnmarshalBinary(raw); err != nil {
+				return nil, err
+			}
+			keys[label] = key
+		}
+		return cryptoservices.NewSoftHSM(keys), nil
+	default:
+		return nil, errUnknownHSMProvider.WithAttributes("provider", c.Provider)
+	}
 }
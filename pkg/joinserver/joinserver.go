@@ -63,6 +63,8 @@ type JoinServer struct {
 
 	euiPrefixes []types.EUI64Prefix
 
+	hsm cryptoservices.NetworkApplication
+
 	entropyMu *sync.Mutex
 	entropy   io.Reader
 
@@ -96,6 +98,16 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 		entropyMu: &sync.Mutex{},
 		entropy:   ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
 	}
+	hsm := conf.HSM
+	if hsm == nil {
+		var err error
+		if hsm, err = conf.HSMConfig.HSM(); err != nil {
+			return nil, err
+		}
+	}
+	if hsm != nil {
+		js.hsm = cryptoservices.NewHSM(hsm, cryptoservices.DefaultHSMKeyLabeler)
+	}
 
 	js.grpc.applicationActivationSettings = applicationActivationSettingsRegistryServer{
 		JS:       js,
@@ -437,6 +449,15 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			} else if cc != nil && dev.ProvisionerID != "" {
 				applicationCryptoService = cryptoservices.NewApplicationRPCClient(cc, js.KeyVault, js.WithClusterAuth())
 			}
+			if js.hsm != nil {
+				// Root keys that are not in the registry nor in a Crypto Server are in the HSM.
+				if networkCryptoService == nil {
+					networkCryptoService = js.hsm
+				}
+				if applicationCryptoService == nil {
+					applicationCryptoService = js.hsm
+				}
+			}
 			if networkCryptoService == nil {
 				return nil, nil, errNoNwkKey.New()
 			}
@@ -0,0 +1,323 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicerepository implements a payload formatter that resolves the codec of the end device
+// from the Device Repository.
+package devicerepository
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+// CacheConfig is the configuration of the codec cache.
+// Both the resolved codecs and the compiled codec scripts are cached.
+type CacheConfig struct {
+	TTL  time.Duration `name:"ttl" description:"TTL of cached codecs"`
+	Size int           `name:"size" description:"Number of cached codecs"`
+}
+
+type codecKind string
+
+const (
+	uplinkDecoder   codecKind = "uplinkDecoder"
+	downlinkEncoder codecKind = "downlinkEncoder"
+	downlinkDecoder codecKind = "downlinkDecoder"
+)
+
+// model is the end device model definition in the Device Repository.
+type model struct {
+	FirmwareVersions []struct {
+		Version          string   `yaml:"version"`
+		HardwareVersions []string `yaml:"hardwareVersions"`
+		Profiles         map[string]struct {
+			Codec string `yaml:"codec"`
+		} `yaml:"profiles"`
+	} `yaml:"firmwareVersions"`
+}
+
+// codec is the payload codec definition in the Device Repository.
+type codec map[codecKind]struct {
+	FileName string `yaml:"fileName"`
+}
+
+// versionKey identifies an end device version.
+type versionKey struct {
+	brandID, modelID                 string
+	hardwareVersion, firmwareVersion string
+}
+
+// codecKey is the key of the script path of a codec of an end device version in a band.
+type codecKey struct {
+	versionKey
+	bandID string
+	kind   codecKind
+}
+
+// programKey is the key of the compiled codec script of an end device version.
+// Bands that use the same codec share the program.
+type programKey struct {
+	versionKey
+	path string
+	kind codecKind
+}
+
+type host struct {
+	fetcher  fetch.Interface
+	scripts  javascript.Compiler
+	codecs   gcache.Cache
+	programs gcache.Cache
+}
+
+// New returns a new payload formatter that loads the codecs from the Device Repository using the given fetcher.
+// The codecs are executed with the JavaScript payload formatter.
+// Resolved codecs and compiled codec scripts are cached according to the given configuration;
+// if the TTL is zero, codecs are not cached.
+func New(fetcher fetch.Interface, conf CacheConfig) messageprocessors.PayloadEncodeDecoder {
+	h := &host{
+		fetcher: fetcher,
+		scripts: javascript.NewCompiler(),
+	}
+	if conf.TTL > 0 {
+		newCache := func() gcache.Cache {
+			var builder *gcache.CacheBuilder
+			if conf.Size > 0 {
+				builder = gcache.New(conf.Size).LRU()
+			} else {
+				builder = gcache.New(-1)
+			}
+			return builder.Expiration(conf.TTL).Build()
+		}
+		h.codecs, h.programs = newCache(), newCache()
+	}
+	return h
+}
+
+var (
+	errNoVersionIdentifiers = errors.DefineFailedPrecondition("no_version_identifiers", "no end device version identifiers")
+	errModel                = errors.DefineNotFound("model", "model `{brand_id}/{model_id}` not found")
+	errFirmwareVersion      = errors.DefineNotFound("firmware_version", "firmware version `{firmware_version}` of model `{brand_id}/{model_id}` not found")
+	errBand                 = errors.DefineNotFound("band", "band `{band_id}` of model `{brand_id}/{model_id}` not found")
+	errAmbiguousCodec       = errors.DefineFailedPrecondition("ambiguous_codec", "codec of model `{brand_id}/{model_id}` depends on the band, but no band is specified")
+	errNoCodec              = errors.DefineNotFound("no_codec", "no codec for model `{brand_id}/{model_id}`")
+	errCodecDefinition      = errors.DefineCorruption("codec_definition", "invalid codec definition `{codec_id}`")
+	errNoScript             = errors.DefineNotFound("no_script", "no `{kind}` script in codec `{codec_id}`")
+)
+
+// repositoryBandID returns the band ID as used in the Device Repository.
+// For example, EU_863_870 becomes EU863-870 and AS_923 becomes AS923.
+func repositoryBandID(bandID string) string {
+	parts := strings.Split(bandID, "_")
+	if len(parts) < 2 {
+		return bandID
+	}
+	return parts[0] + strings.Join(parts[1:], "-")
+}
+
+func (h *host) codecID(version *ttnpb.EndDeviceVersionIdentifiers, bandID string) (string, error) {
+	attributes := []interface{}{
+		"brand_id", version.BrandID,
+		"model_id", version.ModelID,
+	}
+	b, err := h.fetcher.File("vendor", version.BrandID, fmt.Sprintf("%s.yaml", version.ModelID))
+	if err != nil {
+		return "", errModel.WithAttributes(attributes...).WithCause(err)
+	}
+	var m model
+	if err := yaml.Unmarshal(b, &m); err != nil {
+		return "", errModel.WithAttributes(attributes...).WithCause(err)
+	}
+	for _, fw := range m.FirmwareVersions {
+		if fw.Version != version.FirmwareVersion {
+			continue
+		}
+		if version.HardwareVersion != "" && len(fw.HardwareVersions) > 0 {
+			var found bool
+			for _, hw := range fw.HardwareVersions {
+				if hw == version.HardwareVersion {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+		if bandID != "" {
+			profile, ok := fw.Profiles[repositoryBandID(bandID)]
+			if !ok {
+				return "", errBand.WithAttributes(append(attributes, "band_id", bandID)...)
+			}
+			if profile.Codec == "" {
+				return "", errNoCodec.WithAttributes(attributes...)
+			}
+			return profile.Codec, nil
+		}
+		// Without band, the codec can only be resolved if all profiles use the same codec.
+		var codecID string
+		for _, profile := range fw.Profiles {
+			if codecID != "" && profile.Codec != codecID {
+				return "", errAmbiguousCodec.WithAttributes(attributes...)
+			}
+			codecID = profile.Codec
+		}
+		if codecID == "" {
+			return "", errNoCodec.WithAttributes(attributes...)
+		}
+		return codecID, nil
+	}
+	return "", errFirmwareVersion.WithAttributes(append(attributes, "firmware_version", version.FirmwareVersion)...)
+}
+
+// loadCodecPath returns the path of the script of the given kind of the codec of the end device version.
+func (h *host) loadCodecPath(version *ttnpb.EndDeviceVersionIdentifiers, bandID string, kind codecKind) (string, error) {
+	codecID, err := h.codecID(version, bandID)
+	if err != nil {
+		return "", err
+	}
+	b, err := h.fetcher.File("vendor", version.BrandID, fmt.Sprintf("%s.yaml", codecID))
+	if err != nil {
+		return "", err
+	}
+	var c codec
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return "", errCodecDefinition.WithAttributes("codec_id", codecID).WithCause(err)
+	}
+	def, ok := c[kind]
+	if !ok || def.FileName == "" {
+		return "", errNoScript.WithAttributes(
+			"kind", string(kind),
+			"codec_id", codecID,
+		)
+	}
+	return def.FileName, nil
+}
+
+func (h *host) codecPath(version *ttnpb.EndDeviceVersionIdentifiers, bandID string, kind codecKind) (string, error) {
+	if h.codecs == nil {
+		return h.loadCodecPath(version, bandID, kind)
+	}
+	key := codecKey{
+		versionKey: versionKeyOf(version),
+		bandID:     bandID,
+		kind:       kind,
+	}
+	if v, err := h.codecs.Get(key); err == nil {
+		return v.(string), nil
+	}
+	path, err := h.loadCodecPath(version, bandID, kind)
+	if err != nil {
+		return "", err
+	}
+	if err := h.codecs.Set(key, path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func versionKeyOf(version *ttnpb.EndDeviceVersionIdentifiers) versionKey {
+	return versionKey{
+		brandID:         version.BrandID,
+		modelID:         version.ModelID,
+		hardwareVersion: version.HardwareVersion,
+		firmwareVersion: version.FirmwareVersion,
+	}
+}
+
+// loadProgram fetches the script at the given path and compiles it for the given kind.
+func (h *host) loadProgram(ctx context.Context, version *ttnpb.EndDeviceVersionIdentifiers, path string, kind codecKind) (*javascript.Program, error) {
+	script, err := h.fetcher.File("vendor", version.BrandID, path)
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case uplinkDecoder:
+		return h.scripts.CompileDecodeUplink(ctx, string(script))
+	case downlinkEncoder:
+		return h.scripts.CompileEncodeDownlink(ctx, string(script))
+	default:
+		return h.scripts.CompileDecodeDownlink(ctx, string(script))
+	}
+}
+
+// program returns the compiled script of the given kind for the end device version.
+// The parameter of the formatter optionally specifies the band ID (i.e. EU_863_870).
+func (h *host) program(ctx context.Context, version *ttnpb.EndDeviceVersionIdentifiers, bandID string, kind codecKind) (*javascript.Program, error) {
+	defer trace.StartRegion(ctx, "load device repository program").End()
+
+	if version == nil || version.BrandID == "" || version.ModelID == "" {
+		return nil, errNoVersionIdentifiers.New()
+	}
+	path, err := h.codecPath(version, bandID, kind)
+	if err != nil {
+		return nil, err
+	}
+	if h.programs == nil {
+		return h.loadProgram(ctx, version, path, kind)
+	}
+	key := programKey{
+		versionKey: versionKeyOf(version),
+		path:       path,
+		kind:       kind,
+	}
+	if v, err := h.programs.Get(key); err == nil {
+		return v.(*javascript.Program), nil
+	}
+	program, err := h.loadProgram(ctx, version, path, kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.programs.Set(key, program); err != nil {
+		return nil, err
+	}
+	return program, nil
+}
+
+// EncodeDownlink implements messageprocessors.PayloadEncodeDecoder.
+func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	program, err := h.program(ctx, version, parameter, downlinkEncoder)
+	if err != nil {
+		return err
+	}
+	return h.scripts.EncodeDownlinkProgram(ctx, ids, version, msg, program)
+}
+
+// DecodeUplink implements messageprocessors.PayloadEncodeDecoder.
+func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	program, err := h.program(ctx, version, parameter, uplinkDecoder)
+	if err != nil {
+		return err
+	}
+	return h.scripts.DecodeUplinkProgram(ctx, ids, version, msg, program)
+}
+
+// DecodeDownlink implements messageprocessors.PayloadEncodeDecoder.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	program, err := h.program(ctx, version, parameter, downlinkDecoder)
+	if err != nil {
+		return err
+	}
+	return h.scripts.DecodeDownlinkProgram(ctx, ids, version, msg, program)
+}
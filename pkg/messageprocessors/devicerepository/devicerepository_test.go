@@ -0,0 +1,274 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository_test
+
+import (
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	. "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var repository = map[string][]byte{
+	"vendor/acme/sensor.yaml": []byte(`firmwareVersions:
+  - version: '1.0'
+    hardwareVersions:
+      - '1.0'
+    profiles:
+      EU863-870:
+        codec: sensor-codec
+      US902-928:
+        codec: sensor-codec
+  - version: '2.0'
+    profiles:
+      EU863-870:
+        codec: sensor-codec
+      US902-928:
+        codec: sensor-us-codec
+`),
+	"vendor/acme/sensor-codec.yaml": []byte(`uplinkDecoder:
+  fileName: sensor.js
+downlinkEncoder:
+  fileName: sensor.js
+`),
+	"vendor/acme/sensor-us-codec.yaml": []byte(`uplinkDecoder:
+  fileName: sensor-us.js
+`),
+	"vendor/acme/sensor.js": []byte(`
+function decodeUplink(input) {
+  return { data: { temperature: input.bytes[0] } };
+}
+
+function encodeDownlink(input) {
+  return { bytes: [input.data.setpoint], fPort: 2 };
+}
+`),
+	"vendor/acme/sensor-us.js": []byte(`
+function decodeUplink(input) {
+  return { data: { temperature: input.bytes[0] * 2 } };
+}
+`),
+}
+
+func TestDeviceRepository(t *testing.T) {
+	ctx := test.Context()
+	host := New(fetch.NewMemFetcher(repository), CacheConfig{
+		TTL:  time.Minute,
+		Size: 16,
+	})
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name           string
+			Version        *ttnpb.EndDeviceVersionIdentifiers
+			Parameter      string
+			Temperature    float64
+			ErrorAssertion func(error) bool
+		}{
+			{
+				Name:           "NoVersion",
+				ErrorAssertion: errors.IsFailedPrecondition,
+			},
+			{
+				Name: "UnknownModel",
+				Version: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandID:         "acme",
+					ModelID:         "unknown",
+					FirmwareVersion: "1.0",
+				},
+				ErrorAssertion: errors.IsNotFound,
+			},
+			{
+				Name: "UnknownFirmwareVersion",
+				Version: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandID:         "acme",
+					ModelID:         "sensor",
+					FirmwareVersion: "3.0",
+				},
+				ErrorAssertion: errors.IsNotFound,
+			},
+			{
+				Name: "SameCodecForAllBands",
+				Version: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandID:         "acme",
+					ModelID:         "sensor",
+					HardwareVersion: "1.0",
+					FirmwareVersion: "1.0",
+				},
+				Temperature: 21,
+			},
+			{
+				Name: "AmbiguousCodec",
+				Version: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandID:         "acme",
+					ModelID:         "sensor",
+					FirmwareVersion: "2.0",
+				},
+				ErrorAssertion: errors.IsFailedPrecondition,
+			},
+			{
+				Name: "Band",
+				Version: &ttnpb.EndDeviceVersionIdentifiers{
+					BrandID:         "acme",
+					ModelID:         "sensor",
+					FirmwareVersion: "2.0",
+				},
+				Parameter:   "US_902_928",
+				Temperature: 42,
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				msg := &ttnpb.ApplicationUplink{
+					FPort:      1,
+					FRMPayload: []byte{21},
+				}
+				err := host.DecodeUplink(ctx, ids, tc.Version, msg, tc.Parameter)
+				if tc.ErrorAssertion != nil {
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					return
+				}
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {
+							Kind: &pbtypes.Value_NumberValue{NumberValue: tc.Temperature},
+						},
+					},
+				})
+			})
+		}
+	})
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"setpoint": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 20},
+					},
+				},
+			},
+		}
+		err := host.EncodeDownlink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "acme",
+			ModelID:         "sensor",
+			FirmwareVersion: "1.0",
+		}, msg, "")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.FRMPayload, should.Resemble, []byte{20})
+		a.So(msg.FPort, should.Equal, uint32(2))
+	})
+
+	t.Run("NoScript", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeDownlink(ctx, ids, &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "acme",
+			ModelID:         "sensor",
+			FirmwareVersion: "1.0",
+		}, &ttnpb.ApplicationDownlink{}, "")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+}
+
+// countingFetcher counts the fetches per file.
+type countingFetcher struct {
+	fetch.Interface
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (f *countingFetcher) File(pathElements ...string) ([]byte, error) {
+	f.mu.Lock()
+	f.counts[path.Join(pathElements...)]++
+	f.mu.Unlock()
+	return f.Interface.File(pathElements...)
+}
+
+func TestDeviceRepositoryCache(t *testing.T) {
+	ctx := test.Context()
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "acme",
+		ModelID:         "sensor",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0",
+	}
+
+	for _, tc := range []struct {
+		Name          string
+		Config        CacheConfig
+		ModelFetches  int
+		ScriptFetches int
+	}{
+		{
+			Name:          "NoCache",
+			ModelFetches:  3,
+			ScriptFetches: 3,
+		},
+		{
+			Name: "Cache",
+			Config: CacheConfig{
+				TTL:  time.Minute,
+				Size: 16,
+			},
+			// The codec is resolved once per band, and the bands share the compiled script.
+			ModelFetches:  2,
+			ScriptFetches: 1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			fetcher := &countingFetcher{
+				Interface: fetch.NewMemFetcher(repository),
+				counts:    make(map[string]int),
+			}
+			host := New(fetcher, tc.Config)
+			for _, bandID := range []string{"EU_863_870", "EU_863_870", "US_902_928"} {
+				msg := &ttnpb.ApplicationUplink{
+					FPort:      1,
+					FRMPayload: []byte{21},
+				}
+				if !a.So(host.DecodeUplink(ctx, ids, version, msg, bandID), should.BeNil) {
+					t.FailNow()
+				}
+				a.So(msg.DecodedPayload.Fields["temperature"].GetNumberValue(), should.Equal, 21)
+			}
+			a.So(fetcher.counts["vendor/acme/sensor.yaml"], should.Equal, tc.ModelFetches)
+			a.So(fetcher.counts["vendor/acme/sensor.js"], should.Equal, tc.ScriptFetches)
+		})
+	}
+}
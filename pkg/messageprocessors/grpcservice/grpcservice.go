@@ -0,0 +1,295 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcservice contains the payload formatter message processors that call an external gRPC service.
+package grpcservice
+
+import (
+	"context"
+	"path"
+	"runtime/trace"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Config is the configuration of the gRPC service payload formatter.
+type Config struct {
+	Timeout          time.Duration        `name:"timeout" description:"Timeout of requests to payload formatter services"`
+	Insecure         bool                 `name:"insecure" description:"Connect to payload formatter services without TLS"`
+	TLS              tlsconfig.ClientAuth `name:"tls" description:"TLS client authentication to payload formatter services (optional)"`
+	AllowedAddresses []string             `name:"allowed-addresses" description:"Addresses of payload formatter services that may be used, as host:port patterns with * wildcards"`
+	MaxConnections   int                  `name:"max-connections" description:"Maximum number of connections to payload formatter services"`
+}
+
+const defaultMaxConnections = 128
+
+type host struct {
+	ctx       context.Context
+	component *component.Component
+	config    Config
+
+	connsMu sync.Mutex
+	conns   gcache.Cache
+}
+
+// conn is a pooled connection to a payload formatter service.
+// The connection is closed when it is evicted from the pool and no calls are in flight.
+type conn struct {
+	*grpc.ClientConn
+	address string
+
+	// refs is the number of calls in flight. refs and evicted are guarded by the connsMu of the host.
+	refs    int
+	evicted bool
+}
+
+func closeConn(ctx context.Context, address string, conn *grpc.ClientConn) {
+	if err := conn.Close(); err != nil {
+		log.FromContext(ctx).WithError(err).WithField("address", address).Warn("Failed to close payload formatter service connection")
+	}
+}
+
+// New returns a new payload formatter that calls the ttnpb.MessageProcessor gRPC service at the address that is
+// given as formatter parameter. Only addresses matching one of the allowed addresses are dialed.
+// Connections are pooled per address, up to the maximum number of connections. The least recently used connection
+// is evicted when the pool is full. All connections are evicted when the given context is done.
+// Evicted connections are closed when the calls in flight on them are finished.
+func New(ctx context.Context, c *component.Component, conf Config) messageprocessors.PayloadEncodeDecoder {
+	size := conf.MaxConnections
+	if size <= 0 {
+		size = defaultMaxConnections
+	}
+	// The pool is only modified while holding connsMu, so evictions are guarded by connsMu as well.
+	closeFunc := func(key, value interface{}) {
+		c := value.(*conn)
+		c.evicted = true
+		if c.refs == 0 {
+			closeConn(ctx, c.address, c.ClientConn)
+		}
+	}
+	h := &host{
+		ctx:       ctx,
+		component: c,
+		config:    conf,
+		conns: gcache.New(size).LRU().
+			EvictedFunc(closeFunc).
+			PurgeVisitorFunc(closeFunc).
+			Build(),
+	}
+	go func() {
+		<-ctx.Done()
+		h.connsMu.Lock()
+		defer h.connsMu.Unlock()
+		h.conns.Purge()
+	}()
+	return h
+}
+
+var (
+	errNoAddress         = errors.DefineInvalidArgument("no_address", "no payload formatter service address")
+	errAddressNotAllowed = errors.DefinePermissionDenied("address_not_allowed", "payload formatter service address `{address}` is not allowed")
+	errDial              = errors.DefineUnavailable("dial", "dial payload formatter service `{address}`")
+	errService           = errors.DefineAborted("service", "payload formatter service `{address}` failed")
+)
+
+// allowed returns whether the address matches one of the allowed addresses.
+func (h *host) allowed(address string) bool {
+	for _, pattern := range h.config.AllowedAddresses {
+		if ok, err := path.Match(pattern, address); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *host) dialOptions(ctx context.Context) ([]grpc.DialOption, error) {
+	opts := rpcclient.DefaultDialOptions(ctx)
+	if h.config.Insecure {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+	tlsConfig, err := h.component.GetTLSClientConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if conf := h.config.TLS; conf.Source != "" {
+		if conf.Source == "key-vault" {
+			conf.KeyVault.KeyVault = h.component.KeyVault
+		}
+		if err := conf.ApplyTo(tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+	return append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))), nil
+}
+
+// client returns a client for the service at the given address, reusing an existing connection if possible.
+// The returned release func must be called when the calls on the client are finished.
+func (h *host) client(address string) (ttnpb.MessageProcessorClient, func(), error) {
+	if address == "" {
+		return nil, nil, errNoAddress.New()
+	}
+	if !h.allowed(address) {
+		return nil, nil, errAddressNotAllowed.WithAttributes("address", address)
+	}
+	h.connsMu.Lock()
+	defer h.connsMu.Unlock()
+	if v, err := h.conns.Get(address); err == nil {
+		cl, release := h.acquire(v.(*conn))
+		return cl, release, nil
+	}
+	opts, err := h.dialOptions(h.ctx)
+	if err != nil {
+		return nil, nil, errDial.WithAttributes("address", address).WithCause(err)
+	}
+	// The connection is established in the background; it is reused and reconnected by gRPC as needed.
+	cc, err := grpc.DialContext(h.ctx, address, opts...)
+	if err != nil {
+		return nil, nil, errDial.WithAttributes("address", address).WithCause(err)
+	}
+	c := &conn{
+		ClientConn: cc,
+		address:    address,
+	}
+	if err := h.conns.Set(address, c); err != nil {
+		closeConn(h.ctx, address, cc)
+		return nil, nil, errDial.WithAttributes("address", address).WithCause(err)
+	}
+	cl, release := h.acquire(c)
+	return cl, release, nil
+}
+
+// acquire references the connection until the returned release func is called.
+// The caller must hold connsMu.
+func (h *host) acquire(c *conn) (ttnpb.MessageProcessorClient, func()) {
+	c.refs++
+	release := func() {
+		h.connsMu.Lock()
+		defer h.connsMu.Unlock()
+		if c.refs--; c.refs == 0 && c.evicted {
+			closeConn(h.ctx, c.address, c.ClientConn)
+		}
+	}
+	return ttnpb.NewMessageProcessorClient(c.ClientConn), release
+}
+
+func (h *host) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if h.config.Timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, h.config.Timeout)
+}
+
+func versionIDs(version *ttnpb.EndDeviceVersionIdentifiers) ttnpb.EndDeviceVersionIdentifiers {
+	if version == nil {
+		return ttnpb.EndDeviceVersionIdentifiers{}
+	}
+	return *version
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the service at the given address.
+func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, address string) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	if msg.DecodedPayload == nil {
+		return nil
+	}
+	cl, release, err := h.client(address)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := h.withTimeout(ctx)
+	defer cancel()
+	res, err := cl.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            address,
+	})
+	if err != nil {
+		return errService.WithAttributes("address", address).WithCause(err)
+	}
+	msg.FRMPayload = res.FRMPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	if res.FPort != 0 {
+		msg.FPort = res.FPort
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, address string) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	cl, release, err := h.client(address)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := h.withTimeout(ctx)
+	defer cancel()
+	res, err := cl.DecodeUplink(ctx, &ttnpb.DecodeUplinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            address,
+	})
+	if err != nil {
+		return errService.WithAttributes("address", address).WithCause(err)
+	}
+	msg.DecodedPayload = res.DecodedPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	return nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, address string) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	cl, release, err := h.client(address)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ctx, cancel := h.withTimeout(ctx)
+	defer cancel()
+	res, err := cl.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		EndDeviceVersionIDs:  versionIDs(version),
+		Message:              *msg,
+		Formatter:            ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		Parameter:            address,
+	})
+	if err != nil {
+		return errService.WithAttributes("address", address).WithCause(err)
+	}
+	msg.DecodedPayload = res.DecodedPayload
+	msg.DecodedPayloadWarnings = res.DecodedPayloadWarnings
+	return nil
+}
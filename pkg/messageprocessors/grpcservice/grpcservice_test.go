@@ -0,0 +1,222 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var errDecode = errors.DefineInvalidArgument("decode", "decode failed")
+
+type mockMessageProcessor struct {
+	mu    sync.Mutex
+	delay time.Duration
+}
+
+func (m *mockMessageProcessor) setDelay(d time.Duration) {
+	m.mu.Lock()
+	m.delay = d
+	m.mu.Unlock()
+}
+
+func (m *mockMessageProcessor) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	setpoint := req.Message.DecodedPayload.Fields["setpoint"].GetNumberValue()
+	return &ttnpb.ApplicationDownlink{
+		FPort:      2,
+		FRMPayload: []byte{byte(setpoint)},
+	}, nil
+}
+
+func (m *mockMessageProcessor) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkMessageRequest) (*ttnpb.ApplicationUplink, error) {
+	m.mu.Lock()
+	delay := m.delay
+	m.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(delay):
+	}
+	if len(req.Message.FRMPayload) == 0 {
+		return nil, errDecode.New()
+	}
+	return &ttnpb.ApplicationUplink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"temperature": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: float64(req.Message.FRMPayload[0])},
+				},
+			},
+		},
+		DecodedPayloadWarnings: []string{"test"},
+	}, nil
+}
+
+func (m *mockMessageProcessor) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkMessageRequest) (*ttnpb.ApplicationDownlink, error) {
+	return nil, errDecode.New()
+}
+
+func TestGRPCService(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	mock := &mockMessageProcessor{}
+	serve := func() string {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to listen: %v", err)
+		}
+		srv := grpc.NewServer(grpc.UnaryInterceptor(errors.UnaryServerInterceptor()))
+		ttnpb.RegisterMessageProcessorServer(srv, mock)
+		go srv.Serve(lis)
+		go func() {
+			<-ctx.Done()
+			srv.Stop()
+		}()
+		return lis.Addr().String()
+	}
+	address, otherAddress := serve(), serve()
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	host := New(ctx, c, Config{
+		Timeout:          (1 << 8) * test.Delay,
+		Insecure:         true,
+		AllowedAddresses: []string{address, otherAddress},
+		MaxConnections:   1,
+	})
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+
+	t.Run("NoAddress", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{FRMPayload: []byte{21}}, "")
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("AddressNotAllowed", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{FRMPayload: []byte{21}}, "169.254.169.254:80")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("DecodeUplink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      1,
+			FRMPayload: []byte{21},
+		}
+		err := host.DecodeUplink(ctx, ids, nil, msg, address)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"temperature": {
+					Kind: &pbtypes.Value_NumberValue{NumberValue: 21},
+				},
+			},
+		})
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"test"})
+		a.So(msg.FRMPayload, should.Resemble, []byte{21})
+	})
+
+	t.Run("DecodeUplinkError", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{FPort: 1}, address)
+		a.So(errors.IsAborted(err), should.BeTrue)
+		a.So(errors.Resemble(errors.Cause(err), errDecode), should.BeTrue)
+	})
+
+	t.Run("DecodeUplinkTimeout", func(t *testing.T) {
+		a := assertions.New(t)
+		mock.setDelay((1 << 10) * test.Delay)
+		defer mock.setDelay(0)
+		err := host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{FPort: 1, FRMPayload: []byte{21}}, address)
+		a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
+	})
+
+	t.Run("EncodeDownlink", func(t *testing.T) {
+		a := assertions.New(t)
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"setpoint": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 20},
+					},
+				},
+			},
+		}
+		err := host.EncodeDownlink(ctx, ids, nil, msg, address)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(msg.FRMPayload, should.Resemble, []byte{20})
+		a.So(msg.FPort, should.Equal, uint32(2))
+	})
+
+	t.Run("DecodeDownlinkError", func(t *testing.T) {
+		a := assertions.New(t)
+		err := host.DecodeDownlink(ctx, ids, nil, &ttnpb.ApplicationDownlink{FPort: 1}, address)
+		a.So(errors.IsAborted(err), should.BeTrue)
+	})
+
+	t.Run("EvictConnection", func(t *testing.T) {
+		a := assertions.New(t)
+		// The pool holds one connection, so every call to the other address evicts the connection of the previous one.
+		for _, address := range []string{otherAddress, address, otherAddress} {
+			msg := &ttnpb.ApplicationUplink{
+				FPort:      1,
+				FRMPayload: []byte{42},
+			}
+			if !a.So(host.DecodeUplink(ctx, ids, nil, msg, address), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(msg.DecodedPayload.Fields["temperature"].GetNumberValue(), should.Equal, 42)
+		}
+	})
+
+	t.Run("EvictConnectionInUse", func(t *testing.T) {
+		a := assertions.New(t)
+		mock.setDelay((1 << 5) * test.Delay)
+		defer mock.setDelay(0)
+		// The connection of the call in flight is evicted by the call to the other address, but it must not be closed
+		// before the call in flight is finished.
+		errCh := make(chan error, 2)
+		for _, address := range []string{address, otherAddress} {
+			go func(address string) {
+				errCh <- host.DecodeUplink(ctx, ids, nil, &ttnpb.ApplicationUplink{FPort: 1, FRMPayload: []byte{42}}, address)
+			}(address)
+			time.Sleep((1 << 3) * test.Delay)
+		}
+		for i := 0; i < 2; i++ {
+			a.So(<-errCh, should.BeNil)
+		}
+	})
+}
@@ -30,16 +30,47 @@ import (
 )
 
 type host struct {
-	engine scripting.Engine
+	engine scripting.ProgramEngine
 }
 
 // New creates and returns a new Javascript payload encoder and decoder.
 func New() messageprocessors.PayloadEncodeDecoder {
+	return NewCompiler()
+}
+
+// Program is a compiled payload formatter script.
+type Program struct {
+	program scripting.Program
+}
+
+// Compiler is a Javascript payload encoder and decoder that compiles scripts to programs.
+// Programs can be cached and run concurrently, so that scripts that are run repeatedly are only compiled once.
+// A program must be run with the method that corresponds to the method that compiled it.
+type Compiler interface {
+	messageprocessors.PayloadEncodeDecoder
+	CompileEncodeDownlink(ctx context.Context, script string) (*Program, error)
+	CompileDecodeUplink(ctx context.Context, script string) (*Program, error)
+	CompileDecodeDownlink(ctx context.Context, script string) (*Program, error)
+	EncodeDownlinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, program *Program) error
+	DecodeUplinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, program *Program) error
+	DecodeDownlinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, program *Program) error
+}
+
+// NewCompiler creates and returns a new Javascript payload encoder and decoder that compiles scripts to programs.
+func NewCompiler() Compiler {
 	return &host{
 		engine: js.New(scripting.DefaultOptions),
 	}
 }
 
+func (h *host) compile(ctx context.Context, script string) (*Program, error) {
+	program, err := h.engine.Compile(ctx, script)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{program: program}, nil
+}
+
 type encodeDownlinkInput struct {
 	Data  map[string]interface{} `json:"data"`
 	FPort *uint8                 `json:"fPort"`
@@ -60,24 +91,20 @@ var (
 
 // EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the given script.
 func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
-	defer trace.StartRegion(ctx, "encode downlink message").End()
-
-	decoded := msg.DecodedPayload
-	if decoded == nil {
+	if msg.DecodedPayload == nil {
 		return nil
 	}
-	data, err := gogoproto.Map(decoded)
+	program, err := h.CompileEncodeDownlink(ctx, script)
 	if err != nil {
-		return errInput.WithCause(err)
-	}
-	fPort := uint8(msg.FPort)
-	input := encodeDownlinkInput{
-		Data:  data,
-		FPort: &fPort,
+		return err
 	}
+	return h.EncodeDownlinkProgram(ctx, ids, version, msg, program)
+}
 
+// CompileEncodeDownlink compiles the given script for EncodeDownlinkProgram.
+func (h *host) CompileEncodeDownlink(ctx context.Context, script string) (*Program, error) {
 	// Fallback to legacy Encoder() function for backwards compatibility with The Things Network Stack V2 payload functions.
-	script = fmt.Sprintf(`
+	return h.compile(ctx, fmt.Sprintf(`
 		%s
 
 		function main(input) {
@@ -89,8 +116,28 @@ func (h *host) EncodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifier
 				fPort: input.fPort
 			}
 		}
-	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	`, script))
+}
+
+// EncodeDownlinkProgram encodes the message's DecodedPayload to FRMPayload using the given program.
+func (h *host) EncodeDownlinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, program *Program) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	data, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	fPort := uint8(msg.FPort)
+	input := encodeDownlinkInput{
+		Data:  data,
+		FPort: &fPort,
+	}
+
+	valueAs, err := h.engine.RunProgram(ctx, program.program, "main", input)
 	if err != nil {
 		return err
 	}
@@ -128,15 +175,17 @@ type decodeUplinkOutput struct {
 
 // DecodeUplink decodes the message's FRMPayload to DecodedPayload using the given script.
 func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, script string) error {
-	defer trace.StartRegion(ctx, "decode uplink message").End()
-
-	input := decodeUplinkInput{
-		Bytes: msg.FRMPayload,
-		FPort: uint8(msg.FPort),
+	program, err := h.CompileDecodeUplink(ctx, script)
+	if err != nil {
+		return err
 	}
+	return h.DecodeUplinkProgram(ctx, ids, version, msg, program)
+}
 
+// CompileDecodeUplink compiles the given script for DecodeUplinkProgram.
+func (h *host) CompileDecodeUplink(ctx context.Context, script string) (*Program, error) {
 	// Fallback to legacy Decoder() function for backwards compatibility with The Things Network Stack V2 payload functions.
-	script = fmt.Sprintf(`
+	return h.compile(ctx, fmt.Sprintf(`
 		%s
 
 		function main(input) {
@@ -147,8 +196,19 @@ func (h *host) DecodeUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers,
 				data: Decoder(input.bytes, input.fPort)
 			}
 		}
-	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	`, script))
+}
+
+// DecodeUplinkProgram decodes the message's FRMPayload to DecodedPayload using the given program.
+func (h *host) DecodeUplinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, program *Program) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	input := decodeUplinkInput{
+		Bytes: msg.FRMPayload,
+		FPort: uint8(msg.FPort),
+	}
+
+	valueAs, err := h.engine.RunProgram(ctx, program.program, "main", input)
 	if err != nil {
 		return err
 	}
@@ -182,23 +242,36 @@ type decodeDownlinkOutput struct {
 	Errors   []string               `json:"errors"`
 }
 
-// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the given script.
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the given script.
 func (h *host) DecodeDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
-	defer trace.StartRegion(ctx, "decode downlink message").End()
-
-	input := decodeDownlinkInput{
-		Bytes: msg.FRMPayload,
-		FPort: uint8(msg.FPort),
+	program, err := h.CompileDecodeDownlink(ctx, script)
+	if err != nil {
+		return err
 	}
+	return h.DecodeDownlinkProgram(ctx, ids, version, msg, program)
+}
 
-	script = fmt.Sprintf(`
+// CompileDecodeDownlink compiles the given script for DecodeDownlinkProgram.
+func (h *host) CompileDecodeDownlink(ctx context.Context, script string) (*Program, error) {
+	return h.compile(ctx, fmt.Sprintf(`
 		%s
 
 		function main(input) {
 			return decodeDownlink(input);
 		}
-	`, script)
-	valueAs, err := h.engine.Run(ctx, script, "main", input)
+	`, script))
+}
+
+// DecodeDownlinkProgram decodes the message's FRMPayload to DecodedPayload using the given program.
+func (h *host) DecodeDownlinkProgram(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, program *Program) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	input := decodeDownlinkInput{
+		Bytes: msg.FRMPayload,
+		FPort: uint8(msg.FPort),
+	}
+
+	valueAs, err := h.engine.RunProgram(ctx, program.program, "main", input)
 	if err != nil {
 		return err
 	}
@@ -42,6 +42,7 @@ type MACSettingConfig struct {
 	ClassCTimeout              *time.Duration             `name:"class-c-timeout" description:"Deadline for a device in class C mode to respond to requests from the Network Server if not configured in device's MAC settings"`
 	StatusTimePeriodicity      *time.Duration             `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 	StatusCountPeriodicity     *uint32                    `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+	ADRAlgorithm               string                     `name:"adr-algorithm" description:"The default ADR algorithm Network Server should use if not configured in device's MAC settings (snr-margin, loss-aware, static)"`
 }
 
 // Parse parses the configuration and returns ttnpb.MACSettings.
@@ -50,6 +51,7 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 		ClassBTimeout:         c.ClassBTimeout,
 		ClassCTimeout:         c.ClassCTimeout,
 		StatusTimePeriodicity: c.StatusTimePeriodicity,
+		ADRAlgorithm:          c.ADRAlgorithm,
 	}
 	if c.ADRMargin != nil {
 		p.ADRMargin = &pbtypes.FloatValue{Value: *c.ADRMargin}
@@ -72,6 +74,13 @@ func (c MACSettingConfig) Parse() ttnpb.MACSettings {
 	return p
 }
 
+// HandoverRoamingConfig defines the configuration of handover roaming, in which the Network Server acts as Serving
+// Network Server for end devices of partner networks.
+type HandoverRoamingConfig struct {
+	ApplicationID   string `name:"application-id" description:"ID of the application in which end devices served through handover roaming are registered"`
+	FrequencyPlanID string `name:"frequency-plan-id" description:"Frequency plan ID of end devices served through handover roaming"`
+}
+
 // DownlinkPriorityConfig defines priorities for downlink messages.
 type DownlinkPriorityConfig struct {
 	// JoinAccept is the downlink priority for join-accept messages.
@@ -113,19 +122,32 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	return p, nil
 }
 
+// DeduplicationWindowConfig represents the deduplication windows that override the default deduplication window.
+// A zero duration falls back to the default deduplication window.
+type DeduplicationWindowConfig struct {
+	ClassB       time.Duration `name:"class-b" description:"Time window during which, duplicate messages of class B devices are collected for metadata"`
+	ClassC       time.Duration `name:"class-c" description:"Time window during which, duplicate messages of class C devices are collected for metadata"`
+	PacketBroker time.Duration `name:"packet-broker" description:"Time window during which, duplicate messages of devices reached through Packet Broker are collected for metadata (only applies to devices with a recent uplink through Packet Broker if the first copy is received locally)"`
+}
+
 // Config represents the NetworkServer configuration.
 type Config struct {
 	ApplicationUplinkQueue ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
 	Devices                DeviceRegistry               `name:"-"`
+	MulticastGroups        MulticastGroupRegistry       `name:"-"`
 	DownlinkTasks          DownlinkTaskQueue            `name:"-"`
 	UplinkDeduplicator     UplinkDeduplicator           `name:"-"`
 	NetID                  types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
 	DevAddrPrefixes        []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
 	DeduplicationWindow    time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
 	CooldownWindow         time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DeduplicationWindows   DeduplicationWindowConfig    `name:"deduplication-windows" description:"Deduplication windows per device class and uplink path"`
+	MergeLateMetadata      bool                         `name:"merge-late-metadata" description:"Merge metadata of duplicate messages arriving in the cooldown window into the processed message"`
 	DownlinkPriorities     DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
 	DefaultMACSettings     MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
 	Interop                config.InteropClient         `name:"interop" description:"Interop client configuration"`
+	HandoverRoaming        HandoverRoamingConfig        `name:"handover-roaming" description:"Handover roaming configuration"`
+	RoamingTokenKey        []byte                       `name:"roaming-token-key" description:"AES 128 or 256-bit key for encrypting uplink tokens passed to Home Network Servers"`
 	DeviceKEKLabel         string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity  int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
 }
@@ -149,6 +171,7 @@ var DefaultConfig = Config{
 		ClassCTimeout:          func(v time.Duration) *time.Duration { return &v }(mac.DefaultClassCTimeout),
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
+		ADRAlgorithm:           mac.DefaultADRAlgorithm,
 	},
 	DownlinkQueueCapacity: 10000,
 }
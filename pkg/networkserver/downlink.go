@@ -617,9 +617,13 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 				},
 			},
 		}
-		if md.PacketBroker != nil {
+		switch {
+		case md.PacketBroker != nil:
 			tail = append(tail, path)
-		} else {
+		case md.GatewayID == passiveRoamingGatewayIdentifiers.GatewayID:
+			path.GatewayIdentifiers = &md.GatewayIdentifiers
+			tail = append(tail, path)
+		default:
 			path.GatewayIdentifiers = &md.GatewayIdentifiers
 			switch md.DownlinkPathConstraint {
 			case ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE:
@@ -643,6 +647,23 @@ func downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath
 	return nil
 }
 
+// classBDownlinkPathsFromRecentUplinks returns the downlink paths of the most recent uplink that was received by
+// gateways that are synchronized with GPS, which is required to transmit class B downlink in ping slots.
+func classBDownlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath {
+	for i := len(ups) - 1; i >= 0; i-- {
+		mds := make([]*ttnpb.RxMetadata, 0, len(ups[i].RxMetadata))
+		for _, md := range ups[i].RxMetadata {
+			if md.GPSTime != nil {
+				mds = append(mds, md)
+			}
+		}
+		if paths := downlinkPathsFromMetadata(mds...); len(paths) > 0 {
+			return paths
+		}
+	}
+	return nil
+}
+
 type scheduledDownlink struct {
 	Message    *ttnpb.DownlinkMessage
 	TransmitAt time.Time
@@ -784,7 +805,16 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 	attempts := make([]*attempt, 0, len(paths))
 	for _, path := range paths {
 		var target downlinkTarget
-		if path.GatewayIdentifiers != nil {
+		switch {
+		case path.GatewayIdentifiers != nil && path.GatewayID == passiveRoamingGatewayIdentifiers.GatewayID:
+			logger := logger.WithField("target", "passive_roaming")
+			var err error
+			target, err = ns.passiveRoamingDownlinkTarget(path)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to get passive roaming downlink target")
+				continue
+			}
+		case path.GatewayIdentifiers != nil:
 			logger := logger.WithFields(log.Fields(
 				"target", "gateway_server",
 				"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
@@ -795,7 +825,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 				continue
 			}
 			target = &gatewayServerDownlinkTarget{peer: peer}
-		} else {
+		default:
 			logger := logger.WithField("target", "packet_broker_agent")
 			peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_PACKET_BROKER_AGENT, nil)
 			if err != nil {
@@ -898,6 +928,109 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *sched
 	return nil, queuedEvents, downlinkSchedulingError(errs)
 }
 
+// scheduleMulticastDownlinkByPaths schedules the downlink on each of the paths, since a multicast downlink is transmitted
+// by all gateways of the multicast group. It returns the first scheduled downlink and fails only if all attempts fail.
+func (ns *NetworkServer) scheduleMulticastDownlinkByPaths(ctx context.Context, req *scheduleRequest, paths ...downlinkPath) (*scheduledDownlink, []events.Event, error) {
+	if len(paths) == 0 {
+		return nil, nil, errNoPath.New()
+	}
+	var (
+		scheduled    *scheduledDownlink
+		queuedEvents []events.Event
+		errs         downlinkSchedulingError
+	)
+	for _, path := range paths {
+		txReq := *req.TxRequest
+		pathReq := *req
+		pathReq.TxRequest = &txReq
+		if scheduled != nil {
+			// Downlink events are associated with the downlink message, not with the transmission.
+			pathReq.DownlinkEvents = nil
+		}
+		down, evs, err := ns.scheduleDownlinkByPaths(ctx, &pathReq, path)
+		queuedEvents = append(queuedEvents, evs...)
+		if err != nil {
+			if schedErr, ok := err.(downlinkSchedulingError); ok {
+				errs = append(errs, schedErr...)
+			} else {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if scheduled == nil {
+			scheduled = down
+		}
+	}
+	if scheduled == nil {
+		return nil, queuedEvents, errs
+	}
+	if len(errs) > 0 {
+		log.FromContext(ctx).WithField("failed_count", len(errs)).Warn("Failed to schedule multicast downlink on some gateways")
+	}
+	return scheduled, queuedEvents, nil
+}
+
+// multicastGroupGateways returns the gateways of the multicast group of the multicast device identified by ids.
+// If the multicast group has no gateways, the gateways that received the last uplink of the members are returned.
+// It returns nil if the Network Server has no multicast group registry or if the device has no multicast group.
+func (ns *NetworkServer) multicastGroupGateways(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) []ttnpb.GatewayAntennaIdentifiers {
+	if ns.multicastGroups == nil {
+		return nil
+	}
+	grp, err := ns.multicastGroups.Get(ctx, ids, []string{
+		"gateways",
+		"member_ids",
+	})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.FromContext(ctx).WithError(err).Warn("Failed to get multicast group")
+		}
+		return nil
+	}
+	if len(grp.Gateways) > 0 {
+		return grp.Gateways
+	}
+	return ns.multicastGroupMemberGateways(ctx, grp.MemberIDs...)
+}
+
+// multicastGroupMemberGateways returns the gateways that received the last uplink of the given members.
+// Uplinks received via Packet Broker or passive roaming are skipped, as these gateways cannot be scheduled on directly.
+func (ns *NetworkServer) multicastGroupMemberGateways(ctx context.Context, members ...ttnpb.EndDeviceIdentifiers) []ttnpb.GatewayAntennaIdentifiers {
+	var gtws []ttnpb.GatewayAntennaIdentifiers
+	seen := make(map[string]struct{})
+	for _, member := range members {
+		dev, _, err := ns.devices.GetByID(ctx, member.ApplicationIdentifiers, member.DeviceID, []string{
+			"recent_uplinks",
+		})
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				log.FromContext(ctx).WithError(err).WithField("member_id", member.DeviceID).Warn("Failed to get multicast group member")
+			}
+			continue
+		}
+		if len(dev.RecentUplinks) == 0 {
+			continue
+		}
+		for _, md := range LastUplink(dev.RecentUplinks...).RxMetadata {
+			if md.PacketBroker != nil ||
+				md.GatewayID == passiveRoamingGatewayIdentifiers.GatewayID ||
+				md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d", unique.ID(ctx, md.GatewayIdentifiers), md.AntennaIndex)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			gtws = append(gtws, ttnpb.GatewayAntennaIdentifiers{
+				GatewayIdentifiers: md.GatewayIdentifiers,
+				AntennaIndex:       md.AntennaIndex,
+			})
+		}
+	}
+	return gtws
+}
+
 func loggerWithTxRequestFields(logger log.Interface, req *ttnpb.TxRequest, rx1, rx2 bool) log.Interface {
 	pairs := []interface{}{
 		"attempt_rx1", rx1,
@@ -1316,8 +1449,14 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		}
 	}
 
+	fixedPaths := genState.ApplicationDownlink.GetClassBC().GetGateways()
+	var multicastPaths bool
+	if len(fixedPaths) == 0 && dev.Multicast {
+		fixedPaths = ns.multicastGroupGateways(ctx, dev.EndDeviceIdentifiers)
+		multicastPaths = len(fixedPaths) > 0
+	}
 	var paths []downlinkPath
-	if fixedPaths := genState.ApplicationDownlink.GetClassBC().GetGateways(); len(fixedPaths) > 0 {
+	if len(fixedPaths) > 0 {
 		paths = make([]downlinkPath, 0, len(fixedPaths))
 		for i := range fixedPaths {
 			paths = append(paths, downlinkPath{
@@ -1330,7 +1469,13 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 			})
 		}
 	} else {
-		paths = downlinkPathsFromRecentUplinks(dev.MACState.RecentUplinks...)
+		if slot.Class == ttnpb.CLASS_B {
+			// Prefer gateways that are synchronized with GPS, as these can transmit in the ping slots.
+			paths = classBDownlinkPathsFromRecentUplinks(dev.MACState.RecentUplinks...)
+		}
+		if len(paths) == 0 {
+			paths = downlinkPathsFromRecentUplinks(dev.MACState.RecentUplinks...)
+		}
 		if len(paths) == 0 {
 			log.FromContext(ctx).Error("No downlink path available, skip class B/C downlink slot")
 			if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
@@ -1352,7 +1497,11 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		Rx2Frequency:     freq,
 		AbsoluteTime:     absTime,
 	}
-	down, queuedEvents, err := ns.scheduleDownlinkByPaths(
+	schedule := ns.scheduleDownlinkByPaths
+	if multicastPaths {
+		schedule = ns.scheduleMulticastDownlinkByPaths
+	}
+	down, queuedEvents, err := schedule(
 		log.NewContext(ctx, loggerWithTxRequestFields(log.FromContext(ctx), req, false, true)),
 		&scheduleRequest{
 			TxRequest:            req,
@@ -30,6 +30,8 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
@@ -38,6 +40,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
 )
 
 func TestAppendRecentDownlink(t *testing.T) {
@@ -104,6 +107,65 @@ func TestAppendRecentDownlink(t *testing.T) {
 	}
 }
 
+func TestClassBDownlinkPathsFromRecentUplinks(t *testing.T) {
+	gpsTime := time.Unix(1600000000, 0).UTC()
+	makeMetadata := func(id string, gpsTime *time.Time) *ttnpb.RxMetadata {
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: id},
+			UplinkToken:            []byte(id),
+			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
+			GPSTime:                gpsTime,
+		}
+	}
+	makePath := func(id string) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: &ttnpb.GatewayIdentifiers{GatewayID: id},
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: []byte(id),
+				},
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name     string
+		Uplinks  []*ttnpb.UplinkMessage
+		Expected []downlinkPath
+	}{
+		{
+			Name: "no GPS time",
+			Uplinks: []*ttnpb.UplinkMessage{
+				{RxMetadata: []*ttnpb.RxMetadata{makeMetadata("gtw-1", nil)}},
+			},
+		},
+		{
+			Name: "latest uplink",
+			Uplinks: []*ttnpb.UplinkMessage{
+				{RxMetadata: []*ttnpb.RxMetadata{makeMetadata("gtw-1", &gpsTime)}},
+				{RxMetadata: []*ttnpb.RxMetadata{makeMetadata("gtw-2", nil), makeMetadata("gtw-3", &gpsTime)}},
+			},
+			Expected: []downlinkPath{makePath("gtw-3")},
+		},
+		{
+			Name: "older uplink",
+			Uplinks: []*ttnpb.UplinkMessage{
+				{RxMetadata: []*ttnpb.RxMetadata{makeMetadata("gtw-1", &gpsTime)}},
+				{RxMetadata: []*ttnpb.RxMetadata{makeMetadata("gtw-2", nil)}},
+			},
+			Expected: []downlinkPath{makePath("gtw-1")},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(classBDownlinkPathsFromRecentUplinks(tc.Uplinks...), should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
+
 func TestGenerateDataDownlink(t *testing.T) {
 	const appIDString = "generate-data-downlink-test-app-id"
 	appID := ttnpb.ApplicationIdentifiers{ApplicationID: appIDString}
@@ -1087,3 +1149,368 @@ func TestGenerateDataDownlink(t *testing.T) {
 		})
 	}
 }
+
+var errDownlinkTest = errors.DefineUnavailable("downlink_test", "downlink test failure")
+
+func TestMulticastGroupGateways(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "test-app",
+		},
+		DeviceID: "test-mc-dev",
+	}
+	gateways := []ttnpb.GatewayAntennaIdentifiers{
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-2"},
+			AntennaIndex:       1,
+		},
+	}
+
+	memberIDs := func(devIDs ...string) []ttnpb.EndDeviceIdentifiers {
+		res := make([]ttnpb.EndDeviceIdentifiers, 0, len(devIDs))
+		for _, devID := range devIDs {
+			res = append(res, ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceID:               devID,
+			})
+		}
+		return res
+	}
+	uplink := func(mds ...*ttnpb.RxMetadata) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RxMetadata: mds,
+		}
+	}
+	members := map[string]*ttnpb.EndDevice{
+		"test-dev-1": {
+			RecentUplinks: []*ttnpb.UplinkMessage{
+				uplink(&ttnpb.RxMetadata{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-old"},
+				}),
+				uplink(
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+					},
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-2"},
+						AntennaIndex:       1,
+					},
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "packetbroker"},
+						PacketBroker:       &ttnpb.PacketBrokerMetadata{},
+					},
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: passiveRoamingGatewayIdentifiers,
+					},
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-never"},
+						DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+					},
+				),
+			},
+		},
+		"test-dev-2": {
+			RecentUplinks: []*ttnpb.UplinkMessage{
+				uplink(
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+					},
+					&ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-3"},
+					},
+				),
+			},
+		},
+		"test-dev-3": {},
+	}
+	devices := MockDeviceRegistry{
+		GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+			if appID != ids.ApplicationIdentifiers {
+				return nil, ctx, errDeviceNotFound.New()
+			}
+			if devID == "test-dev-error" {
+				return nil, ctx, errDownlinkTest.New()
+			}
+			dev, ok := members[devID]
+			if !ok {
+				return nil, ctx, errDeviceNotFound.New()
+			}
+			return dev, ctx, nil
+		},
+	}
+
+	for _, tc := range []struct {
+		Name            string
+		MulticastGroups MulticastGroupRegistry
+		Gateways        []ttnpb.GatewayAntennaIdentifiers
+	}{
+		{
+			Name: "NoRegistry",
+		},
+		{
+			Name: "NotFound",
+			MulticastGroups: MockMulticastGroupRegistry{
+				GetFunc: func(context.Context, ttnpb.EndDeviceIdentifiers, []string) (*ttnpb.MulticastGroup, error) {
+					return nil, errMulticastGroupNotFound.New()
+				},
+			},
+		},
+		{
+			Name: "Error",
+			MulticastGroups: MockMulticastGroupRegistry{
+				GetFunc: func(context.Context, ttnpb.EndDeviceIdentifiers, []string) (*ttnpb.MulticastGroup, error) {
+					return nil, errDownlinkTest.New()
+				},
+			},
+		},
+		{
+			Name: "Found",
+			MulticastGroups: MockMulticastGroupRegistry{
+				GetFunc: func(ctx context.Context, getIDs ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error) {
+					if getIDs != ids {
+						return nil, errMulticastGroupNotFound.New()
+					}
+					return &ttnpb.MulticastGroup{
+						EndDeviceIdentifiers: ids,
+						Gateways:             gateways,
+					}, nil
+				},
+			},
+			Gateways: gateways,
+		},
+		{
+			Name: "Members",
+			MulticastGroups: MockMulticastGroupRegistry{
+				GetFunc: func(ctx context.Context, getIDs ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error) {
+					return &ttnpb.MulticastGroup{
+						EndDeviceIdentifiers: ids,
+						MemberIDs:            memberIDs("test-dev-1", "test-dev-2", "test-dev-3", "test-dev-error", "test-dev-unknown"),
+					}, nil
+				},
+			},
+			Gateways: []ttnpb.GatewayAntennaIdentifiers{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-2"},
+					AntennaIndex:       1,
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-3"},
+				},
+			},
+		},
+		{
+			Name: "MembersWithGateways",
+			MulticastGroups: MockMulticastGroupRegistry{
+				GetFunc: func(ctx context.Context, getIDs ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error) {
+					return &ttnpb.MulticastGroup{
+						EndDeviceIdentifiers: ids,
+						MemberIDs:            memberIDs("test-dev-1", "test-dev-2"),
+						Gateways:             gateways[1:],
+					}, nil
+				},
+			},
+			Gateways: gateways[1:],
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ns := &NetworkServer{
+					devices:         devices,
+					multicastGroups: tc.MulticastGroups,
+				}
+				a.So(ns.multicastGroupGateways(ctx, ids), should.Resemble, tc.Gateways)
+			},
+		})
+	}
+}
+
+func TestScheduleMulticastDownlinkByPaths(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "test-app",
+		},
+		DeviceID: "test-mc-dev",
+	}
+	gatewayIDs := []string{"gateway-1", "gateway-2", "gateway-3"}
+	paths := make([]downlinkPath, 0, len(gatewayIDs))
+	for _, id := range gatewayIDs {
+		fixed := &ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: id},
+		}
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: &fixed.GatewayIdentifiers,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_Fixed{
+					Fixed: fixed,
+				},
+			},
+		})
+	}
+	newRequest := func() *scheduleRequest {
+		return &scheduleRequest{
+			TxRequest: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_C,
+				Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+				Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+				Rx2Frequency:     869525000,
+			},
+			EndDeviceIdentifiers: ids,
+			Payload: &ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+			},
+			RawPayload:     []byte("test-payload"),
+			DownlinkEvents: events.Builders{evtForwardDataUplink},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name              string
+		Paths             []downlinkPath
+		UnknownGatewayIDs []string
+		FailingGatewayIDs []string
+		// ScheduledGatewayID is the gateway of the returned downlink. If empty, scheduling fails.
+		ScheduledGatewayID string
+		ErrorCount         int
+	}{
+		{
+			Name: "NoPaths",
+		},
+		{
+			Name:               "AllScheduled",
+			Paths:              paths,
+			ScheduledGatewayID: "gateway-1",
+		},
+		{
+			Name:               "PartialFailure",
+			Paths:              paths,
+			UnknownGatewayIDs:  []string{"gateway-1"},
+			FailingGatewayIDs:  []string{"gateway-2"},
+			ScheduledGatewayID: "gateway-3",
+		},
+		{
+			Name:              "AllFailed",
+			Paths:             paths,
+			UnknownGatewayIDs: []string{"gateway-1"},
+			FailingGatewayIDs: []string{"gateway-2", "gateway-3"},
+			ErrorCount:        2,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				contains := func(ids []string, id string) bool {
+					for _, v := range ids {
+						if v == id {
+							return true
+						}
+					}
+					return false
+				}
+				type gsScheduleRequest struct {
+					GatewayID string
+					Message   *ttnpb.DownlinkMessage
+				}
+				scheduleCh := make(chan gsScheduleRequest, len(tc.Paths))
+				c := component.MustNew(
+					log.Noop,
+					&component.Config{},
+					component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+						return &test.MockCluster{
+							AuthFunc: func() grpc.CallOption {
+								return grpc.EmptyCallOption{}
+							},
+							GetPeerFunc: func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (cluster.Peer, error) {
+								a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER)
+								gatewayID := ids.(ttnpb.GatewayIdentifiers).GatewayID
+								if contains(tc.UnknownGatewayIDs, gatewayID) {
+									return nil, errPeerNotFound.New()
+								}
+								return NewGSPeer(ctx, &MockNsGsServer{
+									ScheduleDownlinkFunc: func(ctx context.Context, msg *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+										scheduleCh <- gsScheduleRequest{
+											GatewayID: gatewayID,
+											Message:   msg,
+										}
+										if contains(tc.FailingGatewayIDs, gatewayID) {
+											return nil, errDownlinkTest.New()
+										}
+										return &ttnpb.ScheduleDownlinkResponse{
+											Delay: time.Second,
+										}, nil
+									},
+								}), nil
+							},
+							JoinFunc: test.ClusterJoinNilFunc,
+						}, nil
+					}),
+				)
+				componenttest.StartComponent(t, c)
+				ns := &NetworkServer{
+					Component: c,
+					ctx:       ctx,
+				}
+
+				down, evs, err := ns.scheduleMulticastDownlinkByPaths(ctx, newRequest(), tc.Paths...)
+				close(scheduleCh)
+
+				var scheduledGatewayIDs []string
+				for req := range scheduleCh {
+					scheduledGatewayIDs = append(scheduledGatewayIDs, req.GatewayID)
+					if a.So(req.Message.GetRequest(), should.NotBeNil) && a.So(req.Message.GetRequest().DownlinkPaths, should.HaveLength, 1) {
+						a.So(req.Message.GetRequest().DownlinkPaths[0].GetFixed().GatewayID, should.Equal, req.GatewayID)
+					}
+					a.So(req.Message.RawPayload, should.Resemble, []byte("test-payload"))
+				}
+				var expectedGatewayIDs []string
+				for _, path := range tc.Paths {
+					if !contains(tc.UnknownGatewayIDs, path.GatewayID) {
+						expectedGatewayIDs = append(expectedGatewayIDs, path.GatewayID)
+					}
+				}
+				a.So(scheduledGatewayIDs, should.Resemble, expectedGatewayIDs)
+
+				eventCounts := make(map[string]int)
+				for _, ev := range evs {
+					eventCounts[ev.Name()]++
+				}
+				a.So(eventCounts[evtScheduleDataDownlinkAttempt.Definition().Name()], should.Equal, len(expectedGatewayIDs))
+				a.So(eventCounts[evtScheduleDataDownlinkFail.Definition().Name()], should.Equal, len(tc.FailingGatewayIDs))
+
+				if tc.ScheduledGatewayID == "" {
+					a.So(down, should.BeNil)
+					if len(tc.Paths) == 0 {
+						a.So(errors.Resemble(err, errNoPath), should.BeTrue)
+					} else if a.So(err, should.HaveSameTypeAs, downlinkSchedulingError{}) {
+						a.So(err.(downlinkSchedulingError), should.HaveLength, tc.ErrorCount)
+					}
+					a.So(eventCounts[evtForwardDataUplink.Definition().Name()], should.Equal, 0)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(down, should.NotBeNil) {
+					t.FailNow()
+				}
+				if a.So(down.Message.GetRequest().DownlinkPaths, should.HaveLength, 1) {
+					a.So(down.Message.GetRequest().DownlinkPaths[0].GetFixed().GatewayID, should.Equal, tc.ScheduledGatewayID)
+				}
+				a.So(eventCounts[evtScheduleDataDownlinkSuccess.Definition().Name()], should.Equal, len(expectedGatewayIDs)-len(tc.FailingGatewayIDs))
+				// The downlink events are published once, even if the downlink is scheduled on multiple gateways.
+				a.So(eventCounts[evtForwardDataUplink.Definition().Name()], should.Equal, 1)
+			},
+		})
+	}
+}
@@ -46,10 +46,13 @@ var (
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
+	errMulticastGroupMember       = errors.DefineInvalidArgument("multicast_group_member", "multicast group member `{member_id}` is not in application `{application_id}`")
+	errMulticastGroupNotFound     = errors.DefineNotFound("multicast_group_not_found", "multicast group not found")
 	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
 	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoPath                     = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errNoPayload                  = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+	errNotMulticast               = errors.DefineFailedPrecondition("not_multicast", "device is not a multicast device")
 	errOutdatedData               = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
 	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
 	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
@@ -342,6 +342,14 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		)
 	}
 
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_algorithm") {
+		if name := req.EndDevice.GetMACSettings().GetADRAlgorithm(); name != "" {
+			if _, err := mac.GetADRAlgorithm(name); err != nil {
+				return nil, errInvalidFieldValue.WithAttributes("field", "mac_settings.adr_algorithm").WithCause(err)
+			}
+		}
+	}
+
 	gets := append(req.FieldMask.Paths[:0:0], req.FieldMask.Paths...)
 	var needsDownlinkCheck bool
 	if ttnpb.HasAnyField([]string{
@@ -577,6 +585,11 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 		logRegistryRPCError(ctx, err, "Failed to delete device from registry")
 		return nil, err
 	}
+	if ns.multicastGroups != nil {
+		if err := DeleteMulticastGroup(ctx, ns.multicastGroups, *req); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete multicast group from registry")
+		}
+	}
 	if evt != nil {
 		events.Publish(evt)
 	}
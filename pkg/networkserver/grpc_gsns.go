@@ -24,6 +24,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
@@ -59,8 +60,13 @@ type UplinkDeduplicator interface {
 	AccumulatedMetadata(context.Context, *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error)
 }
 
-func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage) (bool, error) {
-	ok, err := ns.uplinkDeduplicator.DeduplicateUplink(ctx, up, ns.collectionWindow(ctx))
+// collectionWindow returns the duration of the deduplication and cooldown windows of up of dev.
+func (ns *NetworkServer) collectionWindow(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) time.Duration {
+	return ns.deduplicationWindow(ctx, dev, up) + ns.cooldownWindow
+}
+
+func (ns *NetworkServer) deduplicateUplink(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) (bool, error) {
+	ok, err := ns.uplinkDeduplicator.DeduplicateUplink(ctx, up, ns.collectionWindow(ctx, dev, up))
 	if err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to deduplicate uplink")
 		return false, err
@@ -706,6 +712,116 @@ func (ns *NetworkServer) mergeMetadata(ctx context.Context, up *ttnpb.UplinkMess
 	registerMergeMetadata(ctx, up)
 }
 
+// sameRxMetadataSource returns true if a and b represent the reception of the same gateway antenna.
+func sameRxMetadataSource(a, b *ttnpb.RxMetadata) bool {
+	return a.GatewayIdentifiers.GatewayID == b.GatewayIdentifiers.GatewayID &&
+		a.AntennaIndex == b.AntennaIndex &&
+		bytes.Equal(a.UplinkToken, b.UplinkToken)
+}
+
+// appendMissingRxMetadata appends the metadata in mds, that is not present in up.RxMetadata yet, to up.RxMetadata.
+// appendMissingRxMetadata returns true if any metadata was appended.
+func appendMissingRxMetadata(up *ttnpb.UplinkMessage, mds ...*ttnpb.RxMetadata) bool {
+	var appended bool
+outer:
+	for _, md := range mds {
+		for _, existing := range up.RxMetadata {
+			if sameRxMetadataSource(existing, md) {
+				continue outer
+			}
+		}
+		up.RxMetadata = append(up.RxMetadata, md)
+		appended = true
+	}
+	return appended
+}
+
+// startMergeLateDataUplinkMetadata starts a task that merges the metadata of duplicates of up, which arrived after the
+// deduplication window, into the processed uplink stored in the recent uplinks of dev.
+// The task waits until halfway the cooldown window, so that the accumulated metadata is read before it expires.
+// Duplicates that arrive within the deduplication window are already merged into up, so a duplicate does not cause
+// any registry operation by itself, and the registry is only updated if late metadata has been accumulated.
+func (ns *NetworkServer) startMergeLateDataUplinkMetadata(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) {
+	mergeAt := up.ReceivedAt.Add(ns.deduplicationWindow(ctx, dev, up) + ns.cooldownWindow/2)
+	taskCtx := log.NewContext(ns.Context(), log.FromContext(ctx))
+	taskCtx = events.ContextWithCorrelationID(taskCtx, events.CorrelationIDsFromContext(ctx)...)
+	ns.StartTask(&component.TaskConfig{
+		Context: taskCtx,
+		ID:      mergeLateMetadataTaskName,
+		Func: func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timeAfter(timeUntil(mergeAt)):
+			}
+			mds, err := ns.uplinkDeduplicator.AccumulatedMetadata(ctx, up)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to get accumulated metadata of data uplink")
+				return nil
+			}
+			if len(mds) > len(up.RxMetadata) {
+				ns.mergeLateDataUplinkMetadata(ctx, dev, up, mds)
+			}
+			return nil
+		},
+		Restart: component.TaskRestartNever,
+	})
+}
+
+// mergeLateDataUplinkMetadata merges mds into the uplink matching up, which has already been processed and stored
+// in the recent uplinks of dev. The merged uplink is published in an event, so that ADR and location solving
+// can benefit from the metadata of the late duplicates.
+func (ns *NetworkServer) mergeLateDataUplinkMetadata(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage, mds []*ttnpb.RxMetadata) {
+	window := ns.collectionWindow(ctx, dev, up)
+	matches := func(recent *ttnpb.UplinkMessage) bool {
+		d := recent.ReceivedAt.Sub(up.ReceivedAt)
+		if d < 0 {
+			d = -d
+		}
+		return d <= window &&
+			recent.Settings.Frequency == up.Settings.Frequency &&
+			bytes.Equal(recent.RawPayload, up.RawPayload)
+	}
+	var merged *ttnpb.UplinkMessage
+	_, ctx, err := ns.devices.SetByID(ctx, dev.ApplicationIdentifiers, dev.DeviceID,
+		[]string{
+			"mac_state.recent_uplinks",
+			"recent_adr_uplinks",
+			"recent_uplinks",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, nil
+			}
+			var paths []string
+			for _, recent := range []struct {
+				path string
+				ups  []*ttnpb.UplinkMessage
+			}{
+				{"mac_state.recent_uplinks", stored.GetMACState().GetRecentUplinks()},
+				{"recent_adr_uplinks", stored.RecentADRUplinks},
+				{"recent_uplinks", stored.RecentUplinks},
+			} {
+				for _, recentUp := range recent.ups {
+					if matches(recentUp) && appendMissingRxMetadata(recentUp, mds...) {
+						paths = ttnpb.AddFields(paths, recent.path)
+						merged = recentUp
+					}
+				}
+			}
+			return stored, paths, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to merge late metadata")
+		return
+	}
+	if merged == nil {
+		return
+	}
+	log.FromContext(ctx).WithField("metadata_count", len(merged.RxMetadata)).Debug("Merged late metadata")
+	publishEvents(ctx, evtUpdateDataUplinkMetadata.NewWithIdentifiersAndData(ctx, dev.EndDeviceIdentifiers, merged))
+}
+
 func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	if len(up.RawPayload) < 4 {
 		return errRawPayloadTooShort.New()
@@ -804,6 +920,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return errDeviceNotFound.WithCause(err)
 	}
 	if !ok {
+		if netID, ok := ns.passiveRoamingForwardingNetID(up, pld.DevAddr); ok {
+			return ns.forwardPassiveRoamingUplink(ctx, up, func(context.Context) (types.NetID, error) {
+				return netID, nil
+			})
+		}
 		return errDeviceNotFound
 	}
 
@@ -826,7 +947,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		publishEvents(ctx, queuedEvents...)
 	}()
 
-	ok, err = ns.deduplicateUplink(ctx, up)
+	ok, err = ns.deduplicateUplink(ctx, matched.Device, up)
 	if err != nil {
 		return err
 	}
@@ -842,7 +963,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case <-ns.deduplicationDone(ctx, up):
+	case <-ns.deduplicationDone(ctx, matched.Device, up):
 	}
 	ns.mergeMetadata(ctx, up)
 
@@ -1025,6 +1146,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 	queuedEvents = append(queuedEvents, evtProcessDataUplink.NewWithIdentifiersAndData(ctx, matched.Device.EndDeviceIdentifiers, up))
 	registerProcessUplink(ctx, up)
+	if ns.mergeLateMetadata {
+		ns.startMergeLateDataUplinkMetadata(ctx, stored, up)
+	}
 	return nil
 }
 
@@ -1080,8 +1204,8 @@ func (ns *NetworkServer) sendJoinRequest(ctx context.Context, ids ttnpb.EndDevic
 	return nil, queuedEvents, errJoinServerNotFound.New()
 }
 
-func (ns *NetworkServer) deduplicationDone(ctx context.Context, up *ttnpb.UplinkMessage) <-chan time.Time {
-	return timeAfter(timeUntil(up.ReceivedAt.Add(ns.deduplicationWindow(ctx))))
+func (ns *NetworkServer) deduplicationDone(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) <-chan time.Time {
+	return timeAfter(timeUntil(up.ReceivedAt.Add(ns.deduplicationWindow(ctx, dev, up))))
 }
 
 func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
@@ -1091,23 +1215,33 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		"join_eui", pld.JoinEUI,
 	))
 
-	matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
-		[]string{
-			"frequency_plan_id",
-			"lorawan_phy_version",
-			"lorawan_version",
-			"mac_settings",
-			"session.dev_addr",
-			"supports_class_b",
-			"supports_class_c",
-			"supports_join",
-		},
-	)
+	matched, matchedCtx, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI, joinRequestDeviceFields)
 	if err != nil {
+		if errors.IsNotFound(err) && ns.roamingClient != nil && !isPassiveRoamingUplink(up) {
+			return ns.handleRoamingJoinRequest(ctx, up)
+		}
 		logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
 		return err
 	}
-	ctx = matchedCtx
+	return ns.handleDeviceJoinRequest(matchedCtx, up, matched, false)
+}
+
+// joinRequestDeviceFields are the fields of the end device needed to handle a join-request.
+var joinRequestDeviceFields = []string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"net_id",
+	"session.dev_addr",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// handleDeviceJoinRequest handles the join-request up of matched.
+// If deduplicated is true, up has already been deduplicated.
+func (ns *NetworkServer) handleDeviceJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage, matched *ttnpb.EndDevice, deduplicated bool) (err error) {
 	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, matched.EndDeviceIdentifiers))
 
 	queuedEvents := []events.Event{
@@ -1154,14 +1288,17 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		"device_channel_index", drIdx,
 	)
 
-	ok, err = ns.deduplicateUplink(ctx, up)
-	if err != nil {
-		return err
-	}
-	if !ok {
-		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, errDuplicate))
-		registerReceiveDuplicateUplink(ctx, up)
-		return nil
+	if !deduplicated {
+		// NOTE: Devices join in class A, so only the uplink path determines the deduplication window.
+		ok, err = ns.deduplicateUplink(ctx, nil, up)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.EndDeviceIdentifiers, errDuplicate))
+			registerReceiveDuplicateUplink(ctx, up)
+			return nil
+		}
 	}
 
 	devAddr := ns.newDevAddr(ctx, matched)
@@ -1191,8 +1328,14 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		ConsumedAirtime: up.ConsumedAirtime,
 	}
 
-	resp, joinEvents, err := ns.sendJoinRequest(ctx, matched.EndDeviceIdentifiers, req)
-	queuedEvents = append(queuedEvents, joinEvents...)
+	var resp *ttnpb.JoinResponse
+	if netID, ok := ns.handoverRoamingNetID(matched); ok {
+		resp, err = ns.sendHandoverRoamingJoinRequest(ctx, netID, up, req)
+	} else {
+		var joinEvents []events.Event
+		resp, joinEvents, err = ns.sendJoinRequest(ctx, matched.EndDeviceIdentifiers, req)
+		queuedEvents = append(queuedEvents, joinEvents...)
+	}
 	if err != nil {
 		return err
 	}
@@ -1220,7 +1363,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case <-ns.deduplicationDone(ctx, up):
+	case <-ns.deduplicationDone(ctx, nil, up):
 	}
 	ns.mergeMetadata(ctx, up)
 
@@ -1299,11 +1442,18 @@ func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.Upli
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
-func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (_ *pbtypes.Empty, err error) {
+func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink handles an uplink message received from a Gateway Server or a Forwarding Network Server.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
@@ -1312,7 +1462,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	up.ReceivedAt = timeNow().UTC()
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1321,7 +1471,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		}
 	}()
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"version", up.Payload.Major,
 		)
 	}
@@ -1344,7 +1494,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"spreading_factor", dr.LoRa.GetSpreadingFactor(),
 		))
 	default:
-		return nil, errDataRateNotFound.New()
+		return errDataRateNotFound.New()
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1355,12 +1505,12 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
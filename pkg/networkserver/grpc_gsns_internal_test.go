@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
@@ -90,3 +91,182 @@ func TestAppendRecentUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestAppendMissingRxMetadata(t *testing.T) {
+	mds := [...]*ttnpb.RxMetadata{
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+			UplinkToken:        []byte("token-1"),
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+			AntennaIndex:       1,
+			UplinkToken:        []byte("token-1"),
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "packetbroker"},
+			UplinkToken:        []byte("token-2"),
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "packetbroker"},
+			UplinkToken:        []byte("token-3"),
+		},
+	}
+	for _, tc := range []struct {
+		Name             string
+		Existing         []*ttnpb.RxMetadata
+		Merge            []*ttnpb.RxMetadata
+		Expected         []*ttnpb.RxMetadata
+		ExpectedAppended bool
+	}{
+		{
+			Name:     "no metadata",
+			Existing: mds[:2],
+			Expected: mds[:2],
+		},
+		{
+			Name:     "all present",
+			Existing: mds[:],
+			Merge:    mds[1:3],
+			Expected: mds[:],
+		},
+		{
+			Name:             "antenna",
+			Existing:         mds[:1],
+			Merge:            mds[:2],
+			Expected:         mds[:2],
+			ExpectedAppended: true,
+		},
+		{
+			Name:             "Packet Broker",
+			Existing:         mds[:3],
+			Merge:            mds[2:],
+			Expected:         mds[:],
+			ExpectedAppended: true,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				up := &ttnpb.UplinkMessage{
+					RxMetadata: append([]*ttnpb.RxMetadata{}, tc.Existing...),
+				}
+				appended := appendMissingRxMetadata(up, tc.Merge...)
+				a.So(appended, should.Equal, tc.ExpectedAppended)
+				a.So(up.RxMetadata, should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestMakeWindowDurationFunc(t *testing.T) {
+	pbUp := &ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "packetbroker"},
+				PacketBroker:       &ttnpb.PacketBrokerMetadata{},
+			},
+		},
+	}
+	gsUp := &ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+			},
+		},
+	}
+	makeDevice := func(class ttnpb.Class, recent ...*ttnpb.UplinkMessage) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				DeviceClass:   class,
+				RecentUplinks: recent,
+			},
+		}
+	}
+	conf := DeduplicationWindowConfig{
+		ClassB:       2 * time.Second,
+		ClassC:       300 * time.Millisecond,
+		PacketBroker: time.Second,
+	}
+	for _, tc := range []struct {
+		Name     string
+		Config   DeduplicationWindowConfig
+		Device   *ttnpb.EndDevice
+		Uplink   *ttnpb.UplinkMessage
+		Expected time.Duration
+	}{
+		{
+			Name:     "no overrides",
+			Device:   makeDevice(ttnpb.CLASS_B, pbUp),
+			Uplink:   pbUp,
+			Expected: 200 * time.Millisecond,
+		},
+		{
+			Name:     "no device",
+			Config:   conf,
+			Uplink:   gsUp,
+			Expected: 200 * time.Millisecond,
+		},
+		{
+			Name:     "class A",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_A, gsUp),
+			Uplink:   gsUp,
+			Expected: 200 * time.Millisecond,
+		},
+		{
+			Name:     "class B",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_B, gsUp),
+			Uplink:   gsUp,
+			Expected: 2 * time.Second,
+		},
+		{
+			Name:     "class C",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_C, gsUp),
+			Uplink:   gsUp,
+			Expected: 300 * time.Millisecond,
+		},
+		{
+			Name:     "Packet Broker uplink",
+			Config:   conf,
+			Uplink:   pbUp,
+			Expected: time.Second,
+		},
+		{
+			// The first copy determines the window, so a later copy through Packet Broker is not collected.
+			Name:     "local uplink without recent uplinks",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_A),
+			Uplink:   gsUp,
+			Expected: 200 * time.Millisecond,
+		},
+		{
+			Name:     "Packet Broker recent uplink",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_C, gsUp, pbUp),
+			Uplink:   gsUp,
+			Expected: time.Second,
+		},
+		{
+			Name:     "class B through Packet Broker",
+			Config:   conf,
+			Device:   makeDevice(ttnpb.CLASS_B, pbUp),
+			Uplink:   pbUp,
+			Expected: 2 * time.Second,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				f := makeWindowDurationFunc(200*time.Millisecond, tc.Config)
+				a.So(f(ctx, tc.Device, tc.Uplink), should.Equal, tc.Expected)
+			},
+		})
+	}
+}
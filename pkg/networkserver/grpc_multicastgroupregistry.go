@@ -0,0 +1,132 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtUpdateMulticastGroup = events.Define(
+		"ns.multicast_group.update", "update multicast group",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithUpdatedFieldsDataType(),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteMulticastGroup = events.Define(
+		"ns.multicast_group.delete", "delete multicast group",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_DEVICES_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+// nsMulticastGroupRegistryServer implements ttnpb.NsMulticastGroupRegistryServer.
+type nsMulticastGroupRegistryServer struct {
+	ns *NetworkServer
+}
+
+// Get implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistryServer) Get(ctx context.Context, req *ttnpb.GetMulticastGroupRequest) (*ttnpb.MulticastGroup, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	grp, err := s.ns.multicastGroups.Get(ctx, req.EndDeviceIdentifiers, req.FieldMask.Paths)
+	if errors.IsNotFound(err) {
+		return nil, errMulticastGroupNotFound.WithCause(err)
+	}
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get multicast group from registry")
+		return nil, err
+	}
+	return grp, nil
+}
+
+// Set implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistryServer) Set(ctx context.Context, req *ttnpb.SetMulticastGroupRequest) (*ttnpb.MulticastGroup, error) {
+	ids := req.MulticastGroup.EndDeviceIdentifiers
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "member_ids") {
+		for _, member := range req.MulticastGroup.MemberIDs {
+			if member.ApplicationIdentifiers != ids.ApplicationIdentifiers {
+				return nil, errMulticastGroupMember.WithAttributes(
+					"member_id", member.DeviceID,
+					"application_id", ids.ApplicationID,
+				)
+			}
+		}
+	}
+
+	dev, _, err := s.ns.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, []string{"multicast"})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get multicast device from registry")
+		return nil, err
+	}
+	if !dev.Multicast {
+		return nil, errNotMulticast.New()
+	}
+
+	var evt events.Event
+	grp, err := s.ns.multicastGroups.Set(ctx, ids, req.FieldMask.Paths, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) {
+		evt = evtUpdateMulticastGroup.NewWithIdentifiersAndData(ctx, ids, req.FieldMask.Paths)
+		if stored != nil {
+			return &req.MulticastGroup, req.FieldMask.Paths, nil
+		}
+		return &req.MulticastGroup, append(req.FieldMask.Paths,
+			"ids.application_ids",
+			"ids.device_id",
+		), nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to set multicast group in registry")
+		return nil, err
+	}
+	if evt != nil {
+		events.Publish(evt)
+	}
+	return grp, nil
+}
+
+// Delete implements ttnpb.NsMulticastGroupRegistryServer.
+func (s *nsMulticastGroupRegistryServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	var evt events.Event
+	_, err := s.ns.multicastGroups.Set(ctx, *req, nil, func(stored *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) {
+		if stored == nil {
+			return nil, nil, errMulticastGroupNotFound.New()
+		}
+		evt = evtDeleteMulticastGroup.NewWithIdentifiersAndData(ctx, req, nil)
+		return nil, nil, nil
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to delete multicast group from registry")
+		return nil, err
+	}
+	if evt != nil {
+		events.Publish(evt)
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,325 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// handoverRoamingEnabled returns whether the Network Server acts as Serving Network Server in handover roaming.
+func (ns *NetworkServer) handoverRoamingEnabled() bool {
+	return ns.roamingClient != nil && ns.handoverRoaming.ApplicationID != ""
+}
+
+// handoverRoamingNetID returns the NetID of the Home Network Server of dev, if dev is served through handover roaming.
+func (ns *NetworkServer) handoverRoamingNetID(dev *ttnpb.EndDevice) (types.NetID, bool) {
+	if !ns.handoverRoamingEnabled() || dev.NetID == nil || dev.ApplicationID != ns.handoverRoaming.ApplicationID {
+		return types.NetID{}, false
+	}
+	return *dev.NetID, true
+}
+
+// handoverRoamingDeviceIdentifiers returns the identifiers of the end device identified by devEUI served through
+// handover roaming.
+func (ns *NetworkServer) handoverRoamingDeviceIdentifiers(devEUI types.EUI64) ttnpb.EndDeviceIdentifiers {
+	return ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: ns.handoverRoaming.ApplicationID,
+		},
+		DeviceID: fmt.Sprintf("eui-%s", strings.ToLower(devEUI.String())),
+		DevEUI:   &devEUI,
+	}
+}
+
+var (
+	errNoDeviceProfile            = errors.DefineInvalidArgument("no_device_profile", "no DeviceProfile specified")
+	errHandoverRoamingMACVersion  = errors.DefineInvalidArgument("handover_roaming_mac_version", "MAC version `{mac_version}` is not supported in handover roaming")
+	errNoHandoverRoamingAgreement = errors.DefineNotFound("no_handover_roaming_agreement", "no handover roaming agreement with `{net_id}`")
+
+	errNoHandoverRoamingFrequencyPlanID = errors.DefineInvalidArgument("no_handover_roaming_frequency_plan_id", "no frequency plan ID specified for handover roaming")
+)
+
+// handoverRoamingPHYVersion returns the Regional Parameters version of an end device with the MAC version and the
+// Regional Parameters revision of its device profile.
+func handoverRoamingPHYVersion(macVersion ttnpb.MACVersion, regParamsRevision string) (ttnpb.PHYVersion, error) {
+	switch macVersion {
+	case ttnpb.MAC_V1_0:
+		return ttnpb.PHY_V1_0, nil
+	case ttnpb.MAC_V1_0_1:
+		return ttnpb.PHY_V1_0_1, nil
+	case ttnpb.MAC_V1_0_2:
+		if regParamsRevision == "A" {
+			return ttnpb.PHY_V1_0_2_REV_A, nil
+		}
+		return ttnpb.PHY_V1_0_2_REV_B, nil
+	case ttnpb.MAC_V1_0_3, ttnpb.MAC_V1_0_4:
+		return ttnpb.PHY_V1_0_3_REV_A, nil
+	case ttnpb.MAC_V1_1:
+		if regParamsRevision == "A" {
+			return ttnpb.PHY_V1_1_REV_A, nil
+		}
+		return ttnpb.PHY_V1_1_REV_B, nil
+	}
+	return ttnpb.PHY_UNKNOWN, errHandoverRoamingMACVersion.WithAttributes("mac_version", macVersion)
+}
+
+// handoverRoamingMACSettings returns the MAC settings of an end device with the device profile.
+func handoverRoamingMACSettings(profile *interop.DeviceProfile) *ttnpb.MACSettings {
+	settings := &ttnpb.MACSettings{
+		Supports32BitFCnt: &pbtypes.BoolValue{Value: profile.Supports32bitFCnt},
+	}
+	if profile.ClassBTimeout != nil {
+		d := time.Duration(*profile.ClassBTimeout) * time.Second
+		settings.ClassBTimeout = &d
+	}
+	if profile.ClassCTimeout != nil {
+		d := time.Duration(*profile.ClassCTimeout) * time.Second
+		settings.ClassCTimeout = &d
+	}
+	if profile.PingSlotDR != nil {
+		settings.PingSlotDataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(*profile.PingSlotDR)}
+	}
+	if profile.PingSlotFreq != nil {
+		settings.PingSlotFrequency = &pbtypes.UInt64Value{Value: uint64(math.Round(*profile.PingSlotFreq * 1e6))}
+	}
+	if profile.RXDelay1 != nil {
+		settings.Rx1Delay = &ttnpb.RxDelayValue{Value: ttnpb.RxDelay(*profile.RXDelay1)}
+	}
+	if profile.RXDROffset1 != nil {
+		settings.Rx1DataRateOffset = &pbtypes.UInt32Value{Value: uint32(*profile.RXDROffset1)}
+	}
+	if profile.RXDataRate2 != nil {
+		settings.Rx2DataRateIndex = &ttnpb.DataRateIndexValue{Value: ttnpb.DataRateIndex(*profile.RXDataRate2)}
+	}
+	if profile.RXFreq2 != nil {
+		settings.Rx2Frequency = &pbtypes.UInt64Value{Value: uint64(math.Round(*profile.RXFreq2 * 1e6))}
+	}
+	for _, freq := range profile.FactoryPresetFreqs {
+		settings.FactoryPresetFrequencies = append(settings.FactoryPresetFrequencies, uint64(math.Round(freq*1e6)))
+	}
+	return settings
+}
+
+// handoverRoamingEndDevice returns the end device identified by the EUIs, served through handover roaming for the
+// Home Network Server identified by netID with the device profile.
+func (ns *NetworkServer) handoverRoamingEndDevice(netID types.NetID, joinEUI, devEUI types.EUI64, profile *interop.DeviceProfile) (*ttnpb.EndDevice, error) {
+	if profile == nil {
+		return nil, errNoDeviceProfile.New()
+	}
+	macVersion := ttnpb.MACVersion(profile.MACVersion)
+	phyVersion, err := handoverRoamingPHYVersion(macVersion, profile.RegParamsRevision)
+	if err != nil {
+		return nil, err
+	}
+	ids := ns.handoverRoamingDeviceIdentifiers(devEUI)
+	ids.JoinEUI = &joinEUI
+	return &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ids,
+		FrequencyPlanID:      ns.handoverRoaming.FrequencyPlanID,
+		LoRaWANVersion:       macVersion,
+		LoRaWANPHYVersion:    phyVersion,
+		MACSettings:          handoverRoamingMACSettings(profile),
+		NetID:                &netID,
+		SupportsClassB:       profile.SupportsClassB,
+		SupportsClassC:       profile.SupportsClassC,
+		SupportsJoin:         true,
+	}, nil
+}
+
+// handleRoamingJoinRequest handles the join-request of an end device which is not registered in the Network Server.
+// If a handover roaming agreement exists with the network of the end device and the Home Network Server activates the
+// end device through handover roaming, the Network Server takes over the MAC-layer control of the end device.
+// Otherwise, the join-request is forwarded to the Home Network Server through passive roaming.
+func (ns *NetworkServer) handleRoamingJoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	pld := up.Payload.GetJoinRequestPayload()
+	if !ns.handoverRoamingEnabled() {
+		return ns.forwardPassiveRoamingUplink(ctx, up, ns.passiveRoamingHomeNetIDByEUI(pld.JoinEUI, pld.DevEUI))
+	}
+
+	up, ok, err := ns.deduplicateRoamingUplink(ctx, up)
+	if err != nil || !ok {
+		return err
+	}
+	netID, err := ns.roamingClient.HomeNSRequest(ctx, ns.netID, pld.JoinEUI, pld.DevEUI)
+	if err != nil {
+		return err
+	}
+	if netID.Equal(ns.netID) {
+		return errNoHandoverRoamingAgreement.WithAttributes("net_id", *netID)
+	}
+	logger := log.FromContext(ctx).WithField("home_net_id", *netID)
+
+	if agreement, ok := ns.roamingClient.HandoverRoamingAgreement(*netID); ok && agreement.Serving {
+		logger.Debug("Request profile from Home Network Server")
+		ans, err := ns.roamingClient.ProfileRequest(ctx, *netID, &interop.ProfileReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				SenderID: interop.NetID(ns.netID),
+			},
+			DevEUI: interop.EUI64(pld.DevEUI),
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to request profile from Home Network Server")
+			return err
+		}
+		if ans.RoamingActivationType == interop.RoamingActivationTypeHandover {
+			return ns.startHandoverRoaming(ctx, up, *netID, ans.DeviceProfile)
+		}
+	}
+
+	homeNetID, err := ns.passiveRoamingHomeNetID(*netID)
+	if err != nil {
+		return err
+	}
+	return ns.sendPassiveRoamingUplink(ctx, up, homeNetID)
+}
+
+// startHandoverRoaming registers the end device which sent the deduplicated join-request up with the device profile
+// of the Home Network Server identified by netID and handles the join-request.
+func (ns *NetworkServer) startHandoverRoaming(ctx context.Context, up *ttnpb.UplinkMessage, netID types.NetID, profile *interop.DeviceProfile) error {
+	pld := up.Payload.GetJoinRequestPayload()
+	dev, err := ns.handoverRoamingEndDevice(netID, pld.JoinEUI, pld.DevEUI, profile)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx)
+	stored, storedCtx, err := ns.devices.SetByID(ctx, dev.ApplicationIdentifiers, dev.DeviceID, joinRequestDeviceFields,
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored != nil {
+				return stored, nil, nil
+			}
+			logger.Debug("Create end device for handover roaming")
+			return dev, []string{
+				"frequency_plan_id",
+				"ids.application_ids",
+				"ids.dev_eui",
+				"ids.device_id",
+				"ids.join_eui",
+				"lorawan_phy_version",
+				"lorawan_version",
+				"mac_settings",
+				"net_id",
+				"supports_class_b",
+				"supports_class_c",
+				"supports_join",
+			}, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to create end device for handover roaming")
+		return err
+	}
+	return ns.handleDeviceJoinRequest(storedCtx, up, stored, true)
+}
+
+// sendHandoverRoamingJoinRequest sends the join-request req to the Home Network Server identified by netID in a
+// HRStartReq.
+func (ns *NetworkServer) sendHandoverRoamingJoinRequest(ctx context.Context, netID types.NetID, up *ttnpb.UplinkMessage, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	logger := log.FromContext(ctx).WithField("home_net_id", netID)
+	logger.Debug("Start handover roaming with Home Network Server")
+	resp, err := ns.roamingClient.HRStartRequest(ctx, netID, req, passiveRoamingULMetaData(up, ns.roamingTokenEncrypter))
+	if err != nil {
+		logger.WithError(err).Warn("Home Network Server did not accept handover roaming")
+		return nil, err
+	}
+	logger.Debug("Join-request accepted by Home Network Server")
+	return resp, nil
+}
+
+// handoverRoamingApplicationUplinks forwards the application uplinks of end devices served through handover roaming
+// to their Home Network Server and returns the remaining application uplinks.
+func (ns *NetworkServer) handoverRoamingApplicationUplinks(ctx context.Context, ups ...*ttnpb.ApplicationUp) []*ttnpb.ApplicationUp {
+	if !ns.handoverRoamingEnabled() {
+		return ups
+	}
+	remaining := make([]*ttnpb.ApplicationUp, 0, len(ups))
+	for _, up := range ups {
+		if up.ApplicationID != ns.handoverRoaming.ApplicationID {
+			remaining = append(remaining, up)
+			continue
+		}
+		if msg := up.GetUplinkMessage(); msg != nil && msg.FPort > 0 {
+			ns.forwardHandoverRoamingUplink(ctx, up.EndDeviceIdentifiers, msg)
+		}
+	}
+	return remaining
+}
+
+// forwardHandoverRoamingUplink forwards the application uplink msg of the end device identified by ids to its Home
+// Network Server in a XmitDataReq.
+func (ns *NetworkServer) forwardHandoverRoamingUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationUplink) {
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, []string{
+		"net_id",
+		"session.dev_addr",
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to load device from registry")
+		return
+	}
+	netID, ok := ns.handoverRoamingNetID(dev)
+	if !ok {
+		return
+	}
+	logger := log.FromContext(ctx).WithField("home_net_id", netID)
+
+	fPort, fCnt := uint8(msg.FPort), msg.FCnt
+	ulFreq := float64(msg.Settings.Frequency) / 1e6
+	dataRate := int(msg.Settings.DataRateIndex)
+	gwCnt := len(msg.RxMetadata)
+	md := &interop.ULMetaData{
+		FPort:     &fPort,
+		FCntUp:    &fCnt,
+		Confirmed: msg.Confirmed,
+		DataRate:  &dataRate,
+		ULFreq:    &ulFreq,
+		RecvTime:  msg.ReceivedAt.UTC().Format(time.RFC3339Nano),
+		GWCnt:     &gwCnt,
+		GWInfo:    make([]interop.GWInfoElement, 0, len(msg.RxMetadata)),
+	}
+	if dev.DevEUI != nil {
+		devEUI := interop.EUI64(*dev.DevEUI)
+		md.DevEUI = &devEUI
+	}
+	if dev.Session != nil {
+		devAddr := interop.DevAddr(dev.Session.DevAddr)
+		md.DevAddr = &devAddr
+	}
+	if fp, err := ns.FrequencyPlans.GetByID(ns.handoverRoaming.FrequencyPlanID); err == nil {
+		md.RFRegion, _ = interop.RFRegion(fp.BandID)
+	}
+	for _, rxMD := range msg.RxMetadata {
+		md.GWInfo = append(md.GWInfo, roamingGWInfo(rxMD, md.RFRegion))
+	}
+
+	logger.Debug("Forward application uplink to Home Network Server")
+	if _, err := ns.roamingClient.XmitDataRequest(ctx, netID, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID: interop.NetID(ns.netID),
+		},
+		FRMPayload: msg.FRMPayload,
+		ULMetaData: md,
+	}); err != nil {
+		logger.WithError(err).Warn("Failed to forward application uplink to Home Network Server")
+	}
+}
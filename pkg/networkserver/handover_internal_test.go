@@ -0,0 +1,260 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHandoverRoamingPHYVersion(t *testing.T) {
+	for _, tc := range []struct {
+		MACVersion        ttnpb.MACVersion
+		RegParamsRevision string
+		PHYVersion        ttnpb.PHYVersion
+		ErrorAssertion    func(error) bool
+	}{
+		{
+			MACVersion: ttnpb.MAC_V1_0,
+			PHYVersion: ttnpb.PHY_V1_0,
+		},
+		{
+			MACVersion:        ttnpb.MAC_V1_0_2,
+			RegParamsRevision: "A",
+			PHYVersion:        ttnpb.PHY_V1_0_2_REV_A,
+		},
+		{
+			MACVersion: ttnpb.MAC_V1_0_2,
+			PHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+		},
+		{
+			MACVersion: ttnpb.MAC_V1_0_3,
+			PHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		},
+		{
+			MACVersion:        ttnpb.MAC_V1_1,
+			RegParamsRevision: "B",
+			PHYVersion:        ttnpb.PHY_V1_1_REV_B,
+		},
+		{
+			MACVersion: ttnpb.MAC_UNKNOWN,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errHandoverRoamingMACVersion)
+			},
+		},
+	} {
+		t.Run(tc.MACVersion.String(), func(t *testing.T) {
+			a := assertions.New(t)
+			phyVersion, err := handoverRoamingPHYVersion(tc.MACVersion, tc.RegParamsRevision)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(phyVersion, should.Equal, tc.PHYVersion)
+		})
+	}
+}
+
+func TestHandoverRoamingEndDevice(t *testing.T) {
+	a := assertions.New(t)
+
+	ns := &NetworkServer{
+		handoverRoaming: HandoverRoamingConfig{
+			ApplicationID:   "handover-roaming",
+			FrequencyPlanID: "EU_863_870",
+		},
+	}
+	netID := types.NetID{0x00, 0x00, 0x42}
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff}
+	classBTimeout := uint32(10)
+	rxDelay1, rx2DataRate, rx2Freq := 1, 3, 869.525
+
+	_, err := ns.handoverRoamingEndDevice(netID, joinEUI, devEUI, nil)
+	a.So(errors.Resemble(err, errNoDeviceProfile), should.BeTrue)
+
+	dev, err := ns.handoverRoamingEndDevice(netID, joinEUI, devEUI, &interop.DeviceProfile{
+		SupportsClassB:     true,
+		ClassBTimeout:      &classBTimeout,
+		MACVersion:         interop.MACVersion(ttnpb.MAC_V1_0_3),
+		RXDelay1:           &rxDelay1,
+		RXDataRate2:        &rx2DataRate,
+		RXFreq2:            &rx2Freq,
+		FactoryPresetFreqs: []float64{868.1, 868.3, 868.5},
+		Supports32bitFCnt:  true,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	classBTimeoutDuration := 10 * time.Second
+	a.So(dev, should.Resemble, &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "handover-roaming",
+			},
+			DeviceID: "eui-01020304050607ff",
+			DevEUI:   &devEUI,
+			JoinEUI:  &joinEUI,
+		},
+		FrequencyPlanID:   "EU_863_870",
+		LoRaWANVersion:    ttnpb.MAC_V1_0_3,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+		MACSettings: &ttnpb.MACSettings{
+			ClassBTimeout:            &classBTimeoutDuration,
+			Rx1Delay:                 &ttnpb.RxDelayValue{Value: ttnpb.RX_DELAY_1},
+			Rx2DataRateIndex:         &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_3},
+			Rx2Frequency:             &pbtypes.UInt64Value{Value: 869525000},
+			FactoryPresetFrequencies: []uint64{868100000, 868300000, 868500000},
+			Supports32BitFCnt:        &pbtypes.BoolValue{Value: true},
+		},
+		NetID:          &netID,
+		SupportsClassB: true,
+		SupportsJoin:   true,
+	})
+}
+
+func TestStartHandoverRoaming(t *testing.T) {
+	netID := types.NetID{0x00, 0x00, 0x42}
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff}
+	up := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_JOIN_REQUEST,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_JoinRequestPayload{
+				JoinRequestPayload: &ttnpb.JoinRequestPayload{
+					JoinEUI: joinEUI,
+					DevEUI:  devEUI,
+				},
+			},
+		},
+	}
+	profile := &interop.DeviceProfile{
+		MACVersion: interop.MACVersion(ttnpb.MAC_V1_0_3),
+	}
+	ns := &NetworkServer{
+		handoverRoaming: HandoverRoamingConfig{
+			ApplicationID:   "handover-roaming",
+			FrequencyPlanID: "EU_863_870",
+		},
+	}
+	ids := ns.handoverRoamingDeviceIdentifiers(devEUI)
+
+	for _, tc := range []struct {
+		Name           string
+		Profile        *interop.DeviceProfile
+		Stored         *ttnpb.EndDevice
+		ErrorAssertion func(error) bool
+		SetAssertion   func(*assertions.Assertion, *ttnpb.EndDevice, []string) bool
+	}{
+		{
+			Name: "NoProfile",
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errNoDeviceProfile)
+			},
+		},
+		{
+			Name: "UnsupportedMACVersion",
+			Profile: &interop.DeviceProfile{
+				MACVersion: interop.MACVersion(ttnpb.MAC_UNKNOWN),
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errHandoverRoamingMACVersion)
+			},
+		},
+		{
+			Name:    "Create",
+			Profile: profile,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errInteropTest)
+			},
+			SetAssertion: func(a *assertions.Assertion, dev *ttnpb.EndDevice, paths []string) bool {
+				return a.So(dev, should.NotBeNil) &&
+					a.So(dev.EndDeviceIdentifiers, should.Resemble, ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: ids.ApplicationIdentifiers,
+						DeviceID:               ids.DeviceID,
+						DevEUI:                 &devEUI,
+						JoinEUI:                &joinEUI,
+					}) &&
+					a.So(dev.NetID, should.Resemble, &netID) &&
+					a.So(dev.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_3) &&
+					a.So(paths, should.Contain, "net_id")
+			},
+		},
+		{
+			Name:    "Existing",
+			Profile: profile,
+			Stored: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				NetID:                &netID,
+				LoRaWANVersion:       ttnpb.MAC_V1_0_2,
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errInteropTest)
+			},
+			SetAssertion: func(a *assertions.Assertion, dev *ttnpb.EndDevice, paths []string) bool {
+				return a.So(dev, should.NotBeNil) &&
+					a.So(dev.LoRaWANVersion, should.Equal, ttnpb.MAC_V1_0_2) &&
+					a.So(paths, should.BeEmpty)
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var setCalls int
+				ns := &NetworkServer{
+					handoverRoaming: ns.handoverRoaming,
+					devices: MockDeviceRegistry{
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+							setCalls++
+							a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+							a.So(devID, should.Equal, ids.DeviceID)
+							dev, paths, err := f(ctx, tc.Stored)
+							if !a.So(err, should.BeNil) || tc.SetAssertion == nil {
+								return nil, ctx, err
+							}
+							a.So(tc.SetAssertion(a, dev, paths), should.BeTrue)
+							// Fail the registry call, so that the join-request is not handled.
+							return nil, ctx, errInteropTest.New()
+						},
+					},
+				}
+				err := ns.startHandoverRoaming(ctx, up, netID, tc.Profile)
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				if tc.SetAssertion != nil {
+					a.So(setCalls, should.Equal, 1)
+				} else {
+					a.So(setCalls, should.Equal, 0)
+				}
+			},
+		})
+	}
+}
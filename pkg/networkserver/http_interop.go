@@ -0,0 +1,307 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+func (srv interopServer) passiveRoamingAgreement(netID interop.NetID) (interop.PassiveRoamingAgreement, error) {
+	agreement, ok := srv.NS.roamingClient.PassiveRoamingAgreement(types.NetID(netID))
+	if !ok {
+		return interop.PassiveRoamingAgreement{}, interop.ErrNoRoamingAgreement.New()
+	}
+	return agreement, nil
+}
+
+func (srv interopServer) handoverRoamingAgreement(netID interop.NetID) (interop.HandoverRoamingAgreement, error) {
+	agreement, ok := srv.NS.roamingClient.HandoverRoamingAgreement(types.NetID(netID))
+	if !ok || !agreement.Serving || !srv.NS.handoverRoamingEnabled() {
+		return interop.HandoverRoamingAgreement{}, interop.ErrNoRoamingAgreement.New()
+	}
+	return agreement, nil
+}
+
+// PRStartRequest handles the uplink message forwarded by the Forwarding Network Server.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	agreement, err := srv.passiveRoamingAgreement(in.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	if !agreement.Home {
+		return nil, interop.ErrDeviceRoaming.New()
+	}
+	up, err := passiveRoamingUplinkMessage(types.NetID(in.SenderID), in)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if err := srv.NS.handleUplink(ctx, up); err != nil {
+		switch {
+		case errors.Resemble(err, errDeviceNotFound):
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		case up.Payload != nil && up.Payload.MType == ttnpb.MType_JOIN_REQUEST && errors.IsNotFound(err):
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	lifetime := uint32(0)
+	return &interop.PRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// PRStopRequest handles the request of the Home Network Server to stop a passive roaming session.
+// As the Network Server only supports stateless passive roaming, there is no session to stop.
+func (srv interopServer) PRStopRequest(ctx context.Context, in *interop.PRStopReq) (*interop.PRStopAns, error) {
+	if _, err := srv.passiveRoamingAgreement(in.SenderID); err != nil {
+		return nil, err
+	}
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.PRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// XmitDataRequest schedules the downlink message of the Home Network Server on the gateways that received the
+// uplink message forwarded in the PRStartReq.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if len(in.FRMPayload) > 0 {
+		return srv.handoverRoamingXmitDataRequest(ctx, in)
+	}
+	agreement, err := srv.passiveRoamingAgreement(in.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	if !agreement.Forwarding {
+		return nil, interop.ErrDeviceRoaming.New()
+	}
+	if len(in.PHYPayload) == 0 {
+		return nil, interop.ErrMalformedMessage.WithCause(errNoPayload.New())
+	}
+	if in.DLMetaData == nil {
+		return nil, interop.ErrMalformedMessage.WithCause(errNoDLMetaData.New())
+	}
+	req, err := passiveRoamingTxRequest(in.DLMetaData)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+
+	logger := log.FromContext(ctx)
+	errs := make([]error, 0, len(in.DLMetaData.GWInfo))
+	for _, gwInfo := range in.DLMetaData.GWInfo {
+		token, err := unwrapForwardingUplinkToken(gwInfo.ULToken, srv.NS.roamingTokenKey)
+		if err != nil {
+			return nil, interop.ErrMalformedMessage.WithCause(errUplinkToken.WithCause(err))
+		}
+		logger := logger.WithField("gateway_uid", unique.ID(ctx, token.GatewayIdentifiers))
+		peer, err := srv.NS.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, token.GatewayIdentifiers)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get Gateway Server peer")
+			errs = append(errs, err)
+			continue
+		}
+		req.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: token.UplinkToken,
+				},
+			},
+		}
+		target := &gatewayServerDownlinkTarget{peer: peer}
+		if _, err := target.Schedule(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: in.PHYPayload,
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: req,
+			},
+		}, srv.NS.WithClusterAuth()); err != nil {
+			logger.WithError(err).Debug("Failed to schedule downlink")
+			errs = append(errs, err)
+			continue
+		}
+
+		header, err := in.AnswerHeader()
+		if err != nil {
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		}
+		return &interop.XmitDataAns{
+			NsNsMessageHeader: header,
+			Result: interop.Result{
+				ResultCode: interop.ResultSuccess,
+			},
+			DLFreq1: in.DLMetaData.DLFreq1,
+			DLFreq2: in.DLMetaData.DLFreq2,
+		}, nil
+	}
+	if len(errs) == 0 {
+		return nil, interop.ErrTransmitFailed.WithCause(errNoPath.New())
+	}
+	return nil, interop.ErrTransmitFailed.WithCause(downlinkSchedulingError(errs))
+}
+
+// handoverRoamingXmitDataRequest queues the application downlink of the Home Network Server for the end device served
+// through handover roaming.
+func (srv interopServer) handoverRoamingXmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if _, err := srv.handoverRoamingAgreement(in.SenderID); err != nil {
+		return nil, err
+	}
+	dlMD := in.DLMetaData
+	switch {
+	case dlMD == nil:
+		return nil, interop.ErrMalformedMessage.WithCause(errNoDLMetaData.New())
+	case dlMD.DevEUI == nil, dlMD.FPort == nil, dlMD.FCntDown == nil:
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	ids := srv.NS.handoverRoamingDeviceIdentifiers(types.EUI64(*dlMD.DevEUI))
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ids))
+
+	priority := ttnpb.TxSchedulePriority_NORMAL
+	if dlMD.HiPriorityFlag {
+		priority = ttnpb.TxSchedulePriority_HIGHEST
+	}
+	down := &ttnpb.ApplicationDownlink{
+		FPort:      uint32(*dlMD.FPort),
+		FCnt:       *dlMD.FCntDown,
+		FRMPayload: in.FRMPayload,
+		Confirmed:  dlMD.Confirmed,
+		Priority:   priority,
+	}
+	dev, ctx, err := srv.NS.devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID,
+		[]string{
+			"frequency_plan_id",
+			"last_dev_status_received_at",
+			"lorawan_phy_version",
+			"mac_settings",
+			"mac_state",
+			"multicast",
+			"net_id",
+			"pending_mac_state",
+			"pending_session",
+			"recent_uplinks",
+			"session",
+		},
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil || dev.NetID == nil || !dev.NetID.Equal(types.NetID(in.SenderID)) {
+				return nil, nil, interop.ErrUnknownDevEUI.New()
+			}
+			switch {
+			case dev.Session != nil:
+				down.SessionKeyID = dev.Session.SessionKeyID
+			case dev.PendingSession != nil:
+				down.SessionKeyID = dev.PendingSession.SessionKeyID
+			default:
+				return nil, nil, errUnknownSession.New()
+			}
+			if err := matchQueuedApplicationDownlinks(ctx, dev, srv.NS.FrequencyPlans, down); err != nil {
+				return nil, nil, err
+			}
+			if len(dev.Session.GetQueuedApplicationDownlinks()) > srv.NS.downlinkQueueCapacity || len(dev.PendingSession.GetQueuedApplicationDownlinks()) > srv.NS.downlinkQueueCapacity {
+				return nil, nil, errDownlinkQueueCapacityExceeded.New()
+			}
+			return dev, []string{
+				"session.queued_application_downlinks",
+				"pending_session.queued_application_downlinks",
+			}, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to push handover roaming downlink to queue")
+		if errors.Resemble(err, interop.ErrUnknownDevEUI) {
+			return nil, err
+		}
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+	if err := srv.NS.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after handover roaming downlink")
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// HRStopRequest handles the request of the Home Network Server to stop serving the end device through handover
+// roaming. The end device is deleted from the device registry.
+func (srv interopServer) HRStopRequest(ctx context.Context, in *interop.HRStopReq) (*interop.HRStopAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if _, err := srv.handoverRoamingAgreement(in.SenderID); err != nil {
+		return nil, err
+	}
+	ids := srv.NS.handoverRoamingDeviceIdentifiers(types.EUI64(in.DevEUI))
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ids))
+
+	_, _, err := srv.NS.devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, []string{"net_id"},
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil || dev.NetID == nil || !dev.NetID.Equal(types.NetID(in.SenderID)) {
+				return nil, nil, interop.ErrUnknownDevEUI.New()
+			}
+			return nil, nil, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to delete handover roaming device from registry")
+		return nil, err
+	}
+	log.FromContext(ctx).Debug("Stopped handover roaming")
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.HRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
@@ -0,0 +1,655 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var (
+	interopTestNetID     = types.NetID{0x00, 0x00, 0x13}
+	interopTestPeerNetID = types.NetID{0x00, 0x00, 0x42}
+	interopTestTokenKey  = []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	interopTestDevEUI    = types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0xff}
+	interopTestJoinEUI   = types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+
+	errInteropTest = errors.DefineUnavailable("interop_test", "interop test failure")
+
+	interopTestHandoverRoaming = HandoverRoamingConfig{
+		ApplicationID:   "handover-roaming",
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	}
+)
+
+// newInteropTestComponent returns a started component of which the cluster returns the Gateway Server peers of
+// getPeer.
+func newInteropTestComponent(t *testing.T, getPeer func(context.Context, ttnpb.ClusterRole, ttnpb.Identifiers) (cluster.Peer, error)) *component.Component {
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				AuthFunc: func() grpc.CallOption {
+					return grpc.EmptyCallOption{}
+				},
+				GetPeerFunc: getPeer,
+				JoinFunc:    test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	c.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	componenttest.StartComponent(t, c)
+	return c
+}
+
+func interopTestPassiveRoamingAgreement(agreement interop.PassiveRoamingAgreement) func(types.NetID) (interop.PassiveRoamingAgreement, bool) {
+	return func(netID types.NetID) (interop.PassiveRoamingAgreement, bool) {
+		if !netID.Equal(agreement.NetID) {
+			return interop.PassiveRoamingAgreement{}, false
+		}
+		return agreement, true
+	}
+}
+
+func interopTestHandoverRoamingAgreement(agreement interop.HandoverRoamingAgreement) func(types.NetID) (interop.HandoverRoamingAgreement, bool) {
+	return func(netID types.NetID) (interop.HandoverRoamingAgreement, bool) {
+		if !netID.Equal(agreement.NetID) {
+			return interop.HandoverRoamingAgreement{}, false
+		}
+		return agreement, true
+	}
+}
+
+func TestInteropPRStartRequest(t *testing.T) {
+	dataRate, ulFreq := 5, 868.1
+	ulMD := interop.ULMetaData{
+		RFRegion: "EU868",
+		DataRate: &dataRate,
+		ULFreq:   &ulFreq,
+	}
+	header := interop.NsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: "1.1",
+			TransactionID:   42,
+			MessageType:     interop.MessageTypePRStartReq,
+		},
+		SenderID:   interop.NetID(interopTestPeerNetID),
+		ReceiverID: interop.NetID(interopTestNetID),
+	}
+	phyPayload := []byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04}
+
+	for _, tc := range []struct {
+		Name           string
+		Agreement      interop.PassiveRoamingAgreement
+		ULMetaData     interop.ULMetaData
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NoAgreement",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID: types.NetID{0x00, 0x00, 0x01},
+				Home:  true,
+			},
+			ULMetaData: ulMD,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrNoRoamingAgreement)
+			},
+		},
+		{
+			Name: "NotHome",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID:      interopTestPeerNetID,
+				Forwarding: true,
+			},
+			ULMetaData: ulMD,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrDeviceRoaming)
+			},
+		},
+		{
+			Name: "NoRFRegion",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID: interopTestPeerNetID,
+				Home:  true,
+			},
+			ULMetaData: interop.ULMetaData{
+				DataRate: &dataRate,
+				ULFreq:   &ulFreq,
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrMalformedMessage)
+			},
+		},
+		{
+			Name: "UnknownDevAddr",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID: interopTestPeerNetID,
+				Home:  true,
+			},
+			ULMetaData: ulMD,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownDevAddr)
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var rangeCalls int
+				srv := interopServer{
+					NS: &NetworkServer{
+						netID: interopTestNetID,
+						devices: MockDeviceRegistry{
+							RangeByUplinkMatchesFunc: func(ctx context.Context, up *ttnpb.UplinkMessage, _ time.Duration, _ func(context.Context, UplinkMatch) (bool, error)) error {
+								rangeCalls++
+								a.So(isPassiveRoamingUplink(up), should.BeTrue)
+								a.So(up.Payload.GetMACPayload().DevAddr, should.Resemble, types.DevAddr{0x01, 0x02, 0x03, 0x04})
+								return nil
+							},
+						},
+						roamingClient: MockRoamingClient{
+							PassiveRoamingAgreementFunc: interopTestPassiveRoamingAgreement(tc.Agreement),
+						},
+					},
+				}
+				ans, err := srv.PRStartRequest(ctx, &interop.PRStartReq{
+					NsNsMessageHeader: header,
+					PHYPayload:        phyPayload,
+					ULMetaData:        tc.ULMetaData,
+				})
+				a.So(ans, should.BeNil)
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				if tc.Name == "UnknownDevAddr" {
+					a.So(rangeCalls, should.Equal, 1)
+				} else {
+					a.So(rangeCalls, should.Equal, 0)
+				}
+			},
+		})
+	}
+}
+
+func TestInteropXmitDataRequest(t *testing.T) {
+	encrypter, err := newRoamingTokenEncrypter(interopTestTokenKey)
+	if err != nil {
+		t.Fatalf("Failed to create roaming token encrypter: %s", err)
+	}
+	newULToken := func(gatewayID string) interop.Buffer {
+		token, err := wrapForwardingUplinkToken(ttnpb.GatewayIdentifiers{GatewayID: gatewayID}, []byte(gatewayID), encrypter)
+		if err != nil {
+			t.Fatalf("Failed to wrap forwarding uplink token: %s", err)
+		}
+		return token
+	}
+	header := interop.NsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: "1.1",
+			TransactionID:   42,
+			MessageType:     interop.MessageTypeXmitDataReq,
+		},
+		SenderID:   interop.NetID(interopTestPeerNetID),
+		ReceiverID: interop.NetID(interopTestNetID),
+	}
+	phyPayload := []byte{0x60, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04}
+	dlFreq1, dataRate1 := 868.1, 5
+	forwarding := interop.PassiveRoamingAgreement{
+		NetID:      interopTestPeerNetID,
+		Forwarding: true,
+	}
+
+	for _, tc := range []struct {
+		Name               string
+		Agreement          interop.PassiveRoamingAgreement
+		GWInfo             []interop.GWInfoElement
+		UnknownGatewayIDs  []string
+		FailingGatewayIDs  []string
+		ScheduledGatewayID string
+		ErrorAssertion     func(error) bool
+	}{
+		{
+			Name: "NoAgreement",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID:      types.NetID{0x00, 0x00, 0x01},
+				Forwarding: true,
+			},
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: newULToken("gateway-1")},
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrNoRoamingAgreement)
+			},
+		},
+		{
+			Name: "NotForwarding",
+			Agreement: interop.PassiveRoamingAgreement{
+				NetID: interopTestPeerNetID,
+				Home:  true,
+			},
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: newULToken("gateway-1")},
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrDeviceRoaming)
+			},
+		},
+		{
+			Name:      "InvalidULToken",
+			Agreement: forwarding,
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: interop.Buffer(`{"gateway_ids":{"gateway_id":"gateway-1"},"uplink_token":"Zm9v"}`)},
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrMalformedMessage)
+			},
+		},
+		{
+			Name:      "Success",
+			Agreement: forwarding,
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: newULToken("gateway-1")},
+				{ULToken: newULToken("gateway-2")},
+			},
+			ScheduledGatewayID: "gateway-1",
+		},
+		{
+			Name:      "PartialFailure",
+			Agreement: forwarding,
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: newULToken("gateway-1")},
+				{ULToken: newULToken("gateway-2")},
+				{ULToken: newULToken("gateway-3")},
+			},
+			UnknownGatewayIDs:  []string{"gateway-1"},
+			FailingGatewayIDs:  []string{"gateway-2"},
+			ScheduledGatewayID: "gateway-3",
+		},
+		{
+			Name:      "AllFailed",
+			Agreement: forwarding,
+			GWInfo: []interop.GWInfoElement{
+				{ULToken: newULToken("gateway-1")},
+				{ULToken: newULToken("gateway-2")},
+			},
+			UnknownGatewayIDs: []string{"gateway-1"},
+			FailingGatewayIDs: []string{"gateway-2"},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrTransmitFailed)
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				contains := func(ids []string, id string) bool {
+					for _, v := range ids {
+						if v == id {
+							return true
+						}
+					}
+					return false
+				}
+				scheduleCh := make(chan *ttnpb.DownlinkMessage, len(tc.GWInfo))
+				c := newInteropTestComponent(t, func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (cluster.Peer, error) {
+					a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER)
+					gatewayID := ids.(ttnpb.GatewayIdentifiers).GatewayID
+					if contains(tc.UnknownGatewayIDs, gatewayID) {
+						return nil, errInteropTest.New()
+					}
+					return NewGSPeer(ctx, &MockNsGsServer{
+						ScheduleDownlinkFunc: func(ctx context.Context, msg *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+							if contains(tc.FailingGatewayIDs, gatewayID) {
+								return nil, errInteropTest.New()
+							}
+							scheduleCh <- msg
+							return &ttnpb.ScheduleDownlinkResponse{}, nil
+						},
+					}), nil
+				})
+				srv := interopServer{
+					NS: &NetworkServer{
+						Component:       c,
+						ctx:             ctx,
+						netID:           interopTestNetID,
+						roamingTokenKey: interopTestTokenKey,
+						roamingClient: MockRoamingClient{
+							PassiveRoamingAgreementFunc: interopTestPassiveRoamingAgreement(tc.Agreement),
+						},
+					},
+				}
+				ans, err := srv.XmitDataRequest(ctx, &interop.XmitDataReq{
+					NsNsMessageHeader: header,
+					PHYPayload:        phyPayload,
+					DLMetaData: &interop.DLMetaData{
+						DLFreq1:   &dlFreq1,
+						DataRate1: &dataRate1,
+						RXDelay1:  1,
+						ClassMode: "A",
+						GWInfo:    tc.GWInfo,
+					},
+				})
+				close(scheduleCh)
+				if tc.ErrorAssertion != nil {
+					a.So(ans, should.BeNil)
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(scheduleCh, should.BeEmpty)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(ans, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(ans.MessageType, should.Equal, interop.MessageTypeXmitDataAns)
+				a.So(ans.DLFreq1, should.Resemble, &dlFreq1)
+
+				msgs := make([]*ttnpb.DownlinkMessage, 0, len(tc.GWInfo))
+				for msg := range scheduleCh {
+					msgs = append(msgs, msg)
+				}
+				if !a.So(msgs, should.HaveLength, 1) {
+					t.FailNow()
+				}
+				a.So(msgs[0].RawPayload, should.Resemble, phyPayload)
+				a.So(msgs[0].GetRequest(), should.Resemble, &ttnpb.TxRequest{
+					Class: ttnpb.CLASS_A,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_UplinkToken{
+								UplinkToken: []byte(tc.ScheduledGatewayID),
+							},
+						},
+					},
+					Rx1Delay:         ttnpb.RX_DELAY_1,
+					Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+					Rx1Frequency:     868100000,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+				})
+			},
+		})
+	}
+}
+
+func TestInteropHandoverRoamingXmitDataRequest(t *testing.T) {
+	header := interop.NsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: "1.1",
+			TransactionID:   42,
+			MessageType:     interop.MessageTypeXmitDataReq,
+		},
+		SenderID:   interop.NetID(interopTestPeerNetID),
+		ReceiverID: interop.NetID(interopTestNetID),
+	}
+	devEUI := interop.EUI64(interopTestDevEUI)
+	fPort, fCntDown := uint8(42), uint32(1)
+	serving := interop.HandoverRoamingAgreement{
+		NetID:   interopTestPeerNetID,
+		Serving: true,
+	}
+	ids := (&NetworkServer{handoverRoaming: interopTestHandoverRoaming}).handoverRoamingDeviceIdentifiers(interopTestDevEUI)
+	newDevice := func(netID types.NetID) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+			FrequencyPlanID:      test.EUFrequencyPlanID,
+			LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+			LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+			NetID:                &netID,
+			MACState: &ttnpb.MACState{
+				LoRaWANVersion: ttnpb.MAC_V1_0_3,
+				DeviceClass:    ttnpb.CLASS_A,
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyID: []byte("session-key-id"),
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Agreement      interop.HandoverRoamingAgreement
+		Device         *ttnpb.EndDevice
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NoAgreement",
+			Agreement: interop.HandoverRoamingAgreement{
+				NetID: interopTestPeerNetID,
+				Home:  true,
+			},
+			Device: newDevice(interopTestPeerNetID),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrNoRoamingAgreement)
+			},
+		},
+		{
+			Name:      "UnknownDevice",
+			Agreement: serving,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownDevEUI)
+			},
+		},
+		{
+			Name:      "NetIDMismatch",
+			Agreement: serving,
+			Device:    newDevice(types.NetID{0x00, 0x00, 0x01}),
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownDevEUI)
+			},
+		},
+		{
+			Name:      "Queued",
+			Agreement: serving,
+			Device:    newDevice(interopTestPeerNetID),
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var (
+					setDevice *ttnpb.EndDevice
+					setPaths  []string
+				)
+				srv := interopServer{
+					NS: &NetworkServer{
+						Component:             newInteropTestComponent(t, nil),
+						ctx:                   ctx,
+						netID:                 interopTestNetID,
+						handoverRoaming:       interopTestHandoverRoaming,
+						downlinkQueueCapacity: 10,
+						devices: MockDeviceRegistry{
+							SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+								a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+								a.So(devID, should.Equal, ids.DeviceID)
+								var stored *ttnpb.EndDevice
+								if tc.Device != nil {
+									stored = CopyEndDevice(tc.Device)
+								}
+								dev, paths, err := f(ctx, stored)
+								if err != nil {
+									return nil, ctx, err
+								}
+								setDevice, setPaths = dev, paths
+								return dev, ctx, nil
+							},
+						},
+						downlinkTasks: MockDownlinkTaskQueue{
+							AddFunc: func(context.Context, ttnpb.EndDeviceIdentifiers, time.Time, bool) error {
+								return nil
+							},
+						},
+						roamingClient: MockRoamingClient{
+							HandoverRoamingAgreementFunc: interopTestHandoverRoamingAgreement(tc.Agreement),
+						},
+					},
+				}
+				ans, err := srv.XmitDataRequest(ctx, &interop.XmitDataReq{
+					NsNsMessageHeader: header,
+					FRMPayload:        []byte{0x01, 0x02, 0x03},
+					DLMetaData: &interop.DLMetaData{
+						DevEUI:         &devEUI,
+						FPort:          &fPort,
+						FCntDown:       &fCntDown,
+						Confirmed:      true,
+						HiPriorityFlag: true,
+					},
+				})
+				if tc.ErrorAssertion != nil {
+					a.So(ans, should.BeNil)
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(setDevice, should.BeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(ans, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(setPaths, should.Resemble, []string{
+					"session.queued_application_downlinks",
+					"pending_session.queued_application_downlinks",
+				})
+				a.So(setDevice.Session.QueuedApplicationDownlinks, should.Resemble, []*ttnpb.ApplicationDownlink{
+					{
+						SessionKeyID: []byte("session-key-id"),
+						FPort:        42,
+						FCnt:         1,
+						FRMPayload:   []byte{0x01, 0x02, 0x03},
+						Confirmed:    true,
+						Priority:     ttnpb.TxSchedulePriority_HIGHEST,
+					},
+				})
+			},
+		})
+	}
+}
+
+func TestInteropHRStopRequest(t *testing.T) {
+	header := interop.NsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: "1.1",
+			TransactionID:   42,
+			MessageType:     interop.MessageTypeHRStopReq,
+		},
+		SenderID:   interop.NetID(interopTestPeerNetID),
+		ReceiverID: interop.NetID(interopTestNetID),
+	}
+	serving := interop.HandoverRoamingAgreement{
+		NetID:   interopTestPeerNetID,
+		Serving: true,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Agreement      interop.HandoverRoamingAgreement
+		NetID          *types.NetID
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NoAgreement",
+			Agreement: interop.HandoverRoamingAgreement{
+				NetID:   types.NetID{0x00, 0x00, 0x01},
+				Serving: true,
+			},
+			NetID: &interopTestPeerNetID,
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrNoRoamingAgreement)
+			},
+		},
+		{
+			Name:      "NetIDMismatch",
+			Agreement: serving,
+			NetID:     &types.NetID{0x00, 0x00, 0x01},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, interop.ErrUnknownDevEUI)
+			},
+		},
+		{
+			Name:      "Deleted",
+			Agreement: serving,
+			NetID:     &interopTestPeerNetID,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var deleted bool
+				ns := &NetworkServer{
+					netID:           interopTestNetID,
+					handoverRoaming: interopTestHandoverRoaming,
+					roamingClient: MockRoamingClient{
+						HandoverRoamingAgreementFunc: interopTestHandoverRoamingAgreement(tc.Agreement),
+					},
+				}
+				ids := ns.handoverRoamingDeviceIdentifiers(interopTestDevEUI)
+				ns.devices = MockDeviceRegistry{
+					SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+						a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+						a.So(devID, should.Equal, ids.DeviceID)
+						dev, _, err := f(ctx, &ttnpb.EndDevice{
+							EndDeviceIdentifiers: ids,
+							NetID:                tc.NetID,
+						})
+						if err != nil {
+							return nil, ctx, err
+						}
+						deleted = dev == nil
+						return dev, ctx, nil
+					},
+				}
+				ans, err := interopServer{NS: ns}.HRStopRequest(ctx, &interop.HRStopReq{
+					NsNsMessageHeader: header,
+					DevEUI:            interop.EUI64(interopTestDevEUI),
+				})
+				if tc.ErrorAssertion != nil {
+					a.So(ans, should.BeNil)
+					a.So(tc.ErrorAssertion(err), should.BeTrue)
+					a.So(deleted, should.BeFalse)
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(ans, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+				a.So(ans.MessageType, should.Equal, interop.MessageTypeHRStopAns)
+				a.So(deleted, should.BeTrue)
+			},
+		})
+	}
+}
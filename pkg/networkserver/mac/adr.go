@@ -74,6 +74,9 @@ const (
 	// maxNbTrans is the maximum NbTrans parameter used by the algorithm.
 	maxNbTrans = 3
 
+	// lossRateMargin is the margin in dB subtracted by the loss-aware algorithm per unit of loss rate.
+	lossRateMargin = 10
+
 	// OptimalADRUplinkCount is the amount of uplinks required to ensure optimal results from the ADR algorithm.
 	OptimalADRUplinkCount = 20
 
@@ -144,7 +147,17 @@ func txPowerStep(phy *band.Band, from, to uint8) float32 {
 	return phy.TxOffset[from] - phy.TxOffset[to]
 }
 
-func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
+// snrMarginADRAlgorithm is an ADRAlgorithm, which maximizes the data rate and minimizes the TX output power
+// based on the SNR margin of the recent ADR uplinks, and adapts NbTrans to the loss rate.
+type snrMarginADRAlgorithm struct {
+	// lossAware makes the algorithm conservative: the margin is reduced by the loss rate, the data rate is not
+	// increased on a negative margin, and the data rate is only increased and the TX output power only decreased
+	// if the optimal amount of uplinks is available.
+	lossAware bool
+}
+
+// AdaptDataRate implements ADRAlgorithm.
+func (a snrMarginADRAlgorithm) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
 	if len(dev.RecentADRUplinks) == 0 {
 		return nil
 	}
@@ -223,6 +236,12 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	if len(dev.RecentADRUplinks) < OptimalADRUplinkCount {
 		margin -= safetyMargin
 	}
+	if a.lossAware {
+		margin -= lossRate(dev.RecentADRUplinks...) * lossRateMargin
+		if margin > 0 && len(dev.RecentADRUplinks) < OptimalADRUplinkCount {
+			margin = 0
+		}
+	}
 
 	// NOTE: Network Server may only increase the data rate index of the device.
 	// NOTE(2): TX output power is reset whenever data rate is increased.
@@ -233,7 +252,10 @@ func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 		dev.MACState.DesiredParameters.ADRDataRateIndex = minDataRateIndex
 		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
 	}
-	if marginSteps := (margin - txPowerStep(phy, 0, minTxPowerIndex)) / drStep; marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex) {
+	switch marginSteps := (margin - txPowerStep(phy, 0, minTxPowerIndex)) / drStep; {
+	case marginSteps < 0 && a.lossAware:
+		maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex
+	case marginSteps >= 0 && marginSteps < float32(maxDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex):
 		maxDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex + ttnpb.DataRateIndex(marginSteps)
 	}
 	for drIdx := maxDataRateIndex; drIdx > minDataRateIndex; drIdx-- {
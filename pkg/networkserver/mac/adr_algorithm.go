@@ -0,0 +1,97 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// ADRAlgorithm computes the ADR parameters of a device.
+type ADRAlgorithm interface {
+	// AdaptDataRate sets the desired ADR data rate index, TX output power index and NbTrans of the device
+	// in dev.MACState.DesiredParameters.
+	AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error
+}
+
+const (
+	// ADRAlgorithmSNRMargin is the name of the ADR algorithm, which maximizes the data rate based on the SNR margin.
+	ADRAlgorithmSNRMargin = "snr-margin"
+	// ADRAlgorithmLossAware is the name of the conservative variant of the SNR margin ADR algorithm, which takes
+	// the loss rate into account. This is suitable for devices with varying link conditions, such as mobile trackers.
+	ADRAlgorithmLossAware = "loss-aware"
+	// ADRAlgorithmStatic is the name of the ADR algorithm, which keeps the current ADR parameters of the device.
+	ADRAlgorithmStatic = "static"
+
+	// DefaultADRAlgorithm is the ADR algorithm used if not specified in MACSettings of the device or NS-wide defaults.
+	DefaultADRAlgorithm = ADRAlgorithmSNRMargin
+)
+
+// staticADRAlgorithm is an ADRAlgorithm, which keeps the current ADR parameters.
+type staticADRAlgorithm struct{}
+
+// AdaptDataRate implements ADRAlgorithm.
+func (staticADRAlgorithm) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
+	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
+	return nil
+}
+
+var adrAlgorithms = map[string]ADRAlgorithm{
+	ADRAlgorithmSNRMargin: snrMarginADRAlgorithm{},
+	ADRAlgorithmLossAware: snrMarginADRAlgorithm{lossAware: true},
+	ADRAlgorithmStatic:    staticADRAlgorithm{},
+}
+
+// RegisterADRAlgorithm registers the ADR algorithm by the given name.
+// This function is not safe for concurrent use and should be called on initialization.
+func RegisterADRAlgorithm(name string, algorithm ADRAlgorithm) {
+	if _, ok := adrAlgorithms[name]; ok {
+		panic(ErrADRAlgorithmAlreadyRegistered.WithAttributes("name", name))
+	}
+	adrAlgorithms[name] = algorithm
+}
+
+// GetADRAlgorithm returns the ADR algorithm by the given name.
+func GetADRAlgorithm(name string) (ADRAlgorithm, error) {
+	algorithm, ok := adrAlgorithms[name]
+	if !ok {
+		return nil, ErrUnknownADRAlgorithm.WithAttributes("name", name)
+	}
+	return algorithm, nil
+}
+
+// DeviceADRAlgorithm returns the name of the ADR algorithm to use for the device.
+func DeviceADRAlgorithm(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) string {
+	if name := dev.GetMACSettings().GetADRAlgorithm(); name != "" {
+		return name
+	}
+	if defaults.ADRAlgorithm != "" {
+		return defaults.ADRAlgorithm
+	}
+	return DefaultADRAlgorithm
+}
+
+// AdaptDataRate sets the desired ADR parameters of the device using the ADR algorithm of the device.
+func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults ttnpb.MACSettings) error {
+	algorithm, err := GetADRAlgorithm(DeviceADRAlgorithm(dev, defaults))
+	if err != nil {
+		return err
+	}
+	return algorithm.AdaptDataRate(ctx, dev, phy, defaults)
+}
@@ -20,6 +20,7 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -262,6 +263,128 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MACState.DesiredParameters.ADRNbTrans = 1
 			},
 		},
+		{
+			Name: "adapted example from Semtech paper/static",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_4,
+						ADRNbTrans:       3,
+						ADRTxPowerIndex:  2,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRAlgorithm: ADRAlgorithmStatic,
+				},
+				RecentADRUplinks: semtechPaperUplinks,
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper/loss-aware",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_4,
+						ADRNbTrans:       3,
+						ADRTxPowerIndex:  2,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: ADRAlgorithmLossAware,
+				},
+				RecentADRUplinks: semtechPaperUplinks,
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_4
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper/loss-aware/negative margin",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Channels: MakeDefaultEU868CurrentChannels(),
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 14.5,
+					},
+					ADRAlgorithm: ADRAlgorithmLossAware,
+				},
+				RecentADRUplinks: semtechPaperUplinks,
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper/loss-aware/insufficient uplinks",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRNbTrans:      1,
+						ADRTxPowerIndex: 1,
+						Channels:        MakeDefaultEU868CurrentChannels(),
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_4,
+						ADRNbTrans:       3,
+						ADRTxPowerIndex:  2,
+						Channels:         MakeDefaultEU868CurrentChannels(),
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					ADRAlgorithm: ADRAlgorithmLossAware,
+				},
+				RecentADRUplinks: semtechPaperUplinks[:10],
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+				dev.MACState.DesiredParameters.ADRNbTrans = 2
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -283,3 +406,49 @@ func TestAdaptDataRate(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceADRAlgorithm(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		Defaults ttnpb.MACSettings
+		Expected string
+	}{
+		{
+			Name:     "no settings",
+			Device:   &ttnpb.EndDevice{},
+			Expected: DefaultADRAlgorithm,
+		},
+		{
+			Name:   "defaults",
+			Device: &ttnpb.EndDevice{},
+			Defaults: ttnpb.MACSettings{
+				ADRAlgorithm: ADRAlgorithmStatic,
+			},
+			Expected: ADRAlgorithmStatic,
+		},
+		{
+			Name: "device settings",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					ADRAlgorithm: ADRAlgorithmLossAware,
+				},
+			},
+			Defaults: ttnpb.MACSettings{
+				ADRAlgorithm: ADRAlgorithmStatic,
+			},
+			Expected: ADRAlgorithmLossAware,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			name := DeviceADRAlgorithm(tc.Device, tc.Defaults)
+			a.So(name, should.Equal, tc.Expected)
+			_, err := GetADRAlgorithm(name)
+			a.So(err, should.BeNil)
+		})
+	}
+
+	_, err := GetADRAlgorithm("unknown")
+	assertions.New(t).So(errors.IsNotFound(err), should.BeTrue)
+}
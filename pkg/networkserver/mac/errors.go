@@ -21,4 +21,7 @@ import (
 var (
 	ErrRequestNotFound = errors.DefineInvalidArgument("request_not_found", "MAC response received, but corresponding request not found")
 	ErrNoPayload       = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+
+	ErrUnknownADRAlgorithm           = errors.DefineNotFound("unknown_adr_algorithm", "unknown ADR algorithm `{name}`")
+	ErrADRAlgorithmAlreadyRegistered = errors.DefineAlreadyExists("adr_algorithm_already_registered", "ADR algorithm `{name}` already registered")
 )
@@ -18,6 +18,7 @@ package networkserver
 import (
 	"context"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"sync"
 	"time"
@@ -28,12 +29,14 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/grpc"
+	"gopkg.in/square/go-jose.v2"
 )
 
 const (
@@ -57,12 +60,45 @@ const (
 	networkInitiatedDownlinkInterval = time.Second
 )
 
-// windowDurationFunc is a function, which is used by Network Server to determine the duration of deduplication and cooldown windows.
-type windowDurationFunc func(ctx context.Context) time.Duration
+// windowDurationFunc is a function, which is used by Network Server to determine the duration of deduplication and cooldown windows
+// of an uplink message of a device.
+type windowDurationFunc func(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) time.Duration
+
+// makeWindowDurationFunc returns a windowDurationFunc, which returns d, unless overridden by conf for the class of the device
+// or for devices reached through Packet Broker. If both overrides apply, the longest window is returned.
+// The window is determined by the first copy of the uplink. If the first copy is received locally, the Packet Broker
+// window only applies if one of the recent uplinks of the device was received through Packet Broker. Otherwise, copies
+// received through Packet Broker after the default window are not collected, unless late metadata is merged.
+func makeWindowDurationFunc(d time.Duration, conf DeduplicationWindowConfig) windowDurationFunc {
+	return func(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) time.Duration {
+		window := d
+		switch dev.GetMACState().GetDeviceClass() {
+		case ttnpb.CLASS_B:
+			if conf.ClassB > 0 {
+				window = conf.ClassB
+			}
+		case ttnpb.CLASS_C:
+			if conf.ClassC > 0 {
+				window = conf.ClassC
+			}
+		}
+		if conf.PacketBroker > window && reachedThroughPacketBroker(dev, up) {
+			window = conf.PacketBroker
+		}
+		return window
+	}
+}
 
-// makeWindowEndAfterFunc returns a windowDurationFunc, which always returns d.
-func makeWindowDurationFunc(d time.Duration) windowDurationFunc {
-	return func(ctx context.Context) time.Duration { return d }
+// reachedThroughPacketBroker returns true if up or any of the recent uplinks of dev was received through Packet Broker.
+func reachedThroughPacketBroker(dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) bool {
+	for _, up := range append([]*ttnpb.UplinkMessage{up}, dev.GetMACState().GetRecentUplinks()...) {
+		for _, md := range up.GetRxMetadata() {
+			if md.PacketBroker != nil {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // newDevAddrFunc is a function, which is used by Network Server to derive new DevAddrs.
@@ -95,14 +131,27 @@ type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 }
 
+// RoamingClient is a client, which Network Server can use for passive and handover roaming.
+type RoamingClient interface {
+	HomeNSRequest(context.Context, types.NetID, types.EUI64, types.EUI64) (*types.NetID, error)
+	PassiveRoamingAgreement(types.NetID) (interop.PassiveRoamingAgreement, bool)
+	PassiveRoamingNetID(types.DevAddr) (types.NetID, bool)
+	HandoverRoamingAgreement(types.NetID) (interop.HandoverRoamingAgreement, bool)
+	PRStartRequest(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+	ProfileRequest(context.Context, types.NetID, *interop.ProfileReq) (*interop.ProfileAns, error)
+	HRStartRequest(context.Context, types.NetID, *ttnpb.JoinRequest, interop.ULMetaData) (*ttnpb.JoinResponse, error)
+}
+
 // NetworkServer implements the Network Server component.
 //
-// The Network Server exposes the GsNs, AsNs, DeviceRegistry and ApplicationDownlinkQueue services.
+// The Network Server exposes the GsNs, AsNs, DeviceRegistry, MulticastGroupRegistry and ApplicationDownlinkQueue services.
 type NetworkServer struct {
 	*component.Component
 	ctx context.Context
 
-	devices DeviceRegistry
+	devices         DeviceRegistry
+	multicastGroups MulticastGroupRegistry
 
 	netID      types.NetID
 	newDevAddr newDevAddrFunc
@@ -114,11 +163,18 @@ type NetworkServer struct {
 	downlinkPriorities DownlinkPriorities
 
 	deduplicationWindow windowDurationFunc
-	collectionWindow    windowDurationFunc
+	cooldownWindow      time.Duration
+	mergeLateMetadata   bool
 
 	defaultMACSettings ttnpb.MACSettings
 
-	interopClient InteropClient
+	interopClient   InteropClient
+	roamingClient   RoamingClient
+	interop         interopServer
+	handoverRoaming HandoverRoamingConfig
+
+	roamingTokenKey       []byte
+	roamingTokenEncrypter jose.Encrypter
 
 	uplinkDeduplicator UplinkDeduplicator
 
@@ -132,8 +188,9 @@ type Option func(ns *NetworkServer)
 var DefaultOptions []Option
 
 const (
-	downlinkProcessTaskName = "process_downlink"
-	maxInt                  = int(^uint(0) >> 1)
+	downlinkProcessTaskName   = "process_downlink"
+	mergeLateMetadataTaskName = "merge_late_metadata"
+	maxInt                    = int(^uint(0) >> 1)
 )
 
 // New returns new NetworkServer.
@@ -174,8 +231,20 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	if name := conf.DefaultMACSettings.ADRAlgorithm; name != "" {
+		if _, err := mac.GetADRAlgorithm(name); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+	}
+
+	if conf.HandoverRoaming.ApplicationID != "" && conf.HandoverRoaming.FrequencyPlanID == "" {
+		return nil, errInvalidConfiguration.WithCause(errNoHandoverRoamingFrequencyPlanID.New())
+	}
 
-	var interopCl InteropClient
+	var (
+		interopCl InteropClient
+		roamingCl RoamingClient
+	)
 	if !conf.Interop.IsZero() {
 		interopConf := conf.Interop
 		interopConf.GetFallbackTLSConfig = func(ctx context.Context) (*tls.Config, error) {
@@ -183,10 +252,27 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		}
 		interopConf.BlobConfig = c.GetBaseConfig(ctx).Blob
 
-		interopCl, err = interop.NewClient(ctx, interopConf)
+		cl, err := interop.NewClient(ctx, interopConf)
 		if err != nil {
 			return nil, err
 		}
+		interopCl, roamingCl = cl, cl
+	}
+
+	var (
+		roamingTokenKey       []byte
+		roamingTokenEncrypter jose.Encrypter
+	)
+	if roamingCl != nil {
+		roamingTokenKey = conf.RoamingTokenKey
+		if len(roamingTokenKey) == 0 {
+			roamingTokenKey = random.Bytes(16)
+			log.FromContext(ctx).WithField("roaming_token_key", hex.EncodeToString(roamingTokenKey)).Warn("No roaming token key configured, generated a random one")
+		}
+		roamingTokenEncrypter, err = newRoamingTokenEncrypter(roamingTokenKey)
+		if err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
 	}
 
 	ns := &NetworkServer{
@@ -196,13 +282,19 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		newDevAddr:            makeNewDevAddrFunc(devAddrPrefixes...),
 		applicationServers:    &sync.Map{},
 		applicationUplinks:    conf.ApplicationUplinkQueue.Queue,
-		deduplicationWindow:   makeWindowDurationFunc(conf.DeduplicationWindow),
-		collectionWindow:      makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
+		deduplicationWindow:   makeWindowDurationFunc(conf.DeduplicationWindow, conf.DeduplicationWindows),
+		cooldownWindow:        conf.CooldownWindow,
+		mergeLateMetadata:     conf.MergeLateMetadata,
 		devices:               wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
+		multicastGroups:       conf.MulticastGroups,
 		downlinkTasks:         conf.DownlinkTasks,
 		downlinkPriorities:    downlinkPriorities,
 		defaultMACSettings:    conf.DefaultMACSettings.Parse(),
 		interopClient:         interopCl,
+		roamingClient:         roamingCl,
+		handoverRoaming:       conf.HandoverRoaming,
+		roamingTokenKey:       roamingTokenKey,
+		roamingTokenEncrypter: roamingTokenEncrypter,
 		uplinkDeduplicator:    conf.UplinkDeduplicator,
 		deviceKEKLabel:        conf.DeviceKEKLabel,
 		downlinkQueueCapacity: conf.DownlinkQueueCapacity,
@@ -234,7 +326,12 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			IntervalFunc: component.MakeTaskBackoffIntervalFunc(true, component.DefaultTaskBackoffResetDuration, component.DefaultTaskBackoffIntervals[:]...),
 		},
 	})
+	ns.interop = interopServer{NS: ns}
+
 	c.RegisterGRPC(ns)
+	if ns.roamingClient != nil {
+		c.RegisterInterop(ns)
+	}
 	return ns, nil
 }
 
@@ -249,12 +346,24 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsNsServer(s, ns)
 	ttnpb.RegisterNsEndDeviceRegistryServer(s, ns)
 	ttnpb.RegisterNsServer(s, ns)
+	if ns.multicastGroups != nil {
+		ttnpb.RegisterNsMulticastGroupRegistryServer(s, &nsMulticastGroupRegistryServer{ns: ns})
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterNsEndDeviceRegistryHandler(ns.Context(), s, conn)
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
+	if ns.multicastGroups != nil {
+		ttnpb.RegisterNsMulticastGroupRegistryHandler(ns.Context(), s, conn)
+	}
+}
+
+// RegisterInterop registers the sNS-fNS, fNS-sNS and hNS-sNS interop services used for passive and handover roaming.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterSNS(ns.interop)
+	srv.RegisterFNS(ns.interop)
 }
 
 // Roles returns the roles that the Network Server fulfills.
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -271,6 +272,84 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+var _ RoamingClient = MockRoamingClient{}
+
+// MockRoamingClient is a mock RoamingClient used for testing.
+type MockRoamingClient struct {
+	HomeNSRequestFunc            func(context.Context, types.NetID, types.EUI64, types.EUI64) (*types.NetID, error)
+	PassiveRoamingAgreementFunc  func(types.NetID) (interop.PassiveRoamingAgreement, bool)
+	PassiveRoamingNetIDFunc      func(types.DevAddr) (types.NetID, bool)
+	HandoverRoamingAgreementFunc func(types.NetID) (interop.HandoverRoamingAgreement, bool)
+	PRStartRequestFunc           func(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequestFunc          func(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+	ProfileRequestFunc           func(context.Context, types.NetID, *interop.ProfileReq) (*interop.ProfileAns, error)
+	HRStartRequestFunc           func(context.Context, types.NetID, *ttnpb.JoinRequest, interop.ULMetaData) (*ttnpb.JoinResponse, error)
+}
+
+// HomeNSRequest calls HomeNSRequestFunc if set and panics otherwise.
+func (m MockRoamingClient) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (*types.NetID, error) {
+	if m.HomeNSRequestFunc == nil {
+		panic("HomeNSRequest called, but not set")
+	}
+	return m.HomeNSRequestFunc(ctx, netID, joinEUI, devEUI)
+}
+
+// PassiveRoamingAgreement calls PassiveRoamingAgreementFunc if set and returns no agreement otherwise.
+func (m MockRoamingClient) PassiveRoamingAgreement(netID types.NetID) (interop.PassiveRoamingAgreement, bool) {
+	if m.PassiveRoamingAgreementFunc == nil {
+		return interop.PassiveRoamingAgreement{}, false
+	}
+	return m.PassiveRoamingAgreementFunc(netID)
+}
+
+// PassiveRoamingNetID calls PassiveRoamingNetIDFunc if set and returns no NetID otherwise.
+func (m MockRoamingClient) PassiveRoamingNetID(devAddr types.DevAddr) (types.NetID, bool) {
+	if m.PassiveRoamingNetIDFunc == nil {
+		return types.NetID{}, false
+	}
+	return m.PassiveRoamingNetIDFunc(devAddr)
+}
+
+// HandoverRoamingAgreement calls HandoverRoamingAgreementFunc if set and returns no agreement otherwise.
+func (m MockRoamingClient) HandoverRoamingAgreement(netID types.NetID) (interop.HandoverRoamingAgreement, bool) {
+	if m.HandoverRoamingAgreementFunc == nil {
+		return interop.HandoverRoamingAgreement{}, false
+	}
+	return m.HandoverRoamingAgreementFunc(netID)
+}
+
+// PRStartRequest calls PRStartRequestFunc if set and panics otherwise.
+func (m MockRoamingClient) PRStartRequest(ctx context.Context, netID types.NetID, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, netID, req)
+}
+
+// XmitDataRequest calls XmitDataRequestFunc if set and panics otherwise.
+func (m MockRoamingClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, netID, req)
+}
+
+// ProfileRequest calls ProfileRequestFunc if set and panics otherwise.
+func (m MockRoamingClient) ProfileRequest(ctx context.Context, netID types.NetID, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+	if m.ProfileRequestFunc == nil {
+		panic("ProfileRequest called, but not set")
+	}
+	return m.ProfileRequestFunc(ctx, netID, req)
+}
+
+// HRStartRequest calls HRStartRequestFunc if set and panics otherwise.
+func (m MockRoamingClient) HRStartRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest, ulMD interop.ULMetaData) (*ttnpb.JoinResponse, error) {
+	if m.HRStartRequestFunc == nil {
+		panic("HRStartRequest called, but not set")
+	}
+	return m.HRStartRequestFunc(ctx, netID, req, ulMD)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -2023,12 +2102,37 @@ func (m MockDownlinkTaskQueue) Pop(ctx context.Context, f func(context.Context,
 	return m.PopFunc(ctx, f)
 }
 
+var _ MulticastGroupRegistry = MockMulticastGroupRegistry{}
+
+// MockMulticastGroupRegistry is a mock MulticastGroupRegistry used for testing.
+type MockMulticastGroupRegistry struct {
+	GetFunc func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error)
+	SetFunc func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error)) (*ttnpb.MulticastGroup, error)
+}
+
+// Get calls GetFunc if set and panics otherwise.
+func (m MockMulticastGroupRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error) {
+	if m.GetFunc == nil {
+		panic("Get called, but not set")
+	}
+	return m.GetFunc(ctx, ids, paths)
+}
+
+// Set calls SetFunc if set and panics otherwise.
+func (m MockMulticastGroupRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error)) (*ttnpb.MulticastGroup, error) {
+	if m.SetFunc == nil {
+		panic("Set called, but not set")
+	}
+	return m.SetFunc(ctx, ids, gets, f)
+}
+
 var _ DeviceRegistry = MockDeviceRegistry{}
 
 // MockDeviceRegistry is a mock DeviceRegistry used for testing.
 type MockDeviceRegistry struct {
-	GetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	SetByIDFunc func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	GetByIDFunc              func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	SetByIDFunc              func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	RangeByUplinkMatchesFunc func(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, UplinkMatch) (bool, error)) error
 }
 
 // GetByEUI panics.
@@ -2052,7 +2156,10 @@ func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.Application
 	return m.SetByIDFunc(ctx, appID, devID, paths, f)
 }
 
-// RangeByUplinkMatches panics.
-func (m MockDeviceRegistry) RangeByUplinkMatches(context.Context, *ttnpb.UplinkMessage, time.Duration, func(context.Context, UplinkMatch) (bool, error)) error {
-	panic("RangeByUplinkMatches must not be called")
+// RangeByUplinkMatches calls RangeByUplinkMatchesFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, cacheTTL time.Duration, f func(context.Context, UplinkMatch) (bool, error)) error {
+	if m.RangeByUplinkMatchesFunc == nil {
+		panic("RangeByUplinkMatches called, but not set")
+	}
+	return m.RangeByUplinkMatchesFunc(ctx, up, cacheTTL, f)
 }
@@ -51,6 +51,11 @@ var (
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
 		events.WithDataType(&ttnpb.UplinkMessage{}),
 	)
+	evtUpdateDataUplinkMetadata = events.Define(
+		"ns.up.data.metadata.update", "update metadata of processed data message",
+		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
 	evtForwardDataUplink = events.Define(
 		"ns.up.data.forward", "forward data message to Application Server",
 		events.WithVisibility(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ),
@@ -307,6 +312,10 @@ func registerForwardJoinRequest(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.uplinkForwarded.WithLabelValues(ctx, mTypeLabel(msg.Payload.MType)).Inc()
 }
 
+func registerForwardPassiveRoamingUplink(ctx context.Context, msg *ttnpb.UplinkMessage) {
+	nsMetrics.uplinkForwarded.WithLabelValues(ctx, mTypeLabel(msg.Payload.MType)).Inc()
+}
+
 func registerDropUplink(ctx context.Context, msg *ttnpb.UplinkMessage, err error) {
 	cause := unknown
 	if ttnErr, ok := errors.From(err); ok {
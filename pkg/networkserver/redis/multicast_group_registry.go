@@ -0,0 +1,179 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis/v7"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// appendImplicitMulticastGroupGetPaths appends implicit ttnpb.MulticastGroup get paths to paths.
+func appendImplicitMulticastGroupGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"ids",
+		"updated_at",
+	), paths...)
+}
+
+func applyMulticastGroupFieldMask(dst, src *ttnpb.MulticastGroup, paths ...string) (*ttnpb.MulticastGroup, error) {
+	if dst == nil {
+		dst = &ttnpb.MulticastGroup{}
+	}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// MulticastGroupRegistry is an implementation of networkserver.MulticastGroupRegistry.
+type MulticastGroupRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *MulticastGroupRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get implements networkserver.MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get multicast group").End()
+
+	pb := &ttnpb.MulticastGroup{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return applyMulticastGroupFieldMask(nil, pb, appendImplicitMulticastGroupGetPaths(paths...)...)
+}
+
+// Set implements networkserver.MulticastGroupRegistry.
+func (r *MulticastGroupRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error)) (*ttnpb.MulticastGroup, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "set multicast group").End()
+
+	var pb *ttnpb.MulticastGroup
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		cmd := ttnredis.GetProto(tx, uk)
+		stored := &ttnpb.MulticastGroup{}
+		if err := cmd.ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		gets = appendImplicitMulticastGroupGetPaths(gets...)
+
+		var err error
+		if stored != nil {
+			pb, err = applyMulticastGroupFieldMask(nil, stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = applyMulticastGroupFieldMask(nil, stored, gets...)
+			return err
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil && len(sets) == 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			if pb == nil {
+				pb = &ttnpb.MulticastGroup{}
+			}
+
+			pb.UpdatedAt = time.Now().UTC()
+			sets = append(append(sets[:0:0], sets...),
+				"updated_at",
+			)
+
+			updated := &ttnpb.MulticastGroup{}
+			if stored == nil {
+				if err := ttnpb.RequireFields(sets,
+					"ids.application_ids",
+					"ids.device_id",
+				); err != nil {
+					return errInvalidFieldmask.WithCause(err)
+				}
+
+				pb.CreatedAt = pb.UpdatedAt
+				sets = append(sets, "created_at")
+
+				updated, err = applyMulticastGroupFieldMask(updated, pb, sets...)
+				if err != nil {
+					return err
+				}
+				if updated.ApplicationID != ids.ApplicationID || updated.DeviceID != ids.DeviceID {
+					return errInvalidIdentifiers.New()
+				}
+			} else {
+				if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.ApplicationID != stored.ApplicationID {
+					return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+				}
+				if ttnpb.HasAnyField(sets, "ids.device_id") && pb.DeviceID != stored.DeviceID {
+					return errReadOnlyField.WithAttributes("field", "ids.device_id")
+				}
+				updated, err = applyMulticastGroupFieldMask(stored, pb, sets...)
+				if err != nil {
+					return err
+				}
+			}
+			if err := updated.ValidateFields(sets...); err != nil {
+				return err
+			}
+
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, uk, updated, 0)
+				return err
+			}
+
+			pb, err = applyMulticastGroupFieldMask(nil, updated, gets...)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.MulticastGroupRegistry = &MulticastGroupRegistry{}
+
+func TestMulticastGroupRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "networkserver_test", "multicast-groups")
+	defer flush()
+	defer cl.Close()
+
+	reg := &MulticastGroupRegistry{
+		Redis: cl,
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "mc1",
+	}
+	members := []ttnpb.EndDeviceIdentifiers{
+		{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+			DeviceID:               "dev1",
+		},
+	}
+	gateways := []ttnpb.GatewayAntennaIdentifiers{
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw1"},
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw2"},
+			AntennaIndex:       1,
+		},
+	}
+
+	_, err := reg.Get(ctx, ids, []string{"gateways"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	pb, err := reg.Set(ctx, ids, []string{"gateways", "member_ids"}, func(pb *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) {
+		a.So(pb, should.BeNil)
+		return &ttnpb.MulticastGroup{
+			EndDeviceIdentifiers: ids,
+			MemberIDs:            members,
+			Gateways:             gateways,
+		}, []string{"ids", "member_ids", "gateways"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pb.EndDeviceIdentifiers, should.Resemble, ids)
+	a.So(pb.MemberIDs, should.Resemble, members)
+	a.So(pb.Gateways, should.Resemble, gateways)
+	a.So(pb.CreatedAt, should.NotBeZeroValue)
+	a.So(pb.UpdatedAt, should.Equal, pb.CreatedAt)
+
+	pb, err = reg.Get(ctx, ids, []string{"gateways"})
+	if a.So(err, should.BeNil) {
+		a.So(pb.Gateways, should.Resemble, gateways)
+		a.So(pb.MemberIDs, should.BeEmpty)
+	}
+
+	pb, err = reg.Set(ctx, ids, []string{"gateways", "member_ids"}, func(pb *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) {
+		if a.So(pb, should.NotBeNil) {
+			a.So(pb.Gateways, should.Resemble, gateways)
+		}
+		return &ttnpb.MulticastGroup{
+			Gateways: gateways[:1],
+		}, []string{"gateways"}, nil
+	})
+	if a.So(err, should.BeNil) {
+		a.So(pb.MemberIDs, should.Resemble, members)
+		a.So(pb.Gateways, should.Resemble, gateways[:1])
+		a.So(pb.UpdatedAt, should.HappenAfter, pb.CreatedAt)
+	}
+
+	_, err = reg.Set(ctx, ids, nil, func(pb *ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) {
+		return &ttnpb.MulticastGroup{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ids.ApplicationIdentifiers,
+				DeviceID:               "mc2",
+			},
+		}, []string{"ids.device_id"}, nil
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	err = networkserver.DeleteMulticastGroup(ctx, reg, ids)
+	a.So(err, should.BeNil)
+
+	_, err = reg.Get(ctx, ids, []string{"gateways"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
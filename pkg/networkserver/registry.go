@@ -63,6 +63,20 @@ func DeleteDevice(ctx context.Context, r DeviceRegistry, appID ttnpb.Application
 	return err
 }
 
+// MulticastGroupRegistry is a registry, containing multicast groups.
+type MulticastGroupRegistry interface {
+	// Get returns the multicast group of the multicast end device identified by ids.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.MulticastGroup, error)
+	// Set creates, updates or deletes the multicast group of the multicast end device identified by ids.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error)) (*ttnpb.MulticastGroup, error)
+}
+
+// DeleteMulticastGroup deletes the multicast group of the multicast end device identified by ids from r.
+func DeleteMulticastGroup(ctx context.Context, r MulticastGroupRegistry, ids ttnpb.EndDeviceIdentifiers) error {
+	_, err := r.Set(ctx, ids, nil, func(*ttnpb.MulticastGroup) (*ttnpb.MulticastGroup, []string, error) { return nil, nil, nil })
+	return err
+}
+
 func logRegistryRPCError(ctx context.Context, err error, msg string) {
 	logger := log.FromContext(ctx).WithError(err)
 	var printLog func(string)
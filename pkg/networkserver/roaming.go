@@ -0,0 +1,506 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// passiveRoamingGatewayIdentifiers identifies the gateway in the metadata of uplink messages received from a
+// Forwarding Network Server through passive roaming.
+var passiveRoamingGatewayIdentifiers = ttnpb.GatewayIdentifiers{
+	GatewayID: "passive-roaming",
+}
+
+// forwardingUplinkToken is the ULToken the Forwarding Network Server passes in the gateway metadata of a PRStartReq.
+// The token is encrypted with the roaming token key, so that the Home Network Server cannot read or alter it.
+type forwardingUplinkToken struct {
+	GatewayIdentifiers ttnpb.GatewayIdentifiers `json:"gateway_ids"`
+	UplinkToken        []byte                   `json:"uplink_token"`
+}
+
+var errRoamingTokenKey = errors.DefineFailedPrecondition("roaming_token_key", "invalid roaming token key", "length")
+
+// newRoamingTokenEncrypter returns the encrypter of forwarding uplink tokens for the AES 128 or 256-bit key.
+func newRoamingTokenEncrypter(key []byte) (jose.Encrypter, error) {
+	var (
+		enc jose.ContentEncryption
+		alg jose.KeyAlgorithm
+	)
+	switch l := len(key); l {
+	case 16:
+		enc, alg = jose.A128GCM, jose.A128GCMKW
+	case 32:
+		enc, alg = jose.A256GCM, jose.A256GCMKW
+	default:
+		return nil, errRoamingTokenKey.WithAttributes("length", l)
+	}
+	encrypter, err := jose.NewEncrypter(enc, jose.Recipient{
+		Algorithm: alg,
+		Key:       key,
+	}, nil)
+	if err != nil {
+		return nil, errRoamingTokenKey.WithCause(err)
+	}
+	return encrypter, nil
+}
+
+func wrapForwardingUplinkToken(ids ttnpb.GatewayIdentifiers, ulToken []byte, encrypter jose.Encrypter) ([]byte, error) {
+	plaintext, err := json.Marshal(forwardingUplinkToken{
+		GatewayIdentifiers: ids,
+		UplinkToken:        ulToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	obj, err := encrypter.Encrypt(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	s, err := obj.CompactSerialize()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+func unwrapForwardingUplinkToken(token, key []byte) (forwardingUplinkToken, error) {
+	obj, err := jose.ParseEncrypted(string(token))
+	if err != nil {
+		return forwardingUplinkToken{}, err
+	}
+	plaintext, err := obj.Decrypt(key)
+	if err != nil {
+		return forwardingUplinkToken{}, err
+	}
+	var t forwardingUplinkToken
+	if err := json.Unmarshal(plaintext, &t); err != nil {
+		return forwardingUplinkToken{}, err
+	}
+	return t, nil
+}
+
+// homeUplinkToken is the uplink token the Home Network Server stores in the metadata of uplink messages received
+// through passive roaming.
+type homeUplinkToken struct {
+	NetID      types.NetID `json:"net_id"`
+	ULToken    []byte      `json:"ul_token,omitempty"`
+	FNSULToken []byte      `json:"fns_ul_token,omitempty"`
+}
+
+func isPassiveRoamingUplink(up *ttnpb.UplinkMessage) bool {
+	for _, md := range up.RxMetadata {
+		if md.GatewayID == passiveRoamingGatewayIdentifiers.GatewayID {
+			return true
+		}
+	}
+	return false
+}
+
+// passiveRoamingForwardingNetID returns the NetID of the network with which a passive roaming agreement exists that
+// devAddr belongs to and for which the Network Server acts as Forwarding Network Server.
+func (ns *NetworkServer) passiveRoamingForwardingNetID(up *ttnpb.UplinkMessage, devAddr types.DevAddr) (types.NetID, bool) {
+	if ns.roamingClient == nil || isPassiveRoamingUplink(up) {
+		return types.NetID{}, false
+	}
+	netID, ok := ns.roamingClient.PassiveRoamingNetID(devAddr)
+	if !ok || netID.Equal(ns.netID) {
+		return types.NetID{}, false
+	}
+	agreement, ok := ns.roamingClient.PassiveRoamingAgreement(netID)
+	if !ok || !agreement.Forwarding {
+		return types.NetID{}, false
+	}
+	return netID, true
+}
+
+var (
+	errNoPassiveRoamingAgreement   = errors.DefineNotFound("no_passive_roaming_agreement", "no passive roaming agreement with `{net_id}`")
+	errPassiveRoamingNotConfigured = errors.DefineFailedPrecondition("passive_roaming_not_configured", "passive roaming is not configured")
+)
+
+// passiveRoamingHomeNetIDByEUI returns the NetID of the Home Network Server of the end device identified by the EUIs,
+// if a passive roaming agreement exists for which the Network Server acts as Forwarding Network Server.
+func (ns *NetworkServer) passiveRoamingHomeNetIDByEUI(joinEUI, devEUI types.EUI64) func(context.Context) (types.NetID, error) {
+	return func(ctx context.Context) (types.NetID, error) {
+		netID, err := ns.roamingClient.HomeNSRequest(ctx, ns.netID, joinEUI, devEUI)
+		if err != nil {
+			return types.NetID{}, err
+		}
+		return ns.passiveRoamingHomeNetID(*netID)
+	}
+}
+
+// passiveRoamingHomeNetID returns netID if a passive roaming agreement exists with the network identified by netID
+// for which the Network Server acts as Forwarding Network Server.
+func (ns *NetworkServer) passiveRoamingHomeNetID(netID types.NetID) (types.NetID, error) {
+	if netID.Equal(ns.netID) {
+		return types.NetID{}, errNoPassiveRoamingAgreement.WithAttributes("net_id", netID)
+	}
+	agreement, ok := ns.roamingClient.PassiveRoamingAgreement(netID)
+	if !ok || !agreement.Forwarding {
+		return types.NetID{}, errNoPassiveRoamingAgreement.WithAttributes("net_id", netID)
+	}
+	return netID, nil
+}
+
+// deduplicateRoamingUplink deduplicates up, waits for the deduplication window to pass and returns a copy of up with
+// merged metadata. If up is a duplicate, false is returned.
+func (ns *NetworkServer) deduplicateRoamingUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*ttnpb.UplinkMessage, bool, error) {
+	ok, err := ns.deduplicateUplink(ctx, nil, up)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		registerReceiveDuplicateUplink(ctx, up)
+		return nil, false, nil
+	}
+
+	up = CopyUplinkMessage(up)
+	select {
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	case <-ns.deduplicationDone(ctx, nil, up):
+	}
+	ns.mergeMetadata(ctx, up)
+	return up, true, nil
+}
+
+// forwardPassiveRoamingUplink deduplicates up and forwards it to the Home Network Server identified by the NetID
+// returned by homeNetID in a PRStartReq.
+func (ns *NetworkServer) forwardPassiveRoamingUplink(ctx context.Context, up *ttnpb.UplinkMessage, homeNetID func(context.Context) (types.NetID, error)) error {
+	up, ok, err := ns.deduplicateRoamingUplink(ctx, up)
+	if err != nil || !ok {
+		return err
+	}
+	netID, err := homeNetID(ctx)
+	if err != nil {
+		return err
+	}
+	return ns.sendPassiveRoamingUplink(ctx, up, netID)
+}
+
+// sendPassiveRoamingUplink forwards the deduplicated up to the Home Network Server identified by netID in a PRStartReq.
+func (ns *NetworkServer) sendPassiveRoamingUplink(ctx context.Context, up *ttnpb.UplinkMessage, netID types.NetID) error {
+	logger := log.FromContext(ctx).WithField("home_net_id", netID)
+
+	req := &interop.PRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID: interop.NetID(ns.netID),
+		},
+		PHYPayload: up.RawPayload,
+		ULMetaData: passiveRoamingULMetaData(up, ns.roamingTokenEncrypter),
+	}
+	logger.Debug("Forward uplink to Home Network Server")
+	if _, err := ns.roamingClient.PRStartRequest(ctx, netID, req); err != nil {
+		logger.WithError(err).Warn("Failed to forward uplink to Home Network Server")
+		return err
+	}
+	registerForwardPassiveRoamingUplink(ctx, up)
+	return nil
+}
+
+// uplinkBand returns the band and the data rate index of the uplink transmitted with settings.
+// As the frequency plan of the receiving gateways is not known to the Network Server, the first band in which the
+// frequency and the data rate are valid is used.
+func uplinkBand(settings ttnpb.TxSettings) (band.Band, ttnpb.DataRateIndex, bool) {
+	ids := make([]string, 0, len(band.All))
+	for id := range band.All {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		phy := band.All[id]
+		if _, ok := phy.FindSubBand(settings.Frequency); !ok {
+			continue
+		}
+		if idx, _, ok := phy.FindUplinkDataRate(settings.DataRate); ok {
+			return phy, idx, true
+		}
+	}
+	return band.Band{}, 0, false
+}
+
+// roamingGWInfo returns the gateway information of rxMD, without the uplink token.
+func roamingGWInfo(rxMD *ttnpb.RxMetadata, rfRegion string) interop.GWInfoElement {
+	rssi, snr := rxMD.RSSI, rxMD.SNR
+	gwInfo := interop.GWInfoElement{
+		ID:       interop.Buffer(rxMD.GatewayID),
+		RFRegion: rfRegion,
+		RSSI:     &rssi,
+		SNR:      &snr,
+	}
+	if rxMD.Location != nil {
+		lat, lon := rxMD.Location.Latitude, rxMD.Location.Longitude
+		gwInfo.Lat, gwInfo.Lon = &lat, &lon
+	}
+	return gwInfo
+}
+
+// passiveRoamingULMetaData returns the uplink metadata of up. The uplink tokens of the gateways are encrypted with
+// encrypter.
+func passiveRoamingULMetaData(up *ttnpb.UplinkMessage, encrypter jose.Encrypter) interop.ULMetaData {
+	ulFreq := float64(up.Settings.Frequency) / 1e6
+	gwCnt := len(up.RxMetadata)
+	md := interop.ULMetaData{
+		ULFreq:   &ulFreq,
+		RecvTime: up.ReceivedAt.UTC().Format(time.RFC3339Nano),
+		GWCnt:    &gwCnt,
+		GWInfo:   make([]interop.GWInfoElement, 0, len(up.RxMetadata)),
+	}
+	switch up.Payload.MType {
+	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
+		pld := up.Payload.GetMACPayload()
+		devAddr := interop.DevAddr(pld.DevAddr)
+		md.DevAddr = &devAddr
+		md.Confirmed = up.Payload.MType == ttnpb.MType_CONFIRMED_UP
+	case ttnpb.MType_JOIN_REQUEST:
+		devEUI := interop.EUI64(up.Payload.GetJoinRequestPayload().DevEUI)
+		md.DevEUI = &devEUI
+	}
+	if phy, idx, ok := uplinkBand(up.Settings); ok {
+		dataRate := int(idx)
+		md.DataRate = &dataRate
+		md.RFRegion, _ = interop.RFRegion(phy.ID)
+	}
+	for _, rxMD := range up.RxMetadata {
+		gwInfo := roamingGWInfo(rxMD, md.RFRegion)
+		if rxMD.PacketBroker == nil && len(rxMD.UplinkToken) > 0 && rxMD.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+			token, err := wrapForwardingUplinkToken(rxMD.GatewayIdentifiers, rxMD.UplinkToken, encrypter)
+			if err == nil {
+				gwInfo.ULToken = token
+				gwInfo.DLAllowed = true
+			}
+		}
+		md.GWInfo = append(md.GWInfo, gwInfo)
+	}
+	return md
+}
+
+var (
+	errNoRFRegion = errors.DefineInvalidArgument("no_rf_region", "no RFRegion specified")
+	errRFRegion   = errors.DefineInvalidArgument("rf_region", "unknown RFRegion `{rf_region}`")
+	errNoULFreq   = errors.DefineInvalidArgument("no_ul_freq", "no ULFreq specified")
+	errNoDataRate = errors.DefineInvalidArgument("no_data_rate", "no DataRate specified")
+)
+
+// passiveRoamingUplinkMessage converts the PRStartReq received from the Forwarding Network Server identified by netID
+// to an uplink message.
+func passiveRoamingUplinkMessage(netID types.NetID, req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	ulMD := req.ULMetaData
+	switch {
+	case ulMD.RFRegion == "":
+		return nil, errNoRFRegion.New()
+	case ulMD.ULFreq == nil:
+		return nil, errNoULFreq.New()
+	case ulMD.DataRate == nil:
+		return nil, errNoDataRate.New()
+	}
+	bandID, ok := interop.BandID(ulMD.RFRegion)
+	if !ok {
+		return nil, errRFRegion.WithAttributes("rf_region", ulMD.RFRegion)
+	}
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	drIdx := ttnpb.DataRateIndex(*ulMD.DataRate)
+	dr, ok := phy.DataRates[drIdx]
+	if !ok {
+		return nil, errDataRateIndexNotFound.WithAttributes("index", drIdx)
+	}
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      dr.Rate,
+			DataRateIndex: drIdx,
+			Frequency:     uint64(math.Round(*ulMD.ULFreq * 1e6)),
+		},
+		RxMetadata: make([]*ttnpb.RxMetadata, 0, len(ulMD.GWInfo)),
+	}
+	for _, gwInfo := range ulMD.GWInfo {
+		md := &ttnpb.RxMetadata{
+			GatewayIdentifiers: passiveRoamingGatewayIdentifiers,
+		}
+		if gwInfo.RSSI != nil {
+			md.RSSI = *gwInfo.RSSI
+			md.ChannelRSSI = *gwInfo.RSSI
+		}
+		if gwInfo.SNR != nil {
+			md.SNR = *gwInfo.SNR
+		}
+		if gwInfo.Lat != nil && gwInfo.Lon != nil {
+			md.Location = &ttnpb.Location{
+				Latitude:  *gwInfo.Lat,
+				Longitude: *gwInfo.Lon,
+				Source:    ttnpb.SOURCE_UNKNOWN,
+			}
+		}
+		if gwInfo.DLAllowed {
+			token, err := json.Marshal(homeUplinkToken{
+				NetID:      netID,
+				ULToken:    gwInfo.ULToken,
+				FNSULToken: ulMD.FNSULToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			md.UplinkToken = token
+		}
+		up.RxMetadata = append(up.RxMetadata, md)
+	}
+	if len(up.RxMetadata) == 0 {
+		up.RxMetadata = append(up.RxMetadata, &ttnpb.RxMetadata{
+			GatewayIdentifiers: passiveRoamingGatewayIdentifiers,
+		})
+	}
+	return up, nil
+}
+
+// passiveRoamingDownlinkTarget schedules downlink messages through the Forwarding Network Server of the network
+// identified by netID.
+type passiveRoamingDownlinkTarget struct {
+	client      RoamingClient
+	senderNetID types.NetID
+	netID       types.NetID
+}
+
+func (t *passiveRoamingDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*passiveRoamingDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.netID.Equal(t.netID)
+}
+
+var (
+	errUplinkToken  = errors.DefineInvalidArgument("uplink_token", "invalid uplink token")
+	errNoTxRequest  = errors.DefineInvalidArgument("no_tx_request", "no TxRequest specified")
+	errNoDLMetaData = errors.DefineInvalidArgument("no_dl_meta_data", "no DLMetaData specified")
+	errClassMode    = errors.DefineInvalidArgument("class_mode", "invalid ClassMode `{class_mode}`")
+)
+
+var passiveRoamingClassModes = map[ttnpb.Class]string{
+	ttnpb.CLASS_A: "A",
+	ttnpb.CLASS_B: "B",
+	ttnpb.CLASS_C: "C",
+}
+
+func (t *passiveRoamingDownlinkTarget) Schedule(ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption) (time.Duration, error) {
+	req := msg.GetRequest()
+	if req == nil {
+		return 0, errNoTxRequest.New()
+	}
+	dlMD := &interop.DLMetaData{
+		ClassMode:      passiveRoamingClassModes[req.Class],
+		RXDelay1:       int(req.Rx1Delay),
+		GWInfo:         make([]interop.GWInfoElement, 0, len(req.DownlinkPaths)),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGHEST,
+	}
+	if req.Rx1Frequency != 0 {
+		freq, dataRate := float64(req.Rx1Frequency)/1e6, int(req.Rx1DataRateIndex)
+		dlMD.DLFreq1, dlMD.DataRate1 = &freq, &dataRate
+	}
+	if req.Rx2Frequency != 0 {
+		freq, dataRate := float64(req.Rx2Frequency)/1e6, int(req.Rx2DataRateIndex)
+		dlMD.DLFreq2, dlMD.DataRate2 = &freq, &dataRate
+	}
+	for _, path := range req.DownlinkPaths {
+		var token homeUplinkToken
+		if err := json.Unmarshal(path.GetUplinkToken(), &token); err != nil {
+			return 0, errUplinkToken.WithCause(err)
+		}
+		if dlMD.FNSULToken == nil {
+			dlMD.FNSULToken = token.FNSULToken
+		}
+		dlMD.GWInfo = append(dlMD.GWInfo, interop.GWInfoElement{
+			ULToken: token.ULToken,
+		})
+	}
+	if _, err := t.client.XmitDataRequest(ctx, t.netID, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID: interop.NetID(t.senderNetID),
+		},
+		PHYPayload: msg.RawPayload,
+		DLMetaData: dlMD,
+	}); err != nil {
+		return 0, err
+	}
+	return peeringScheduleDelay, nil
+}
+
+// passiveRoamingDownlinkTarget returns the downlink target of the Forwarding Network Server that forwarded the uplink
+// message in which the uplink token of path was received.
+func (ns *NetworkServer) passiveRoamingDownlinkTarget(path downlinkPath) (downlinkTarget, error) {
+	if ns.roamingClient == nil {
+		return nil, errPassiveRoamingNotConfigured.New()
+	}
+	var token homeUplinkToken
+	if err := json.Unmarshal(path.GetUplinkToken(), &token); err != nil {
+		return nil, errUplinkToken.WithCause(err)
+	}
+	return &passiveRoamingDownlinkTarget{
+		client:      ns.roamingClient,
+		senderNetID: ns.netID,
+		netID:       token.NetID,
+	}, nil
+}
+
+// passiveRoamingTxRequest converts the DLMetaData received from the Home Network Server to a TxRequest.
+func passiveRoamingTxRequest(dlMD *interop.DLMetaData) (*ttnpb.TxRequest, error) {
+	req := &ttnpb.TxRequest{
+		Rx1Delay: ttnpb.RxDelay(dlMD.RXDelay1),
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if dlMD.ClassMode != "" {
+		var ok bool
+		for class, classMode := range passiveRoamingClassModes {
+			if classMode == dlMD.ClassMode {
+				req.Class, ok = class, true
+				break
+			}
+		}
+		if !ok {
+			return nil, errClassMode.WithAttributes("class_mode", dlMD.ClassMode)
+		}
+	}
+	if dlMD.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGHEST
+	}
+	if dlMD.DLFreq1 != nil && dlMD.DataRate1 != nil {
+		req.Rx1Frequency = uint64(math.Round(*dlMD.DLFreq1 * 1e6))
+		req.Rx1DataRateIndex = ttnpb.DataRateIndex(*dlMD.DataRate1)
+	}
+	if dlMD.DLFreq2 != nil && dlMD.DataRate2 != nil {
+		req.Rx2Frequency = uint64(math.Round(*dlMD.DLFreq2 * 1e6))
+		req.Rx2DataRateIndex = ttnpb.DataRateIndex(*dlMD.DataRate2)
+	}
+	return req, nil
+}
@@ -0,0 +1,200 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPassiveRoamingUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	gtwIDs := ttnpb.GatewayIdentifiers{
+		GatewayID: "test-gateway",
+	}
+	dataRate := (&ttnpb.LoRaDataRate{
+		SpreadingFactor: 7,
+		Bandwidth:       125000,
+	}).DataRate()
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x04, 0x03, 0x02, 0x01, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+						FCnt:    1,
+					},
+				},
+			},
+		},
+		Settings: ttnpb.TxSettings{
+			DataRate:  dataRate,
+			Frequency: 868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: gtwIDs,
+				RSSI:               -42,
+				SNR:                5.5,
+				UplinkToken:        []byte("test-token"),
+			},
+		},
+		ReceivedAt: time.Unix(42, 0).UTC(),
+	}
+
+	tokenKey := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	encrypter, err := newRoamingTokenEncrypter(tokenKey)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ulMD := passiveRoamingULMetaData(up, encrypter)
+	a.So(ulMD.RFRegion, should.Equal, "EU868")
+	if !a.So(ulMD.DataRate, should.NotBeNil) || !a.So(ulMD.ULFreq, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(*ulMD.DataRate, should.Equal, 5)
+	a.So(*ulMD.ULFreq, should.Equal, 868.1)
+	a.So(ulMD.DevAddr, should.Resemble, &interop.DevAddr{0x01, 0x02, 0x03, 0x04})
+	if !a.So(ulMD.GWInfo, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(ulMD.GWInfo[0].DLAllowed, should.BeTrue)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	roamingUp, err := passiveRoamingUplinkMessage(netID, &interop.PRStartReq{
+		PHYPayload: up.RawPayload,
+		ULMetaData: ulMD,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(roamingUp.RawPayload, should.Resemble, up.RawPayload)
+	a.So(roamingUp.Settings, should.Resemble, ttnpb.TxSettings{
+		DataRate:      dataRate,
+		DataRateIndex: ttnpb.DATA_RATE_5,
+		Frequency:     868100000,
+	})
+	a.So(isPassiveRoamingUplink(roamingUp), should.BeTrue)
+	if !a.So(roamingUp.RxMetadata, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	md := roamingUp.RxMetadata[0]
+	a.So(md.GatewayIdentifiers, should.Resemble, passiveRoamingGatewayIdentifiers)
+	a.So(md.RSSI, should.Equal, float32(-42))
+	a.So(md.SNR, should.Equal, float32(5.5))
+
+	var homeToken homeUplinkToken
+	if !a.So(json.Unmarshal(md.UplinkToken, &homeToken), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(homeToken.NetID, should.Equal, netID)
+	a.So(json.Valid(homeToken.ULToken), should.BeFalse)
+	forwardingToken, err := unwrapForwardingUplinkToken(homeToken.ULToken, tokenKey)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(forwardingToken, should.Resemble, forwardingUplinkToken{
+		GatewayIdentifiers: gtwIDs,
+		UplinkToken:        []byte("test-token"),
+	})
+
+	_, err = unwrapForwardingUplinkToken(homeToken.ULToken, []byte{0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00})
+	a.So(err, should.NotBeNil)
+	forged, err := json.Marshal(forwardingUplinkToken{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "other-gateway"},
+		UplinkToken:        []byte("test-token"),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = unwrapForwardingUplinkToken(forged, tokenKey)
+	a.So(err, should.NotBeNil)
+
+	paths := downlinkPathsFromMetadata(roamingUp.RxMetadata...)
+	if a.So(paths, should.HaveLength, 1) {
+		a.So(paths[0].GatewayIdentifiers, should.Resemble, &passiveRoamingGatewayIdentifiers)
+		a.So(paths[0].GetUplinkToken(), should.Resemble, md.UplinkToken)
+	}
+
+	_, err = passiveRoamingUplinkMessage(netID, &interop.PRStartReq{
+		PHYPayload: up.RawPayload,
+		ULMetaData: interop.ULMetaData{
+			RFRegion: "XX000",
+			DataRate: ulMD.DataRate,
+			ULFreq:   ulMD.ULFreq,
+		},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestPassiveRoamingTxRequest(t *testing.T) {
+	a := assertions.New(t)
+
+	dlFreq1, dlFreq2 := 868.1, 869.525
+	dataRate1, dataRate2 := 5, 0
+	req, err := passiveRoamingTxRequest(&interop.DLMetaData{
+		DLFreq1:        &dlFreq1,
+		DLFreq2:        &dlFreq2,
+		RXDelay1:       1,
+		ClassMode:      "A",
+		DataRate1:      &dataRate1,
+		DataRate2:      &dataRate2,
+		HiPriorityFlag: true,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(req, should.Resemble, &ttnpb.TxRequest{
+			Class:            ttnpb.CLASS_A,
+			Rx1Delay:         ttnpb.RX_DELAY_1,
+			Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+			Rx1Frequency:     868100000,
+			Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+			Rx2Frequency:     869525000,
+			Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+		})
+	}
+
+	req, err = passiveRoamingTxRequest(&interop.DLMetaData{
+		DLFreq2:   &dlFreq2,
+		ClassMode: "C",
+		DataRate2: &dataRate2,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(req, should.Resemble, &ttnpb.TxRequest{
+			Class:            ttnpb.CLASS_C,
+			Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+			Rx2Frequency:     869525000,
+			Priority:         ttnpb.TxSchedulePriority_NORMAL,
+		})
+	}
+
+	_, err = passiveRoamingTxRequest(&interop.DLMetaData{
+		ClassMode: "D",
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
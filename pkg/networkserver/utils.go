@@ -386,6 +386,7 @@ func publishEvents(ctx context.Context, evs ...events.Event) {
 }
 
 func (ns *NetworkServer) enqueueApplicationUplinks(ctx context.Context, ups ...*ttnpb.ApplicationUp) {
+	ups = ns.handoverRoamingApplicationUplinks(ctx, ups...)
 	n := len(ups)
 	if n == 0 {
 		return
@@ -55,13 +55,16 @@ type TenantContextFiller func(parent context.Context, tenantID string) (context.
 
 // Agent implements the Packet Broker Agent component, acting as Home Network.
 //
-// Agent exposes the GsPba and NsPba interfaces for forwarding uplink and subscribing to uplink.
+// Agent exposes the GsPba and NsPba interfaces for forwarding uplink and subscribing to uplink,
+// and the Pba interface for managing peering through Packet Broker.
 type Agent struct {
 	*component.Component
 	ctx context.Context
 
-	dataPlaneAddress string
-	netID            types.NetID
+	dataPlaneAddress    string
+	controlPlaneAddress string
+	iamAddress          string
+	netID               types.NetID
 	tenantID,
 	clusterID string
 	tlsConfig         tlsconfig.ClientAuth
@@ -70,6 +73,7 @@ type Agent struct {
 	devAddrPrefixes   []types.DevAddrPrefix
 
 	tenantContextFillers []TenantContextFiller
+	controlPlane         ControlPlane
 
 	upstreamCh   chan *ttnpb.GatewayUplinkMessage
 	downstreamCh chan *ttnpb.DownlinkMessage
@@ -77,6 +81,7 @@ type Agent struct {
 	grpc struct {
 		nsPba ttnpb.NsPbaServer
 		gsPba ttnpb.GsPbaServer
+		pba   ttnpb.PbaServer
 	}
 }
 
@@ -91,6 +96,14 @@ func WithTenantContextFiller(filler TenantContextFiller) Option {
 	}
 }
 
+// WithControlPlane returns an Option that sets the Packet Broker Control Plane used for managing routing policies.
+// By default, the Control Plane is dialed at the configured address.
+func WithControlPlane(cp ControlPlane) Option {
+	return func(a *Agent) {
+		a.controlPlane = cp
+	}
+}
+
 var (
 	errNetID    = errors.DefineFailedPrecondition("net_id", "invalid NetID `{net_id}`")
 	errTokenKey = errors.DefineFailedPrecondition("token_key", "invalid token key", "length")
@@ -121,14 +134,16 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 		Component: c,
 		ctx:       ctx,
 
-		dataPlaneAddress:  conf.DataPlaneAddress,
-		netID:             conf.NetID,
-		tenantID:          conf.TenantID,
-		clusterID:         conf.ClusterID,
-		tlsConfig:         conf.TLS,
-		forwarderConfig:   conf.Forwarder,
-		homeNetworkConfig: conf.HomeNetwork,
-		devAddrPrefixes:   devAddrPrefixes,
+		dataPlaneAddress:    conf.DataPlaneAddress,
+		controlPlaneAddress: conf.ControlPlaneAddress,
+		iamAddress:          conf.IAMAddress,
+		netID:               conf.NetID,
+		tenantID:            conf.TenantID,
+		clusterID:           conf.ClusterID,
+		tlsConfig:           conf.TLS,
+		forwarderConfig:     conf.Forwarder,
+		homeNetworkConfig:   conf.HomeNetwork,
+		devAddrPrefixes:     devAddrPrefixes,
 	}
 	if a.forwarderConfig.Enable {
 		a.upstreamCh = make(chan *ttnpb.GatewayUplinkMessage, upstreamBufferSize)
@@ -166,9 +181,28 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 	a.grpc.gsPba = &gsPbaServer{
 		upstreamCh: a.upstreamCh,
 	}
+	if a.controlPlaneAddress != "" {
+		a.controlPlane = &controlPlaneClient{
+			dialControlPlane: func(ctx context.Context) (*grpc.ClientConn, error) {
+				return a.dialContext(ctx, a.tlsConfig, a.controlPlaneAddress)
+			},
+			dialIAM: func(ctx context.Context) (*grpc.ClientConn, error) {
+				if a.iamAddress == "" {
+					return nil, errNoIAM.New()
+				}
+				return a.dialContext(ctx, a.tlsConfig, a.iamAddress)
+			},
+		}
+	}
 	for _, opt := range opts {
 		opt(a)
 	}
+	a.grpc.pba = &pbaServer{
+		Component:    c,
+		netID:        a.netID,
+		tenantID:     a.tenantID,
+		controlPlane: a.controlPlane,
+	}
 
 	newTaskConfig := func(id string, fn component.TaskFunc) *component.TaskConfig {
 		return &component.TaskConfig{
@@ -190,6 +224,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsPba", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsPba", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Pba", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(a)
 	return a, nil
@@ -209,10 +244,12 @@ func (a *Agent) Roles() []ttnpb.ClusterRole {
 func (a *Agent) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterNsPbaServer(s, a.grpc.nsPba)
 	ttnpb.RegisterGsPbaServer(s, a.grpc.gsPba)
+	ttnpb.RegisterPbaServer(s, a.grpc.pba)
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (a *Agent) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterPbaHandler(a.Context(), s, conn)
 }
 
 func (a *Agent) dialContext(ctx context.Context, config tlsconfig.ClientAuth, target string) (*grpc.ClientConn, error) {
@@ -32,6 +32,7 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent/mock"
@@ -733,3 +734,108 @@ func TestHomeNetwork(t *testing.T) {
 		})
 	})
 }
+
+func TestPeering(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	cp := mock.NewPBControlPlane()
+	cp.HomeNetworks = []*ttnpb.PacketBrokerNetwork{
+		{
+			ID: &ttnpb.PacketBrokerNetworkIdentifier{
+				NetID:    0x000013,
+				TenantID: "test",
+			},
+			Name:            "Test Network",
+			DevAddrPrefixes: []string{"26000000/7"},
+		},
+	}
+	test.Must(New(c, &Config{
+		NetID:    types.NetID{0x0, 0x0, 0x42},
+		TenantID: "test",
+	}, append(testOptions, WithControlPlane(cp))...))
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_PACKET_BROKER_AGENT)
+
+	client := ttnpb.NewPbaClient(c.LoopbackConn())
+	forwarderID := &ttnpb.PacketBrokerNetworkIdentifier{
+		NetID:    0x000042,
+		TenantID: "test",
+	}
+	homeNetworkID := &ttnpb.PacketBrokerNetworkIdentifier{
+		NetID:    0x000013,
+		TenantID: "test",
+	}
+	uplink := &ttnpb.PacketBrokerRoutingPolicyUplink{
+		JoinRequest: true,
+		MacData:     true,
+	}
+	downlink := &ttnpb.PacketBrokerRoutingPolicyDownlink{
+		JoinAccept: true,
+	}
+
+	t.Run("DefaultRoutingPolicy", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := client.SetHomeNetworkDefaultRoutingPolicy(ctx, &ttnpb.SetPacketBrokerDefaultRoutingPolicyRequest{
+			Uplink:   uplink,
+			Downlink: downlink,
+		}, c.WithClusterAuth())
+		a.So(err, should.BeNil)
+
+		policy, err := client.GetHomeNetworkDefaultRoutingPolicy(ctx, ttnpb.Empty, c.WithClusterAuth())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(policy.Uplink, should.Resemble, uplink)
+		a.So(policy.Downlink, should.Resemble, downlink)
+		a.So(policy.UpdatedAt, should.NotBeNil)
+
+		_, err = client.DeleteHomeNetworkDefaultRoutingPolicy(ctx, ttnpb.Empty, c.WithClusterAuth())
+		a.So(err, should.BeNil)
+
+		_, err = client.GetHomeNetworkDefaultRoutingPolicy(ctx, ttnpb.Empty, c.WithClusterAuth())
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("RoutingPolicy", func(t *testing.T) {
+		a := assertions.New(t)
+
+		_, err := client.SetHomeNetworkRoutingPolicy(ctx, &ttnpb.SetPacketBrokerRoutingPolicyRequest{
+			HomeNetworkID: homeNetworkID,
+			Uplink:        uplink,
+			Downlink:      downlink,
+		}, c.WithClusterAuth())
+		a.So(err, should.BeNil)
+
+		policy, err := client.GetHomeNetworkRoutingPolicy(ctx, homeNetworkID, c.WithClusterAuth())
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(policy.ForwarderID, should.Resemble, forwarderID)
+		a.So(policy.HomeNetworkID, should.Resemble, homeNetworkID)
+		a.So(policy.Uplink, should.Resemble, uplink)
+		a.So(policy.Downlink, should.Resemble, downlink)
+
+		policies, err := client.ListHomeNetworkRoutingPolicies(ctx, ttnpb.Empty, c.WithClusterAuth())
+		if a.So(err, should.BeNil) && a.So(policies.Policies, should.HaveLength, 1) {
+			a.So(policies.Policies[0].HomeNetworkID, should.Resemble, homeNetworkID)
+		}
+
+		_, err = client.DeleteHomeNetworkRoutingPolicy(ctx, homeNetworkID, c.WithClusterAuth())
+		a.So(err, should.BeNil)
+
+		_, err = client.GetHomeNetworkRoutingPolicy(ctx, homeNetworkID, c.WithClusterAuth())
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("HomeNetworks", func(t *testing.T) {
+		a := assertions.New(t)
+
+		networks, err := client.ListHomeNetworks(ctx, ttnpb.Empty, c.WithClusterAuth())
+		if a.So(err, should.BeNil) {
+			a.So(networks.Networks, should.Resemble, cp.HomeNetworks)
+		}
+	})
+}
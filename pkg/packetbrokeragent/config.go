@@ -22,13 +22,15 @@ import (
 
 // Config configures Packet Broker clients.
 type Config struct {
-	DataPlaneAddress string               `name:"data-plane-address" description:"Address of the Packet Broker Data Plane"`
-	NetID            types.NetID          `name:"net-id" description:"LoRa Alliance NetID"`
-	TenantID         string               `name:"tenant-id" description:"Tenant ID within the NetID"`
-	ClusterID        string               `name:"cluster-id" description:"Cluster ID uniquely identifying this cluster within a NetID and tenant"`
-	TLS              tlsconfig.ClientAuth `name:"tls"`
-	Forwarder        ForwarderConfig      `name:"forwarder" description:"Forwarder configuration for publishing uplink messages and subscribing to downlink messages"`
-	HomeNetwork      HomeNetworkConfig    `name:"home-network" description:"Home Network configuration for subscribing to uplink and publishing downlink messages"`
+	DataPlaneAddress    string               `name:"data-plane-address" description:"Address of the Packet Broker Data Plane"`
+	ControlPlaneAddress string               `name:"control-plane-address" description:"Address of the Packet Broker Control Plane"`
+	IAMAddress          string               `name:"iam-address" description:"Address of the Packet Broker IAM"`
+	NetID               types.NetID          `name:"net-id" description:"LoRa Alliance NetID"`
+	TenantID            string               `name:"tenant-id" description:"Tenant ID within the NetID"`
+	ClusterID           string               `name:"cluster-id" description:"Cluster ID uniquely identifying this cluster within a NetID and tenant"`
+	TLS                 tlsconfig.ClientAuth `name:"tls"`
+	Forwarder           ForwarderConfig      `name:"forwarder" description:"Forwarder configuration for publishing uplink messages and subscribing to downlink messages"`
+	HomeNetwork         HomeNetworkConfig    `name:"home-network" description:"Home Network configuration for subscribing to uplink and publishing downlink messages"`
 }
 
 // ForwarderConfig defines configuration of the Forwarder role.
@@ -0,0 +1,289 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+	"fmt"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	iampb "go.packetbroker.org/api/iam"
+	routingpb "go.packetbroker.org/api/routing"
+	packetbroker "go.packetbroker.org/api/v3"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// ControlPlane is the Packet Broker Control Plane that manages the routing policies, together with the Packet Broker
+// IAM that manages the network registrations.
+// Routing policies are set by a Forwarder for each Home Network, with a default policy for the Home Networks that do
+// not have a specific policy.
+type ControlPlane interface {
+	// GetDefaultRoutingPolicy returns the default routing policy of the Forwarder.
+	GetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) (*ttnpb.PacketBrokerRoutingPolicy, error)
+	// SetDefaultRoutingPolicy sets the default routing policy of the Forwarder.
+	SetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error
+	// DeleteDefaultRoutingPolicy deletes the default routing policy of the Forwarder.
+	DeleteDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) error
+	// ListRoutingPolicies lists the routing policies of the Forwarder for specific Home Networks.
+	ListRoutingPolicies(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) ([]*ttnpb.PacketBrokerRoutingPolicy, error)
+	// GetRoutingPolicy returns the routing policy of the Forwarder for the Home Network.
+	GetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) (*ttnpb.PacketBrokerRoutingPolicy, error)
+	// SetRoutingPolicy sets the routing policy of the Forwarder for the Home Network.
+	SetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error
+	// DeleteRoutingPolicy deletes the routing policy of the Forwarder for the Home Network.
+	DeleteRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) error
+	// ListHomeNetworks lists the networks and tenants that are registered as Home Network with Packet Broker.
+	ListHomeNetworks(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error)
+	// ListForwarders lists the networks and tenants that are registered as Forwarder with Packet Broker.
+	ListForwarders(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error)
+}
+
+// controlPlaneClient is a ControlPlane that connects to the Packet Broker Control Plane for the routing policies and
+// to the Packet Broker IAM for the network registrations.
+type controlPlaneClient struct {
+	dialControlPlane func(ctx context.Context) (*grpc.ClientConn, error)
+	dialIAM          func(ctx context.Context) (*grpc.ClientConn, error)
+}
+
+func toPBRoutingPolicy(forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) *packetbroker.RoutingPolicy {
+	res := &packetbroker.RoutingPolicy{
+		ForwarderNetId:    forwarderID.GetNetID(),
+		ForwarderTenantId: forwarderID.GetTenantID(),
+	}
+	if homeNetworkID != nil {
+		res.HomeNetworkNetId = homeNetworkID.NetID
+		res.HomeNetworkTenantId = homeNetworkID.TenantID
+	}
+	if uplink != nil {
+		res.Uplink = &packetbroker.RoutingPolicy_Uplink{
+			JoinRequest:     uplink.JoinRequest,
+			MacData:         uplink.MacData,
+			ApplicationData: uplink.ApplicationData,
+			SignalQuality:   uplink.SignalQuality,
+			Localization:    uplink.Localization,
+		}
+	}
+	if downlink != nil {
+		res.Downlink = &packetbroker.RoutingPolicy_Downlink{
+			JoinAccept:      downlink.JoinAccept,
+			MacData:         downlink.MacData,
+			ApplicationData: downlink.ApplicationData,
+		}
+	}
+	return res
+}
+
+func fromPBRoutingPolicy(policy *packetbroker.RoutingPolicy) (*ttnpb.PacketBrokerRoutingPolicy, error) {
+	res := &ttnpb.PacketBrokerRoutingPolicy{
+		ForwarderID: &ttnpb.PacketBrokerNetworkIdentifier{
+			NetID:    policy.GetForwarderNetId(),
+			TenantID: policy.GetForwarderTenantId(),
+		},
+	}
+	if policy.GetHomeNetworkNetId() != 0 || policy.GetHomeNetworkTenantId() != "" {
+		res.HomeNetworkID = &ttnpb.PacketBrokerNetworkIdentifier{
+			NetID:    policy.GetHomeNetworkNetId(),
+			TenantID: policy.GetHomeNetworkTenantId(),
+		}
+	}
+	if policy.GetUpdatedAt() != nil {
+		updatedAt, err := pbtypes.TimestampFromProto(policy.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		res.UpdatedAt = &updatedAt
+	}
+	if up := policy.GetUplink(); up != nil {
+		res.Uplink = &ttnpb.PacketBrokerRoutingPolicyUplink{
+			JoinRequest:     up.JoinRequest,
+			MacData:         up.MacData,
+			ApplicationData: up.ApplicationData,
+			SignalQuality:   up.SignalQuality,
+			Localization:    up.Localization,
+		}
+	}
+	if down := policy.GetDownlink(); down != nil {
+		res.Downlink = &ttnpb.PacketBrokerRoutingPolicyDownlink{
+			JoinAccept:      down.JoinAccept,
+			MacData:         down.MacData,
+			ApplicationData: down.ApplicationData,
+		}
+	}
+	return res, nil
+}
+
+func (c *controlPlaneClient) withPolicyManager(ctx context.Context, f func(routingpb.PolicyManagerClient) error) error {
+	conn, err := c.dialControlPlane(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return f(routingpb.NewPolicyManagerClient(conn))
+}
+
+// GetDefaultRoutingPolicy implements ControlPlane.
+func (c *controlPlaneClient) GetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) (res *ttnpb.PacketBrokerRoutingPolicy, err error) {
+	err = c.withPolicyManager(ctx, func(client routingpb.PolicyManagerClient) error {
+		pbRes, err := client.GetDefaultPolicy(ctx, &routingpb.GetDefaultPolicyRequest{
+			ForwarderNetId:    forwarderID.NetID,
+			ForwarderTenantId: forwarderID.TenantID,
+		})
+		if err != nil {
+			return err
+		}
+		res, err = fromPBRoutingPolicy(pbRes.GetPolicy())
+		return err
+	})
+	return
+}
+
+// SetDefaultRoutingPolicy implements ControlPlane.
+func (c *controlPlaneClient) SetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error {
+	return c.withPolicyManager(ctx, func(client routingpb.PolicyManagerClient) error {
+		_, err := client.SetDefaultPolicy(ctx, &routingpb.SetPolicyRequest{
+			Policy: toPBRoutingPolicy(forwarderID, nil, uplink, downlink),
+		})
+		return err
+	})
+}
+
+// DeleteDefaultRoutingPolicy implements ControlPlane.
+// The Packet Broker routing policy manager has no delete RPC; a policy is deleted by setting it without uplink and
+// downlink policies.
+func (c *controlPlaneClient) DeleteDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) error {
+	return c.SetDefaultRoutingPolicy(ctx, forwarderID, nil, nil)
+}
+
+// ListRoutingPolicies implements ControlPlane.
+func (c *controlPlaneClient) ListRoutingPolicies(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) (res []*ttnpb.PacketBrokerRoutingPolicy, err error) {
+	err = c.withPolicyManager(ctx, func(client routingpb.PolicyManagerClient) error {
+		pbRes, err := client.ListHomeNetworkPolicies(ctx, &routingpb.ListHomeNetworkPoliciesRequest{
+			ForwarderNetId:    forwarderID.NetID,
+			ForwarderTenantId: forwarderID.TenantID,
+		})
+		if err != nil {
+			return err
+		}
+		res = make([]*ttnpb.PacketBrokerRoutingPolicy, 0, len(pbRes.GetPolicies()))
+		for _, pbPolicy := range pbRes.GetPolicies() {
+			policy, err := fromPBRoutingPolicy(pbPolicy)
+			if err != nil {
+				return err
+			}
+			res = append(res, policy)
+		}
+		return nil
+	})
+	return
+}
+
+// GetRoutingPolicy implements ControlPlane.
+func (c *controlPlaneClient) GetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) (res *ttnpb.PacketBrokerRoutingPolicy, err error) {
+	err = c.withPolicyManager(ctx, func(client routingpb.PolicyManagerClient) error {
+		pbRes, err := client.GetHomeNetworkPolicy(ctx, &routingpb.GetHomeNetworkPolicyRequest{
+			ForwarderNetId:      forwarderID.NetID,
+			ForwarderTenantId:   forwarderID.TenantID,
+			HomeNetworkNetId:    homeNetworkID.NetID,
+			HomeNetworkTenantId: homeNetworkID.TenantID,
+		})
+		if err != nil {
+			return err
+		}
+		res, err = fromPBRoutingPolicy(pbRes.GetPolicy())
+		return err
+	})
+	return
+}
+
+// SetRoutingPolicy implements ControlPlane.
+func (c *controlPlaneClient) SetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error {
+	return c.withPolicyManager(ctx, func(client routingpb.PolicyManagerClient) error {
+		_, err := client.SetHomeNetworkPolicy(ctx, &routingpb.SetPolicyRequest{
+			Policy: toPBRoutingPolicy(forwarderID, homeNetworkID, uplink, downlink),
+		})
+		return err
+	})
+}
+
+// DeleteRoutingPolicy implements ControlPlane.
+// The Packet Broker routing policy manager has no delete RPC; a policy is deleted by setting it without uplink and
+// downlink policies.
+func (c *controlPlaneClient) DeleteRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) error {
+	return c.SetRoutingPolicy(ctx, forwarderID, homeNetworkID, nil, nil)
+}
+
+func fromPBNetwork(netID uint32, tenantID, name string, blocks []*packetbroker.DevAddrBlock) *ttnpb.PacketBrokerNetwork {
+	res := &ttnpb.PacketBrokerNetwork{
+		ID: &ttnpb.PacketBrokerNetworkIdentifier{
+			NetID:    netID,
+			TenantID: tenantID,
+		},
+		Name: name,
+	}
+	for _, block := range blocks {
+		prefix := block.GetPrefix()
+		if prefix == nil {
+			continue
+		}
+		res.DevAddrPrefixes = append(res.DevAddrPrefixes, fmt.Sprintf("%08X/%d", prefix.Value, prefix.Length))
+	}
+	return res
+}
+
+func (c *controlPlaneClient) listNetworks(ctx context.Context, filter func(blocks []*packetbroker.DevAddrBlock) bool) ([]*ttnpb.PacketBrokerNetwork, error) {
+	conn, err := c.dialIAM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	networks, err := iampb.NewNetworkRegistryClient(conn).ListNetworks(ctx, &iampb.ListNetworksRequest{})
+	if err != nil {
+		return nil, err
+	}
+	tenants, err := iampb.NewTenantRegistryClient(conn).ListTenants(ctx, &iampb.ListTenantsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*ttnpb.PacketBrokerNetwork, 0, len(networks.GetNetworks())+len(tenants.GetTenants()))
+	for _, network := range networks.GetNetworks() {
+		if filter(network.DevAddrBlocks) {
+			res = append(res, fromPBNetwork(network.NetId, "", network.Name, network.DevAddrBlocks))
+		}
+	}
+	for _, tenant := range tenants.GetTenants() {
+		if filter(tenant.DevAddrBlocks) {
+			res = append(res, fromPBNetwork(tenant.NetId, tenant.TenantId, tenant.Name, tenant.DevAddrBlocks))
+		}
+	}
+	return res, nil
+}
+
+// ListHomeNetworks implements ControlPlane.
+// Home Networks are the registered networks and tenants that have DevAddr blocks assigned.
+func (c *controlPlaneClient) ListHomeNetworks(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error) {
+	return c.listNetworks(ctx, func(blocks []*packetbroker.DevAddrBlock) bool {
+		return len(blocks) > 0
+	})
+}
+
+// ListForwarders implements ControlPlane.
+// All registered networks and tenants can act as Forwarder.
+func (c *controlPlaneClient) ListForwarders(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error) {
+	return c.listNetworks(ctx, func([]*packetbroker.DevAddrBlock) bool {
+		return true
+	})
+}
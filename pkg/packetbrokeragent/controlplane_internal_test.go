@@ -0,0 +1,111 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	routingpb "go.packetbroker.org/api/routing"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+type mockPolicyManager struct {
+	routingpb.PolicyManagerServer
+
+	mu                  sync.Mutex
+	defaultRequests     []*routingpb.SetPolicyRequest
+	homeNetworkRequests []*routingpb.SetPolicyRequest
+}
+
+func (m *mockPolicyManager) SetDefaultPolicy(_ context.Context, req *routingpb.SetPolicyRequest) (*pbtypes.Empty, error) {
+	m.mu.Lock()
+	m.defaultRequests = append(m.defaultRequests, req)
+	m.mu.Unlock()
+	return &pbtypes.Empty{}, nil
+}
+
+func (m *mockPolicyManager) SetHomeNetworkPolicy(_ context.Context, req *routingpb.SetPolicyRequest) (*pbtypes.Empty, error) {
+	m.mu.Lock()
+	m.homeNetworkRequests = append(m.homeNetworkRequests, req)
+	m.mu.Unlock()
+	return &pbtypes.Empty{}, nil
+}
+
+func TestControlPlaneClientRoutingPolicies(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	pm := &mockPolicyManager{}
+	srv := grpc.NewServer()
+	routingpb.RegisterPolicyManagerServer(srv, pm)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	cp := &controlPlaneClient{
+		dialControlPlane: func(ctx context.Context) (*grpc.ClientConn, error) {
+			return grpc.DialContext(ctx, lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+		},
+	}
+
+	forwarderID := &ttnpb.PacketBrokerNetworkIdentifier{NetID: 0x000013, TenantID: "foo-tenant"}
+	homeNetworkID := &ttnpb.PacketBrokerNetworkIdentifier{NetID: 0x000042}
+	uplink := &ttnpb.PacketBrokerRoutingPolicyUplink{JoinRequest: true, MacData: true}
+	downlink := &ttnpb.PacketBrokerRoutingPolicyDownlink{JoinAccept: true}
+
+	a.So(cp.SetDefaultRoutingPolicy(ctx, forwarderID, uplink, downlink), should.BeNil)
+	a.So(cp.DeleteDefaultRoutingPolicy(ctx, forwarderID), should.BeNil)
+	a.So(cp.SetRoutingPolicy(ctx, forwarderID, homeNetworkID, uplink, downlink), should.BeNil)
+	a.So(cp.DeleteRoutingPolicy(ctx, forwarderID, homeNetworkID), should.BeNil)
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	if !a.So(pm.defaultRequests, should.HaveLength, 2) || !a.So(pm.homeNetworkRequests, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, req := range append(pm.defaultRequests, pm.homeNetworkRequests...) {
+		a.So(req.GetPolicy().GetForwarderNetId(), should.Equal, forwarderID.NetID)
+		a.So(req.GetPolicy().GetForwarderTenantId(), should.Equal, forwarderID.TenantID)
+	}
+	for _, req := range pm.homeNetworkRequests {
+		a.So(req.GetPolicy().GetHomeNetworkNetId(), should.Equal, homeNetworkID.NetID)
+		a.So(req.GetPolicy().GetHomeNetworkTenantId(), should.BeEmpty)
+	}
+
+	// Setting a policy sends the uplink and downlink policies.
+	for _, req := range []*routingpb.SetPolicyRequest{pm.defaultRequests[0], pm.homeNetworkRequests[0]} {
+		a.So(req.GetPolicy().GetUplink().GetJoinRequest(), should.BeTrue)
+		a.So(req.GetPolicy().GetUplink().GetMacData(), should.BeTrue)
+		a.So(req.GetPolicy().GetUplink().GetApplicationData(), should.BeFalse)
+		a.So(req.GetPolicy().GetDownlink().GetJoinAccept(), should.BeTrue)
+	}
+
+	// Deleting a policy sets the policy without uplink and downlink policies.
+	for _, req := range []*routingpb.SetPolicyRequest{pm.defaultRequests[1], pm.homeNetworkRequests[1]} {
+		a.So(req.GetPolicy().GetUplink(), should.BeNil)
+		a.So(req.GetPolicy().GetDownlink(), should.BeNil)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type pbaServer struct {
+	*component.Component
+	netID        types.NetID
+	tenantID     string
+	controlPlane ControlPlane
+}
+
+var (
+	errNoControlPlane = errors.DefineFailedPrecondition("no_control_plane", "no Packet Broker Control Plane configured")
+	errNoIAM          = errors.DefineFailedPrecondition("no_iam", "no Packet Broker IAM configured")
+	errNotAdmin       = errors.DefinePermissionDenied("not_admin", "caller is not an admin")
+)
+
+// requireAdmin checks that the caller is part of the cluster or is an admin of the network.
+func (s *pbaServer) requireAdmin(ctx context.Context) error {
+	if err := clusterauth.Authorized(ctx); err == nil {
+		return nil
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, s.AllowInsecureForCredentials())
+	if err != nil {
+		return err
+	}
+	cc, err := s.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return err
+	}
+	authInfo, err := ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return err
+	}
+	if !authInfo.GetIsAdmin() {
+		return errNotAdmin.New()
+	}
+	return nil
+}
+
+// authorizedControlPlane returns the Packet Broker Control Plane if the caller is allowed to manage peering.
+func (s *pbaServer) authorizedControlPlane(ctx context.Context) (ControlPlane, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if s.controlPlane == nil {
+		return nil, errNoControlPlane.New()
+	}
+	return s.controlPlane, nil
+}
+
+func (s *pbaServer) forwarderID() *ttnpb.PacketBrokerNetworkIdentifier {
+	return &ttnpb.PacketBrokerNetworkIdentifier{
+		NetID:    s.netID.MarshalNumber(),
+		TenantID: s.tenantID,
+	}
+}
+
+// GetHomeNetworkDefaultRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) GetHomeNetworkDefaultRoutingPolicy(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.PacketBrokerDefaultRoutingPolicy, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := cp.GetDefaultRoutingPolicy(ctx, s.forwarderID())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.PacketBrokerDefaultRoutingPolicy{
+		UpdatedAt: policy.UpdatedAt,
+		Uplink:    policy.Uplink,
+		Downlink:  policy.Downlink,
+	}, nil
+}
+
+// SetHomeNetworkDefaultRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) SetHomeNetworkDefaultRoutingPolicy(ctx context.Context, req *ttnpb.SetPacketBrokerDefaultRoutingPolicyRequest) (*pbtypes.Empty, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.SetDefaultRoutingPolicy(ctx, s.forwarderID(), req.Uplink, req.Downlink); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// DeleteHomeNetworkDefaultRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) DeleteHomeNetworkDefaultRoutingPolicy(ctx context.Context, _ *pbtypes.Empty) (*pbtypes.Empty, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.DeleteDefaultRoutingPolicy(ctx, s.forwarderID()); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ListHomeNetworkRoutingPolicies implements ttnpb.PbaServer.
+func (s *pbaServer) ListHomeNetworkRoutingPolicies(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.PacketBrokerRoutingPolicies, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := cp.ListRoutingPolicies(ctx, s.forwarderID())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.PacketBrokerRoutingPolicies{
+		Policies: policies,
+	}, nil
+}
+
+// GetHomeNetworkRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) GetHomeNetworkRoutingPolicy(ctx context.Context, req *ttnpb.PacketBrokerNetworkIdentifier) (*ttnpb.PacketBrokerRoutingPolicy, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cp.GetRoutingPolicy(ctx, s.forwarderID(), req)
+}
+
+// SetHomeNetworkRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) SetHomeNetworkRoutingPolicy(ctx context.Context, req *ttnpb.SetPacketBrokerRoutingPolicyRequest) (*pbtypes.Empty, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.SetRoutingPolicy(ctx, s.forwarderID(), req.HomeNetworkID, req.Uplink, req.Downlink); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// DeleteHomeNetworkRoutingPolicy implements ttnpb.PbaServer.
+func (s *pbaServer) DeleteHomeNetworkRoutingPolicy(ctx context.Context, req *ttnpb.PacketBrokerNetworkIdentifier) (*pbtypes.Empty, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cp.DeleteRoutingPolicy(ctx, s.forwarderID(), req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// ListHomeNetworks implements ttnpb.PbaServer.
+func (s *pbaServer) ListHomeNetworks(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.PacketBrokerNetworks, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	networks, err := cp.ListHomeNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.PacketBrokerNetworks{
+		Networks: networks,
+	}, nil
+}
+
+// ListForwarders implements ttnpb.PbaServer.
+func (s *pbaServer) ListForwarders(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.PacketBrokerNetworks, error) {
+	cp, err := s.authorizedControlPlane(ctx)
+	if err != nil {
+		return nil, err
+	}
+	networks, err := cp.ListForwarders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.PacketBrokerNetworks{
+		Networks: networks,
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errRoutingPolicyNotFound = errors.DefineNotFound("routing_policy_not_found", "routing policy not found")
+
+type networkKey struct {
+	netID    uint32
+	tenantID string
+}
+
+func keyOf(id *ttnpb.PacketBrokerNetworkIdentifier) networkKey {
+	if id == nil {
+		return networkKey{}
+	}
+	return networkKey{id.NetID, id.TenantID}
+}
+
+// PBControlPlane is a mock Packet Broker Control Plane that keeps the routing policies in memory.
+type PBControlPlane struct {
+	HomeNetworks []*ttnpb.PacketBrokerNetwork
+	Forwarders   []*ttnpb.PacketBrokerNetwork
+
+	mu       sync.RWMutex
+	defaults map[networkKey]*ttnpb.PacketBrokerRoutingPolicy
+	policies map[networkKey]map[networkKey]*ttnpb.PacketBrokerRoutingPolicy
+}
+
+// NewPBControlPlane instantiates a new mock Packet Broker Control Plane.
+func NewPBControlPlane() *PBControlPlane {
+	return &PBControlPlane{
+		defaults: make(map[networkKey]*ttnpb.PacketBrokerRoutingPolicy),
+		policies: make(map[networkKey]map[networkKey]*ttnpb.PacketBrokerRoutingPolicy),
+	}
+}
+
+// GetDefaultRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) GetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) (*ttnpb.PacketBrokerRoutingPolicy, error) {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	policy, ok := cp.defaults[keyOf(forwarderID)]
+	if !ok {
+		return nil, errRoutingPolicyNotFound.New()
+	}
+	return policy, nil
+}
+
+// SetDefaultRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) SetDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	now := time.Now().UTC()
+	cp.defaults[keyOf(forwarderID)] = &ttnpb.PacketBrokerRoutingPolicy{
+		ForwarderID: forwarderID,
+		UpdatedAt:   &now,
+		Uplink:      uplink,
+		Downlink:    downlink,
+	}
+	return nil
+}
+
+// DeleteDefaultRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) DeleteDefaultRoutingPolicy(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	delete(cp.defaults, keyOf(forwarderID))
+	return nil
+}
+
+// ListRoutingPolicies implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) ListRoutingPolicies(ctx context.Context, forwarderID *ttnpb.PacketBrokerNetworkIdentifier) ([]*ttnpb.PacketBrokerRoutingPolicy, error) {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	var res []*ttnpb.PacketBrokerRoutingPolicy
+	for _, policy := range cp.policies[keyOf(forwarderID)] {
+		res = append(res, policy)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i].HomeNetworkID, res[j].HomeNetworkID
+		if a.NetID != b.NetID {
+			return a.NetID < b.NetID
+		}
+		return a.TenantID < b.TenantID
+	})
+	return res, nil
+}
+
+// GetRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) GetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) (*ttnpb.PacketBrokerRoutingPolicy, error) {
+	cp.mu.RLock()
+	defer cp.mu.RUnlock()
+	policy, ok := cp.policies[keyOf(forwarderID)][keyOf(homeNetworkID)]
+	if !ok {
+		return nil, errRoutingPolicyNotFound.New()
+	}
+	return policy, nil
+}
+
+// SetRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) SetRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier, uplink *ttnpb.PacketBrokerRoutingPolicyUplink, downlink *ttnpb.PacketBrokerRoutingPolicyDownlink) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	fKey, hKey := keyOf(forwarderID), keyOf(homeNetworkID)
+	if cp.policies[fKey] == nil {
+		cp.policies[fKey] = make(map[networkKey]*ttnpb.PacketBrokerRoutingPolicy)
+	}
+	now := time.Now().UTC()
+	cp.policies[fKey][hKey] = &ttnpb.PacketBrokerRoutingPolicy{
+		ForwarderID:   forwarderID,
+		HomeNetworkID: homeNetworkID,
+		UpdatedAt:     &now,
+		Uplink:        uplink,
+		Downlink:      downlink,
+	}
+	return nil
+}
+
+// DeleteRoutingPolicy implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) DeleteRoutingPolicy(ctx context.Context, forwarderID, homeNetworkID *ttnpb.PacketBrokerNetworkIdentifier) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	delete(cp.policies[keyOf(forwarderID)], keyOf(homeNetworkID))
+	return nil
+}
+
+// ListHomeNetworks implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) ListHomeNetworks(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error) {
+	return cp.HomeNetworks, nil
+}
+
+// ListForwarders implements packetbrokeragent.ControlPlane.
+func (cp *PBControlPlane) ListForwarders(ctx context.Context) ([]*ttnpb.PacketBrokerNetwork, error) {
+	return cp.Forwarders, nil
+}
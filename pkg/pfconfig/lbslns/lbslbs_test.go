@@ -444,3 +444,50 @@ func TestGetDataRatesFromFrequencyPlan(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBeaconing(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		BandID         string
+		Beaconing      *Beaconing
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "InvalidBandID",
+			BandID:         "EU",
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:   "EU",
+			BandID: "EU_863_870",
+			Beaconing: &Beaconing{
+				DataRate: 3,
+				Layout:   [3]int{2, 8, 17},
+				Freqs:    []int{869525000},
+			},
+		},
+		{
+			Name:   "US",
+			BandID: "US_902_928",
+			Beaconing: &Beaconing{
+				DataRate: 8,
+				Layout:   [3]int{5, 11, 23},
+				Freqs:    []int{923300000, 923900000, 924500000, 925100000, 925700000, 926300000, 926900000, 927500000},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cfg, err := GetBeaconing(tc.BandID)
+			if err != nil {
+				if tc.ErrorAssertion == nil || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if tc.ErrorAssertion != nil {
+				t.Fatalf("Expected error")
+			} else {
+				a.So(cfg, should.Resemble, tc.Beaconing)
+			}
+		})
+	}
+}
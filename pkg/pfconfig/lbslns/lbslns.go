@@ -32,7 +32,10 @@ const (
 	configHardwareSpecPrefix = "sx1301"
 )
 
-var errFrequencyPlan = errors.DefineInvalidArgument("frequency_plan", "invalid frequency plan `{name}`")
+var (
+	errFrequencyPlan = errors.DefineInvalidArgument("frequency_plan", "invalid frequency plan `{name}`")
+	errNoBeacon      = errors.DefineFailedPrecondition("no_beacon", "no beacon defined in band `{band_id}`")
+)
 
 // DataRates encodes the available datarates of the channel plan for the Station in the format below:
 // [0] -> SF (Spreading Factor; Range: 7...12 for LoRa, 0 for FSK)
@@ -57,6 +60,17 @@ type RouterConfig struct {
 	NoDwellTime bool `json:"nodwell"`
 
 	MuxTime float64 `json:"MuxTime"`
+
+	Beaconing *Beaconing `json:"bcning,omitempty"`
+}
+
+// Beaconing contains the class B beacon configuration of the Station.
+// The layout contains the offsets of the Time and InfoDesc fields and the length of the beacon frame.
+// The Station cycles through the frequencies in subsequent beacon periods.
+type Beaconing struct {
+	DataRate int    `json:"DR"`
+	Layout   [3]int `json:"layout"`
+	Freqs    []int  `json:"freqs"`
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -133,6 +147,31 @@ func GetRouterConfig(bandID string, fps map[string]*frequencyplans.FrequencyPlan
 	return conf, nil
 }
 
+// GetBeaconing returns the class B beacon configuration of the band.
+func GetBeaconing(bandID string) (*Beaconing, error) {
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, errFrequencyPlan.New()
+	}
+	layout, ok := phy.BeaconLayout()
+	if !ok || phy.Beacon.ComputeFrequency == nil {
+		return nil, errNoBeacon.WithAttributes("band_id", bandID)
+	}
+	conf := &Beaconing{
+		DataRate: int(phy.Beacon.DataRateIndex),
+		Layout:   [3]int{layout.TimeOffset, layout.InfoDescOffset, layout.Length},
+	}
+	// Bands hop over at most 8 beacon frequencies, one for each beacon period.
+	for i := 0; i < 8; i++ {
+		freq := int(phy.Beacon.ComputeFrequency(float64(i * 128)))
+		if i > 0 && freq == conf.Freqs[0] {
+			break
+		}
+		conf.Freqs = append(conf.Freqs, freq)
+	}
+	return conf, nil
+}
+
 // getDataRatesFromBandID parses the available data rates from the band into DataRates.
 func getDataRatesFromBandID(id string) (DataRates, error) {
 	phy, err := band.GetByID(id)
@@ -444,8 +444,12 @@ func DispatchTasks(r WatchCmdable, group, id string, maxLen int64, deadline time
 	var block time.Duration
 	if !deadline.IsZero() {
 		block = time.Until(deadline)
-		if block <= 0 {
+		switch {
+		case block <= 0:
 			block = time.Duration(-1)
+		case block < time.Millisecond:
+			// NOTE: Block is truncated to milliseconds and 0 blocks forever.
+			block = time.Millisecond
 		}
 	}
 
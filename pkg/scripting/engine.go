@@ -22,3 +22,14 @@ import (
 type Engine interface {
 	Run(ctx context.Context, script, fn string, params ...interface{}) (func(target interface{}) error, error)
 }
+
+// Program is a compiled script. A program can only be run by the engine that compiled it.
+type Program interface{}
+
+// ProgramEngine represents a scripting engine that compiles scripts to programs, so that scripts that are
+// run repeatedly are only compiled once.
+type ProgramEngine interface {
+	Engine
+	Compile(ctx context.Context, script string) (Program, error)
+	RunProgram(ctx context.Context, program Program, fn string, params ...interface{}) (func(target interface{}) error, error)
+}
@@ -30,7 +30,7 @@ type js struct {
 }
 
 // New returns a new Javascript scripting engine.
-func New(options scripting.Options) scripting.Engine {
+func New(options scripting.Options) scripting.ProgramEngine {
 	return &js{options}
 }
 
@@ -41,6 +41,7 @@ var (
 	errNoScriptOutput     = errors.DefineAborted("no_script_output", "no script output")
 	errRuntime            = errors.Define("runtime", "runtime error")
 	errEntrypointNotFound = errors.DefineNotFound("entrypoint_not_found", "entrypoint `{entrypoint}` not found")
+	errProgram            = errors.DefineInvalidArgument("program", "program is not compiled by the Javascript engine")
 )
 
 func convertError(err error) error {
@@ -61,9 +62,35 @@ func convertError(err error) error {
 }
 
 // Run executes the Javascript script in the environment env and returns the output.
-func (j *js) Run(ctx context.Context, script, fn string, params ...interface{}) (as func(target interface{}) error, err error) {
+func (j *js) Run(ctx context.Context, script, fn string, params ...interface{}) (func(target interface{}) error, error) {
+	program, err := j.Compile(ctx, script)
+	if err != nil {
+		runs.WithLabelValues("error").Inc()
+		return nil, err
+	}
+	return j.RunProgram(ctx, program, fn, params...)
+}
+
+// Compile compiles the Javascript script to a program. The program can be run concurrently.
+func (j *js) Compile(ctx context.Context, script string) (scripting.Program, error) {
+	defer trace.StartRegion(ctx, "compile javascript").End()
+
+	program, err := goja.Compile("", script, false)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return program, nil
+}
+
+// RunProgram executes the compiled Javascript program in the environment env and returns the output.
+func (j *js) RunProgram(ctx context.Context, program scripting.Program, fn string, params ...interface{}) (as func(target interface{}) error, err error) {
 	defer trace.StartRegion(ctx, "run javascript").End()
 
+	prg, ok := program.(*goja.Program)
+	if !ok {
+		return nil, errProgram.New()
+	}
+
 	start := time.Now()
 	defer func() {
 		runLatency.Observe(time.Since(start).Seconds())
@@ -94,7 +121,7 @@ func (j *js) Run(ctx context.Context, script, fn string, params ...interface{})
 		}
 	}()
 
-	_, err = vm.RunString(script)
+	_, err = vm.RunProgram(prg)
 	if err != nil {
 		return nil, convertError(err)
 	}
@@ -65,6 +65,30 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 1, 0}
 }
 
+type ApplicationPubSub_KafkaProvider_SASL_Mechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_SASL_PLAIN         ApplicationPubSub_KafkaProvider_SASL_Mechanism = 0
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 1
+	ApplicationPubSub_KafkaProvider_SASL_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASL_Mechanism = 2
+)
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_name = map[int32]string{
+	0: "PLAIN",
+	1: "SCRAM_SHA_256",
+	2: "SCRAM_SHA_512",
+}
+
+var ApplicationPubSub_KafkaProvider_SASL_Mechanism_value = map[string]int32{
+	"PLAIN":         0,
+	"SCRAM_SHA_256": 1,
+	"SCRAM_SHA_512": 2,
+}
+
+func (ApplicationPubSub_KafkaProvider_SASL_Mechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	PubSubID               string   `protobuf:"bytes,2,opt,name=pub_sub_id,json=pubSubId,proto3" json:"pub_sub_id,omitempty"`
@@ -124,6 +148,7 @@ type ApplicationPubSub struct {
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
 	//	*ApplicationPubSub_AWSIoT
+	//	*ApplicationPubSub_Kafka
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
@@ -192,10 +217,14 @@ type ApplicationPubSub_MQTT struct {
 type ApplicationPubSub_AWSIoT struct {
 	AWSIoT *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,101,opt,name=aws_iot,json=awsIot,proto3,oneof" json:"aws_iot,omitempty"`
 }
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,102,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
 
 func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()   {}
 func (*ApplicationPubSub_AWSIoT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider()  {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
 	if m != nil {
@@ -246,6 +275,13 @@ func (m *ApplicationPubSub) GetAWSIoT() *ApplicationPubSub_AWSIoTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetBaseTopic() string {
 	if m != nil {
 		return m.BaseTopic
@@ -336,6 +372,7 @@ func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
 		(*ApplicationPubSub_AWSIoT)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
 	}
 }
 
@@ -523,6 +560,11 @@ type ApplicationPubSub_AWSIoTProvider struct {
 	AccessKey *ApplicationPubSub_AWSIoTProvider_AccessKey `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
 	// If set, the integration will assume the given role during operation.
 	AssumeRole *ApplicationPubSub_AWSIoTProvider_AssumeRole `protobuf:"bytes,3,opt,name=assume_role,json=assumeRole,proto3" json:"assume_role,omitempty"`
+	// The X.509 client certificate registered with AWS IoT Core. PEM formatted.
+	// If set, the integration connects to the endpoint with mutual TLS instead of signed WebSocket requests.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
 	// The endpoint address to connect to. If the endpoint address is left empty,
 	// the integration will try to discover it.
 	EndpointAddress string `protobuf:"bytes,4,opt,name=endpoint_address,json=endpointAddress,proto3" json:"endpoint_address,omitempty"`
@@ -606,6 +648,20 @@ func (m *ApplicationPubSub_AWSIoTProvider) GetAssumeRole() *ApplicationPubSub_AW
 	return nil
 }
 
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub_AWSIoTProvider) GetEndpointAddress() string {
 	if m != nil {
 		return m.EndpointAddress
@@ -801,6 +857,168 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) GetStackName() str
 	return ""
 }
 
+// The Apache Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The bootstrap broker addresses, in host:port format.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	// The consumer group used to subscribe to the downlink topics.
+	// If empty, a consumer group derived from the application and pub/sub IDs is used.
+	ConsumerGroup string `protobuf:"bytes,2,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+	UseTLS        bool   `protobuf:"varint,3,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,4,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,5,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,6,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// If set, the integration will authenticate using SASL.
+	SASL                 *ApplicationPubSub_KafkaProvider_SASL `protobuf:"bytes,7,opt,name=sasl,proto3" json:"sasl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
+	if m != nil {
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetConsumerGroup() string {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASL() *ApplicationPubSub_KafkaProvider_SASL {
+	if m != nil {
+		return m.SASL
+	}
+	return nil
+}
+
+type ApplicationPubSub_KafkaProvider_SASL struct {
+	Mechanism            ApplicationPubSub_KafkaProvider_SASL_Mechanism `protobuf:"varint,1,opt,name=mechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism" json:"mechanism,omitempty"`
+	Username             string                                         `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string                                         `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Reset()      { *m = ApplicationPubSub_KafkaProvider_SASL{} }
+func (*ApplicationPubSub_KafkaProvider_SASL) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider_SASL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3, 0}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.Merge(m, src)
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider_SASL proto.InternalMessageInfo
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetMechanism() ApplicationPubSub_KafkaProvider_SASL_Mechanism {
+	if m != nil {
+		return m.Mechanism
+	}
+	return ApplicationPubSub_KafkaProvider_SASL_PLAIN
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 type ApplicationPubSub_Message struct {
 	// The topic on which the Application Server publishes or receives the messages.
 	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
@@ -811,7 +1029,7 @@ type ApplicationPubSub_Message struct {
 func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
 func (*ApplicationPubSub_Message) ProtoMessage() {}
 func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
 }
 func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,6 +1297,8 @@ func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASL_Mechanism", ApplicationPubSub_KafkaProvider_SASL_Mechanism_name, ApplicationPubSub_KafkaProvider_SASL_Mechanism_value)
 	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
 	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
@@ -1097,6 +1317,10 @@ func init() {
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_AssumeRole)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.AssumeRole")
 	proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
 	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider_DefaultIntegration)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider.DefaultIntegration")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider_SASL)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider.SASL")
 	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
 	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
@@ -1121,150 +1345,165 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0xe8, 0x87, 0x12, 0x87, 0x94, 0x44, 0x4f, 0x93, 0x66, 0xc3, 0x24, 0x4b, 0x97, 0x31,
-	0x52, 0x4a, 0xce, 0x92, 0x36, 0x95, 0x06, 0x09, 0xdd, 0xc2, 0xe6, 0x4a, 0x72, 0xac, 0x44, 0x51,
-	0xa4, 0x25, 0x8d, 0x34, 0xb6, 0xec, 0xc5, 0x90, 0x3b, 0xa2, 0x36, 0x5a, 0xee, 0xae, 0x77, 0x66,
-	0xa5, 0xa8, 0x89, 0x01, 0x23, 0xa7, 0xb4, 0x87, 0xc2, 0x40, 0x0f, 0x0d, 0x90, 0x43, 0x0b, 0x14,
-	0x45, 0x03, 0xf4, 0x92, 0x5b, 0x73, 0x6b, 0x80, 0x5e, 0x7c, 0x0c, 0xd0, 0x1e, 0x72, 0x52, 0x23,
-	0xaa, 0x87, 0xdc, 0x9a, 0x5b, 0x0d, 0x15, 0x28, 0x8a, 0x99, 0xdd, 0x25, 0x29, 0xd1, 0xb1, 0x4c,
-	0x19, 0xed, 0x89, 0x6f, 0xe6, 0xbd, 0xf7, 0xcd, 0xfb, 0xe3, 0x7b, 0x33, 0x0b, 0xcf, 0x59, 0x8e,
-	0x87, 0xb7, 0xb1, 0xad, 0x50, 0x86, 0x1b, 0x9b, 0x45, 0xec, 0x9a, 0x45, 0xec, 0xba, 0x96, 0xd9,
-	0xc0, 0xcc, 0x74, 0x6c, 0x4a, 0xbc, 0x2d, 0xe2, 0xe9, 0xae, 0x5f, 0xa7, 0x7e, 0xbd, 0xe0, 0x7a,
-	0x0e, 0x73, 0xd0, 0x24, 0x63, 0x76, 0x21, 0xd4, 0x2a, 0x6c, 0xcd, 0x66, 0x2a, 0x4d, 0x93, 0x6d,
-	0xf8, 0xf5, 0x42, 0xc3, 0x69, 0x15, 0x89, 0xbd, 0xe5, 0xec, 0xb8, 0x9e, 0xf3, 0xde, 0x4e, 0x51,
-	0x08, 0x37, 0x94, 0x26, 0xb1, 0x95, 0x2d, 0x6c, 0x99, 0x06, 0x66, 0xa4, 0xd8, 0x47, 0x04, 0x90,
-	0x19, 0xa5, 0x07, 0xa2, 0xe9, 0x34, 0x9d, 0x40, 0xb9, 0xee, 0xaf, 0x8b, 0x95, 0x58, 0x08, 0x2a,
-	0x14, 0x7f, 0xb6, 0xe9, 0x38, 0x4d, 0x8b, 0x04, 0xc6, 0xda, 0xb6, 0xc3, 0x02, 0x5b, 0x43, 0xae,
-	0x1c, 0x72, 0x3b, 0x18, 0x86, 0xef, 0x09, 0x81, 0x90, 0xff, 0xcc, 0x51, 0x3e, 0x69, 0xb9, 0x6c,
-	0x27, 0x64, 0x9e, 0x3e, 0xca, 0x5c, 0x37, 0x89, 0x65, 0xe8, 0x2d, 0x4c, 0x37, 0x43, 0x89, 0xec,
-	0x51, 0x09, 0x66, 0xb6, 0x08, 0x65, 0xb8, 0xe5, 0x86, 0x02, 0xcf, 0xf7, 0x47, 0xd4, 0x34, 0x88,
-	0xcd, 0xcc, 0x75, 0x93, 0x78, 0xa1, 0x91, 0xb9, 0xbf, 0x01, 0xf8, 0x6c, 0xa5, 0x1b, 0xe7, 0x15,
-	0xbf, 0x5e, 0xf5, 0xeb, 0x8b, 0x5d, 0x31, 0x84, 0xe1, 0x54, 0x4f, 0x1e, 0x74, 0xd3, 0xa0, 0x12,
-	0x38, 0x0d, 0xf2, 0xc9, 0xd2, 0x0b, 0x85, 0xc3, 0xf1, 0x2f, 0xf4, 0xc0, 0xf4, 0x00, 0xa8, 0xe9,
-	0x03, 0x75, 0xf4, 0x17, 0x60, 0x28, 0x0d, 0xee, 0xed, 0x66, 0x63, 0x5f, 0xee, 0x66, 0x81, 0x36,
-	0x89, 0x7b, 0x25, 0x29, 0x5a, 0x85, 0xd0, 0xf5, 0xeb, 0x3a, 0xf5, 0xeb, 0xba, 0x69, 0x48, 0x43,
-	0xa7, 0x41, 0x3e, 0xa1, 0xce, 0x1e, 0xa8, 0x67, 0xbc, 0x9c, 0x74, 0xa6, 0x24, 0xdf, 0xbc, 0x8e,
-	0x95, 0x9f, 0x9d, 0x53, 0x5e, 0xbd, 0x91, 0xbf, 0x58, 0xbe, 0xae, 0xdc, 0xb8, 0x18, 0x2d, 0xa7,
-	0xdf, 0x2f, 0xbd, 0x78, 0xfb, 0x4c, 0x7b, 0x37, 0x3b, 0x1e, 0x1a, 0x3d, 0xaf, 0x8d, 0xbb, 0xa1,
-	0xf9, 0xb9, 0xfb, 0xcf, 0xc2, 0x53, 0x7d, 0x6e, 0xa1, 0x15, 0x38, 0xdc, 0xb5, 0xff, 0xc5, 0x87,
-	0xd8, 0xdf, 0x17, 0x86, 0x07, 0x78, 0xc1, 0xa1, 0xd0, 0x1c, 0x84, 0x0d, 0x8f, 0x60, 0x46, 0x0c,
-	0x1d, 0x33, 0x61, 0x7a, 0xb2, 0x94, 0x29, 0x04, 0x99, 0x29, 0x44, 0x99, 0x29, 0xd4, 0xa2, 0xcc,
-	0xa8, 0xe3, 0x5c, 0xfd, 0xee, 0xdf, 0xb3, 0x40, 0x4b, 0x84, 0x7a, 0x15, 0xc6, 0x41, 0x7c, 0xd7,
-	0x88, 0x40, 0x86, 0x07, 0x01, 0x09, 0xf5, 0x2a, 0x0c, 0x5d, 0x84, 0xf1, 0x75, 0xc7, 0x6b, 0x61,
-	0x26, 0x8d, 0x88, 0x00, 0xfe, 0x30, 0x08, 0xe0, 0x13, 0xc7, 0x05, 0x50, 0x0b, 0xd5, 0xd0, 0x32,
-	0x1c, 0xb1, 0x31, 0xa3, 0xd2, 0x29, 0x71, 0x7e, 0xe1, 0xd8, 0xe8, 0x14, 0x96, 0x2b, 0xb5, 0xea,
-	0x8a, 0xe7, 0x6c, 0x99, 0x06, 0xf1, 0xd4, 0xf1, 0xf6, 0x6e, 0x76, 0x84, 0xef, 0x5c, 0x89, 0x69,
-	0x02, 0x87, 0xe3, 0xb5, 0x6e, 0x31, 0x26, 0x3d, 0xfd, 0xa8, 0x78, 0x6f, 0xae, 0xd6, 0x6a, 0x87,
-	0xf1, 0xf8, 0x0e, 0xc7, 0xe3, 0x38, 0xe8, 0x6d, 0x38, 0x86, 0xb7, 0xa9, 0x6e, 0x3a, 0x4c, 0x22,
-	0x02, 0xf2, 0xdc, 0xf1, 0x90, 0x95, 0xb7, 0xab, 0x8b, 0x4e, 0x17, 0x14, 0xb6, 0x77, 0xb3, 0xf1,
-	0x60, 0xef, 0x4a, 0x4c, 0x8b, 0xe3, 0x6d, 0xba, 0xe8, 0x30, 0xf4, 0x02, 0x84, 0x75, 0x4c, 0x89,
-	0xce, 0x1c, 0xd7, 0x6c, 0x48, 0x71, 0x11, 0xbd, 0xb1, 0x03, 0x75, 0xc4, 0x1b, 0x92, 0x0c, 0x2d,
-	0xc1, 0x59, 0x35, 0xce, 0x41, 0xcb, 0x70, 0xc2, 0x70, 0xb6, 0x6d, 0xcb, 0xb4, 0x37, 0x75, 0xd7,
-	0xa7, 0x1b, 0xd2, 0x98, 0x30, 0x63, 0xfa, 0x11, 0x3c, 0x23, 0x94, 0xe2, 0x26, 0xd1, 0x52, 0x91,
-	0xfe, 0x8a, 0x4f, 0x37, 0x50, 0x0d, 0xa6, 0x3b, 0x78, 0x1e, 0x71, 0x2d, 0xdc, 0x20, 0xd2, 0xf8,
-	0xa0, 0x90, 0x53, 0x11, 0x84, 0x16, 0x20, 0xa0, 0x15, 0x38, 0xe9, 0xbb, 0x02, 0xb3, 0x15, 0x88,
-	0x48, 0x89, 0x41, 0x31, 0x27, 0x02, 0x80, 0x70, 0x89, 0x5e, 0x87, 0xc9, 0x77, 0x1d, 0xd3, 0xd6,
-	0x71, 0xa3, 0x41, 0x5c, 0x26, 0xc1, 0x41, 0xe1, 0x20, 0xd7, 0xae, 0x08, 0x65, 0xb4, 0x04, 0x3b,
-	0x31, 0xd0, 0x71, 0x63, 0x53, 0x4a, 0x0e, 0x0a, 0x96, 0x8c, 0xd4, 0x2b, 0x8d, 0xcd, 0x43, 0x19,
-	0xb1, 0x39, 0x5c, 0xea, 0xc4, 0x19, 0x59, 0xc6, 0x47, 0xf0, 0x28, 0xb1, 0x99, 0x34, 0x71, 0x62,
-	0xbc, 0x2a, 0xb1, 0x19, 0xd2, 0x60, 0x27, 0x3d, 0xfa, 0x3a, 0x36, 0x2d, 0x62, 0x48, 0x93, 0x83,
-	0x22, 0x4e, 0x46, 0x08, 0x97, 0x05, 0xc0, 0x21, 0xcc, 0x5b, 0x3e, 0xf1, 0x89, 0x21, 0x4d, 0x9d,
-	0x18, 0x73, 0x55, 0x00, 0x70, 0x4c, 0xcb, 0x09, 0x1b, 0x3c, 0x75, 0xac, 0x2d, 0x62, 0x48, 0xe9,
-	0x81, 0x31, 0x23, 0x84, 0xaa, 0x00, 0xe0, 0x99, 0xe6, 0x43, 0xdb, 0x6c, 0x10, 0xdd, 0xc0, 0x0c,
-	0x4b, 0x68, 0xe0, 0x4c, 0x87, 0xea, 0xf3, 0x98, 0xe1, 0xcc, 0x3c, 0x4c, 0xf5, 0xb6, 0x1b, 0xf4,
-	0x12, 0x84, 0xe1, 0x95, 0xc0, 0xf7, 0x2c, 0xd1, 0xd0, 0x13, 0xea, 0x93, 0x07, 0xea, 0xa8, 0x37,
-	0xfc, 0x11, 0x00, 0xed, 0xdd, 0x6c, 0xa2, 0x2a, 0xb8, 0x57, 0xb5, 0x25, 0x2d, 0x11, 0x08, 0x5e,
-	0xf5, 0xac, 0xcc, 0x27, 0x71, 0x98, 0xea, 0xed, 0x32, 0x27, 0x83, 0x41, 0xe7, 0x60, 0xa2, 0x61,
-	0x99, 0xc4, 0x66, 0xdd, 0x71, 0xf5, 0xbd, 0xa0, 0x5f, 0x3c, 0xc5, 0xc7, 0xd1, 0x9c, 0xe0, 0xf1,
-	0x71, 0x14, 0x48, 0x2d, 0x1a, 0xe8, 0x79, 0x38, 0xee, 0x53, 0xe2, 0xd9, 0xb8, 0x45, 0x44, 0x7f,
-	0xef, 0x69, 0x30, 0x1d, 0x06, 0x17, 0x72, 0x31, 0xa5, 0xdb, 0x8e, 0x67, 0x84, 0x3d, 0xbc, 0x2b,
-	0x14, 0x31, 0x90, 0x09, 0x27, 0xa8, 0x5f, 0xa7, 0x0d, 0xcf, 0xac, 0x13, 0xfd, 0x96, 0x43, 0xa5,
-	0xd1, 0xd3, 0x20, 0x3f, 0x59, 0x2a, 0x0d, 0xd6, 0x5e, 0x0b, 0xab, 0x4e, 0x55, 0x4d, 0xb7, 0x77,
-	0xb3, 0xa9, 0x6a, 0x04, 0xb6, 0xea, 0x54, 0xb5, 0x14, 0xed, 0xae, 0x28, 0x6a, 0xc0, 0xa4, 0xeb,
-	0xd7, 0x2d, 0x93, 0x6e, 0x88, 0x83, 0xe2, 0x27, 0x3e, 0x68, 0xb2, 0xbd, 0x9b, 0x85, 0x2b, 0x01,
-	0x14, 0x3f, 0x06, 0xba, 0x11, 0x4d, 0xd1, 0xf3, 0x70, 0xcc, 0xe7, 0xbd, 0xd7, 0xa2, 0xa2, 0x9d,
-	0x8e, 0x07, 0x3d, 0xfa, 0x2a, 0x25, 0xb5, 0xa5, 0xaa, 0x16, 0xf7, 0x29, 0xa9, 0x59, 0x14, 0x9d,
-	0x86, 0x71, 0x66, 0x51, 0xbd, 0x81, 0x45, 0x7f, 0x4c, 0xa9, 0x89, 0xf6, 0x6e, 0x76, 0xb4, 0xb6,
-	0x54, 0x9d, 0xab, 0x68, 0xa3, 0xcc, 0xa2, 0x73, 0x18, 0xbd, 0x0a, 0xa7, 0x84, 0x44, 0x90, 0x96,
-	0x06, 0xf1, 0x98, 0x68, 0x7b, 0x29, 0xf5, 0x54, 0x7b, 0x37, 0x3b, 0xc1, 0x45, 0x05, 0x67, 0x8e,
-	0x78, 0x4c, 0x9b, 0xe0, 0x2a, 0x9d, 0x25, 0x7a, 0x19, 0x4e, 0xf6, 0xa8, 0x6e, 0x92, 0x1d, 0xd1,
-	0xe1, 0x52, 0x41, 0x78, 0x3a, 0x9a, 0x6f, 0x90, 0x1d, 0x2d, 0xd5, 0x51, 0x7c, 0x83, 0xec, 0xa0,
-	0xab, 0x70, 0x6c, 0x83, 0x60, 0x83, 0x78, 0x54, 0x4a, 0x9e, 0x1e, 0xce, 0x27, 0x4b, 0x17, 0x06,
-	0x0c, 0xcd, 0x95, 0x40, 0x7b, 0xc1, 0x66, 0xde, 0x8e, 0x16, 0x61, 0x65, 0xca, 0x30, 0xd5, 0xcb,
-	0x40, 0x69, 0x38, 0xcc, 0x6d, 0x12, 0xb5, 0xa9, 0x71, 0x12, 0x3d, 0x01, 0x47, 0xb7, 0xb0, 0xe5,
-	0x93, 0xa0, 0xf4, 0xb4, 0x60, 0x51, 0x1e, 0x7a, 0x05, 0xe4, 0x7e, 0x0c, 0x87, 0x57, 0x9d, 0x2a,
-	0x4a, 0xc3, 0x54, 0xa5, 0xa6, 0xbf, 0xf9, 0x56, 0xb5, 0xa6, 0xbf, 0xb5, 0x3c, 0xb7, 0x90, 0x8e,
-	0xa1, 0x53, 0x70, 0xa2, 0x52, 0xd3, 0x97, 0x16, 0x2a, 0xd1, 0x16, 0xe0, 0x42, 0x0b, 0x3f, 0xad,
-	0xcc, 0xd5, 0x96, 0xde, 0x09, 0x76, 0x86, 0x32, 0x3f, 0x87, 0x70, 0xf2, 0xf0, 0xc0, 0x44, 0x9f,
-	0x0c, 0xc1, 0xb8, 0x47, 0x9a, 0xa6, 0x63, 0x87, 0x7f, 0x8e, 0x0f, 0x87, 0x0e, 0xd4, 0xff, 0x00,
-	0xef, 0xdf, 0x40, 0x83, 0x78, 0x5d, 0xa1, 0x8e, 0xcf, 0x36, 0x94, 0xf3, 0x5a, 0x02, 0xbb, 0x0a,
-	0xc1, 0x94, 0x29, 0xe7, 0xf9, 0xdd, 0x4e, 0xb1, 0x1d, 0x8f, 0x6d, 0x3c, 0x70, 0x5d, 0xd2, 0x20,
-	0x76, 0x3b, 0x6a, 0x93, 0x11, 0xdd, 0x23, 0xdb, 0x5d, 0x97, 0xb4, 0x54, 0x03, 0x2b, 0x0d, 0x62,
-	0x33, 0x0f, 0x5b, 0xca, 0x79, 0x2d, 0x45, 0xfc, 0x9e, 0x15, 0x24, 0x7e, 0x80, 0x1b, 0xd2, 0x1d,
-	0x53, 0x88, 0xaf, 0x6c, 0x13, 0x01, 0xd7, 0x21, 0x4b, 0x5d, 0x72, 0x56, 0x83, 0x2d, 0xd2, 0x15,
-	0xa6, 0x38, 0xb2, 0x3b, 0xe1, 0xd3, 0x3e, 0xb2, 0x24, 0xc8, 0x08, 0x2d, 0x22, 0x4b, 0x5a, 0x18,
-	0x12, 0xf4, 0x0e, 0x84, 0x7c, 0x26, 0x52, 0x2a, 0xaa, 0x26, 0xb8, 0xfc, 0x95, 0x07, 0xbd, 0x94,
-	0x14, 0x2a, 0x02, 0x82, 0xd7, 0x57, 0x02, 0x47, 0x24, 0x5a, 0x83, 0x49, 0x4c, 0xa9, 0xdf, 0x22,
-	0xba, 0xe7, 0x58, 0x24, 0xbc, 0x13, 0x5e, 0x18, 0x1c, 0x5b, 0x60, 0x68, 0x8e, 0x45, 0x34, 0x88,
-	0x3b, 0x34, 0xfa, 0x1d, 0x80, 0x69, 0x62, 0x1b, 0xae, 0x63, 0xda, 0x4c, 0xc7, 0x86, 0xe1, 0x11,
-	0x4a, 0xc3, 0x96, 0xf3, 0xde, 0x81, 0xea, 0x7b, 0x54, 0xba, 0x03, 0x4a, 0xf6, 0xcd, 0x7c, 0x3e,
-	0xcf, 0xef, 0x8a, 0x15, 0xe5, 0x1a, 0xbf, 0x2e, 0x7e, 0xd0, 0x43, 0x77, 0xc9, 0x35, 0xe5, 0xc6,
-	0x4c, 0x0f, 0x63, 0x7a, 0xad, 0x30, 0x3d, 0x93, 0xbf, 0x5e, 0x51, 0xae, 0x85, 0x97, 0xcc, 0x0f,
-	0x7a, 0xe8, 0x2e, 0x29, 0xb4, 0xba, 0x8c, 0xe9, 0x0f, 0xa6, 0xcf, 0x68, 0x53, 0x91, 0x45, 0x95,
-	0xc0, 0x20, 0xa4, 0xc3, 0x31, 0x83, 0xac, 0x63, 0xdf, 0x62, 0xa2, 0xc9, 0x25, 0x4b, 0x73, 0x03,
-	0xfb, 0x3f, 0x1f, 0xe8, 0x2f, 0xda, 0x8c, 0x34, 0x83, 0xb7, 0xd7, 0x95, 0x98, 0x16, 0xa1, 0x66,
-	0xfe, 0x04, 0x60, 0xa2, 0x13, 0x7d, 0x74, 0x19, 0x4e, 0x74, 0xb3, 0xc9, 0x3b, 0x7b, 0x50, 0xf1,
-	0xb9, 0x03, 0x35, 0xed, 0x4d, 0xa6, 0xd3, 0x3c, 0x24, 0x63, 0x37, 0xaf, 0xaf, 0x6d, 0xdf, 0x98,
-	0xe1, 0x8f, 0x8e, 0x64, 0x47, 0x71, 0x71, 0x5e, 0x4b, 0x76, 0x12, 0xb7, 0x68, 0xa0, 0x59, 0x78,
-	0x8a, 0x92, 0x86, 0x47, 0x98, 0x7e, 0xa4, 0x38, 0x3a, 0xfd, 0x3c, 0xaf, 0x4d, 0x05, 0x12, 0xdd,
-	0xc3, 0x15, 0x38, 0x41, 0x09, 0xa5, 0x7c, 0x00, 0x33, 0x67, 0x93, 0xd8, 0xe1, 0x94, 0x18, 0x17,
-	0xb3, 0x48, 0xba, 0x33, 0xa4, 0xa5, 0x42, 0x76, 0x8d, 0x73, 0x33, 0xff, 0x02, 0x10, 0x76, 0x73,
-	0x8b, 0x34, 0x38, 0x8c, 0xbd, 0xe8, 0x2f, 0x7a, 0xe9, 0x40, 0x7d, 0xd9, 0x7b, 0xa9, 0x54, 0xba,
-	0x89, 0x3d, 0xbb, 0x8c, 0xb7, 0x69, 0xd9, 0xc4, 0xad, 0x72, 0xf9, 0x3a, 0x8f, 0xf3, 0xfb, 0xe7,
-	0x4b, 0xb7, 0xcb, 0xbc, 0xa0, 0xd6, 0x8a, 0xdd, 0xe8, 0xeb, 0x67, 0x7f, 0xf2, 0x62, 0xe1, 0x92,
-	0x72, 0xe3, 0x2c, 0x77, 0x6b, 0xb8, 0xa2, 0x2d, 0x6b, 0x1c, 0x0c, 0x2d, 0xc2, 0x24, 0x79, 0x8f,
-	0xf1, 0xd1, 0x64, 0x75, 0xc7, 0x5c, 0xfe, 0x40, 0x7d, 0xca, 0x7b, 0x52, 0xba, 0x97, 0x28, 0xa5,
-	0x79, 0x28, 0x84, 0x66, 0x79, 0xad, 0xa8, 0x04, 0x31, 0x81, 0x0b, 0xa1, 0xc2, 0xe2, 0xbc, 0x06,
-	0x23, 0xe5, 0x45, 0x03, 0xbd, 0x0e, 0xd3, 0x91, 0x73, 0xd1, 0x13, 0x38, 0xac, 0xe8, 0xa7, 0xfb,
-	0x5e, 0x39, 0xf3, 0xa1, 0x80, 0x3a, 0xf2, 0x31, 0x7f, 0xe0, 0x4c, 0x85, 0x8a, 0xd1, 0x76, 0xe6,
-	0x6d, 0x88, 0xfa, 0x93, 0x8a, 0x2a, 0x10, 0x8a, 0x47, 0xae, 0x2e, 0x26, 0x6c, 0x27, 0x71, 0x59,
-	0xef, 0x39, 0x9e, 0x36, 0xe9, 0x66, 0xe8, 0xed, 0x91, 0x0a, 0x3c, 0xa3, 0x25, 0x84, 0xd6, 0x32,
-	0x6e, 0x11, 0x35, 0x05, 0xa1, 0x41, 0x5c, 0xcb, 0xd9, 0x69, 0x11, 0x9b, 0x65, 0xf2, 0x70, 0x2c,
-	0xba, 0xfe, 0x3e, 0x07, 0x47, 0x83, 0x97, 0x01, 0x38, 0x3c, 0x93, 0x83, 0x5d, 0x75, 0x0a, 0x8e,
-	0xbb, 0x51, 0xbb, 0x1c, 0xbe, 0xaf, 0x82, 0xdc, 0x2a, 0x44, 0x7d, 0x75, 0x49, 0xd1, 0x05, 0x38,
-	0x16, 0x7c, 0xbc, 0xe0, 0xcf, 0x4f, 0x3e, 0x2d, 0x7e, 0x70, 0x6c, 0x31, 0x6b, 0x91, 0x46, 0xee,
-	0x0f, 0x00, 0x4a, 0x7d, 0xec, 0xcb, 0xe2, 0xd9, 0x46, 0xd1, 0x5b, 0x70, 0x2c, 0x78, 0xc1, 0x45,
-	0xc8, 0x3f, 0x3a, 0x16, 0x39, 0x54, 0x2d, 0x84, 0xbf, 0xe1, 0x04, 0x0a, 0x51, 0xf8, 0x04, 0xea,
-	0x65, 0x0c, 0x34, 0x81, 0x3e, 0x03, 0xf0, 0x99, 0xd7, 0x08, 0xeb, 0xf7, 0x85, 0xdc, 0xf2, 0x09,
-	0x65, 0xff, 0x83, 0x17, 0xf8, 0x45, 0x08, 0xbb, 0x9f, 0x46, 0xbe, 0xf3, 0x05, 0x7e, 0x99, 0x8b,
-	0xbc, 0x89, 0xe9, 0xa6, 0x3a, 0xc2, 0xd5, 0xb5, 0xc4, 0x7a, 0xb4, 0x91, 0xfb, 0x0b, 0x80, 0xcf,
-	0x2d, 0x99, 0xb4, 0xdf, 0x66, 0x1a, 0x19, 0xfd, 0x7f, 0xf8, 0x04, 0xf2, 0xd8, 0x5e, 0xfc, 0x11,
-	0xc0, 0x67, 0xaa, 0x0f, 0x09, 0xfc, 0x1b, 0x30, 0x1e, 0x54, 0x53, 0x68, 0xfa, 0xf1, 0xe5, 0xf7,
-	0x00, 0xab, 0x43, 0x88, 0xc7, 0xb6, 0xb6, 0xf4, 0xe7, 0x38, 0x7c, 0xfa, 0x01, 0xa6, 0x36, 0x4d,
-	0xca, 0x0b, 0xee, 0x5d, 0x08, 0x5f, 0x23, 0x2c, 0xaa, 0xef, 0xef, 0xf7, 0x01, 0x2f, 0xb4, 0x5c,
-	0xb6, 0x93, 0xc9, 0x3f, 0x6a, 0x99, 0xe7, 0x32, 0x1f, 0xfe, 0xf5, 0x1f, 0xbf, 0x1a, 0x7a, 0x02,
-	0xa1, 0x22, 0xa6, 0xc5, 0xc0, 0x05, 0x25, 0x2c, 0x76, 0xf4, 0x1b, 0x00, 0x87, 0x5f, 0x23, 0x0c,
-	0x9d, 0x3d, 0x8a, 0xf6, 0x90, 0x2a, 0xce, 0x1c, 0x1f, 0xbc, 0xdc, 0x15, 0x71, 0xa6, 0x8a, 0x2e,
-	0x75, 0xcf, 0x2c, 0xbe, 0x6f, 0x1a, 0xb4, 0x70, 0xa4, 0x92, 0x8e, 0xac, 0x6f, 0x07, 0x42, 0xdd,
-	0xcf, 0x61, 0xb7, 0xd1, 0x2f, 0x01, 0x1c, 0xe1, 0xf5, 0x89, 0x94, 0xa3, 0xa7, 0x3e, 0xb4, 0x6a,
-	0x33, 0xb9, 0x63, 0x8d, 0xa4, 0xb9, 0x59, 0x61, 0xa5, 0x82, 0xce, 0xf6, 0x5a, 0x79, 0x8c, 0x85,
-	0xe8, 0x9f, 0x00, 0x0e, 0x57, 0x1f, 0x14, 0xb2, 0xea, 0xe3, 0x85, 0xec, 0xd7, 0x40, 0x58, 0x73,
-	0x17, 0x64, 0x96, 0x7b, 0xcd, 0x09, 0xbf, 0xf9, 0x3e, 0x52, 0xec, 0x7a, 0x64, 0x7b, 0x42, 0x58,
-	0x06, 0x33, 0xd7, 0x2e, 0xe4, 0x5e, 0x3e, 0x19, 0x68, 0x19, 0xcc, 0xa0, 0xbb, 0x00, 0xc6, 0xe7,
-	0x89, 0x45, 0x18, 0x41, 0x03, 0xf5, 0xac, 0xcc, 0x77, 0xd4, 0x6e, 0xee, 0x92, 0xf0, 0xb4, 0x3c,
-	0xf3, 0xca, 0x00, 0x71, 0x17, 0x46, 0x47, 0x2e, 0xa9, 0xbf, 0x07, 0xf7, 0xf6, 0x64, 0xf0, 0xe5,
-	0x9e, 0x0c, 0xbe, 0xda, 0x93, 0x63, 0x5f, 0xef, 0xc9, 0xb1, 0x6f, 0xf6, 0xe4, 0xd8, 0xb7, 0x7b,
-	0x72, 0xec, 0xfe, 0x9e, 0x0c, 0xee, 0xb4, 0x65, 0xf0, 0x51, 0x5b, 0x8e, 0x7d, 0xda, 0x96, 0xc1,
-	0x67, 0x6d, 0x39, 0xf6, 0x79, 0x5b, 0x8e, 0x7d, 0xd1, 0x96, 0x63, 0xf7, 0xda, 0x32, 0xf8, 0xb2,
-	0x2d, 0x83, 0xaf, 0xda, 0x72, 0xec, 0xeb, 0xb6, 0x0c, 0xbe, 0x69, 0xcb, 0xb1, 0x6f, 0xdb, 0x32,
-	0xb8, 0xdf, 0x96, 0x63, 0x77, 0xf6, 0xe5, 0xd8, 0x47, 0xfb, 0x32, 0xb8, 0xbb, 0x2f, 0xc7, 0x3e,
-	0xde, 0x97, 0xc1, 0x6f, 0xf7, 0xe5, 0xd8, 0xa7, 0xfb, 0x72, 0xec, 0xb3, 0x7d, 0x19, 0x7c, 0xbe,
-	0x2f, 0x83, 0x2f, 0xf6, 0x65, 0x70, 0xad, 0xd8, 0x74, 0x0a, 0x6c, 0x83, 0xb0, 0x0d, 0xd3, 0x6e,
-	0xd2, 0x82, 0x4d, 0xd8, 0xb6, 0xe3, 0x6d, 0x16, 0x0f, 0x7f, 0x68, 0xde, 0x9a, 0x2d, 0xba, 0x9b,
-	0xcd, 0x22, 0x63, 0xb6, 0x5b, 0xaf, 0xc7, 0x85, 0xe7, 0xb3, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x06, 0x30, 0xcd, 0x28, 0xdf, 0x17, 0x00, 0x00,
+	// 2515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0x88, 0x12, 0x25, 0x3e, 0x52, 0x12, 0x3d, 0xcd, 0xcf, 0x86, 0x4e, 0x56, 0x2a, 0x6d,
+	0xa4, 0xb2, 0x1d, 0x92, 0x36, 0x95, 0x18, 0x89, 0x9c, 0xd6, 0x26, 0x25, 0xdb, 0x52, 0x2c, 0x2b,
+	0xd2, 0x92, 0x46, 0x1a, 0xff, 0x2d, 0x86, 0xdc, 0x11, 0xb5, 0xe1, 0x72, 0x77, 0xbd, 0x33, 0x2b,
+	0x45, 0x75, 0x0c, 0x18, 0x39, 0xa5, 0x3d, 0x14, 0x46, 0x7b, 0x68, 0x80, 0x1c, 0x5a, 0xa0, 0x28,
+	0x1a, 0xa0, 0x97, 0xdc, 0x9a, 0x5b, 0x03, 0xf4, 0xe2, 0x63, 0x80, 0xf6, 0x90, 0x93, 0x1a, 0x51,
+	0x3d, 0xe4, 0xd6, 0x9c, 0xda, 0x54, 0x2d, 0x8a, 0x62, 0xf6, 0x87, 0xa4, 0x24, 0xc7, 0x12, 0x65,
+	0xb4, 0x27, 0xbe, 0x99, 0xf7, 0xde, 0x37, 0x6f, 0xde, 0x7b, 0xfb, 0xde, 0xcc, 0x10, 0x4e, 0x1b,
+	0x96, 0x43, 0xd6, 0x88, 0x99, 0x65, 0x9c, 0xd4, 0x1a, 0x79, 0x62, 0xeb, 0x79, 0x62, 0xdb, 0x86,
+	0x5e, 0x23, 0x5c, 0xb7, 0x4c, 0x46, 0x9d, 0x55, 0xea, 0xa8, 0xb6, 0x5b, 0x65, 0x6e, 0x35, 0x67,
+	0x3b, 0x16, 0xb7, 0xf0, 0x08, 0xe7, 0x66, 0x2e, 0xd0, 0xca, 0xad, 0x4e, 0xa6, 0x8b, 0x75, 0x9d,
+	0xaf, 0xb8, 0xd5, 0x5c, 0xcd, 0x6a, 0xe6, 0xa9, 0xb9, 0x6a, 0xad, 0xdb, 0x8e, 0xf5, 0xee, 0x7a,
+	0xde, 0x13, 0xae, 0x65, 0xeb, 0xd4, 0xcc, 0xae, 0x12, 0x43, 0xd7, 0x08, 0xa7, 0xf9, 0x3d, 0x84,
+	0x0f, 0x99, 0xce, 0x76, 0x41, 0xd4, 0xad, 0xba, 0xe5, 0x2b, 0x57, 0xdd, 0x65, 0x6f, 0xe4, 0x0d,
+	0x3c, 0x2a, 0x10, 0x7f, 0xbe, 0x6e, 0x59, 0x75, 0x83, 0xfa, 0xc6, 0x9a, 0xa6, 0xc5, 0x7d, 0x5b,
+	0x03, 0xae, 0x1c, 0x70, 0xdb, 0x18, 0x9a, 0xeb, 0x78, 0x02, 0x01, 0xff, 0xe8, 0x6e, 0x3e, 0x6d,
+	0xda, 0x7c, 0x3d, 0x60, 0x8e, 0xef, 0x66, 0x2e, 0xeb, 0xd4, 0xd0, 0xd4, 0x26, 0x61, 0x8d, 0x40,
+	0x62, 0x6c, 0xb7, 0x04, 0xd7, 0x9b, 0x94, 0x71, 0xd2, 0xb4, 0x03, 0x81, 0x63, 0x7b, 0x3d, 0xaa,
+	0x6b, 0xd4, 0xe4, 0xfa, 0xb2, 0x4e, 0x9d, 0xc0, 0xc8, 0xcc, 0x9f, 0x11, 0x3c, 0x5f, 0xec, 0xf8,
+	0x79, 0xd1, 0xad, 0x96, 0xdd, 0xea, 0x5c, 0x47, 0x0c, 0x13, 0x18, 0xed, 0x8a, 0x83, 0xaa, 0x6b,
+	0x4c, 0x42, 0xe3, 0x68, 0x22, 0x51, 0x78, 0x31, 0xb7, 0xd3, 0xff, 0xb9, 0x2e, 0x98, 0x2e, 0x80,
+	0x52, 0x6a, 0xbb, 0x34, 0xf0, 0x13, 0xd4, 0x97, 0x42, 0x0f, 0x37, 0xc6, 0x22, 0x9f, 0x6f, 0x8c,
+	0x21, 0x65, 0x84, 0x74, 0x4b, 0x32, 0xbc, 0x04, 0x60, 0xbb, 0x55, 0x95, 0xb9, 0x55, 0x55, 0xd7,
+	0xa4, 0xbe, 0x71, 0x34, 0x11, 0x2f, 0x4d, 0x6e, 0x97, 0x8e, 0x3b, 0x19, 0xe9, 0x78, 0x41, 0xbe,
+	0x7d, 0x83, 0x64, 0x7f, 0x74, 0x3a, 0xfb, 0xda, 0xad, 0x89, 0xf3, 0x53, 0x37, 0xb2, 0xb7, 0xce,
+	0x87, 0xc3, 0x13, 0x77, 0x0b, 0x2f, 0xdd, 0x3b, 0xde, 0xda, 0x18, 0x1b, 0x0a, 0x8c, 0x9e, 0x51,
+	0x86, 0xec, 0xc0, 0xfc, 0xcc, 0x67, 0xc7, 0xe0, 0xc8, 0x9e, 0x6d, 0xe1, 0x45, 0x88, 0x76, 0xec,
+	0x7f, 0xe9, 0x31, 0xf6, 0xef, 0x71, 0xc3, 0x23, 0x76, 0x21, 0xa0, 0xf0, 0x34, 0x40, 0xcd, 0xa1,
+	0x84, 0x53, 0x4d, 0x25, 0xdc, 0x33, 0x3d, 0x51, 0x48, 0xe7, 0xfc, 0xc8, 0xe4, 0xc2, 0xc8, 0xe4,
+	0x2a, 0x61, 0x64, 0x4a, 0x43, 0x42, 0xfd, 0xc1, 0x5f, 0xc6, 0x90, 0x12, 0x0f, 0xf4, 0x8a, 0x5c,
+	0x80, 0xb8, 0xb6, 0x16, 0x82, 0x44, 0x7b, 0x01, 0x09, 0xf4, 0x8a, 0x1c, 0x9f, 0x87, 0xd8, 0xb2,
+	0xe5, 0x34, 0x09, 0x97, 0xfa, 0x3d, 0x07, 0x7e, 0xcf, 0x77, 0xe0, 0x53, 0xfb, 0x39, 0x50, 0x09,
+	0xd4, 0xf0, 0x02, 0xf4, 0x9b, 0x84, 0x33, 0xe9, 0x88, 0xb7, 0x7e, 0x6e, 0x5f, 0xef, 0xe4, 0x16,
+	0x8a, 0x95, 0xf2, 0xa2, 0x63, 0xad, 0xea, 0x1a, 0x75, 0x4a, 0x43, 0xad, 0x8d, 0xb1, 0x7e, 0x31,
+	0x33, 0x1b, 0x51, 0x3c, 0x1c, 0x81, 0xd7, 0xbc, 0xc3, 0xb9, 0xf4, 0xdc, 0x41, 0xf1, 0xae, 0x2e,
+	0x55, 0x2a, 0x3b, 0xf1, 0xc4, 0x8c, 0xc0, 0x13, 0x38, 0xf8, 0x2d, 0x18, 0x24, 0x6b, 0x4c, 0xd5,
+	0x2d, 0x2e, 0x51, 0x0f, 0xf2, 0xf4, 0xfe, 0x90, 0xc5, 0xb7, 0xca, 0x73, 0x56, 0x07, 0x14, 0x5a,
+	0x1b, 0x63, 0x31, 0x7f, 0x6e, 0x36, 0xa2, 0xc4, 0xc8, 0x1a, 0x9b, 0xb3, 0x38, 0xbe, 0x0c, 0x03,
+	0x0d, 0xb2, 0xdc, 0x20, 0xd2, 0xb2, 0x07, 0x9b, 0xdf, 0x1f, 0xf6, 0x8a, 0x10, 0x0f, 0x51, 0x67,
+	0x23, 0x8a, 0xaf, 0x8f, 0x5f, 0x04, 0xa8, 0x12, 0x46, 0x55, 0x6e, 0xd9, 0x7a, 0x4d, 0x8a, 0x79,
+	0x61, 0x18, 0xdc, 0x2e, 0xf5, 0x3b, 0x7d, 0x92, 0xa6, 0xc4, 0x05, 0xab, 0x22, 0x38, 0x78, 0x01,
+	0x86, 0x35, 0x6b, 0xcd, 0x34, 0x74, 0xb3, 0xa1, 0xda, 0x2e, 0x5b, 0x91, 0x06, 0xbd, 0x85, 0x4f,
+	0x1c, 0xc0, 0x45, 0x94, 0x31, 0x52, 0xa7, 0x4a, 0x32, 0xd4, 0x5f, 0x74, 0xd9, 0x0a, 0xae, 0x40,
+	0xaa, 0x8d, 0xe7, 0x50, 0xdb, 0x20, 0x35, 0x2a, 0x0d, 0xf5, 0x0a, 0x39, 0x1a, 0x42, 0x28, 0x3e,
+	0x02, 0x5e, 0x84, 0x11, 0xd7, 0xf6, 0x30, 0x9b, 0xbe, 0x88, 0x14, 0xef, 0x15, 0x73, 0xd8, 0x07,
+	0x08, 0x86, 0xf8, 0x0d, 0x48, 0xbc, 0x63, 0xe9, 0xa6, 0x4a, 0x6a, 0x35, 0x6a, 0x73, 0x09, 0x7a,
+	0x85, 0x03, 0xa1, 0x5d, 0xf4, 0x94, 0xf1, 0x3c, 0xb4, 0x7d, 0xa0, 0x92, 0x5a, 0x43, 0x4a, 0xf4,
+	0x0a, 0x96, 0x08, 0xd5, 0x8b, 0xb5, 0xc6, 0x8e, 0x88, 0x98, 0x02, 0x2e, 0x79, 0xe8, 0x88, 0x2c,
+	0x90, 0x5d, 0x78, 0x8c, 0x9a, 0x5c, 0x1a, 0x3e, 0x34, 0x5e, 0x99, 0x9a, 0x1c, 0x2b, 0xd0, 0x0e,
+	0x8f, 0xba, 0x4c, 0x74, 0x83, 0x6a, 0xd2, 0x48, 0xaf, 0x88, 0x23, 0x21, 0xc2, 0x25, 0x0f, 0x60,
+	0x07, 0xe6, 0x1d, 0x97, 0xba, 0x54, 0x93, 0x46, 0x0f, 0x8d, 0xb9, 0xe4, 0x01, 0x08, 0x4c, 0xc3,
+	0x0a, 0x3a, 0x05, 0xb3, 0x8c, 0x55, 0xaa, 0x49, 0xa9, 0x9e, 0x31, 0x43, 0x84, 0xb2, 0x07, 0x20,
+	0x22, 0x2d, 0xba, 0xbf, 0x5e, 0xa3, 0xaa, 0x46, 0x38, 0x91, 0x70, 0xcf, 0x91, 0x0e, 0xd4, 0x67,
+	0x08, 0x27, 0xe9, 0x19, 0x48, 0x76, 0xd7, 0x2d, 0xfc, 0x32, 0x40, 0x70, 0xb6, 0x70, 0x1d, 0xc3,
+	0xeb, 0x0c, 0xf1, 0xd2, 0xd3, 0xdb, 0xa5, 0x01, 0x27, 0xfa, 0x01, 0x42, 0xad, 0x8d, 0xb1, 0x78,
+	0xd9, 0xe3, 0x5e, 0x53, 0xe6, 0x95, 0xb8, 0x2f, 0x78, 0xcd, 0x31, 0xd2, 0x1f, 0xc5, 0x20, 0xd9,
+	0x5d, 0xae, 0x0e, 0x07, 0x83, 0x4f, 0x43, 0xbc, 0x66, 0xe8, 0xd4, 0xe4, 0x9d, 0xbe, 0xf7, 0x1d,
+	0xbf, 0x5e, 0x3c, 0x2b, 0xfa, 0xda, 0xb4, 0xc7, 0x13, 0x7d, 0xcd, 0x97, 0x9a, 0xd3, 0xf0, 0x31,
+	0x18, 0x72, 0x19, 0x75, 0x4c, 0xd2, 0xa4, 0x52, 0x74, 0x67, 0x81, 0x69, 0x33, 0x84, 0x90, 0x4d,
+	0x18, 0x5b, 0xb3, 0x1c, 0x4d, 0xea, 0xdf, 0x25, 0x14, 0x32, 0xb0, 0x0e, 0xc3, 0xcc, 0xad, 0xb2,
+	0x9a, 0xa3, 0x57, 0xa9, 0x7a, 0xc7, 0x62, 0xd2, 0xc0, 0x38, 0x9a, 0x18, 0x29, 0x14, 0x7a, 0xab,
+	0xd3, 0xb9, 0x25, 0xab, 0x5c, 0x4a, 0xb5, 0x36, 0xc6, 0x92, 0xe5, 0x10, 0x6c, 0xc9, 0x2a, 0x2b,
+	0x49, 0xd6, 0x19, 0x31, 0x5c, 0x83, 0x84, 0xed, 0x56, 0x0d, 0x9d, 0xad, 0x78, 0x0b, 0xc5, 0x0e,
+	0xbd, 0xd0, 0x48, 0x6b, 0x63, 0x0c, 0x16, 0x7d, 0x28, 0xb1, 0x0c, 0xd8, 0x21, 0xcd, 0xf0, 0x31,
+	0x18, 0x74, 0x45, 0xed, 0x35, 0x98, 0x57, 0x4e, 0x87, 0xfc, 0x62, 0x7f, 0x8d, 0xd1, 0xca, 0x7c,
+	0x59, 0x89, 0xb9, 0x8c, 0x56, 0x0c, 0x86, 0xc7, 0x21, 0xc6, 0x0d, 0xa6, 0xd6, 0x88, 0x57, 0x1f,
+	0x93, 0xa5, 0x78, 0x6b, 0x63, 0x6c, 0xa0, 0x32, 0x5f, 0x9e, 0x2e, 0x2a, 0x03, 0xdc, 0x60, 0xd3,
+	0x04, 0xbf, 0x06, 0xa3, 0x9e, 0x84, 0x1f, 0x96, 0x1a, 0x75, 0xb8, 0x57, 0xf6, 0x92, 0xa5, 0x23,
+	0xad, 0x8d, 0xb1, 0x61, 0x21, 0xea, 0x71, 0xa6, 0xa9, 0xc3, 0x95, 0x61, 0xa1, 0xd2, 0x1e, 0xe2,
+	0xb3, 0x30, 0xd2, 0xa5, 0xda, 0xa0, 0xeb, 0x5e, 0x85, 0x4b, 0xfa, 0xee, 0x69, 0x6b, 0x5e, 0xa1,
+	0xeb, 0x4a, 0xb2, 0xad, 0x78, 0x85, 0xae, 0xe3, 0x6b, 0x30, 0xb8, 0x42, 0x89, 0x46, 0x1d, 0x26,
+	0x25, 0xc6, 0xa3, 0x13, 0x89, 0xc2, 0xb9, 0x1e, 0x5d, 0x33, 0xeb, 0x6b, 0x5f, 0x34, 0xb9, 0xb3,
+	0xae, 0x84, 0x58, 0xe9, 0x29, 0x48, 0x76, 0x33, 0x70, 0x0a, 0xa2, 0xc2, 0x26, 0x2f, 0x37, 0x15,
+	0x41, 0xe2, 0xa7, 0x60, 0x60, 0x95, 0x18, 0x2e, 0xf5, 0x53, 0x4f, 0xf1, 0x07, 0x53, 0x7d, 0xaf,
+	0xa2, 0xcc, 0xeb, 0x10, 0x5d, 0xb2, 0xca, 0x38, 0x05, 0xc9, 0x62, 0x45, 0xbd, 0xfa, 0x66, 0xb9,
+	0xa2, 0xbe, 0xb9, 0x30, 0x7d, 0x31, 0x15, 0xc1, 0x47, 0x60, 0xb8, 0x58, 0x51, 0xe7, 0x2f, 0x16,
+	0xc3, 0x29, 0x24, 0x84, 0x2e, 0xfe, 0xb0, 0x38, 0x5d, 0x99, 0x7f, 0xdb, 0x9f, 0xe9, 0x4b, 0xff,
+	0x1b, 0x60, 0x64, 0x67, 0xe7, 0xc5, 0x1f, 0xf5, 0x41, 0xcc, 0xa1, 0x75, 0xdd, 0x32, 0x83, 0x8f,
+	0xe3, 0xfd, 0xbe, 0xed, 0xd2, 0x7f, 0x90, 0xf3, 0x2f, 0xa4, 0x00, 0x59, 0xce, 0x32, 0xcb, 0xe5,
+	0x2b, 0xd9, 0x33, 0x4a, 0x9c, 0xd8, 0x59, 0x4a, 0x18, 0xcf, 0x9e, 0x11, 0x87, 0xc4, 0xac, 0x69,
+	0x39, 0x7c, 0xe5, 0x91, 0xe3, 0x82, 0x02, 0xc4, 0x6e, 0xab, 0x8d, 0x84, 0x74, 0x97, 0x6c, 0x67,
+	0x5c, 0x50, 0x92, 0x35, 0x92, 0xad, 0x51, 0x93, 0x3b, 0xc4, 0xc8, 0x9e, 0x51, 0x92, 0xd4, 0xed,
+	0x1a, 0x01, 0x75, 0x7d, 0xdc, 0x80, 0x6e, 0x9b, 0x42, 0xdd, 0xec, 0x1a, 0x65, 0xbc, 0x9b, 0x2c,
+	0x74, 0xc8, 0x49, 0x05, 0x9a, 0xb4, 0x23, 0xcc, 0x48, 0x68, 0x77, 0xdc, 0x65, 0x7b, 0xc8, 0x82,
+	0x47, 0x86, 0x68, 0x21, 0x59, 0x50, 0x02, 0x97, 0xe0, 0xb7, 0x01, 0x44, 0x4f, 0x64, 0xcc, 0xcb,
+	0x1a, 0xff, 0x14, 0x39, 0xd5, 0xeb, 0xe9, 0x26, 0x57, 0xf4, 0x20, 0x44, 0x7e, 0xc5, 0x49, 0x48,
+	0xe2, 0x9b, 0x90, 0x20, 0x8c, 0xb9, 0x4d, 0xaa, 0x3a, 0x96, 0x41, 0x83, 0xc3, 0xe5, 0xb9, 0xde,
+	0xb1, 0x3d, 0x0c, 0xc5, 0x32, 0xa8, 0x02, 0xa4, 0x4d, 0x3f, 0xea, 0x6b, 0x89, 0x1d, 0xfa, 0x6b,
+	0x19, 0x3c, 0xd0, 0xd7, 0xf2, 0x6b, 0x04, 0x29, 0x6a, 0x6a, 0xb6, 0xa5, 0x9b, 0x5c, 0x25, 0x9a,
+	0xe6, 0x50, 0xc6, 0x82, 0x2a, 0xf7, 0xee, 0x76, 0xc9, 0x75, 0x98, 0x74, 0x1f, 0x15, 0xcc, 0xdb,
+	0x13, 0x13, 0x13, 0xe2, 0x9c, 0x5b, 0xcc, 0x5e, 0x17, 0x47, 0xdd, 0xf7, 0xba, 0xe8, 0x0e, 0x79,
+	0x33, 0x7b, 0xeb, 0x64, 0x17, 0xe3, 0xc4, 0xcd, 0xdc, 0x89, 0x93, 0x13, 0x37, 0x8a, 0xd9, 0xeb,
+	0xc1, 0x01, 0xf9, 0xbd, 0x2e, 0xba, 0x43, 0x7a, 0x5a, 0x1d, 0xc6, 0x89, 0xf7, 0x4e, 0x1c, 0x57,
+	0x46, 0x43, 0x8b, 0x8a, 0xbe, 0x41, 0x58, 0x85, 0x41, 0x8d, 0x2e, 0x13, 0xd7, 0xe0, 0x5e, 0x5d,
+	0x4d, 0x14, 0xa6, 0x7b, 0x76, 0xf9, 0x8c, 0xaf, 0x3f, 0x67, 0x72, 0x5a, 0xf7, 0xef, 0x8d, 0xb3,
+	0x11, 0x25, 0x44, 0x4d, 0xff, 0x1e, 0x41, 0xbc, 0x1d, 0x70, 0x7c, 0x09, 0x86, 0x3b, 0x09, 0x24,
+	0x9a, 0x89, 0xff, 0x91, 0x65, 0xb6, 0x4b, 0x29, 0x67, 0x24, 0x95, 0x12, 0x2e, 0x19, 0xbc, 0x7d,
+	0xe3, 0xe6, 0xda, 0xad, 0x93, 0xe2, 0xc2, 0x94, 0x68, 0x2b, 0xce, 0xcd, 0x28, 0x89, 0x76, 0xae,
+	0xcc, 0x69, 0x78, 0x12, 0x8e, 0x30, 0x5a, 0x73, 0x28, 0x57, 0x77, 0xe5, 0x63, 0xbb, 0x85, 0x4c,
+	0x28, 0xa3, 0xbe, 0x44, 0x67, 0xf1, 0x2c, 0x0c, 0x33, 0xca, 0x98, 0xe8, 0xf9, 0xdc, 0x6a, 0x50,
+	0x33, 0x68, 0x4c, 0x43, 0x5e, 0xfb, 0x93, 0xee, 0xf7, 0x29, 0xc9, 0x80, 0x5d, 0x11, 0xdc, 0xf4,
+	0x3f, 0x10, 0x40, 0x27, 0x9d, 0xb0, 0x02, 0x51, 0xe2, 0x84, 0x55, 0xe1, 0xc2, 0x76, 0xe9, 0xac,
+	0xf3, 0x72, 0xa1, 0x70, 0x9b, 0x38, 0xe6, 0x14, 0x59, 0x63, 0x53, 0x3a, 0x69, 0x4e, 0x4d, 0xdd,
+	0x10, 0x7e, 0xbe, 0x7b, 0xa6, 0x70, 0x6f, 0x4a, 0xe4, 0xf0, 0xcd, 0x7c, 0xc7, 0xfb, 0xea, 0xa9,
+	0xef, 0xbf, 0x94, 0xbb, 0x90, 0xbd, 0x75, 0x4a, 0x6c, 0x2b, 0x5a, 0x54, 0x16, 0x14, 0x01, 0x86,
+	0xe7, 0x20, 0x41, 0xdf, 0xe5, 0xa2, 0x1b, 0x1a, 0x9d, 0xce, 0x3a, 0xb1, 0x5d, 0x7a, 0xd6, 0x79,
+	0x5a, 0x7a, 0x18, 0x2f, 0xa4, 0x84, 0x2b, 0x3c, 0xcd, 0xa9, 0x9b, 0xf9, 0xac, 0xef, 0x13, 0xb8,
+	0x18, 0x28, 0xcc, 0xcd, 0x28, 0x10, 0x2a, 0xcf, 0x69, 0xf8, 0x0d, 0x48, 0x85, 0x9b, 0x0b, 0xaf,
+	0xef, 0xc1, 0x47, 0xf4, 0xdc, 0x9e, 0x1b, 0xda, 0x4c, 0x20, 0x50, 0xea, 0xff, 0x50, 0x5c, 0xce,
+	0x46, 0x03, 0xc5, 0x70, 0x3a, 0xfd, 0x16, 0xe0, 0xbd, 0x41, 0xc5, 0x45, 0x00, 0xef, 0x82, 0xae,
+	0x7a, 0x4d, 0xbd, 0x1d, 0xb8, 0x31, 0xe7, 0x05, 0x11, 0x36, 0xe9, 0x76, 0xb0, 0xdb, 0x5d, 0x19,
+	0x78, 0x5c, 0x89, 0x7b, 0x5a, 0x0b, 0xa4, 0x49, 0x4b, 0x49, 0x00, 0x8d, 0xda, 0x86, 0xb5, 0xde,
+	0xa4, 0x26, 0x4f, 0xff, 0x78, 0x00, 0x86, 0x77, 0xdc, 0x50, 0x70, 0x16, 0x06, 0xab, 0x8e, 0xd5,
+	0x10, 0x1d, 0x06, 0x8d, 0x47, 0xfd, 0x53, 0x46, 0xea, 0x67, 0x68, 0x78, 0x08, 0xa5, 0x20, 0x33,
+	0xe8, 0x0c, 0xa4, 0x90, 0x08, 0x53, 0x28, 0x83, 0x4b, 0x30, 0x52, 0xb3, 0x4c, 0x11, 0x21, 0x47,
+	0xad, 0x3b, 0x96, 0x6b, 0x07, 0x1e, 0x3c, 0xba, 0x5d, 0x92, 0x9c, 0x67, 0xa4, 0x7f, 0xa2, 0xc2,
+	0x91, 0xdb, 0x9d, 0xef, 0x26, 0xa7, 0x7a, 0xe6, 0x0c, 0x87, 0x2a, 0x97, 0x85, 0x46, 0x77, 0x3b,
+	0x8e, 0x1e, 0xa0, 0x1d, 0xf7, 0x1f, 0xbc, 0x1d, 0x0f, 0x1c, 0xba, 0xc0, 0xc4, 0x0e, 0x54, 0x60,
+	0x14, 0xe8, 0x67, 0x84, 0x19, 0xc1, 0xa5, 0xec, 0xe5, 0x1e, 0x6f, 0x83, 0xb9, 0x72, 0xb1, 0x3c,
+	0xef, 0xdf, 0x5e, 0x05, 0xa5, 0x78, 0x58, 0xe9, 0xbf, 0x23, 0xf0, 0x86, 0x78, 0x19, 0xe2, 0x4d,
+	0x5a, 0x5b, 0x21, 0xa6, 0xce, 0x9a, 0x5e, 0xac, 0x47, 0x0a, 0x3f, 0x38, 0xcc, 0x0a, 0xb9, 0xab,
+	0x21, 0x8a, 0xf7, 0x9d, 0xbd, 0x2f, 0x5e, 0x26, 0x94, 0x0e, 0xf4, 0x8e, 0x73, 0x62, 0xdf, 0x41,
+	0xce, 0x89, 0xd1, 0x6f, 0x39, 0x27, 0x66, 0x5e, 0x87, 0x78, 0x7b, 0x2d, 0x1c, 0x87, 0x81, 0xc5,
+	0xf9, 0xe2, 0xdc, 0x82, 0x7f, 0x12, 0x28, 0x4f, 0x2b, 0xc5, 0xab, 0x6a, 0x79, 0xb6, 0xa8, 0x16,
+	0x5e, 0x39, 0x9b, 0x42, 0x3b, 0xa7, 0x5e, 0x39, 0x53, 0x48, 0xf5, 0xa5, 0x27, 0x60, 0x30, 0xbc,
+	0xfd, 0xbd, 0x00, 0x03, 0xfe, 0xc5, 0x18, 0xed, 0x5c, 0xca, 0x9f, 0x2d, 0x8d, 0xc2, 0x90, 0x1d,
+	0xe6, 0x6b, 0xf4, 0x9b, 0x12, 0xca, 0x2c, 0x01, 0xde, 0xe3, 0x09, 0x86, 0xcf, 0xc1, 0xa0, 0xff,
+	0x08, 0xe8, 0xa7, 0x72, 0xa2, 0xf0, 0xdd, 0x7d, 0xdd, 0xa7, 0x84, 0x1a, 0x99, 0xdf, 0x22, 0x90,
+	0xf6, 0xb0, 0x2f, 0x79, 0xcf, 0x1f, 0x0c, 0xbf, 0x09, 0x83, 0xfe, 0x4b, 0x48, 0x88, 0xfc, 0xca,
+	0xbe, 0xc8, 0x81, 0x6a, 0x2e, 0xf8, 0x0d, 0x0e, 0x60, 0x01, 0x8a, 0x38, 0x80, 0x75, 0x33, 0x7a,
+	0x3a, 0x80, 0x7d, 0x82, 0xe0, 0xe8, 0x65, 0xca, 0xf7, 0xee, 0x85, 0xde, 0x71, 0x29, 0xe3, 0xff,
+	0x83, 0x97, 0xac, 0xf3, 0x00, 0x9d, 0x27, 0xc6, 0x6f, 0x7d, 0xc9, 0xba, 0x24, 0x44, 0xae, 0x12,
+	0xd6, 0x28, 0xf5, 0x0b, 0x75, 0x25, 0xbe, 0x1c, 0x4e, 0x64, 0xfe, 0x88, 0xe0, 0x85, 0x79, 0x9d,
+	0xed, 0xb5, 0x99, 0x85, 0x46, 0xff, 0x1f, 0x9e, 0x12, 0x9f, 0x78, 0x17, 0xbf, 0x43, 0x70, 0xb4,
+	0xfc, 0x18, 0xc7, 0x5f, 0x81, 0x98, 0x9f, 0x4d, 0x81, 0xe9, 0xfb, 0xa7, 0xdf, 0x23, 0xac, 0x0e,
+	0x20, 0x9e, 0xd8, 0xda, 0xc2, 0x1f, 0x62, 0xf0, 0xdc, 0x23, 0x4c, 0xad, 0xeb, 0x4c, 0x24, 0xdc,
+	0x3b, 0x00, 0x97, 0x29, 0x0f, 0xf3, 0xfb, 0x99, 0x3d, 0xc0, 0x17, 0xc5, 0x7b, 0x73, 0x7a, 0xe2,
+	0xa0, 0x69, 0x9e, 0x49, 0xbf, 0xff, 0xa7, 0xbf, 0xfe, 0xbc, 0xef, 0x29, 0x8c, 0xf3, 0x84, 0xe5,
+	0xfd, 0x2d, 0x64, 0x83, 0x64, 0xc7, 0xbf, 0x44, 0x10, 0xbd, 0x4c, 0x39, 0x3e, 0xb5, 0x1b, 0xed,
+	0x31, 0x59, 0x9c, 0xde, 0xdf, 0x79, 0x99, 0x59, 0x6f, 0xcd, 0x12, 0xbe, 0xd0, 0x59, 0x33, 0x7f,
+	0x57, 0xd7, 0x58, 0x6e, 0x57, 0x26, 0xed, 0x1a, 0xdf, 0xf3, 0x85, 0x3a, 0xcf, 0xca, 0xf7, 0xf0,
+	0x4f, 0x11, 0xf4, 0x8b, 0xfc, 0xc4, 0xd9, 0xdd, 0xab, 0x3e, 0x36, 0x6b, 0xd3, 0x99, 0x7d, 0x8d,
+	0x64, 0x99, 0x49, 0xcf, 0xca, 0x2c, 0x3e, 0xd5, 0x6d, 0xe5, 0x3e, 0x16, 0xe2, 0xbf, 0x21, 0x88,
+	0x96, 0x1f, 0xe5, 0xb2, 0xf2, 0x93, 0xb9, 0xec, 0x17, 0xc8, 0xb3, 0xe6, 0x01, 0x4a, 0x2f, 0x74,
+	0x9b, 0xe3, 0xff, 0xe6, 0x0e, 0xe4, 0xbb, 0x2e, 0xd9, 0x2e, 0x17, 0x4e, 0xa1, 0x93, 0xd7, 0xcf,
+	0x65, 0xce, 0x1e, 0x0e, 0x74, 0x0a, 0x9d, 0xc4, 0x0f, 0x10, 0xc4, 0x66, 0xa8, 0x41, 0x39, 0xc5,
+	0x3d, 0xd5, 0xac, 0xf4, 0xb7, 0xe4, 0x6e, 0xe6, 0x82, 0xb7, 0xd3, 0xa9, 0x93, 0xaf, 0xf6, 0xe0,
+	0xf7, 0xfc, 0xdd, 0xae, 0x2d, 0x95, 0x7e, 0x83, 0x1e, 0x6e, 0xca, 0xe8, 0xf3, 0x4d, 0x19, 0x7d,
+	0xb1, 0x29, 0x47, 0xbe, 0xdc, 0x94, 0x23, 0x5f, 0x6d, 0xca, 0x91, 0xaf, 0x37, 0xe5, 0xc8, 0x37,
+	0x9b, 0x32, 0xba, 0xdf, 0x92, 0xd1, 0x07, 0x2d, 0x39, 0xf2, 0x71, 0x4b, 0x46, 0x9f, 0xb4, 0xe4,
+	0xc8, 0xa7, 0x2d, 0x39, 0xf2, 0x59, 0x4b, 0x8e, 0x3c, 0x6c, 0xc9, 0xe8, 0xf3, 0x96, 0x8c, 0xbe,
+	0x68, 0xc9, 0x91, 0x2f, 0x5b, 0x32, 0xfa, 0xaa, 0x25, 0x47, 0xbe, 0x6e, 0xc9, 0xe8, 0x9b, 0x96,
+	0x1c, 0xb9, 0xbf, 0x25, 0x47, 0x3e, 0xd8, 0x92, 0xd1, 0x83, 0x2d, 0x39, 0xf2, 0xe1, 0x96, 0x8c,
+	0x7e, 0xb5, 0x25, 0x47, 0x3e, 0xde, 0x92, 0x23, 0x9f, 0x6c, 0xc9, 0xe8, 0xd3, 0x2d, 0x19, 0x7d,
+	0xb6, 0x25, 0xa3, 0xeb, 0xf9, 0xba, 0x95, 0xe3, 0x2b, 0x94, 0xaf, 0xe8, 0x66, 0x9d, 0xe5, 0x4c,
+	0xca, 0xd7, 0x2c, 0xa7, 0x91, 0xdf, 0xf9, 0x87, 0xcd, 0xea, 0x64, 0xde, 0x6e, 0xd4, 0xf3, 0x9c,
+	0x9b, 0x76, 0xb5, 0x1a, 0xf3, 0x76, 0x3e, 0xf9, 0xdf, 0x01, 0x00, 0x3b, 0xa0, 0xec, 0xf9, 0x27,
+	0x1b, 0x00, 0x00,
 }
 
 func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
@@ -1274,6 +1513,13 @@ func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x ApplicationPubSub_KafkaProvider_SASL_Mechanism) String() string {
+	s, ok := ApplicationPubSub_KafkaProvider_SASL_Mechanism_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1451,6 +1697,30 @@ func (this *ApplicationPubSub_AWSIoT) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_Kafka)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Kafka)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Kafka.Equal(that1.Kafka) {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1562,6 +1832,12 @@ func (this *ApplicationPubSub_AWSIoTProvider) Equal(that interface{}) bool {
 	if !this.AssumeRole.Equal(that1.AssumeRole) {
 		return false
 	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
 	if this.EndpointAddress != that1.EndpointAddress {
 		return false
 	}
@@ -1690,6 +1966,83 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Equal(that inte
 	}
 	return true
 }
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
+		}
+	}
+	if this.ConsumerGroup != that1.ConsumerGroup {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if !this.SASL.Equal(that1.SASL) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider_SASL)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider_SASL)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Mechanism != that1.Mechanism {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	return true
+}
 func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2395,6 +2748,29 @@ func (m *ApplicationPubSub_AWSIoT) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ApplicationPubSub_NATSProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2556,18 +2932,32 @@ func (m *ApplicationPubSub_AWSIoTProvider) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	if m.Deployment != nil {
-		{
-			size := m.Deployment.Size()
-			i -= size
-			if _, err := m.Deployment.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.EndpointAddress) > 0 {
-		i -= len(m.EndpointAddress)
-		copy(dAtA[i:], m.EndpointAddress)
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deployment != nil {
+		{
+			size := m.Deployment.Size()
+			i -= size
+			if _, err := m.Deployment.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.EndpointAddress) > 0 {
+		i -= len(m.EndpointAddress)
+		copy(dAtA[i:], m.EndpointAddress)
 		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.EndpointAddress)))
 		i--
 		dAtA[i] = 0x22
@@ -2692,12 +3082,12 @@ func (m *ApplicationPubSub_AWSIoTProvider_AssumeRole) MarshalToSizedBuffer(dAtA
 	var l int
 	_ = l
 	if m.SessionDuration != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
-		if err22 != nil {
-			return 0, err22
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionDuration):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n22
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n22))
+		i -= n23
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2748,6 +3138,130 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) MarshalToSizedBuff
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SASL != nil {
+		{
+			size, err := m.SASL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerGroup) > 0 {
+		i -= len(m.ConsumerGroup)
+		copy(dAtA[i:], m.ConsumerGroup)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroup)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mechanism != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.Mechanism))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3047,7 +3561,7 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 	if r.Intn(5) != 0 {
 		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
 	}
-	oneofNumber_Provider := []int32{17, 25, 101}[r.Intn(3)]
+	oneofNumber_Provider := []int32{17, 25, 101, 102}[r.Intn(4)]
 	switch oneofNumber_Provider {
 	case 17:
 		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
@@ -3055,6 +3569,8 @@ func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *A
 		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
 	case 101:
 		this.Provider = NewPopulatedApplicationPubSub_AWSIoT(r, easy)
+	case 102:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
 	}
 	if r.Intn(5) != 0 {
 		this.ServiceData = NewPopulatedApplicationPubSub_Message(r, easy)
@@ -3079,6 +3595,11 @@ func NewPopulatedApplicationPubSub_AWSIoT(r randyApplicationserverPubsub, easy b
 	this.AWSIoT = NewPopulatedApplicationPubSub_AWSIoTProvider(r, easy)
 	return this
 }
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
 func NewPopulatedApplicationPubSub_NATSProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATSProvider {
 	this := &ApplicationPubSub_NATSProvider{}
 	this.ServerURL = randStringApplicationserverPubsub(r)
@@ -3138,6 +3659,16 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider(r randyApplicationserverPubsub
 	case 5:
 		this.Deployment = NewPopulatedApplicationPubSub_AWSIoTProvider_Default(r, easy)
 	}
+	v9 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v10 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3178,6 +3709,48 @@ func NewPopulatedApplicationPubSub_AWSIoTProvider_DefaultIntegration(r randyAppl
 	return this
 }
 
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v11 := r.Intn(10)
+	this.Brokers = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.ConsumerGroup = randStringApplicationserverPubsub(r)
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v12 := r.Intn(100)
+	this.TLSCA = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v14 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.SASL = NewPopulatedApplicationPubSub_KafkaProvider_SASL(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider_SASL(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider_SASL {
+	this := &ApplicationPubSub_KafkaProvider_SASL{}
+	this.Mechanism = ApplicationPubSub_KafkaProvider_SASL_Mechanism([]int32{0, 1, 2}[r.Intn(3)])
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
 	this := &ApplicationPubSub_Message{}
 	this.Topic = randStringApplicationserverPubsub(r)
@@ -3189,9 +3762,9 @@ func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy
 func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
 	this := &ApplicationPubSubs{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v9)
-		for i := 0; i < v9; i++ {
+		v15 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v15)
+		for i := 0; i < v15; i++ {
 			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
 		}
 	}
@@ -3203,9 +3776,9 @@ func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *
 func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
 	this := &ApplicationPubSubFormats{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(10)
+		v16 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v10; i++ {
+		for i := 0; i < v16; i++ {
 			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
 		}
 	}
@@ -3216,10 +3789,10 @@ func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy b
 
 func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
 	this := &GetApplicationPubSubRequest{}
-	v11 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v17 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3227,10 +3800,10 @@ func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, eas
 
 func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
 	this := &ListApplicationPubSubsRequest{}
-	v13 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3238,10 +3811,10 @@ func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, e
 
 func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
 	this := &SetApplicationPubSubRequest{}
-	v15 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v21 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v21
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3266,9 +3839,9 @@ func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v17 := r.Intn(100)
-	tmps := make([]rune, v17)
-	for i := 0; i < v17; i++ {
+	v23 := r.Intn(100)
+	tmps := make([]rune, v23)
+	for i := 0; i < v23; i++ {
 		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
 	}
 	return string(tmps)
@@ -3290,11 +3863,11 @@ func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsu
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v18 := r.Int63()
+		v24 := r.Int63()
 		if r.Intn(2) == 0 {
-			v18 *= -1
+			v24 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v18))
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v24))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3440,6 +4013,18 @@ func (m *ApplicationPubSub_AWSIoT) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
 func (m *ApplicationPubSub_NATSProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3532,6 +4117,14 @@ func (m *ApplicationPubSub_AWSIoTProvider) Size() (n int) {
 	if m.Deployment != nil {
 		n += m.Deployment.Size()
 	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
 	return n
 }
 
@@ -3602,6 +4195,64 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	l = len(m.ConsumerGroup)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SASL != nil {
+		l = m.SASL.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_KafkaProvider_SASL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mechanism != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.Mechanism))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationPubSub_Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3759,6 +4410,16 @@ func (this *ApplicationPubSub_AWSIoT) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_NATSProvider) String() string {
 	if this == nil {
 		return "nil"
@@ -3809,6 +4470,8 @@ func (this *ApplicationPubSub_AWSIoTProvider) String() string {
 		`AssumeRole:` + strings.Replace(fmt.Sprintf("%v", this.AssumeRole), "ApplicationPubSub_AWSIoTProvider_AssumeRole", "ApplicationPubSub_AWSIoTProvider_AssumeRole", 1) + `,`,
 		`EndpointAddress:` + fmt.Sprintf("%v", this.EndpointAddress) + `,`,
 		`Deployment:` + fmt.Sprintf("%v", this.Deployment) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3857,6 +4520,34 @@ func (this *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) String() string
 	}, "")
 	return s
 }
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`SASL:` + strings.Replace(fmt.Sprintf("%v", this.SASL), "ApplicationPubSub_KafkaProvider_SASL", "ApplicationPubSub_KafkaProvider_SASL", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider_SASL) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider_SASL{`,
+		`Mechanism:` + fmt.Sprintf("%v", this.Mechanism) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationPubSub_Message) String() string {
 	if this == nil {
 		return "nil"
@@ -4754,11 +5445,46 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			}
 			m.Provider = &ApplicationPubSub_AWSIoT{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_KafkaProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Kafka{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
@@ -5531,6 +6257,74 @@ func (m *ApplicationPubSub_AWSIoTProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Deployment = &ApplicationPubSub_AWSIoTProvider_Default{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
@@ -5942,6 +6736,417 @@ func (m *ApplicationPubSub_AWSIoTProvider_DefaultIntegration) Unmarshal(dAtA []b
 	}
 	return nil
 }
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SASL == nil {
+				m.SASL = &ApplicationPubSub_KafkaProvider_SASL{}
+			}
+			if err := m.SASL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_KafkaProvider_SASL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SASL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SASL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mechanism", wireType)
+			}
+			m.Mechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mechanism |= ApplicationPubSub_KafkaProvider_SASL_Mechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationPubSub_Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
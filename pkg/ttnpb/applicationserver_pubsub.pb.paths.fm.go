@@ -53,6 +53,19 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.aws_iot.deployment.default.stack_name",
 	"provider.aws_iot.endpoint_address",
 	"provider.aws_iot.region",
+	"provider.aws_iot.tls_client_cert",
+	"provider.aws_iot.tls_client_key",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.consumer_group",
+	"provider.kafka.sasl",
+	"provider.kafka.sasl.mechanism",
+	"provider.kafka.sasl.password",
+	"provider.kafka.sasl.username",
+	"provider.kafka.tls_ca",
+	"provider.kafka.tls_client_cert",
+	"provider.kafka.tls_client_key",
+	"provider.kafka.use_tls",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
 	"provider.mqtt.headers",
@@ -172,6 +185,19 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.aws_iot.deployment.default.stack_name",
 	"pubsub.provider.aws_iot.endpoint_address",
 	"pubsub.provider.aws_iot.region",
+	"pubsub.provider.aws_iot.tls_client_cert",
+	"pubsub.provider.aws_iot.tls_client_key",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.consumer_group",
+	"pubsub.provider.kafka.sasl",
+	"pubsub.provider.kafka.sasl.mechanism",
+	"pubsub.provider.kafka.sasl.password",
+	"pubsub.provider.kafka.sasl.username",
+	"pubsub.provider.kafka.tls_ca",
+	"pubsub.provider.kafka.tls_client_cert",
+	"pubsub.provider.kafka.tls_client_key",
+	"pubsub.provider.kafka.use_tls",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
 	"pubsub.provider.mqtt.headers",
@@ -245,6 +271,8 @@ var ApplicationPubSub_AWSIoTProviderFieldPathsNested = []string{
 	"deployment.default.stack_name",
 	"endpoint_address",
 	"region",
+	"tls_client_cert",
+	"tls_client_key",
 }
 
 var ApplicationPubSub_AWSIoTProviderFieldPathsTopLevel = []string{
@@ -253,6 +281,30 @@ var ApplicationPubSub_AWSIoTProviderFieldPathsTopLevel = []string{
 	"deployment",
 	"endpoint_address",
 	"region",
+	"tls_client_cert",
+	"tls_client_key",
+}
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"consumer_group",
+	"sasl",
+	"sasl.mechanism",
+	"sasl.password",
+	"sasl.username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"consumer_group",
+	"sasl",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
 }
 var ApplicationPubSub_MessageFieldPathsNested = []string{
 	"topic",
@@ -290,3 +342,14 @@ var ApplicationPubSub_AWSIoTProvider_DefaultIntegrationFieldPathsNested = []stri
 var ApplicationPubSub_AWSIoTProvider_DefaultIntegrationFieldPathsTopLevel = []string{
 	"stack_name",
 }
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsNested = []string{
+	"mechanism",
+	"password",
+	"username",
+}
+
+var ApplicationPubSub_KafkaProvider_SASLFieldPathsTopLevel = []string{
+	"mechanism",
+	"password",
+	"username",
+}
@@ -4,6 +4,7 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	io "io"
@@ -495,6 +496,80 @@ func (m *ApplicationWebhookTemplates) GetTemplates() []*ApplicationWebhookTempla
 	return nil
 }
 
+type ApplicationWebhookHealth struct {
+	// Number of consecutive failed delivery attempts.
+	FailedAttempts uint32 `protobuf:"varint,1,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	// Time of the last failed delivery attempt.
+	LastFailedAttemptAt *time.Time `protobuf:"bytes,2,opt,name=last_failed_attempt_at,json=lastFailedAttemptAt,proto3,stdtime" json:"last_failed_attempt_at,omitempty"`
+	// Error of the last failed delivery attempt.
+	LastError *ErrorDetails `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Time until which deliveries to the webhook are suspended.
+	// After this time, a single delivery is attempted to probe the webhook.
+	SuspendedUntil       *time.Time `protobuf:"bytes,4,opt,name=suspended_until,json=suspendedUntil,proto3,stdtime" json:"suspended_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ApplicationWebhookHealth) Reset()      { *m = ApplicationWebhookHealth{} }
+func (*ApplicationWebhookHealth) ProtoMessage() {}
+func (*ApplicationWebhookHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{5}
+}
+func (m *ApplicationWebhookHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookHealth.Merge(m, src)
+}
+func (m *ApplicationWebhookHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookHealth proto.InternalMessageInfo
+
+func (m *ApplicationWebhookHealth) GetFailedAttempts() uint32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookHealth) GetLastFailedAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastFailedAttemptAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetLastError() *ErrorDetails {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookHealth) GetSuspendedUntil() *time.Time {
+	if m != nil {
+		return m.SuspendedUntil
+	}
+	return nil
+}
+
 type ApplicationWebhook struct {
 	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt                     time.Time `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
@@ -512,24 +587,35 @@ type ApplicationWebhook struct {
 	TemplateFields map[string]string `protobuf:"bytes,16,rep,name=template_fields,json=templateFields,proto3" json:"template_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The API key to be used for downlink queue operations.
 	// The field is provided for convenience reasons, and can contain API keys with additional rights (albeit this is discouraged).
-	DownlinkAPIKey       string                      `protobuf:"bytes,17,opt,name=downlink_api_key,json=downlinkApiKey,proto3" json:"downlink_api_key,omitempty"`
-	UplinkMessage        *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	ServiceData          *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	DownlinkAPIKey string `protobuf:"bytes,17,opt,name=downlink_api_key,json=downlinkApiKey,proto3" json:"downlink_api_key,omitempty"`
+	// The secret to sign the requests with. The secret is stored encrypted.
+	// If set, the X-Webhook-Timestamp header contains the Unix time in seconds of the request, and the X-Webhook-Signature
+	// header contains the hex encoded HMAC-SHA256 of the timestamp, a dot (.) and the body.
+	SigningSecret *Secret `protobuf:"bytes,20,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// The PEM encoded client certificate to present to the webhook endpoint for mutual TLS.
+	ClientCertificate []byte `protobuf:"bytes,21,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
+	// The PEM encoded private key of the client certificate. The private key is stored encrypted.
+	ClientPrivateKey *Secret                     `protobuf:"bytes,22,opt,name=client_private_key,json=clientPrivateKey,proto3" json:"client_private_key,omitempty"`
+	UplinkMessage    *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept       *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck      *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack     *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent     *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed   *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued   *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved   *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	ServiceData      *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	// The health of the webhook.
+	// This field is managed by the Application Server and can only be reset, which resumes a suspended webhook.
+	Health               *ApplicationWebhookHealth `protobuf:"bytes,19,opt,name=health,proto3" json:"health,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
 func (*ApplicationWebhook) ProtoMessage() {}
 func (*ApplicationWebhook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{5}
+	return fileDescriptor_2652f2d8eaceda0e, []int{6}
 }
 func (m *ApplicationWebhook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,6 +693,27 @@ func (m *ApplicationWebhook) GetDownlinkAPIKey() string {
 	return ""
 }
 
+func (m *ApplicationWebhook) GetSigningSecret() *Secret {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetClientCertificate() []byte {
+	if m != nil {
+		return m.ClientCertificate
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetClientPrivateKey() *Secret {
+	if m != nil {
+		return m.ClientPrivateKey
+	}
+	return nil
+}
+
 func (m *ApplicationWebhook) GetUplinkMessage() *ApplicationWebhook_Message {
 	if m != nil {
 		return m.UplinkMessage
@@ -670,9 +777,25 @@ func (m *ApplicationWebhook) GetServiceData() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetHealth() *ApplicationWebhookHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// HTTP method of the request. Defaults to POST.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Paths of the fields of the upstream message to include in the body.
+	// If empty, all fields are included.
+	FieldMask types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Go text/template to render the body with.
+	// The template is executed with the upstream message in JSON representation, after applying the field mask.
+	// If empty, the body is rendered with the webhook format.
+	BodyTemplate         string   `protobuf:"bytes,4,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -680,7 +803,7 @@ type ApplicationWebhook_Message struct {
 func (m *ApplicationWebhook_Message) Reset()      { *m = ApplicationWebhook_Message{} }
 func (*ApplicationWebhook_Message) ProtoMessage() {}
 func (*ApplicationWebhook_Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{5, 2}
+	return fileDescriptor_2652f2d8eaceda0e, []int{6, 2}
 }
 func (m *ApplicationWebhook_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,6 +839,27 @@ func (m *ApplicationWebhook_Message) GetPath() string {
 	return ""
 }
 
+func (m *ApplicationWebhook_Message) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ApplicationWebhook_Message) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+func (m *ApplicationWebhook_Message) GetBodyTemplate() string {
+	if m != nil {
+		return m.BodyTemplate
+	}
+	return ""
+}
+
 type ApplicationWebhooks struct {
 	Webhooks             []*ApplicationWebhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -725,7 +869,7 @@ type ApplicationWebhooks struct {
 func (m *ApplicationWebhooks) Reset()      { *m = ApplicationWebhooks{} }
 func (*ApplicationWebhooks) ProtoMessage() {}
 func (*ApplicationWebhooks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{6}
+	return fileDescriptor_2652f2d8eaceda0e, []int{7}
 }
 func (m *ApplicationWebhooks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +915,7 @@ type ApplicationWebhookFormats struct {
 func (m *ApplicationWebhookFormats) Reset()      { *m = ApplicationWebhookFormats{} }
 func (*ApplicationWebhookFormats) ProtoMessage() {}
 func (*ApplicationWebhookFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{7}
+	return fileDescriptor_2652f2d8eaceda0e, []int{8}
 }
 func (m *ApplicationWebhookFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +961,7 @@ type GetApplicationWebhookRequest struct {
 func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
 func (*GetApplicationWebhookRequest) ProtoMessage() {}
 func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{8}
+	return fileDescriptor_2652f2d8eaceda0e, []int{9}
 }
 func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1007,7 @@ type ListApplicationWebhooksRequest struct {
 func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
 func (*ListApplicationWebhooksRequest) ProtoMessage() {}
 func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{9}
+	return fileDescriptor_2652f2d8eaceda0e, []int{10}
 }
 func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1053,7 @@ type SetApplicationWebhookRequest struct {
 func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
 func (*SetApplicationWebhookRequest) ProtoMessage() {}
 func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{10}
+	return fileDescriptor_2652f2d8eaceda0e, []int{11}
 }
 func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1099,7 @@ type GetApplicationWebhookTemplateRequest struct {
 func (m *GetApplicationWebhookTemplateRequest) Reset()      { *m = GetApplicationWebhookTemplateRequest{} }
 func (*GetApplicationWebhookTemplateRequest) ProtoMessage() {}
 func (*GetApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{11}
+	return fileDescriptor_2652f2d8eaceda0e, []int{12}
 }
 func (m *GetApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1146,7 @@ func (m *ListApplicationWebhookTemplatesRequest) Reset() {
 }
 func (*ListApplicationWebhookTemplatesRequest) ProtoMessage() {}
 func (*ListApplicationWebhookTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{12}
+	return fileDescriptor_2652f2d8eaceda0e, []int{13}
 }
 func (m *ListApplicationWebhookTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1038,6 +1182,278 @@ func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() types.FieldMask
 	return types.FieldMask{}
 }
 
+// ApplicationWebhookDelivery is a request of a webhook that is queued for delivery.
+type ApplicationWebhookDelivery struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Unique identifier of the delivery.
+	DeliveryID string    `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	CreatedAt  time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// HTTP method of the request.
+	Method string `protobuf:"bytes,10,opt,name=method,proto3" json:"method,omitempty"`
+	// URL of the request.
+	URL string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP headers of the request.
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Body of the request.
+	Body []byte `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// Number of failed delivery attempts.
+	Attempts uint32 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Time of the last failed delivery attempt.
+	LastAttemptAt *time.Time `protobuf:"bytes,8,opt,name=last_attempt_at,json=lastAttemptAt,proto3,stdtime" json:"last_attempt_at,omitempty"`
+	// Error of the last failed delivery attempt.
+	LastError            *ErrorDetails `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationWebhookDelivery) Reset()      { *m = ApplicationWebhookDelivery{} }
+func (*ApplicationWebhookDelivery) ProtoMessage() {}
+func (*ApplicationWebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
+}
+func (m *ApplicationWebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDelivery.Merge(m, src)
+}
+func (m *ApplicationWebhookDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDelivery proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDelivery) GetDeliveryID() string {
+	if m != nil {
+		return m.DeliveryID
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetLastAttemptAt() *time.Time {
+	if m != nil {
+		return m.LastAttemptAt
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookDelivery) GetLastError() *ErrorDetails {
+	if m != nil {
+		return m.LastError
+	}
+	return nil
+}
+
+type ApplicationWebhookDeliveries struct {
+	Deliveries           []*ApplicationWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookDeliveries) Reset()      { *m = ApplicationWebhookDeliveries{} }
+func (*ApplicationWebhookDeliveries) ProtoMessage() {}
+func (*ApplicationWebhookDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{15}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeliveries.Merge(m, src)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDeliveries proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDeliveries) GetDeliveries() []*ApplicationWebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
+type ListApplicationWebhookFailedDeliveriesRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Reset() {
+	*m = ListApplicationWebhookFailedDeliveriesRequest{}
+}
+func (*ListApplicationWebhookFailedDeliveriesRequest) ProtoMessage() {}
+func (*ListApplicationWebhookFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{16}
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.Merge(m, src)
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationWebhookFailedDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type ReplayApplicationWebhookFailedDeliveriesRequest struct {
+	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Identifiers of the failed deliveries to replay.
+	// If empty, all failed deliveries of the webhook are replayed.
+	DeliveryIDs          []string `protobuf:"bytes,2,rep,name=delivery_ids,json=deliveryIds,proto3" json:"delivery_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Reset() {
+	*m = ReplayApplicationWebhookFailedDeliveriesRequest{}
+}
+func (*ReplayApplicationWebhookFailedDeliveriesRequest) ProtoMessage() {}
+func (*ReplayApplicationWebhookFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{17}
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.Merge(m, src)
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayApplicationWebhookFailedDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) GetDeliveryIDs() []string {
+	if m != nil {
+		return m.DeliveryIDs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
@@ -1053,6 +1469,8 @@ func init() {
 	golang_proto.RegisterType((*ApplicationWebhookTemplate_Message)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplate.Message")
 	proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
 	golang_proto.RegisterType((*ApplicationWebhookTemplates)(nil), "ttn.lorawan.v3.ApplicationWebhookTemplates")
+	proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
+	golang_proto.RegisterType((*ApplicationWebhookHealth)(nil), "ttn.lorawan.v3.ApplicationWebhookHealth")
 	proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
 	golang_proto.RegisterType((*ApplicationWebhook)(nil), "ttn.lorawan.v3.ApplicationWebhook")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhook.HeadersEntry")
@@ -1077,6 +1495,16 @@ func init() {
 	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
 	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
 	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	golang_proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery.HeadersEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery.HeadersEntry")
+	proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	golang_proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	proto.RegisterType((*ListApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookFailedDeliveriesRequest")
+	golang_proto.RegisterType((*ListApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookFailedDeliveriesRequest")
+	proto.RegisterType((*ReplayApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookFailedDeliveriesRequest")
+	golang_proto.RegisterType((*ReplayApplicationWebhookFailedDeliveriesRequest)(nil), "ttn.lorawan.v3.ReplayApplicationWebhookFailedDeliveriesRequest")
 }
 
 func init() {
@@ -1087,122 +1515,159 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0xdc, 0xc6,
-	0x15, 0xde, 0xd1, 0xdf, 0x6a, 0x67, 0x25, 0x59, 0x1e, 0x29, 0x09, 0xbb, 0xb6, 0xb9, 0x02, 0xe3,
-	0x26, 0xb2, 0xeb, 0xe5, 0x16, 0x72, 0xdc, 0x36, 0x42, 0x1a, 0x43, 0x1b, 0xc5, 0x8a, 0x6a, 0x3b,
-	0x8e, 0xb9, 0x71, 0x82, 0xc4, 0x48, 0x16, 0xa3, 0xe5, 0xec, 0x8a, 0x5d, 0x2e, 0xc9, 0x90, 0xb3,
-	0x52, 0xd5, 0xc0, 0x68, 0xd0, 0x93, 0xd1, 0x4b, 0x83, 0xe6, 0xd0, 0x9e, 0x8a, 0x00, 0x45, 0x81,
-	0xe4, 0xd4, 0xa0, 0xa7, 0x1c, 0x83, 0xa2, 0x07, 0xa3, 0x27, 0x03, 0x3d, 0x34, 0x27, 0x35, 0xe2,
-	0xf6, 0x90, 0x53, 0x91, 0xa3, 0xa1, 0x53, 0x31, 0xc3, 0x21, 0x97, 0xfb, 0xa3, 0x88, 0xbb, 0x72,
-	0x7a, 0x12, 0xc9, 0x79, 0xef, 0x7b, 0xdf, 0x7b, 0xf3, 0xf8, 0xbe, 0x59, 0x0a, 0x16, 0x4c, 0xdb,
-	0xc5, 0xbb, 0xd8, 0x2a, 0x78, 0x14, 0x57, 0x1b, 0x45, 0xec, 0x18, 0x45, 0xec, 0x38, 0xa6, 0x51,
-	0xc5, 0xd4, 0xb0, 0x2d, 0x8f, 0xb8, 0x3b, 0xc4, 0xad, 0xec, 0x92, 0x2d, 0xd5, 0x71, 0x6d, 0x6a,
-	0xa3, 0x39, 0x4a, 0x2d, 0x55, 0xb8, 0xa8, 0x3b, 0x97, 0x73, 0x6b, 0x75, 0x83, 0x6e, 0xb7, 0xb6,
-	0xd4, 0xaa, 0xdd, 0x2c, 0x12, 0x6b, 0xc7, 0xde, 0x73, 0x5c, 0xfb, 0x17, 0x7b, 0x45, 0x6e, 0x5c,
-	0x2d, 0xd4, 0x89, 0x55, 0xd8, 0xc1, 0xa6, 0xa1, 0x63, 0x4a, 0x8a, 0x7d, 0x17, 0x01, 0x64, 0xae,
-	0x10, 0x83, 0xa8, 0xdb, 0x75, 0x3b, 0x70, 0xde, 0x6a, 0xd5, 0xf8, 0x1d, 0xbf, 0xe1, 0x57, 0xc2,
-	0xfc, 0x6c, 0xdd, 0xb6, 0xeb, 0x26, 0x09, 0x98, 0x5a, 0x96, 0x4d, 0x03, 0xa2, 0x62, 0xf5, 0x8c,
-	0x58, 0x8d, 0x30, 0x48, 0xd3, 0xa1, 0x7b, 0x62, 0x71, 0xa9, 0x77, 0xb1, 0x66, 0x10, 0x53, 0xaf,
-	0x34, 0xb1, 0xd7, 0x10, 0x16, 0xf9, 0x5e, 0x0b, 0x6a, 0x34, 0x89, 0x47, 0x71, 0xd3, 0x11, 0x06,
-	0x4f, 0xf7, 0x97, 0xcb, 0xd0, 0x89, 0x45, 0x8d, 0x9a, 0x41, 0x5c, 0x41, 0x42, 0xf9, 0x17, 0x80,
-	0xe7, 0xd6, 0x3a, 0x45, 0x7c, 0x93, 0x6c, 0x6d, 0xdb, 0x76, 0x63, 0xb3, 0x63, 0x87, 0x30, 0x3c,
-	0x15, 0xab, 0x72, 0xc5, 0xd0, 0x3d, 0x09, 0x2c, 0x81, 0xe5, 0xec, 0xca, 0x33, 0x6a, 0x77, 0x81,
-	0xd5, 0x18, 0x4e, 0x0c, 0xa0, 0x34, 0x7f, 0x58, 0x9a, 0xfc, 0x0d, 0x18, 0x9b, 0x07, 0x0f, 0xf6,
-	0xf3, 0xa9, 0x87, 0xfb, 0x79, 0xa0, 0xcd, 0xe1, 0xb8, 0xa5, 0x87, 0xca, 0x10, 0xee, 0x06, 0x81,
-	0x2b, 0x86, 0x2e, 0x8d, 0x2d, 0x81, 0xe5, 0x4c, 0xe9, 0xb9, 0xc3, 0xd2, 0x79, 0x57, 0x91, 0xce,
-	0xaf, 0xc8, 0xef, 0xde, 0xc5, 0x85, 0x5f, 0xfe, 0xb0, 0xf0, 0xfc, 0x3b, 0xcb, 0x57, 0x57, 0xef,
-	0x16, 0xde, 0xb9, 0x1a, 0xde, 0x5e, 0x78, 0x7f, 0xe5, 0xd2, 0xbd, 0xf3, 0xfe, 0x7e, 0x3e, 0x13,
-	0xb2, 0x5e, 0xd7, 0x32, 0xbb, 0x61, 0x02, 0xca, 0xaf, 0xe0, 0xf7, 0xfb, 0x13, 0x7b, 0x9d, 0x34,
-	0x1d, 0x13, 0x53, 0x12, 0x4f, 0xf0, 0x0d, 0x98, 0xa5, 0xe2, 0x31, 0x0b, 0x0f, 0x78, 0xf8, 0x2b,
-	0xc9, 0xc3, 0xc3, 0x08, 0x74, 0x5d, 0x83, 0x34, 0x0a, 0xa0, 0xfc, 0x17, 0xc0, 0xfc, 0xd1, 0x0c,
-	0xae, 0xb1, 0xfd, 0x44, 0x3f, 0x85, 0x63, 0x51, 0xc8, 0x42, 0xf2, 0x90, 0x63, 0x9b, 0xeb, 0xda,
-	0x98, 0xa1, 0xa3, 0x33, 0x70, 0xc2, 0xc2, 0x4d, 0x22, 0x4a, 0x96, 0x3e, 0x2c, 0x4d, 0xb8, 0x63,
-	0xd2, 0xa2, 0xc6, 0x1f, 0xa2, 0x0b, 0x30, 0xab, 0x13, 0xaf, 0xea, 0x1a, 0x0e, 0x0b, 0x2f, 0x8d,
-	0xc7, 0x6d, 0x74, 0x2d, 0xbe, 0x86, 0x9e, 0x84, 0x53, 0x1e, 0xa9, 0xba, 0x84, 0x4a, 0x13, 0x4b,
-	0x60, 0x79, 0x5a, 0x13, 0x77, 0xe8, 0x12, 0x9c, 0xd5, 0x49, 0x0d, 0xb7, 0x4c, 0x5a, 0xd9, 0xc1,
-	0x66, 0x8b, 0x48, 0x93, 0xdd, 0x20, 0x33, 0x62, 0xf5, 0x0d, 0xb6, 0xa8, 0xfc, 0x63, 0x06, 0xe6,
-	0x8e, 0x4e, 0x18, 0xbd, 0x05, 0xc7, 0x3b, 0xcd, 0x73, 0xe5, 0x5b, 0x9a, 0xe7, 0xe8, 0xbd, 0x1a,
-	0xd0, 0x4b, 0x0c, 0xf3, 0xb1, 0xd5, 0x41, 0x85, 0xd3, 0xa6, 0x5d, 0xb7, 0x2b, 0x2d, 0xd7, 0xe4,
-	0x95, 0xc8, 0x94, 0x16, 0x0e, 0x4b, 0x93, 0xee, 0xf8, 0x7d, 0x00, 0xfc, 0xfd, 0x7c, 0xfa, 0x86,
-	0x5d, 0xb7, 0xef, 0x68, 0x37, 0xb4, 0x34, 0x33, 0xba, 0xe3, 0x9a, 0xcc, 0xde, 0xb0, 0x6a, 0x81,
-	0xfd, 0x64, 0xbf, 0xfd, 0xa6, 0x55, 0x0b, 0xec, 0x99, 0x11, 0xb3, 0xdf, 0x84, 0xa7, 0x75, 0xbb,
-	0xda, 0x6a, 0x12, 0x2b, 0x18, 0x05, 0xdc, 0x71, 0x8a, 0x3b, 0x9e, 0x8d, 0x39, 0xce, 0xaf, 0xc7,
-	0x8d, 0x18, 0xc2, 0x7c, 0x97, 0x9b, 0x08, 0xbd, 0x85, 0x3d, 0xc2, 0x11, 0xd2, 0xfd, 0xa1, 0x4b,
-	0xd8, 0x23, 0x3c, 0x34, 0x33, 0x62, 0xf6, 0xb7, 0x61, 0x7a, 0x9b, 0x60, 0x9d, 0xb8, 0x9e, 0x34,
-	0xbd, 0x34, 0xbe, 0x9c, 0x5d, 0xf9, 0x71, 0xf2, 0x1d, 0x50, 0x5f, 0x09, 0x3c, 0x5f, 0xb6, 0xa8,
-	0xbb, 0xa7, 0x85, 0x38, 0xe8, 0x2a, 0x9c, 0xaa, 0xd9, 0x6e, 0x13, 0x53, 0x29, 0xc3, 0x09, 0x3c,
-	0x1b, 0x34, 0xf0, 0xe2, 0x71, 0x0d, 0xac, 0x09, 0x37, 0xb4, 0x01, 0xa7, 0xf8, 0x58, 0xf3, 0x24,
-	0xc8, 0x29, 0x15, 0x93, 0x53, 0xe2, 0xaf, 0x8f, 0x26, 0xdc, 0xd1, 0x2d, 0xf8, 0x54, 0xd5, 0x25,
-	0xec, 0x05, 0xd6, 0xed, 0x5d, 0xcb, 0x34, 0xac, 0x46, 0x05, 0x3b, 0x46, 0xa5, 0x41, 0xf6, 0xa4,
-	0x05, 0xd6, 0xd0, 0x25, 0xc9, 0xdf, 0xcf, 0x2f, 0xbe, 0xc4, 0x4d, 0xd6, 0x85, 0xc5, 0xda, 0x6b,
-	0x9b, 0xd7, 0xc9, 0x9e, 0xb6, 0x58, 0xed, 0x7e, 0xea, 0x18, 0xd7, 0xc9, 0x1e, 0x7a, 0x0b, 0xce,
-	0xb5, 0x1c, 0x8e, 0xd3, 0x24, 0x9e, 0x87, 0xeb, 0x44, 0xca, 0xf2, 0xb6, 0x5d, 0x19, 0xa2, 0x68,
-	0x37, 0x03, 0x4f, 0x6d, 0x36, 0x40, 0x12, 0xb7, 0xa8, 0x0c, 0xb3, 0x3f, 0xb7, 0x0d, 0xab, 0x82,
-	0xab, 0x55, 0xe2, 0x50, 0x69, 0x66, 0x64, 0x5c, 0xc8, 0x60, 0xd6, 0x38, 0x0a, 0xba, 0x03, 0x67,
-	0x3a, 0x99, 0x57, 0x1b, 0xd2, 0xec, 0xc8, 0xa8, 0xd9, 0x10, 0x67, 0xad, 0xda, 0x40, 0x6f, 0xc2,
-	0xd9, 0x08, 0xd6, 0x62, 0xb8, 0x73, 0x23, 0xe3, 0x46, 0xfc, 0x5e, 0xc5, 0x3d, 0xc0, 0x1e, 0xb1,
-	0xa8, 0x74, 0xea, 0xe4, 0xc0, 0x65, 0x62, 0x51, 0x74, 0x17, 0x9e, 0x8a, 0x80, 0x6b, 0xd8, 0x30,
-	0x89, 0x2e, 0xcd, 0x8f, 0x0c, 0x3d, 0x17, 0x42, 0x5d, 0xe3, 0x48, 0x5d, 0xe0, 0xef, 0xb5, 0x48,
-	0x8b, 0xe8, 0xd2, 0xe9, 0x93, 0x83, 0xdf, 0xe6, 0x48, 0x0c, 0xdc, 0xb4, 0x85, 0xc8, 0x7a, 0xb6,
-	0xb9, 0x43, 0x74, 0x09, 0x8d, 0x0e, 0x1e, 0x42, 0x95, 0x39, 0x12, 0xeb, 0x0f, 0x76, 0x3e, 0x32,
-	0xaa, 0xa4, 0xa2, 0x63, 0x8a, 0xa5, 0xc5, 0xd1, 0xfb, 0x43, 0xe0, 0xac, 0x63, 0x8a, 0x73, 0xab,
-	0x70, 0x26, 0x3e, 0x1a, 0xd0, 0x3c, 0x1c, 0x67, 0xef, 0x1c, 0xd7, 0x33, 0x8d, 0x5d, 0xa2, 0x45,
-	0x38, 0x19, 0x28, 0x07, 0x1f, 0xcd, 0x5a, 0x70, 0xb3, 0x3a, 0xf6, 0x13, 0x90, 0x3b, 0x07, 0xd3,
-	0xe1, 0x2b, 0x81, 0xe0, 0x84, 0x83, 0xe9, 0xb6, 0xf0, 0xe3, 0xd7, 0x4a, 0x1d, 0x9e, 0x39, 0x9a,
-	0x8d, 0x87, 0x5e, 0x81, 0x99, 0x50, 0x6a, 0x99, 0xa4, 0xb0, 0xe9, 0x71, 0x31, 0x79, 0x36, 0x5a,
-	0xc7, 0x59, 0xf9, 0x74, 0x06, 0xa2, 0x7e, 0x4b, 0x74, 0x3b, 0xae, 0x56, 0x85, 0xe3, 0xa1, 0x13,
-	0xa8, 0xd4, 0x4b, 0x10, 0x06, 0xc3, 0x46, 0xaf, 0x60, 0xca, 0x0b, 0x92, 0x5d, 0xc9, 0xa9, 0xc1,
-	0x31, 0x4e, 0x0d, 0x8f, 0x71, 0xea, 0xeb, 0xe1, 0x31, 0xae, 0x34, 0xcd, 0xdc, 0x3f, 0xfc, 0x77,
-	0x1e, 0x68, 0x19, 0xe1, 0xb7, 0x46, 0x19, 0x48, 0xcb, 0xd1, 0x43, 0x90, 0xf1, 0x61, 0x40, 0x84,
-	0xdf, 0x1a, 0xed, 0x12, 0x8f, 0x89, 0x04, 0xe2, 0xb1, 0xd9, 0x11, 0x8f, 0xc9, 0xa4, 0x93, 0xfa,
-	0x58, 0xd1, 0x98, 0x1a, 0x4d, 0x34, 0xde, 0x85, 0x33, 0xb1, 0xe3, 0x9a, 0x27, 0x26, 0xc7, 0x88,
-	0xe7, 0x89, 0x09, 0xbe, 0x3b, 0xd9, 0xce, 0xa9, 0xcd, 0x43, 0x15, 0x78, 0x2a, 0xc2, 0x17, 0xea,
-	0x34, 0xcf, 0x73, 0xfe, 0x51, 0x82, 0x9c, 0xbb, 0xe4, 0x49, 0xa4, 0x3e, 0x47, 0xbb, 0x1e, 0xa2,
-	0x17, 0xe0, 0x7c, 0x9f, 0x4a, 0x9d, 0xe6, 0xb5, 0x40, 0xfe, 0x7e, 0x7e, 0xae, 0x47, 0x9f, 0xa2,
-	0x31, 0x21, 0x94, 0xe9, 0x76, 0x9f, 0x32, 0xa5, 0x79, 0x01, 0x12, 0x74, 0xff, 0x51, 0x8a, 0x74,
-	0xbd, 0x5b, 0x91, 0xa6, 0x87, 0xc6, 0x8b, 0x2b, 0xd1, 0xcd, 0x1e, 0x25, 0xca, 0x0c, 0x8d, 0xd6,
-	0xa5, 0x40, 0xb7, 0x7a, 0x15, 0x08, 0x0e, 0x8d, 0xd7, 0xad, 0x3c, 0xb7, 0x7a, 0x95, 0x27, 0x3b,
-	0x3a, 0x20, 0x57, 0x9c, 0x72, 0xbf, 0xe2, 0xcc, 0x0c, 0x0d, 0xd9, 0xab, 0x34, 0xe5, 0x7e, 0xa5,
-	0x99, 0x1d, 0x1d, 0x54, 0x28, 0x4c, 0xb9, 0x5f, 0x61, 0xe6, 0x86, 0x07, 0xed, 0x51, 0x96, 0x9b,
-	0x3d, 0xca, 0x82, 0x86, 0xdf, 0xef, 0xc7, 0xa5, 0x28, 0x6b, 0x70, 0x61, 0xc0, 0xfb, 0xf7, 0x38,
-	0x45, 0xe9, 0x0e, 0x5c, 0xe8, 0x4f, 0xc4, 0x43, 0x2f, 0xc2, 0x69, 0xf1, 0xbb, 0x33, 0xd4, 0x22,
-	0xe5, 0xf8, 0xfc, 0xb5, 0xc8, 0x47, 0xf9, 0x14, 0xc0, 0xef, 0xf5, 0x1b, 0x5c, 0xe3, 0xf3, 0xce,
-	0x43, 0xaf, 0xc1, 0x74, 0x30, 0xfa, 0x42, 0xf0, 0x04, 0x83, 0x48, 0xf8, 0xaa, 0xe2, 0xaf, 0x98,
-	0xc1, 0x02, 0x86, 0x15, 0x39, 0xbe, 0x30, 0x4c, 0x85, 0x94, 0xbf, 0x02, 0x78, 0x76, 0x83, 0xd0,
-	0x01, 0xf9, 0x90, 0xf7, 0x5a, 0xc4, 0xa3, 0xdf, 0x85, 0x70, 0x5e, 0x85, 0xb0, 0xf3, 0xf9, 0xe3,
-	0x48, 0xe1, 0xe4, 0x7b, 0x7e, 0x13, 0x7b, 0x8d, 0xd2, 0x04, 0x73, 0xd7, 0x32, 0xb5, 0xf0, 0x81,
-	0xf2, 0x77, 0x00, 0xe5, 0x1b, 0x86, 0x37, 0x80, 0xb5, 0x17, 0xd2, 0xfe, 0x3f, 0x7c, 0xe6, 0x38,
-	0x71, 0x1a, 0x7f, 0x01, 0xf0, 0x6c, 0xf9, 0xdb, 0x6a, 0xff, 0x2a, 0x4c, 0x8b, 0xa6, 0x12, 0xe4,
-	0x13, 0xf4, 0xe1, 0x00, 0xe2, 0x21, 0xc8, 0xc9, 0x19, 0xff, 0x0d, 0xc0, 0xf3, 0x03, 0xbb, 0x25,
-	0x3a, 0x89, 0x09, 0xe6, 0xdf, 0xe1, 0xc7, 0x81, 0x13, 0x27, 0x61, 0xc0, 0x67, 0x06, 0x37, 0x4f,
-	0x74, 0x1c, 0x0d, 0xb3, 0xe8, 0x0e, 0x05, 0x86, 0x0e, 0xb5, 0xf2, 0xdb, 0xcc, 0xa0, 0x4f, 0x28,
-	0x1a, 0xa9, 0x1b, 0x1e, 0x7b, 0x51, 0x4d, 0x08, 0x37, 0x08, 0x0d, 0x07, 0xc3, 0x93, 0x7d, 0xc8,
-	0x2f, 0x37, 0x1d, 0xba, 0x97, 0xbb, 0x90, 0x78, 0x3e, 0x28, 0x67, 0x7e, 0xfd, 0xcf, 0xff, 0x7c,
-	0x34, 0xf6, 0x04, 0x5a, 0x28, 0x62, 0xaf, 0x28, 0x76, 0xbd, 0x20, 0xc6, 0x04, 0xfa, 0x18, 0xc0,
-	0xec, 0x06, 0xa1, 0xd1, 0x07, 0x9c, 0xe7, 0x7a, 0x71, 0x93, 0xec, 0x6c, 0x6e, 0x88, 0x63, 0xb9,
-	0x52, 0xe4, 0x74, 0x2e, 0xa0, 0x67, 0xe3, 0x74, 0xa2, 0xa3, 0x7a, 0xf1, 0x7d, 0x43, 0xf7, 0xd4,
-	0xd8, 0xe1, 0xef, 0x1e, 0xfa, 0x08, 0xc0, 0x59, 0xb6, 0x37, 0x9d, 0x1f, 0x06, 0x7d, 0xc3, 0x31,
-	0xd9, 0xd6, 0xe5, 0x7e, 0x90, 0x9c, 0xa6, 0xa7, 0x9c, 0xe3, 0x3c, 0x9f, 0x42, 0x4f, 0x0c, 0xe4,
-	0x89, 0xfe, 0x04, 0xe0, 0xf8, 0x06, 0xa1, 0xe8, 0x52, 0xa2, 0x82, 0x85, 0x0c, 0x12, 0xbc, 0xab,
-	0xca, 0xcf, 0x78, 0xe0, 0x75, 0x54, 0x8a, 0x05, 0x16, 0x75, 0xe9, 0x99, 0x5e, 0x3d, 0xf7, 0xf7,
-	0x02, 0xa3, 0xce, 0x67, 0xd6, 0x7b, 0xe8, 0x77, 0x00, 0x4e, 0xb0, 0xe2, 0x20, 0x35, 0x59, 0xc9,
-	0xa2, 0x52, 0x3d, 0x7d, 0x3c, 0x51, 0x4f, 0xb9, 0xc2, 0x99, 0x16, 0x51, 0xa1, 0x9b, 0xe9, 0x31,
-	0x2c, 0xd1, 0x23, 0x00, 0xc7, 0xcb, 0x83, 0x4a, 0x57, 0x3e, 0x69, 0xe9, 0xfe, 0x08, 0x38, 0xa3,
-	0xdf, 0x83, 0x9c, 0xd6, 0x4d, 0x49, 0x5c, 0xa9, 0x89, 0x8a, 0x18, 0x37, 0x8e, 0x15, 0x73, 0x15,
-	0x5c, 0x7c, 0xfb, 0x45, 0xe5, 0xf9, 0x91, 0x81, 0x57, 0xc1, 0x45, 0xd6, 0xcb, 0x53, 0xeb, 0xc4,
-	0x24, 0x94, 0xa0, 0xe1, 0x64, 0x33, 0x77, 0xc4, 0x20, 0x50, 0x4a, 0x3c, 0xe3, 0x17, 0x2e, 0xae,
-	0x0e, 0xb5, 0x07, 0x11, 0x71, 0x76, 0x53, 0xfa, 0x33, 0x78, 0x70, 0x20, 0x83, 0x87, 0x07, 0x32,
-	0xf8, 0xf2, 0x40, 0x4e, 0x7d, 0x75, 0x20, 0xa7, 0xbe, 0x3e, 0x90, 0x53, 0xdf, 0x1c, 0xc8, 0xa9,
-	0x47, 0x07, 0x32, 0xf8, 0xc0, 0x97, 0xc1, 0x7d, 0x5f, 0x4e, 0x7d, 0xe2, 0xcb, 0xe0, 0x33, 0x5f,
-	0x4e, 0x7d, 0xee, 0xcb, 0xa9, 0x2f, 0x7c, 0x39, 0xf5, 0xc0, 0x97, 0xc1, 0x43, 0x5f, 0x06, 0x5f,
-	0xfa, 0x72, 0xea, 0x2b, 0x5f, 0x06, 0x5f, 0xfb, 0x72, 0xea, 0x1b, 0x5f, 0x06, 0x8f, 0x7c, 0x39,
-	0xf5, 0x41, 0x5b, 0x4e, 0xdd, 0x6f, 0xcb, 0xe0, 0xc3, 0xb6, 0x9c, 0xfa, 0x43, 0x5b, 0x06, 0x1f,
-	0xb7, 0xe5, 0xd4, 0x27, 0x6d, 0x39, 0xf5, 0x59, 0x5b, 0x06, 0x9f, 0xb7, 0x65, 0xf0, 0x45, 0x5b,
-	0x06, 0x6f, 0x17, 0xeb, 0xb6, 0x4a, 0xb7, 0x09, 0xdd, 0x36, 0xac, 0xba, 0xa7, 0x5a, 0x84, 0xee,
-	0xda, 0x6e, 0xa3, 0xd8, 0xfd, 0x1f, 0x8d, 0x9d, 0xcb, 0x45, 0xa7, 0x51, 0x2f, 0x52, 0x6a, 0x39,
-	0x5b, 0x5b, 0x53, 0x3c, 0xf7, 0xcb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x0c, 0x2f, 0x6e,
-	0x25, 0x1a, 0x00, 0x00,
+	// 2429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xe6, 0x88, 0xd4, 0xdf, 0xf0, 0x47, 0xf2, 0x48, 0x56, 0x36, 0xb4, 0xbc, 0x54, 0x37, 0x6e,
+	0x2c, 0xab, 0x26, 0xd9, 0xca, 0x71, 0xd3, 0xa8, 0x71, 0x1c, 0xd2, 0xf4, 0x8f, 0x62, 0x3b, 0x96,
+	0x97, 0x92, 0x83, 0xc4, 0x4d, 0xd8, 0x15, 0x77, 0x44, 0x6d, 0xb4, 0xdc, 0x65, 0x76, 0x87, 0x52,
+	0x95, 0xc0, 0xa8, 0xd1, 0x93, 0xd1, 0x53, 0xd0, 0x1c, 0xda, 0x53, 0x11, 0xb4, 0x28, 0x90, 0x9e,
+	0x1a, 0xf4, 0x50, 0xf8, 0x68, 0x14, 0x3d, 0x18, 0x05, 0x8a, 0x1a, 0xe8, 0xa1, 0xe9, 0x45, 0x8d,
+	0xa8, 0xa2, 0xf0, 0xa9, 0xc8, 0xd1, 0xd0, 0xa9, 0x98, 0xd9, 0x59, 0x72, 0xf9, 0x67, 0x2d, 0xa9,
+	0xba, 0x39, 0x69, 0x67, 0xe6, 0xbd, 0x6f, 0xde, 0x7b, 0xf3, 0x66, 0xbe, 0x37, 0x43, 0xc1, 0xa4,
+	0x6e, 0x5a, 0xca, 0x96, 0x62, 0x24, 0x6d, 0xa2, 0x14, 0x37, 0xd2, 0x4a, 0x45, 0x4b, 0x2b, 0x95,
+	0x8a, 0xae, 0x15, 0x15, 0xa2, 0x99, 0x86, 0x8d, 0xad, 0x4d, 0x6c, 0x15, 0xb6, 0xf0, 0x6a, 0xaa,
+	0x62, 0x99, 0xc4, 0x44, 0x31, 0x42, 0x8c, 0x14, 0x57, 0x49, 0x6d, 0x9e, 0x89, 0x67, 0x4a, 0x1a,
+	0x59, 0xaf, 0xae, 0xa6, 0x8a, 0x66, 0x39, 0x8d, 0x8d, 0x4d, 0x73, 0xbb, 0x62, 0x99, 0x3f, 0xda,
+	0x4e, 0x33, 0xe1, 0x62, 0xb2, 0x84, 0x8d, 0xe4, 0xa6, 0xa2, 0x6b, 0xaa, 0x42, 0x70, 0xba, 0xed,
+	0xc3, 0x81, 0x8c, 0x27, 0x3d, 0x10, 0x25, 0xb3, 0x64, 0x3a, 0xca, 0xab, 0xd5, 0x35, 0xd6, 0x62,
+	0x0d, 0xf6, 0xc5, 0xc5, 0xa7, 0x4b, 0xa6, 0x59, 0xd2, 0xb1, 0x63, 0xa9, 0x61, 0x98, 0xc4, 0x31,
+	0x94, 0x8f, 0x1e, 0xe3, 0xa3, 0x75, 0x0c, 0x5c, 0xae, 0x90, 0x6d, 0x3e, 0x38, 0xd3, 0x3a, 0xb8,
+	0xa6, 0x61, 0x5d, 0x2d, 0x94, 0x15, 0x7b, 0x83, 0x4b, 0x24, 0x5a, 0x25, 0x88, 0x56, 0xc6, 0x36,
+	0x51, 0xca, 0x15, 0x2e, 0x70, 0xbc, 0x3d, 0x5c, 0xd8, 0xb2, 0x4c, 0x8b, 0x0f, 0xbf, 0xd0, 0x3e,
+	0xac, 0xa9, 0xd8, 0x20, 0xda, 0x9a, 0x86, 0x2d, 0xd7, 0xc6, 0x44, 0xbb, 0x90, 0x8d, 0x8b, 0x16,
+	0x26, 0x5c, 0x40, 0xfa, 0x3b, 0x80, 0xc7, 0x33, 0x8d, 0x45, 0x78, 0x0b, 0xaf, 0xae, 0x9b, 0xe6,
+	0xc6, 0x62, 0x03, 0x08, 0x29, 0x70, 0xcc, 0xb3, 0x4a, 0x05, 0x4d, 0xb5, 0x05, 0x30, 0x03, 0x66,
+	0xc3, 0xf3, 0x2f, 0xa6, 0x9a, 0x17, 0x28, 0xe5, 0xc1, 0xf1, 0x00, 0x64, 0xc7, 0xf7, 0xb3, 0x83,
+	0x3f, 0x05, 0x03, 0xe3, 0xe0, 0xe1, 0x4e, 0x22, 0xf0, 0x68, 0x27, 0x01, 0xe4, 0x98, 0xe2, 0x95,
+	0xb4, 0x51, 0x1e, 0xc2, 0x2d, 0x67, 0xe2, 0x82, 0xa6, 0x0a, 0x03, 0x33, 0x60, 0x76, 0x34, 0xfb,
+	0xd2, 0x7e, 0xf6, 0x84, 0x25, 0x09, 0x27, 0xe6, 0xc5, 0xf7, 0x6e, 0x2b, 0xc9, 0x0f, 0xbf, 0x9d,
+	0x7c, 0xe5, 0xdd, 0xd9, 0xf3, 0x0b, 0xb7, 0x93, 0xef, 0x9e, 0x77, 0x9b, 0xa7, 0x3e, 0x9a, 0x3f,
+	0x7d, 0xe7, 0x44, 0x6d, 0x27, 0x31, 0xea, 0x5a, 0x9d, 0x93, 0x47, 0xb7, 0x5c, 0x07, 0xa4, 0x1f,
+	0xc3, 0x6f, 0xb6, 0x3b, 0xb6, 0x8c, 0xcb, 0x15, 0x5d, 0x21, 0xd8, 0xeb, 0xe0, 0x2d, 0x18, 0x26,
+	0xbc, 0x9b, 0x4e, 0x0f, 0xd8, 0xf4, 0x67, 0xfd, 0x4f, 0x0f, 0xeb, 0xa0, 0x39, 0x19, 0x92, 0xfa,
+	0x04, 0xd2, 0x7f, 0x00, 0x4c, 0x74, 0xb7, 0xe0, 0x12, 0xcd, 0x07, 0x74, 0x0e, 0x0e, 0xd4, 0xa7,
+	0x4c, 0xfa, 0x9f, 0x72, 0x60, 0x31, 0x27, 0x0f, 0x68, 0x2a, 0x3a, 0x06, 0x43, 0x86, 0x52, 0xc6,
+	0x3c, 0x64, 0xc3, 0xfb, 0xd9, 0x90, 0x35, 0x20, 0x4c, 0xca, 0xac, 0x13, 0x9d, 0x82, 0x61, 0x15,
+	0xdb, 0x45, 0x4b, 0xab, 0xd0, 0xe9, 0x85, 0xa0, 0x57, 0x46, 0x95, 0xbd, 0x63, 0x68, 0x0a, 0x0e,
+	0x39, 0x69, 0x21, 0x84, 0x66, 0xc0, 0xec, 0x88, 0xcc, 0x5b, 0xe8, 0x34, 0x8c, 0xaa, 0x78, 0x4d,
+	0xa9, 0xea, 0xa4, 0xb0, 0xa9, 0xe8, 0x55, 0x2c, 0x0c, 0x36, 0x83, 0x44, 0xf8, 0xe8, 0x2d, 0x3a,
+	0x28, 0xfd, 0x39, 0x02, 0xe3, 0xdd, 0x1d, 0x46, 0x6f, 0xc3, 0x60, 0x23, 0x79, 0xce, 0x3e, 0x25,
+	0x79, 0xba, 0xaf, 0x55, 0x87, 0x5c, 0xa2, 0x98, 0xff, 0xb3, 0x38, 0xa4, 0xe0, 0x88, 0x6e, 0x96,
+	0xcc, 0x42, 0xd5, 0xd2, 0x59, 0x24, 0x46, 0xb3, 0x13, 0xfb, 0xd9, 0x41, 0x2b, 0x78, 0x0f, 0x80,
+	0xda, 0x4e, 0x62, 0xf8, 0x9a, 0x59, 0x32, 0x57, 0xe4, 0x6b, 0xf2, 0x30, 0x15, 0x5a, 0xb1, 0x74,
+	0x2a, 0xaf, 0x19, 0x6b, 0x8e, 0xfc, 0x60, 0xbb, 0xfc, 0xa2, 0xb1, 0xe6, 0xc8, 0x53, 0x21, 0x2a,
+	0xbf, 0x08, 0x8f, 0xa8, 0x66, 0xb1, 0x5a, 0xc6, 0x86, 0x73, 0x94, 0x30, 0xc5, 0x21, 0xa6, 0x38,
+	0xed, 0x51, 0x1c, 0xcf, 0x79, 0x85, 0x28, 0xc2, 0x78, 0x93, 0x1a, 0x9f, 0x7a, 0x55, 0xb1, 0x31,
+	0x43, 0x18, 0x6e, 0x9f, 0x3a, 0xab, 0xd8, 0x98, 0x4d, 0x4d, 0x85, 0xa8, 0xfc, 0x4d, 0x38, 0xbc,
+	0x8e, 0x15, 0x15, 0x5b, 0xb6, 0x30, 0x32, 0x13, 0x9c, 0x0d, 0xcf, 0xbf, 0xec, 0x7f, 0x05, 0x52,
+	0x57, 0x1c, 0xcd, 0x8b, 0x06, 0xb1, 0xb6, 0x65, 0x17, 0x07, 0x9d, 0x87, 0x43, 0x6b, 0xa6, 0x55,
+	0x56, 0x88, 0x30, 0xca, 0x0c, 0x38, 0xe9, 0x24, 0xf0, 0xe4, 0x41, 0x09, 0x2c, 0x73, 0x35, 0x74,
+	0x19, 0x0e, 0xb1, 0x63, 0xd1, 0x16, 0x20, 0x33, 0x29, 0xed, 0xdf, 0x24, 0xb6, 0x7d, 0x64, 0xae,
+	0x8e, 0x6e, 0xc0, 0xe7, 0x8a, 0x16, 0xa6, 0x1b, 0x58, 0x35, 0xb7, 0x0c, 0x5d, 0x33, 0x36, 0x0a,
+	0x4a, 0x45, 0x2b, 0x6c, 0xe0, 0x6d, 0x61, 0x82, 0x26, 0x74, 0x56, 0xa8, 0xed, 0x24, 0x26, 0x2f,
+	0x30, 0x91, 0x1c, 0x97, 0xc8, 0x2c, 0x2d, 0x5e, 0xc5, 0xdb, 0xf2, 0x64, 0xb1, 0xb9, 0xb7, 0xa2,
+	0x5d, 0xc5, 0xdb, 0xe8, 0x6d, 0x18, 0xab, 0x56, 0x18, 0x4e, 0x19, 0xdb, 0xb6, 0x52, 0xc2, 0x42,
+	0x98, 0xa5, 0xed, 0x7c, 0x0f, 0x41, 0xbb, 0xee, 0x68, 0xca, 0x51, 0x07, 0x89, 0x37, 0x51, 0x1e,
+	0x86, 0xdf, 0x37, 0x35, 0xa3, 0xa0, 0x14, 0x8b, 0xb8, 0x42, 0x84, 0x48, 0xdf, 0xb8, 0x90, 0xc2,
+	0x64, 0x18, 0x0a, 0x5a, 0x81, 0x91, 0x86, 0xe7, 0xc5, 0x0d, 0x21, 0xda, 0x37, 0x6a, 0xd8, 0xc5,
+	0xc9, 0x14, 0x37, 0xd0, 0x5b, 0x30, 0x5a, 0x87, 0x35, 0x28, 0x6e, 0xac, 0x6f, 0xdc, 0xba, 0x7d,
+	0x6f, 0x2a, 0x2d, 0xc0, 0x36, 0x36, 0x88, 0x30, 0x76, 0x78, 0xe0, 0x3c, 0x36, 0x08, 0xba, 0x0d,
+	0xc7, 0xea, 0xc0, 0x6b, 0x8a, 0xa6, 0x63, 0x55, 0x18, 0xef, 0x1b, 0x3a, 0xe6, 0x42, 0x5d, 0x62,
+	0x48, 0x4d, 0xe0, 0x1f, 0x54, 0x71, 0x15, 0xab, 0xc2, 0x91, 0xc3, 0x83, 0xdf, 0x64, 0x48, 0x14,
+	0x5c, 0x37, 0x39, 0xc9, 0xda, 0xa6, 0xbe, 0x89, 0x55, 0x01, 0xf5, 0x0f, 0xee, 0x42, 0xe5, 0x19,
+	0x12, 0xcd, 0x0f, 0x5a, 0x5f, 0x69, 0x45, 0x5c, 0x50, 0x15, 0xa2, 0x08, 0x93, 0xfd, 0xe7, 0x07,
+	0xc7, 0xc9, 0x29, 0x44, 0x89, 0x2f, 0xc0, 0x88, 0xf7, 0x68, 0x40, 0xe3, 0x30, 0x48, 0xf7, 0x1c,
+	0xe3, 0x33, 0x99, 0x7e, 0xa2, 0x49, 0x38, 0xe8, 0x30, 0x07, 0x3b, 0x9a, 0x65, 0xa7, 0xb1, 0x30,
+	0xf0, 0x3d, 0x10, 0x3f, 0x0e, 0x87, 0xdd, 0x2d, 0x81, 0x60, 0xa8, 0xa2, 0x90, 0x75, 0xae, 0xc7,
+	0xbe, 0xa5, 0x12, 0x3c, 0xd6, 0xdd, 0x1a, 0x1b, 0x5d, 0x81, 0xa3, 0x2e, 0xd5, 0x52, 0x4a, 0xa1,
+	0xa7, 0xc7, 0x9c, 0x7f, 0x6f, 0xe4, 0x86, 0xb2, 0xf4, 0xab, 0x01, 0x28, 0xb4, 0x4b, 0x5e, 0xc1,
+	0x8a, 0x4e, 0xd6, 0xd1, 0x49, 0x38, 0xe6, 0x64, 0x51, 0x41, 0x21, 0x54, 0x85, 0x38, 0xfc, 0x15,
+	0x95, 0x63, 0x4e, 0x77, 0x86, 0xf7, 0xa2, 0x15, 0x38, 0xa5, 0x2b, 0x36, 0x29, 0x34, 0x4b, 0x17,
+	0x14, 0xc2, 0x1c, 0x0f, 0xcf, 0xc7, 0x53, 0x4e, 0xb9, 0x97, 0x72, 0xcb, 0xbd, 0xd4, 0xb2, 0x5b,
+	0xee, 0x65, 0x43, 0x1f, 0xff, 0x33, 0x01, 0xe4, 0x09, 0xaa, 0x7f, 0xc9, 0x8b, 0x9a, 0x21, 0xe8,
+	0xfb, 0x10, 0x32, 0x58, 0x56, 0xf8, 0x31, 0xea, 0x0a, 0xcf, 0x4f, 0xb7, 0xfa, 0x79, 0x91, 0x0e,
+	0xe6, 0x30, 0x51, 0x34, 0xdd, 0x96, 0x47, 0xa9, 0x3c, 0xeb, 0x41, 0x8b, 0x70, 0xcc, 0xae, 0xda,
+	0x15, 0x6c, 0xa8, 0x58, 0x2d, 0x54, 0x0d, 0xa2, 0x39, 0xa4, 0xe6, 0xc7, 0x98, 0x58, 0x5d, 0x71,
+	0x85, 0xea, 0x49, 0x7f, 0x19, 0x83, 0xa8, 0x3d, 0x48, 0xe8, 0xa6, 0x97, 0xd2, 0x93, 0x07, 0xc7,
+	0xdf, 0x07, 0x95, 0x5f, 0x80, 0xd0, 0x39, 0x91, 0x55, 0x7f, 0xc1, 0x1b, 0xa1, 0xea, 0xcc, 0xe6,
+	0x51, 0xae, 0x97, 0x21, 0x14, 0xa4, 0x5a, 0x51, 0x5d, 0x90, 0x60, 0x2f, 0x20, 0x5c, 0x2f, 0x43,
+	0x9a, 0x18, 0x36, 0xe4, 0x83, 0x61, 0x17, 0x1b, 0x0c, 0x3b, 0xe8, 0x97, 0xce, 0x0e, 0x64, 0xd6,
+	0xa1, 0xfe, 0x98, 0xf5, 0x3d, 0x18, 0xf1, 0xd4, 0xb4, 0xb6, 0x30, 0x76, 0x98, 0xa2, 0x2b, 0xc4,
+	0x56, 0x27, 0xdc, 0x28, 0x6d, 0x6d, 0x54, 0x80, 0x63, 0x75, 0x7c, 0x4e, 0xe1, 0xe3, 0xcc, 0xe7,
+	0xef, 0xfa, 0xf0, 0xb9, 0x89, 0xc3, 0xb9, 0xeb, 0x31, 0xd2, 0xd4, 0x89, 0x5e, 0x85, 0xe3, 0x6d,
+	0x54, 0x7e, 0x84, 0xc5, 0x02, 0xd5, 0x76, 0x12, 0xb1, 0x16, 0x12, 0x8f, 0xa9, 0xcd, 0xf4, 0x7d,
+	0x0e, 0xc6, 0x6c, 0xad, 0x64, 0x68, 0x46, 0xa9, 0xc0, 0xeb, 0x5a, 0xe7, 0xc0, 0x9b, 0x6a, 0xb5,
+	0x2e, 0xcf, 0x46, 0xe5, 0x28, 0x97, 0x76, 0x9a, 0xe8, 0x65, 0x88, 0x8a, 0xba, 0x86, 0x0d, 0x52,
+	0x28, 0x62, 0x8b, 0x46, 0xa1, 0xa8, 0x10, 0x2c, 0x1c, 0x9d, 0x01, 0xb3, 0x91, 0xec, 0xc8, 0x7e,
+	0x76, 0xf0, 0xc3, 0xa0, 0x70, 0xf7, 0x75, 0xf9, 0x88, 0x23, 0x73, 0xa1, 0x21, 0x82, 0x72, 0x75,
+	0xc5, 0x8a, 0xa5, 0x6d, 0xd2, 0xe0, 0x50, 0xbb, 0xa7, 0x9e, 0x3a, 0xf7, 0xb8, 0xa3, 0xb1, 0xe4,
+	0x28, 0x50, 0xeb, 0x6f, 0xb6, 0x15, 0x1f, 0xc3, 0x33, 0xc0, 0xdf, 0x01, 0xd7, 0xad, 0xe8, 0xb8,
+	0xda, 0x5c, 0x74, 0x8c, 0xf4, 0x8c, 0xe7, 0x2d, 0x36, 0xae, 0xb7, 0x14, 0x1b, 0xa3, 0x3d, 0xa3,
+	0x35, 0x15, 0x19, 0x37, 0x5a, 0x8b, 0x0c, 0xd8, 0x33, 0x5e, 0x73, 0x71, 0x71, 0xa3, 0xb5, 0xb8,
+	0x08, 0xf7, 0x0f, 0xc8, 0x8a, 0x8a, 0x7c, 0x7b, 0x51, 0x11, 0xe9, 0x19, 0xb2, 0xb5, 0x98, 0xc8,
+	0xb7, 0x17, 0x13, 0xd1, 0xfe, 0x41, 0x79, 0x11, 0x91, 0x6f, 0x2f, 0x22, 0x62, 0xbd, 0x83, 0xb6,
+	0x14, 0x0f, 0xd7, 0x5b, 0x8a, 0x07, 0xd4, 0xfb, 0x7a, 0x7b, 0x8a, 0x06, 0xf4, 0x3a, 0x1c, 0x5a,
+	0x67, 0xec, 0xca, 0x6a, 0xf3, 0xf0, 0xfc, 0xec, 0xc1, 0x40, 0x0e, 0x1b, 0xcb, 0x5c, 0xef, 0x50,
+	0x65, 0x47, 0x06, 0x4e, 0x74, 0x38, 0x7f, 0x7a, 0x82, 0x78, 0x00, 0x9e, 0x5a, 0xba, 0xa0, 0xef,
+	0xc0, 0xa1, 0x32, 0x26, 0xeb, 0xa6, 0xfb, 0x94, 0xf1, 0xfc, 0x7e, 0x76, 0xca, 0x9a, 0x94, 0x03,
+	0x72, 0x68, 0xe9, 0x46, 0x7e, 0x59, 0x0e, 0x2e, 0xad, 0x2c, 0xcb, 0x83, 0x4b, 0x99, 0xe5, 0x0b,
+	0x57, 0x64, 0x2e, 0x88, 0xce, 0x43, 0xd8, 0x78, 0x20, 0xea, 0x4a, 0x58, 0xcc, 0xe0, 0xeb, 0x8a,
+	0xbd, 0x91, 0x0d, 0x51, 0xc2, 0x92, 0x47, 0xd7, 0xdc, 0x0e, 0x94, 0x84, 0xd1, 0x55, 0x53, 0xdd,
+	0x2e, 0xb8, 0xc7, 0x28, 0x67, 0xac, 0x11, 0xc6, 0x58, 0xc2, 0xdd, 0x19, 0x39, 0x42, 0x87, 0x5d,
+	0xcf, 0xa5, 0x15, 0x38, 0xd1, 0x1e, 0x65, 0x1b, 0xbd, 0x06, 0x47, 0xf8, 0x03, 0x8a, 0x5b, 0x54,
+	0x49, 0x07, 0x2f, 0x8e, 0x5c, 0xd7, 0x91, 0x7e, 0x0b, 0xe0, 0xf3, 0xed, 0x02, 0x97, 0x18, 0x27,
+	0xd9, 0x68, 0x09, 0x0e, 0x3b, 0xf4, 0xe4, 0x82, 0xfb, 0x20, 0x0b, 0xae, 0x9b, 0xe2, 0x7f, 0x39,
+	0x4f, 0x72, 0x18, 0x9a, 0x08, 0xde, 0x81, 0x5e, 0x56, 0x51, 0xfa, 0x3d, 0x80, 0xd3, 0x97, 0x31,
+	0xe9, 0xe0, 0x0f, 0xfe, 0xa0, 0x8a, 0x6d, 0xf2, 0x2c, 0x8a, 0x9b, 0xe6, 0x65, 0x1e, 0xe8, 0x79,
+	0x99, 0xa5, 0x3f, 0x01, 0x28, 0x5e, 0xd3, 0xec, 0x0e, 0x56, 0xdb, 0xae, 0xd9, 0xff, 0x87, 0xf7,
+	0xba, 0x43, 0xbb, 0xf1, 0x3b, 0x00, 0xa7, 0xf3, 0x4f, 0x8b, 0xfd, 0x9b, 0x70, 0x98, 0x27, 0x15,
+	0x37, 0xde, 0x47, 0x1e, 0x76, 0x30, 0xdc, 0x05, 0x39, 0xbc, 0xc5, 0x7f, 0x04, 0xf0, 0x44, 0xc7,
+	0x6c, 0xa9, 0x5f, 0x29, 0xb8, 0xe5, 0xcf, 0xf0, 0x95, 0xeb, 0xd0, 0x4e, 0x68, 0xf0, 0xc5, 0xce,
+	0xc9, 0x53, 0xbf, 0x57, 0xb9, 0x5e, 0x34, 0x4f, 0x05, 0x7a, 0x9f, 0xea, 0xdf, 0xa1, 0x4e, 0x6f,
+	0x81, 0x39, 0xac, 0x6b, 0x9b, 0xd8, 0xda, 0x7e, 0x16, 0x7b, 0x2b, 0x4d, 0x9f, 0xf9, 0x1c, 0xf8,
+	0xc6, 0x2b, 0x72, 0x8c, 0xbe, 0xcf, 0xba, 0xb3, 0xd2, 0xf7, 0x59, 0x57, 0x64, 0x51, 0x6d, 0xb9,
+	0x69, 0x04, 0xfb, 0xbb, 0x69, 0x4c, 0xd5, 0xcf, 0x7a, 0xc8, 0x0e, 0x18, 0xde, 0x42, 0xdf, 0x80,
+	0xc1, 0xc6, 0xbd, 0x61, 0xcc, 0x73, 0x6f, 0x08, 0xd2, 0x3b, 0x43, 0xb0, 0xda, 0xfc, 0x22, 0x37,
+	0xe8, 0xf7, 0x45, 0xce, 0x75, 0xa5, 0xcb, 0xbd, 0x01, 0xc1, 0x10, 0x3d, 0xe6, 0xd9, 0xad, 0x21,
+	0x22, 0xb3, 0x6f, 0x14, 0x87, 0x23, 0xf5, 0xbb, 0xeb, 0x30, 0xbb, 0xbb, 0xd6, 0xdb, 0xe8, 0x0a,
+	0x1c, 0x63, 0xd7, 0x4b, 0xcf, 0x75, 0x75, 0xc4, 0xe7, 0x0d, 0x31, 0x4a, 0x15, 0xbb, 0x5d, 0x54,
+	0x47, 0x7b, 0xba, 0xa8, 0x1e, 0x86, 0xcf, 0xa5, 0xf7, 0xe1, 0x74, 0xd7, 0x30, 0x69, 0xd8, 0x46,
+	0x6f, 0x40, 0x77, 0xcd, 0xb5, 0x5e, 0x5e, 0x0a, 0xdc, 0x40, 0xcb, 0x1e, 0x6d, 0xe9, 0x0f, 0x00,
+	0x26, 0x3b, 0x6f, 0x20, 0xa7, 0xa6, 0x6b, 0x4c, 0xfb, 0x0c, 0x39, 0x44, 0x84, 0x83, 0xba, 0x56,
+	0xd6, 0x9c, 0xbb, 0x71, 0x94, 0x31, 0xfc, 0x5c, 0x50, 0x78, 0x3c, 0x2c, 0x3b, 0xdd, 0x4e, 0x45,
+	0x52, 0xc2, 0x2c, 0xa1, 0xa3, 0x32, 0xfb, 0x96, 0xee, 0x03, 0x98, 0x96, 0x71, 0x45, 0x57, 0xb6,
+	0xbf, 0x56, 0xd3, 0xe7, 0x61, 0xc4, 0xb3, 0x45, 0x6d, 0x61, 0x60, 0x26, 0x48, 0x77, 0x47, 0x6d,
+	0x27, 0x11, 0x6e, 0xec, 0x51, 0x5b, 0x0e, 0x37, 0x36, 0xa9, 0x3d, 0xff, 0xd7, 0x8e, 0x3f, 0x2a,
+	0xc8, 0xb8, 0xa4, 0xd9, 0x34, 0x55, 0x74, 0x08, 0x2f, 0x63, 0xe2, 0x56, 0x18, 0x53, 0x6d, 0x69,
+	0x7b, 0x91, 0xfe, 0x26, 0x17, 0x3f, 0xe5, 0xbb, 0xd0, 0x90, 0x8e, 0xfd, 0xe4, 0x6f, 0xff, 0xfa,
+	0x64, 0xe0, 0x28, 0x9a, 0x48, 0x2b, 0x76, 0x9a, 0xd3, 0x47, 0x92, 0xd7, 0x1b, 0xe8, 0x53, 0x00,
+	0xc3, 0x97, 0x31, 0xa9, 0xff, 0xa4, 0xf1, 0x52, 0x2b, 0xae, 0x1f, 0x8a, 0x88, 0xf7, 0xf0, 0x50,
+	0x25, 0xa5, 0x99, 0x39, 0xa7, 0xd0, 0x49, 0xaf, 0x39, 0xf5, 0xc7, 0xab, 0xf4, 0x47, 0x9a, 0x6a,
+	0xa7, 0x3c, 0x37, 0xfd, 0x3b, 0xe8, 0x13, 0x00, 0xa3, 0x34, 0x47, 0x1b, 0x4f, 0x65, 0x6d, 0x55,
+	0x96, 0x3f, 0x0e, 0x88, 0x7f, 0xcb, 0xbf, 0x99, 0xb6, 0x74, 0x9c, 0xd9, 0xf9, 0x1c, 0x3a, 0xda,
+	0xd1, 0x4e, 0xf4, 0x6b, 0x00, 0x83, 0x97, 0xe9, 0x0f, 0x4a, 0xbe, 0x02, 0xe6, 0x5a, 0xe0, 0x83,
+	0xf4, 0xa5, 0x37, 0xd8, 0xc4, 0x39, 0x94, 0xf5, 0x4c, 0xcc, 0xe3, 0xd2, 0x52, 0x06, 0xb5, 0xb4,
+	0xef, 0x38, 0x42, 0x8d, 0x1f, 0x1e, 0xef, 0xa0, 0x9f, 0x01, 0x18, 0xa2, 0xc1, 0x41, 0x29, 0x7f,
+	0x21, 0xab, 0x87, 0xea, 0x85, 0x83, 0x0d, 0xb5, 0xa5, 0xb3, 0xcc, 0xd2, 0x34, 0x4a, 0x36, 0x5b,
+	0x7a, 0x80, 0x95, 0xe8, 0x09, 0x80, 0xc1, 0x7c, 0xa7, 0xd0, 0xe5, 0x0f, 0x1b, 0xba, 0x5f, 0x02,
+	0x66, 0xd1, 0xcf, 0x41, 0x5c, 0x6e, 0x36, 0x89, 0x7f, 0xa5, 0x7c, 0x05, 0xd1, 0x2b, 0xec, 0x09,
+	0xe6, 0x02, 0x98, 0x7b, 0xe7, 0x35, 0xe9, 0x95, 0xbe, 0x81, 0x17, 0xc0, 0x1c, 0xcd, 0xe5, 0xa1,
+	0x1c, 0xd6, 0x31, 0xc1, 0xa8, 0xb7, 0x03, 0x28, 0xde, 0xe5, 0x20, 0x90, 0xb2, 0xcc, 0xe3, 0x57,
+	0xe7, 0x16, 0x7a, 0x5a, 0x83, 0xba, 0xe1, 0x6c, 0x41, 0x76, 0x01, 0x9c, 0xa4, 0xf9, 0xd0, 0x7a,
+	0x70, 0xa2, 0x73, 0xfe, 0xb2, 0xa6, 0xcb, 0x81, 0x1b, 0x3f, 0xed, 0x9b, 0x95, 0x28, 0x17, 0xfd,
+	0x80, 0x79, 0x72, 0x0b, 0x2d, 0x1f, 0x3e, 0xef, 0xd3, 0xce, 0x9b, 0x46, 0xb2, 0xc1, 0x74, 0xe8,
+	0x1f, 0x00, 0x4e, 0x39, 0x84, 0xd1, 0xe6, 0xe5, 0xf9, 0x56, 0x33, 0x7b, 0x24, 0x96, 0xae, 0x6b,
+	0xb3, 0xc6, 0x3c, 0xfa, 0xa1, 0x74, 0xfb, 0x59, 0x78, 0x94, 0xb6, 0x98, 0x95, 0x0b, 0x60, 0x2e,
+	0xfb, 0x1b, 0xf0, 0x70, 0x57, 0x04, 0x8f, 0x76, 0x45, 0xf0, 0xc5, 0xae, 0x18, 0xf8, 0x72, 0x57,
+	0x0c, 0x3c, 0xde, 0x15, 0x03, 0x5f, 0xed, 0x8a, 0x81, 0x27, 0xbb, 0x22, 0xb8, 0x5b, 0x13, 0xc1,
+	0xbd, 0x9a, 0x18, 0xf8, 0xac, 0x26, 0x82, 0xcf, 0x6b, 0x62, 0xe0, 0x7e, 0x4d, 0x0c, 0x3c, 0xa8,
+	0x89, 0x81, 0x87, 0x35, 0x11, 0x3c, 0xaa, 0x89, 0xe0, 0x8b, 0x9a, 0x18, 0xf8, 0xb2, 0x26, 0x82,
+	0xc7, 0x35, 0x31, 0xf0, 0x55, 0x4d, 0x04, 0x4f, 0x6a, 0x62, 0xe0, 0xee, 0x9e, 0x18, 0xb8, 0xb7,
+	0x27, 0x82, 0x8f, 0xf7, 0xc4, 0xc0, 0x2f, 0xf6, 0x44, 0xf0, 0xe9, 0x9e, 0x18, 0xf8, 0x6c, 0x4f,
+	0x0c, 0x7c, 0xbe, 0x27, 0x82, 0xfb, 0x7b, 0x22, 0x78, 0xb0, 0x27, 0x82, 0x77, 0xd2, 0x25, 0x33,
+	0x45, 0xd6, 0x31, 0x59, 0xd7, 0x8c, 0x92, 0x9d, 0x32, 0x30, 0xd9, 0x32, 0xad, 0x8d, 0x74, 0xf3,
+	0xff, 0x67, 0x6c, 0x9e, 0x49, 0x57, 0x36, 0x4a, 0x69, 0x42, 0x8c, 0xca, 0xea, 0xea, 0x10, 0x8b,
+	0xcf, 0x99, 0xff, 0x0e, 0x00, 0x97, 0x62, 0xf2, 0x75, 0x37, 0x23, 0x00, 0x00,
 }
 
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
@@ -1436,14 +1901,14 @@ func (this *ApplicationWebhookTemplates) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ApplicationWebhook) Equal(that interface{}) bool {
+func (this *ApplicationWebhookHealth) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationWebhook)
+	that1, ok := that.(*ApplicationWebhookHealth)
 	if !ok {
-		that2, ok := that.(ApplicationWebhook)
+		that2, ok := that.(ApplicationWebhookHealth)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1455,11 +1920,52 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+	if this.FailedAttempts != that1.FailedAttempts {
 		return false
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
+	if that1.LastFailedAttemptAt == nil {
+		if this.LastFailedAttemptAt != nil {
+			return false
+		}
+	} else if !this.LastFailedAttemptAt.Equal(*that1.LastFailedAttemptAt) {
+		return false
+	}
+	if !this.LastError.Equal(that1.LastError) {
+		return false
+	}
+	if that1.SuspendedUntil == nil {
+		if this.SuspendedUntil != nil {
+			return false
+		}
+	} else if !this.SuspendedUntil.Equal(*that1.SuspendedUntil) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhook)
+	if !ok {
+		that2, ok := that.(ApplicationWebhook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
 	}
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
@@ -1492,6 +1998,15 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if this.DownlinkAPIKey != that1.DownlinkAPIKey {
 		return false
 	}
+	if !this.SigningSecret.Equal(that1.SigningSecret) {
+		return false
+	}
+	if !bytes.Equal(this.ClientCertificate, that1.ClientCertificate) {
+		return false
+	}
+	if !this.ClientPrivateKey.Equal(that1.ClientPrivateKey) {
+		return false
+	}
 	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
 		return false
 	}
@@ -1519,6 +2034,9 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.ServiceData.Equal(that1.ServiceData) {
 		return false
 	}
+	if !this.Health.Equal(that1.Health) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -1543,6 +2061,15 @@ func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
 	if this.Path != that1.Path {
 		return false
 	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.BodyTemplate != that1.BodyTemplate {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhooks) Equal(that interface{}) bool {
@@ -1735,6 +2262,157 @@ func (this *ListApplicationWebhookTemplatesRequest) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ApplicationWebhookDelivery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDelivery)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDelivery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.DeliveryID != that1.DeliveryID {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Body, that1.Body) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if that1.LastAttemptAt == nil {
+		if this.LastAttemptAt != nil {
+			return false
+		}
+	} else if !this.LastAttemptAt.Equal(*that1.LastAttemptAt) {
+		return false
+	}
+	if !this.LastError.Equal(that1.LastError) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookDeliveries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDeliveries)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDeliveries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListApplicationWebhookFailedDeliveriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListApplicationWebhookFailedDeliveriesRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationWebhookFailedDeliveriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
+}
+func (this *ReplayApplicationWebhookFailedDeliveriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayApplicationWebhookFailedDeliveriesRequest)
+	if !ok {
+		that2, ok := that.(ReplayApplicationWebhookFailedDeliveriesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationWebhookIdentifiers.Equal(&that1.ApplicationWebhookIdentifiers) {
+		return false
+	}
+	if len(this.DeliveryIDs) != len(that1.DeliveryIDs) {
+		return false
+	}
+	for i := range this.DeliveryIDs {
+		if this.DeliveryIDs[i] != that1.DeliveryIDs[i] {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1755,6 +2433,11 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the deliveries of the webhook that failed after the maximum number of attempts.
+	ListFailedDeliveries(ctx context.Context, in *ListApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
+	// Replay failed deliveries of the webhook.
+	// The deliveries are removed from the failed deliveries and queued for delivery.
+	ReplayFailedDeliveries(ctx context.Context, in *ReplayApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -1828,6 +2511,24 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListFailedDeliveries(ctx context.Context, in *ListApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayFailedDeliveries(ctx context.Context, in *ReplayApplicationWebhookFailedDeliveriesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -1837,6 +2538,11 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the deliveries of the webhook that failed after the maximum number of attempts.
+	ListFailedDeliveries(context.Context, *ListApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error)
+	// Replay failed deliveries of the webhook.
+	// The deliveries are removed from the failed deliveries and queued for delivery.
+	ReplayFailedDeliveries(context.Context, *ReplayApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error)
 }
 
 // UnimplementedApplicationWebhookRegistryServer can be embedded to have forward compatible implementations.
@@ -1864,6 +2570,12 @@ func (*UnimplementedApplicationWebhookRegistryServer) Set(ctx context.Context, r
 func (*UnimplementedApplicationWebhookRegistryServer) Delete(ctx context.Context, req *ApplicationWebhookIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) ListFailedDeliveries(ctx context.Context, req *ListApplicationWebhookFailedDeliveriesRequest) (*ApplicationWebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedDeliveries not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) ReplayFailedDeliveries(ctx context.Context, req *ReplayApplicationWebhookFailedDeliveriesRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFailedDeliveries not implemented")
+}
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
 	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
@@ -1995,6 +2707,42 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListFailedDeliveries(ctx, req.(*ListApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayApplicationWebhookFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayFailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayFailedDeliveries(ctx, req.(*ReplayApplicationWebhookFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -2027,6 +2775,14 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ListFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayFailedDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ReplayFailedDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -2473,7 +3229,7 @@ func (m *ApplicationWebhookTemplates) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookHealth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2483,19 +3239,29 @@ func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookHealth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ServiceData != nil {
+	if m.SuspendedUntil != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SuspendedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedUntil):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastError != nil {
 		{
-			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2503,35 +3269,136 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x1a
 	}
-	if len(m.DownlinkAPIKey) > 0 {
-		i -= len(m.DownlinkAPIKey)
-		copy(dAtA[i:], m.DownlinkAPIKey)
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
+	if m.LastFailedAttemptAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastFailedAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n14))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x12
+	}
+	if m.FailedAttempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.FailedAttempts))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x8
 	}
-	if len(m.TemplateFields) > 0 {
-		for k := range m.TemplateFields {
-			v := m.TemplateFields[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(baseI-i))
-			i--
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientPrivateKey != nil {
+		{
+			size, err := m.ClientPrivateKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.ClientCertificate) > 0 {
+		i -= len(m.ClientCertificate)
+		copy(dAtA[i:], m.ClientCertificate)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ClientCertificate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SigningSecret != nil {
+		{
+			size, err := m.SigningSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ServiceData != nil {
+		{
+			size, err := m.ServiceData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.DownlinkAPIKey) > 0 {
+		i -= len(m.DownlinkAPIKey)
+		copy(dAtA[i:], m.DownlinkAPIKey)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DownlinkAPIKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.TemplateFields) > 0 {
+		for k := range m.TemplateFields {
+			v := m.TemplateFields[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(baseI-i))
+			i--
 			dAtA[i] = 0x1
 			i--
 			dAtA[i] = 0x82
@@ -2678,20 +3545,20 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err22 != nil {
-		return 0, err22
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err28 != nil {
+		return 0, err28
 	}
-	i -= n22
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n22))
+	i -= n28
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n28))
 	i--
 	dAtA[i] = 0x1a
-	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err23 != nil {
-		return 0, err23
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err29 != nil {
+		return 0, err29
 	}
-	i -= n23
-	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n23))
+	i -= n29
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n29))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2727,6 +3594,30 @@ func (m *ApplicationWebhook_Message) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.BodyTemplate) > 0 {
+		i -= len(m.BodyTemplate)
+		copy(dAtA[i:], m.BodyTemplate)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.BodyTemplate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Path) > 0 {
 		i -= len(m.Path)
 		copy(dAtA[i:], m.Path)
@@ -3021,95 +3912,332 @@ func (m *ListApplicationWebhookTemplatesRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverWeb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
-	this := &ApplicationWebhookIdentifiers{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	this.WebhookID = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *ApplicationWebhookDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedApplicationWebhookTemplateIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateIdentifiers {
-	this := &ApplicationWebhookTemplateIdentifiers{}
-	this.TemplateID = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *ApplicationWebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedApplicationWebhookTemplateField(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateField {
-	this := &ApplicationWebhookTemplateField{}
-	this.ID = randStringApplicationserverWeb(r)
-	this.Name = randStringApplicationserverWeb(r)
-	this.Description = randStringApplicationserverWeb(r)
-	this.Secret = bool(r.Intn(2) == 0)
-	this.DefaultValue = randStringApplicationserverWeb(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *ApplicationWebhookDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return this
-}
-
-func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplate {
-	this := &ApplicationWebhookTemplate{}
-	v2 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v2
-	this.Name = randStringApplicationserverWeb(r)
-	this.Description = randStringApplicationserverWeb(r)
-	this.LogoURL = randStringApplicationserverWeb(r)
-	this.InfoURL = randStringApplicationserverWeb(r)
-	this.DocumentationURL = randStringApplicationserverWeb(r)
-	this.BaseURL = randStringApplicationserverWeb(r)
-	if r.Intn(5) != 0 {
-		v3 := r.Intn(10)
-		this.Headers = make(map[string]string)
-		for i := 0; i < v3; i++ {
-			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	this.Format = randStringApplicationserverWeb(r)
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(5)
-		this.Fields = make([]*ApplicationWebhookTemplateField, v4)
-		for i := 0; i < v4; i++ {
-			this.Fields[i] = NewPopulatedApplicationWebhookTemplateField(r, easy)
+	if m.LastAttemptAt != nil {
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastAttemptAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptAt):])
+		if err42 != nil {
+			return 0, err42
 		}
+		i -= n42
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n42))
+		i--
+		dAtA[i] = 0x42
 	}
-	if r.Intn(5) != 0 {
-		this.UplinkMessage = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if m.Attempts != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x38
 	}
-	if r.Intn(5) != 0 {
-		this.JoinAccept = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkAck = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkNack = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkSent = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err43 != nil {
+		return 0, err43
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkFailed = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	i -= n43
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DeliveryID) > 0 {
+		i -= len(m.DeliveryID)
+		copy(dAtA[i:], m.DeliveryID)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DeliveryID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if r.Intn(5) != 0 {
-		this.DownlinkQueued = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
-	if r.Intn(5) != 0 {
-		this.LocationSolved = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationWebhookDeliveries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationWebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationWebhookDeliveries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Limit != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeliveryIDs) > 0 {
+		for iNdEx := len(m.DeliveryIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeliveryIDs[iNdEx])
+			copy(dAtA[i:], m.DeliveryIDs[iNdEx])
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.DeliveryIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverWeb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverWeb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationWebhookIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookIdentifiers {
+	this := &ApplicationWebhookIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.WebhookID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateIdentifiers(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateIdentifiers {
+	this := &ApplicationWebhookTemplateIdentifiers{}
+	this.TemplateID = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplateField(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplateField {
+	this := &ApplicationWebhookTemplateField{}
+	this.ID = randStringApplicationserverWeb(r)
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.Secret = bool(r.Intn(2) == 0)
+	this.DefaultValue = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookTemplate(r randyApplicationserverWeb, easy bool) *ApplicationWebhookTemplate {
+	this := &ApplicationWebhookTemplate{}
+	v2 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v2
+	this.Name = randStringApplicationserverWeb(r)
+	this.Description = randStringApplicationserverWeb(r)
+	this.LogoURL = randStringApplicationserverWeb(r)
+	this.InfoURL = randStringApplicationserverWeb(r)
+	this.DocumentationURL = randStringApplicationserverWeb(r)
+	this.BaseURL = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	this.Format = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(5)
+		this.Fields = make([]*ApplicationWebhookTemplateField, v4)
+		for i := 0; i < v4; i++ {
+			this.Fields[i] = NewPopulatedApplicationWebhookTemplateField(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkMessage = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.JoinAccept = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkAck = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkNack = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkSent = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkFailed = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkQueued = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LocationSolved = NewPopulatedApplicationWebhookTemplate_Message(r, easy)
 	}
 	this.CreateDownlinkAPIKey = bool(r.Intn(2) == 0)
 	if r.Intn(5) != 0 {
@@ -3142,6 +4270,23 @@ func NewPopulatedApplicationWebhookTemplates(r randyApplicationserverWeb, easy b
 	return this
 }
 
+func NewPopulatedApplicationWebhookHealth(r randyApplicationserverWeb, easy bool) *ApplicationWebhookHealth {
+	this := &ApplicationWebhookHealth{}
+	this.FailedAttempts = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LastFailedAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.LastError = NewPopulatedErrorDetails(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.SuspendedUntil = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *ApplicationWebhook {
 	this := &ApplicationWebhook{}
 	v6 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
@@ -3197,6 +4342,20 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 	if r.Intn(5) != 0 {
 		this.ServiceData = NewPopulatedApplicationWebhook_Message(r, easy)
 	}
+	if r.Intn(5) == 0 {
+		this.Health = NewPopulatedApplicationWebhookHealth(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.SigningSecret = NewPopulatedSecret(r, easy)
+	}
+	v11 := r.Intn(100)
+	this.ClientCertificate = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.ClientCertificate[i] = byte(r.Intn(256))
+	}
+	if r.Intn(5) != 0 {
+		this.ClientPrivateKey = NewPopulatedSecret(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3205,6 +4364,10 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bool) *ApplicationWebhook_Message {
 	this := &ApplicationWebhook_Message{}
 	this.Path = randStringApplicationserverWeb(r)
+	this.Method = randStringApplicationserverWeb(r)
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
+	this.BodyTemplate = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3212,10 +4375,10 @@ func NewPopulatedApplicationWebhook_Message(r randyApplicationserverWeb, easy bo
 
 func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *ApplicationWebhooks {
 	this := &ApplicationWebhooks{}
-	if r.Intn(5) != 0 {
-		v11 := r.Intn(5)
-		this.Webhooks = make([]*ApplicationWebhook, v11)
-		for i := 0; i < v11; i++ {
+	if r.Intn(5) == 0 {
+		v13 := r.Intn(5)
+		this.Webhooks = make([]*ApplicationWebhook, v13)
+		for i := 0; i < v13; i++ {
 			this.Webhooks[i] = NewPopulatedApplicationWebhook(r, easy)
 		}
 	}
@@ -3227,9 +4390,9 @@ func NewPopulatedApplicationWebhooks(r randyApplicationserverWeb, easy bool) *Ap
 func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy bool) *ApplicationWebhookFormats {
 	this := &ApplicationWebhookFormats{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(10)
+		v14 := r.Intn(10)
 		this.Formats = make(map[string]string)
-		for i := 0; i < v12; i++ {
+		for i := 0; i < v14; i++ {
 			this.Formats[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
@@ -3240,10 +4403,10 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v13 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v15 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3251,10 +4414,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v17 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3262,10 +4425,10 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v17 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v19 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v19
+	v20 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3273,10 +4436,10 @@ func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
 	this := &GetApplicationWebhookTemplateRequest{}
-	v19 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v21 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v21
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3284,8 +4447,80 @@ func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWe
 
 func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
 	this := &ListApplicationWebhookTemplatesRequest{}
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDelivery(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDelivery {
+	this := &ApplicationWebhookDelivery{}
+	v24 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v24
+	this.DeliveryID = randStringApplicationserverWeb(r)
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v25
+	this.URL = randStringApplicationserverWeb(r)
+	if r.Intn(5) != 0 {
+		v26 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v26; i++ {
+			this.Headers[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
+		}
+	}
+	v27 := r.Intn(100)
+	this.Body = make([]byte, v27)
+	for i := 0; i < v27; i++ {
+		this.Body[i] = byte(r.Intn(256))
+	}
+	this.Attempts = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LastAttemptAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.LastError = NewPopulatedErrorDetails(r, easy)
+	}
+	this.Method = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDeliveries(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeliveries {
+	this := &ApplicationWebhookDeliveries{}
+	if r.Intn(5) == 0 {
+		v28 := r.Intn(5)
+		this.Deliveries = make([]*ApplicationWebhookDelivery, v28)
+		for i := 0; i < v28; i++ {
+			this.Deliveries[i] = NewPopulatedApplicationWebhookDelivery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationWebhookFailedDeliveriesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookFailedDeliveriesRequest {
+	this := &ListApplicationWebhookFailedDeliveriesRequest{}
+	v29 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v29
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReplayApplicationWebhookFailedDeliveriesRequest(r randyApplicationserverWeb, easy bool) *ReplayApplicationWebhookFailedDeliveriesRequest {
+	this := &ReplayApplicationWebhookFailedDeliveriesRequest{}
+	v30 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v30
+	v31 := r.Intn(10)
+	this.DeliveryIDs = make([]string, v31)
+	for i := 0; i < v31; i++ {
+		this.DeliveryIDs[i] = randStringApplicationserverWeb(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3310,9 +4545,9 @@ func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v32 := r.Intn(100)
+	tmps := make([]rune, v32)
+	for i := 0; i < v32; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -3334,11 +4569,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v23 := r.Int63()
+		v33 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v33 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v33))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3539,24 +4774,48 @@ func (m *ApplicationWebhookTemplates) Size() (n int) {
 	return n
 }
 
-func (m *ApplicationWebhook) Size() (n int) {
+func (m *ApplicationWebhookHealth) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ApplicationWebhookIdentifiers.Size()
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovApplicationserverWeb(uint64(l))
-	l = len(m.BaseURL)
-	if l > 0 {
+	if m.FailedAttempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.FailedAttempts))
+	}
+	if m.LastFailedAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastFailedAttemptAt)
 		n += 1 + l + sovApplicationserverWeb(uint64(l))
 	}
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.SuspendedUntil != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SuspendedUntil)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.BaseURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
@@ -3619,6 +4878,22 @@ func (m *ApplicationWebhook) Size() (n int) {
 		l = m.ServiceData.Size()
 		n += 2 + l + sovApplicationserverWeb(uint64(l))
 	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.SigningSecret != nil {
+		l = m.SigningSecret.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ClientCertificate)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.ClientPrivateKey != nil {
+		l = m.ClientPrivateKey.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3632,6 +4907,16 @@ func (m *ApplicationWebhook_Message) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApplicationserverWeb(uint64(l))
 	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.BodyTemplate)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3730,6 +5015,103 @@ func (m *ListApplicationWebhookTemplatesRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.DeliveryID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverWeb(uint64(len(k))) + 1 + len(v) + sovApplicationserverWeb(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverWeb(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Attempts))
+	}
+	if m.LastAttemptAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastAttemptAt)
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationWebhookIdentifiers.Size()
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	if len(m.DeliveryIDs) > 0 {
+		for _, s := range m.DeliveryIDs {
+			l = len(s)
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApplicationserverWeb(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3840,6 +5222,19 @@ func (this *ApplicationWebhookTemplates) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookHealth{`,
+		`FailedAttempts:` + fmt.Sprintf("%v", this.FailedAttempts) + `,`,
+		`LastFailedAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastFailedAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastError:` + strings.Replace(fmt.Sprintf("%v", this.LastError), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`SuspendedUntil:` + strings.Replace(fmt.Sprintf("%v", this.SuspendedUntil), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationWebhook) String() string {
 	if this == nil {
 		return "nil"
@@ -3883,6 +5278,10 @@ func (this *ApplicationWebhook) String() string {
 		`TemplateFields:` + mapStringForTemplateFields + `,`,
 		`DownlinkAPIKey:` + fmt.Sprintf("%v", this.DownlinkAPIKey) + `,`,
 		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
+		`Health:` + strings.Replace(this.Health.String(), "ApplicationWebhookHealth", "ApplicationWebhookHealth", 1) + `,`,
+		`SigningSecret:` + strings.Replace(fmt.Sprintf("%v", this.SigningSecret), "Secret", "Secret", 1) + `,`,
+		`ClientCertificate:` + fmt.Sprintf("%v", this.ClientCertificate) + `,`,
+		`ClientPrivateKey:` + strings.Replace(fmt.Sprintf("%v", this.ClientPrivateKey), "Secret", "Secret", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3893,6 +5292,9 @@ func (this *ApplicationWebhook_Message) String() string {
 	}
 	s := strings.Join([]string{`&ApplicationWebhook_Message{`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`BodyTemplate:` + fmt.Sprintf("%v", this.BodyTemplate) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3986,6 +5388,73 @@ func (this *ListApplicationWebhookTemplatesRequest) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDelivery{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryID:` + fmt.Sprintf("%v", this.DeliveryID) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastAttemptAt:` + strings.Replace(fmt.Sprintf("%v", this.LastAttemptAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastError:` + strings.Replace(fmt.Sprintf("%v", this.LastError), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeliveries := "[]*ApplicationWebhookDelivery{"
+	for _, f := range this.Deliveries {
+		repeatedStringForDeliveries += strings.Replace(f.String(), "ApplicationWebhookDelivery", "ApplicationWebhookDelivery", 1) + ","
+	}
+	repeatedStringForDeliveries += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDeliveries{`,
+		`Deliveries:` + repeatedStringForDeliveries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationWebhookFailedDeliveriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationWebhookFailedDeliveriesRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayApplicationWebhookFailedDeliveriesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayApplicationWebhookFailedDeliveriesRequest{`,
+		`ApplicationWebhookIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationWebhookIdentifiers.String(), "ApplicationWebhookIdentifiers", "ApplicationWebhookIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeliveryIDs:` + fmt.Sprintf("%v", this.DeliveryIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplicationserverWeb(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -5385,7 +6854,7 @@ func (m *ApplicationWebhookTemplates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
+func (m *ApplicationWebhookHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5408,17 +6877,17 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationWebhookHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationWebhookHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
 			}
-			var msglen int
+			m.FailedAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -5428,28 +6897,14 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FailedAttempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverWeb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailedAttemptAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5476,13 +6931,16 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailedAttemptAt == nil {
+				m.LastFailedAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastFailedAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5509,15 +6967,18 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastError == nil {
+				m.LastError = &ErrorDetails{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedUntil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverWeb
@@ -5527,69 +6988,257 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			if m.SuspendedUntil == nil {
+				m.SuspendedUntil = new(time.Time)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserverWeb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SuspendedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if msglen < 0 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplicationserverWeb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
 					iNdEx++
 					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
@@ -6223,34 +7872,176 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverWeb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			if m.Health == nil {
+				m.Health = &ApplicationWebhookHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SigningSecret == nil {
+				m.SigningSecret = &Secret{}
+			}
+			if err := m.SigningSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCertificate = append(m.ClientCertificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientCertificate == nil {
+				m.ClientCertificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPrivateKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientPrivateKey == nil {
+				m.ClientPrivateKey = &Secret{}
+			}
+			if err := m.ClientPrivateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -6308,6 +8099,103 @@ func (m *ApplicationWebhook_Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -7161,6 +9049,802 @@ func (m *ListApplicationWebhookTemplatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationWebhookDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverWeb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverWeb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthApplicationserverWeb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAttemptAt == nil {
+				m.LastAttemptAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastAttemptAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &ErrorDetails{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookDeliveries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deliveries = append(m.Deliveries, &ApplicationWebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationWebhookFailedDeliveriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationWebhookFailedDeliveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationWebhookFailedDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayApplicationWebhookFailedDeliveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayApplicationWebhookFailedDeliveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationWebhookIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationWebhookIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryIDs = append(m.DeliveryIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationserverWeb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
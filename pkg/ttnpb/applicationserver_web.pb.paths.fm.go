@@ -96,42 +96,122 @@ var ApplicationWebhookTemplatesFieldPathsNested = []string{
 var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
 	"templates",
 }
+var ApplicationWebhookHealthFieldPathsNested = []string{
+	"failed_attempts",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"last_failed_attempt_at",
+	"suspended_until",
+}
+
+var ApplicationWebhookHealthFieldPathsTopLevel = []string{
+	"failed_attempts",
+	"last_error",
+	"last_failed_attempt_at",
+	"suspended_until",
+}
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"client_certificate",
+	"client_private_key",
+	"client_private_key.key_id",
+	"client_private_key.value",
 	"created_at",
 	"downlink_ack",
+	"downlink_ack.body_template",
+	"downlink_ack.field_mask",
+	"downlink_ack.method",
 	"downlink_ack.path",
 	"downlink_api_key",
 	"downlink_failed",
+	"downlink_failed.body_template",
+	"downlink_failed.field_mask",
+	"downlink_failed.method",
 	"downlink_failed.path",
 	"downlink_nack",
+	"downlink_nack.body_template",
+	"downlink_nack.field_mask",
+	"downlink_nack.method",
 	"downlink_nack.path",
 	"downlink_queued",
+	"downlink_queued.body_template",
+	"downlink_queued.field_mask",
+	"downlink_queued.method",
 	"downlink_queued.path",
 	"downlink_sent",
+	"downlink_sent.body_template",
+	"downlink_sent.field_mask",
+	"downlink_sent.method",
 	"downlink_sent.path",
 	"format",
 	"headers",
+	"health",
+	"health.failed_attempts",
+	"health.last_error",
+	"health.last_error.attributes",
+	"health.last_error.cause",
+	"health.last_error.cause.attributes",
+	"health.last_error.cause.correlation_id",
+	"health.last_error.cause.message_format",
+	"health.last_error.cause.name",
+	"health.last_error.cause.namespace",
+	"health.last_error.code",
+	"health.last_error.correlation_id",
+	"health.last_error.details",
+	"health.last_error.message_format",
+	"health.last_error.name",
+	"health.last_error.namespace",
+	"health.last_failed_attempt_at",
+	"health.suspended_until",
 	"ids",
 	"ids.application_ids",
 	"ids.application_ids.application_id",
 	"ids.webhook_id",
 	"join_accept",
+	"join_accept.body_template",
+	"join_accept.field_mask",
+	"join_accept.method",
 	"join_accept.path",
 	"location_solved",
+	"location_solved.body_template",
+	"location_solved.field_mask",
+	"location_solved.method",
 	"location_solved.path",
 	"service_data",
+	"service_data.body_template",
+	"service_data.field_mask",
+	"service_data.method",
 	"service_data.path",
+	"signing_secret",
+	"signing_secret.key_id",
+	"signing_secret.value",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
 	"updated_at",
 	"uplink_message",
+	"uplink_message.body_template",
+	"uplink_message.field_mask",
+	"uplink_message.method",
 	"uplink_message.path",
 }
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"client_certificate",
+	"client_private_key",
 	"created_at",
 	"downlink_ack",
 	"downlink_api_key",
@@ -141,10 +221,12 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"downlink_sent",
 	"format",
 	"headers",
+	"health",
 	"ids",
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"signing_secret",
 	"template_fields",
 	"template_ids",
 	"updated_at",
@@ -190,35 +272,87 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.client_certificate",
+	"webhook.client_private_key",
+	"webhook.client_private_key.key_id",
+	"webhook.client_private_key.value",
 	"webhook.created_at",
 	"webhook.downlink_ack",
+	"webhook.downlink_ack.body_template",
+	"webhook.downlink_ack.field_mask",
+	"webhook.downlink_ack.method",
 	"webhook.downlink_ack.path",
 	"webhook.downlink_api_key",
 	"webhook.downlink_failed",
+	"webhook.downlink_failed.body_template",
+	"webhook.downlink_failed.field_mask",
+	"webhook.downlink_failed.method",
 	"webhook.downlink_failed.path",
 	"webhook.downlink_nack",
+	"webhook.downlink_nack.body_template",
+	"webhook.downlink_nack.field_mask",
+	"webhook.downlink_nack.method",
 	"webhook.downlink_nack.path",
 	"webhook.downlink_queued",
+	"webhook.downlink_queued.body_template",
+	"webhook.downlink_queued.field_mask",
+	"webhook.downlink_queued.method",
 	"webhook.downlink_queued.path",
 	"webhook.downlink_sent",
+	"webhook.downlink_sent.body_template",
+	"webhook.downlink_sent.field_mask",
+	"webhook.downlink_sent.method",
 	"webhook.downlink_sent.path",
 	"webhook.format",
 	"webhook.headers",
+	"webhook.health",
+	"webhook.health.failed_attempts",
+	"webhook.health.last_error",
+	"webhook.health.last_error.attributes",
+	"webhook.health.last_error.cause",
+	"webhook.health.last_error.cause.attributes",
+	"webhook.health.last_error.cause.correlation_id",
+	"webhook.health.last_error.cause.message_format",
+	"webhook.health.last_error.cause.name",
+	"webhook.health.last_error.cause.namespace",
+	"webhook.health.last_error.code",
+	"webhook.health.last_error.correlation_id",
+	"webhook.health.last_error.details",
+	"webhook.health.last_error.message_format",
+	"webhook.health.last_error.name",
+	"webhook.health.last_error.namespace",
+	"webhook.health.last_failed_attempt_at",
+	"webhook.health.suspended_until",
 	"webhook.ids",
 	"webhook.ids.application_ids",
 	"webhook.ids.application_ids.application_id",
 	"webhook.ids.webhook_id",
 	"webhook.join_accept",
+	"webhook.join_accept.body_template",
+	"webhook.join_accept.field_mask",
+	"webhook.join_accept.method",
 	"webhook.join_accept.path",
 	"webhook.location_solved",
+	"webhook.location_solved.body_template",
+	"webhook.location_solved.field_mask",
+	"webhook.location_solved.method",
 	"webhook.location_solved.path",
 	"webhook.service_data",
+	"webhook.service_data.body_template",
+	"webhook.service_data.field_mask",
+	"webhook.service_data.method",
 	"webhook.service_data.path",
+	"webhook.signing_secret",
+	"webhook.signing_secret.key_id",
+	"webhook.signing_secret.value",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
 	"webhook.updated_at",
 	"webhook.uplink_message",
+	"webhook.uplink_message.body_template",
+	"webhook.uplink_message.field_mask",
+	"webhook.uplink_message.method",
 	"webhook.uplink_message.path",
 }
 
@@ -243,6 +377,80 @@ var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
 }
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"delivery_id",
+	"headers",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"last_attempt_at",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"method",
+	"url",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"delivery_id",
+	"headers",
+	"ids",
+	"last_attempt_at",
+	"last_error",
+	"method",
+	"url",
+}
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+var ListApplicationWebhookFailedDeliveriesRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+	"page",
+}
+
+var ListApplicationWebhookFailedDeliveriesRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+	"page",
+}
+var ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsNested = []string{
+	"delivery_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+}
+
+var ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsTopLevel = []string{
+	"delivery_ids",
+	"ids",
+}
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
 }
@@ -251,9 +459,15 @@ var ApplicationWebhookTemplate_MessageFieldPathsTopLevel = []string{
 	"path",
 }
 var ApplicationWebhook_MessageFieldPathsNested = []string{
+	"body_template",
+	"field_mask",
+	"method",
 	"path",
 }
 
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
+	"body_template",
+	"field_mask",
+	"method",
 	"path",
 }
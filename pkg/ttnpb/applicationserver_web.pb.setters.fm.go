@@ -502,6 +502,70 @@ func (dst *ApplicationWebhookTemplates) SetFields(src *ApplicationWebhookTemplat
 	return nil
 }
 
+func (dst *ApplicationWebhookHealth) SetFields(src *ApplicationWebhookHealth, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "failed_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'failed_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FailedAttempts = src.FailedAttempts
+			} else {
+				var zero uint32
+				dst.FailedAttempts = zero
+			}
+		case "last_failed_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_failed_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFailedAttemptAt = src.LastFailedAttemptAt
+			} else {
+				dst.LastFailedAttemptAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+		case "suspended_until":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended_until' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SuspendedUntil = src.SuspendedUntil
+			} else {
+				dst.SuspendedUntil = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -616,6 +680,65 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 				var zero string
 				dst.DownlinkAPIKey = zero
 			}
+		case "signing_secret":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.SigningSecret == nil) && dst.SigningSecret == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SigningSecret
+				}
+				if dst.SigningSecret != nil {
+					newDst = dst.SigningSecret
+				} else {
+					newDst = &Secret{}
+					dst.SigningSecret = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SigningSecret = src.SigningSecret
+				} else {
+					dst.SigningSecret = nil
+				}
+			}
+		case "client_certificate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_certificate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientCertificate = src.ClientCertificate
+			} else {
+				dst.ClientCertificate = nil
+			}
+		case "client_private_key":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.ClientPrivateKey == nil) && dst.ClientPrivateKey == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ClientPrivateKey
+				}
+				if dst.ClientPrivateKey != nil {
+					newDst = dst.ClientPrivateKey
+				} else {
+					newDst = &Secret{}
+					dst.ClientPrivateKey = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ClientPrivateKey = src.ClientPrivateKey
+				} else {
+					dst.ClientPrivateKey = nil
+				}
+			}
 		case "uplink_message":
 			if len(subs) > 0 {
 				var newDst, newSrc *ApplicationWebhook_Message
@@ -841,6 +964,31 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.ServiceData = nil
 				}
 			}
+		case "health":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookHealth
+				if (src == nil || src.Health == nil) && dst.Health == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Health
+				}
+				if dst.Health != nil {
+					newDst = dst.Health
+				} else {
+					newDst = &ApplicationWebhookHealth{}
+					dst.Health = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Health = src.Health
+				} else {
+					dst.Health = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1066,6 +1214,244 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 	return nil
 }
 
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryID = src.DeliveryID
+			} else {
+				var zero string
+				dst.DeliveryID = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Method = src.Method
+			} else {
+				var zero string
+				dst.Method = zero
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URL = src.URL
+			} else {
+				var zero string
+				dst.URL = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				dst.Body = nil
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "last_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAttemptAt = src.LastAttemptAt
+			} else {
+				dst.LastAttemptAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationWebhookFailedDeliveriesRequest) SetFields(src *ListApplicationWebhookFailedDeliveriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationWebhookFailedDeliveriesRequest) SetFields(src *ReplayApplicationWebhookFailedDeliveriesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationWebhookIdentifiers
+				}
+				newDst = &dst.ApplicationWebhookIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationWebhookIdentifiers = src.ApplicationWebhookIdentifiers
+				} else {
+					var zero ApplicationWebhookIdentifiers
+					dst.ApplicationWebhookIdentifiers = zero
+				}
+			}
+		case "delivery_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delivery_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeliveryIDs = src.DeliveryIDs
+			} else {
+				dst.DeliveryIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhookTemplate_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1100,6 +1486,36 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 				var zero string
 				dst.Path = zero
 			}
+		case "method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Method = src.Method
+			} else {
+				var zero string
+				dst.Method = zero
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "body_template":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body_template' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BodyTemplate = src.BodyTemplate
+			} else {
+				var zero string
+				dst.BodyTemplate = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
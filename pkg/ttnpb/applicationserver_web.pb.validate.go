@@ -794,6 +794,126 @@ var _ interface {
 	ErrorName() string
 } = ApplicationWebhookTemplatesValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookHealth with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookHealth) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookHealthFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "failed_attempts":
+			// no validation rules for FailedAttempts
+		case "last_failed_attempt_at":
+
+			if v, ok := interface{}(m.GetLastFailedAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "last_failed_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "suspended_until":
+
+			if v, ok := interface{}(m.GetSuspendedUntil()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookHealthValidationError{
+						field:  "suspended_until",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookHealthValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookHealthValidationError is the validation error returned by
+// ApplicationWebhookHealth.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookHealthValidationError) ErrorName() string {
+	return "ApplicationWebhookHealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookHealthValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhook with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -894,6 +1014,39 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 			// no validation rules for TemplateFields
 		case "downlink_api_key":
 			// no validation rules for DownlinkAPIKey
+		case "signing_secret":
+
+			if v, ok := interface{}(m.GetSigningSecret()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "signing_secret",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "client_certificate":
+
+			if len(m.GetClientCertificate()) > 8192 {
+				return ApplicationWebhookValidationError{
+					field:  "client_certificate",
+					reason: "value length must be at most 8192 bytes",
+				}
+			}
+
+		case "client_private_key":
+
+			if v, ok := interface{}(m.GetClientPrivateKey()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "client_private_key",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "uplink_message":
 
 			if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
@@ -1002,6 +1155,18 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "health":
+
+			if v, ok := interface{}(m.GetHealth()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "health",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -1770,6 +1935,471 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookDelivery with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDelivery) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "delivery_id":
+			// no validation rules for DeliveryID
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "method":
+			// no validation rules for Method
+		case "url":
+
+			if uri, err := url.Parse(m.GetURL()); err != nil {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "headers":
+			// no validation rules for Headers
+		case "body":
+			// no validation rules for Body
+		case "attempts":
+			// no validation rules for Attempts
+		case "last_attempt_at":
+
+			if v, ok := interface{}(m.GetLastAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookDeliveryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveryValidationError is the validation error returned by
+// ApplicationWebhookDelivery.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveryValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveryValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeliveries with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDeliveries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deliveries":
+
+			for idx, item := range m.GetDeliveries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeliveriesValidationError{
+							field:  fmt.Sprintf("deliveries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeliveriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveriesValidationError is the validation error returned
+// by ApplicationWebhookDeliveries.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveriesValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveriesValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationWebhookFailedDeliveriesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ListApplicationWebhookFailedDeliveriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationWebhookFailedDeliveriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationWebhookFailedDeliveriesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationWebhookFailedDeliveriesRequestValidationError is the
+// validation error returned by
+// ListApplicationWebhookFailedDeliveriesRequest.ValidateFields if the
+// designated constraints aren't met.
+type ListApplicationWebhookFailedDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) ErrorName() string {
+	return "ListApplicationWebhookFailedDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationWebhookFailedDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationWebhookFailedDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ReplayApplicationWebhookFailedDeliveriesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ReplayApplicationWebhookFailedDeliveriesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationWebhookFailedDeliveriesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationWebhookIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ReplayApplicationWebhookFailedDeliveriesRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "delivery_ids":
+			// no validation rules for DeliveryIDs
+		default:
+			return ReplayApplicationWebhookFailedDeliveriesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationWebhookFailedDeliveriesRequestValidationError is the
+// validation error returned by
+// ReplayApplicationWebhookFailedDeliveriesRequest.ValidateFields if the
+// designated constraints aren't met.
+type ReplayApplicationWebhookFailedDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) ErrorName() string {
+	return "ReplayApplicationWebhookFailedDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationWebhookFailedDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationWebhookFailedDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationWebhookFailedDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationWebhookFailedDeliveriesRequestValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhookTemplate_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -1871,6 +2501,36 @@ func (m *ApplicationWebhook_Message) ValidateFields(paths ...string) error {
 		switch name {
 		case "path":
 			// no validation rules for Path
+		case "method":
+
+			if _, ok := _ApplicationWebhook_Message_Method_InLookup[m.GetMethod()]; !ok {
+				return ApplicationWebhook_MessageValidationError{
+					field:  "method",
+					reason: "value must be in list [ POST PUT PATCH]",
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhook_MessageValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "body_template":
+
+			if utf8.RuneCountInString(m.GetBodyTemplate()) > 4096 {
+				return ApplicationWebhook_MessageValidationError{
+					field:  "body_template",
+					reason: "value length must be at most 4096 runes",
+				}
+			}
+
 		default:
 			return ApplicationWebhook_MessageValidationError{
 				field:  name,
@@ -1937,3 +2597,10 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationWebhook_MessageValidationError{}
+
+var _ApplicationWebhook_Message_Method_InLookup = map[string]struct{}{
+	"":      {},
+	"POST":  {},
+	"PUT":   {},
+	"PATCH": {},
+}
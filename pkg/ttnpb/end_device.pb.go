@@ -919,8 +919,11 @@ type MACSettings struct {
 	// The frequency of the class B beacon (Hz) Network Server should configure device to use via MAC commands.
 	// If unset, the default value from Network Server configuration will be used.
 	DesiredBeaconFrequency *types.UInt64Value `protobuf:"bytes,29,opt,name=desired_beacon_frequency,json=desiredBeaconFrequency,proto3" json:"desired_beacon_frequency,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+	// The ADR algorithm Network Server should use for the device.
+	// If unset, the default value from Network Server configuration will be used.
+	ADRAlgorithm         string   `protobuf:"bytes,30,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1151,6 +1154,13 @@ func (m *MACSettings) GetDesiredBeaconFrequency() *types.UInt64Value {
 	return nil
 }
 
+func (m *MACSettings) GetADRAlgorithm() string {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return ""
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server.
@@ -2809,330 +2819,330 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 5155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0x4b, 0x6c, 0x1c, 0xd9,
-	0x75, 0x76, 0x57, 0xb3, 0xc9, 0xee, 0x3e, 0x24, 0xfb, 0x71, 0x49, 0x8a, 0x25, 0x4a, 0xea, 0xe6,
-	0xb4, 0x28, 0x0d, 0xa5, 0x11, 0x29, 0x91, 0x1a, 0x8d, 0x6d, 0xd9, 0xf3, 0xcb, 0x5d, 0x6c, 0x72,
-	0xd4, 0x92, 0x28, 0xd1, 0x57, 0xaf, 0x7f, 0xf4, 0x2a, 0x17, 0xbb, 0x2e, 0xa9, 0x1a, 0x76, 0x57,
-	0xb5, 0xab, 0xaa, 0x29, 0xd2, 0x33, 0x02, 0x26, 0x46, 0x02, 0x3b, 0x46, 0x12, 0x38, 0x5a, 0x19,
-	0x59, 0x04, 0xb3, 0x31, 0xe0, 0x55, 0x60, 0x04, 0x59, 0x0c, 0xb2, 0xb1, 0xb3, 0x48, 0x30, 0x9b,
-	0x00, 0xb3, 0xf0, 0xc2, 0xf0, 0x82, 0xb1, 0x5a, 0x1b, 0xaf, 0x02, 0x2f, 0x0d, 0x2e, 0x8c, 0xe0,
-	0x3e, 0xea, 0xd1, 0x2f, 0x3e, 0x46, 0x63, 0x63, 0x36, 0x64, 0xf5, 0xbd, 0xe7, 0x7c, 0xe7, 0xdc,
-	0x73, 0x5f, 0xe7, 0x51, 0x05, 0x85, 0xaa, 0x65, 0x6b, 0xcf, 0x34, 0x73, 0xc6, 0x71, 0xb5, 0xca,
-	0xc6, 0x79, 0xad, 0x6e, 0x9c, 0x27, 0xa6, 0xae, 0xea, 0x64, 0xd3, 0xa8, 0x90, 0xd9, 0xba, 0x6d,
-	0xb9, 0x16, 0x4a, 0xb9, 0xae, 0x39, 0x2b, 0xe8, 0x66, 0x37, 0x2f, 0x4e, 0x14, 0xd7, 0x0d, 0xf7,
-	0x69, 0x63, 0x75, 0xb6, 0x62, 0xd5, 0xce, 0x13, 0x73, 0xd3, 0xda, 0xae, 0xdb, 0xd6, 0xd6, 0xf6,
-	0x79, 0x46, 0x5c, 0x99, 0x59, 0x27, 0xe6, 0xcc, 0xa6, 0x56, 0x35, 0x74, 0xcd, 0x25, 0xe7, 0x3b,
-	0x1e, 0x38, 0xe4, 0xc4, 0x4c, 0x08, 0x62, 0xdd, 0x5a, 0xb7, 0x38, 0xf3, 0x6a, 0x63, 0x8d, 0xfd,
-	0x62, 0x3f, 0xd8, 0x93, 0x20, 0xcf, 0xad, 0x5b, 0xd6, 0x7a, 0x95, 0x04, 0x54, 0x7a, 0xc3, 0xd6,
-	0x5c, 0xc3, 0x32, 0x45, 0xff, 0x64, 0x7b, 0xff, 0x9a, 0x41, 0xaa, 0xba, 0x5a, 0xd3, 0x9c, 0x0d,
-	0x41, 0x71, 0xbc, 0x9d, 0xc2, 0x71, 0xed, 0x46, 0xc5, 0x15, 0xbd, 0xf9, 0xf6, 0x5e, 0xd7, 0xa8,
-	0x11, 0xc7, 0xd5, 0x6a, 0xf5, 0x5e, 0x0a, 0x3c, 0xb3, 0xb5, 0x7a, 0x9d, 0xd8, 0x8e, 0xe8, 0x3f,
-	0xd9, 0x69, 0x46, 0x43, 0x27, 0xa6, 0x6b, 0xac, 0x19, 0x01, 0xd1, 0xf1, 0x4e, 0xa2, 0x0f, 0x2c,
-	0xc3, 0xec, 0xdd, 0xbb, 0x41, 0xb6, 0x3d, 0xde, 0x7c, 0x67, 0xaf, 0x37, 0x23, 0xc2, 0x04, 0x9d,
-	0x04, 0x35, 0xe2, 0x38, 0xda, 0x3a, 0xd9, 0x03, 0xa2, 0x6e, 0x54, 0xdc, 0x86, 0x4d, 0xf6, 0x82,
-	0x70, 0x35, 0x5d, 0x73, 0x35, 0x4e, 0x51, 0xf8, 0xab, 0x18, 0xc4, 0x6f, 0x13, 0xc7, 0x31, 0x2c,
-	0x13, 0x3d, 0x80, 0x84, 0x4e, 0x36, 0x55, 0x4d, 0xd7, 0x6d, 0x39, 0x3a, 0x29, 0x4d, 0x0f, 0x29,
-	0x57, 0x3e, 0xdb, 0xc9, 0x47, 0x7e, 0xbb, 0x93, 0xff, 0xda, 0xba, 0x35, 0xeb, 0x3e, 0x25, 0xee,
-	0x53, 0xc3, 0x5c, 0x77, 0x66, 0x4d, 0xe2, 0x3e, 0xb3, 0xec, 0x8d, 0xf3, 0xad, 0xe0, 0x9b, 0x17,
-	0xcf, 0xd7, 0x37, 0xd6, 0xcf, 0xbb, 0xdb, 0x75, 0xe2, 0xcc, 0x96, 0xc8, 0x66, 0x51, 0xd7, 0x6d,
-	0x1c, 0xd7, 0xf9, 0x03, 0x2a, 0x42, 0x8c, 0x8e, 0x5d, 0xee, 0x9b, 0x94, 0xa6, 0x07, 0xe7, 0x8f,
-	0xcd, 0xb6, 0x2e, 0xc0, 0x59, 0xa1, 0xc2, 0x75, 0xb2, 0xed, 0x28, 0x99, 0x5d, 0xa5, 0xff, 0xc7,
-	0x52, 0x34, 0x23, 0x51, 0xe1, 0x9f, 0xef, 0xe4, 0x25, 0xcc, 0x58, 0xd1, 0x1b, 0x30, 0x5c, 0xd5,
-	0x1c, 0x57, 0x5d, 0x53, 0x2b, 0xa6, 0xab, 0x36, 0xea, 0x72, 0x6c, 0x52, 0x9a, 0x1e, 0xc6, 0x40,
-	0x1b, 0x97, 0x16, 0x4c, 0xf7, 0x6e, 0x1d, 0x4d, 0x43, 0x96, 0x91, 0x98, 0x82, 0x48, 0xb7, 0x9e,
-	0x99, 0x72, 0x3f, 0x23, 0x63, 0xbc, 0x37, 0x29, 0x5d, 0xc9, 0x7a, 0x66, 0xfa, 0x94, 0x5a, 0x98,
-	0x72, 0x20, 0xa0, 0x2c, 0xfa, 0x94, 0xb3, 0x30, 0xca, 0x28, 0x2b, 0x96, 0xb9, 0x16, 0x26, 0x8e,
-	0x33, 0xe2, 0x0c, 0xed, 0x5b, 0xb0, 0xcc, 0x35, 0x9f, 0x7e, 0x01, 0xc0, 0x71, 0x35, 0xdb, 0x25,
-	0xba, 0xaa, 0xb9, 0x72, 0x82, 0x8d, 0x77, 0x62, 0x96, 0xaf, 0xb6, 0x59, 0x6f, 0xb5, 0xcd, 0xde,
-	0xf1, 0x96, 0xa3, 0x92, 0xa0, 0xc3, 0xfc, 0xc9, 0xff, 0xe4, 0x25, 0x9c, 0x14, 0x7c, 0x45, 0x17,
-	0x11, 0x38, 0xfe, 0xbd, 0x06, 0x69, 0x50, 0x8c, 0x7a, 0xbd, 0x6a, 0x54, 0xd8, 0xd6, 0x60, 0x72,
-	0xab, 0x86, 0xb9, 0xe1, 0xc8, 0xc9, 0xc9, 0xbe, 0xe9, 0xc1, 0xf9, 0x93, 0xed, 0x66, 0x2c, 0x06,
-	0xc4, 0x25, 0x41, 0x8b, 0x27, 0x38, 0x50, 0x97, 0x2e, 0xe7, 0x5a, 0x2c, 0x21, 0x65, 0xa2, 0x85,
-	0x7f, 0xc9, 0xc0, 0xf0, 0x72, 0x71, 0x61, 0x45, 0xb3, 0xb5, 0x1a, 0x71, 0x89, 0xed, 0xa0, 0xd3,
-	0x90, 0xa8, 0x69, 0x5b, 0x2a, 0x31, 0xec, 0xba, 0x2c, 0x4d, 0x4a, 0xd3, 0x51, 0x65, 0xb0, 0xb9,
-	0x93, 0x8f, 0x2f, 0x6b, 0x5b, 0x8b, 0x65, 0xbc, 0x82, 0xe3, 0x35, 0x6d, 0x6b, 0xd1, 0xb0, 0xeb,
-	0xe8, 0x03, 0x18, 0xd1, 0x74, 0x5b, 0xa5, 0xeb, 0x49, 0xb5, 0x35, 0x97, 0xa8, 0x86, 0xa9, 0x93,
-	0x2d, 0x36, 0x31, 0xa9, 0xf9, 0x13, 0xed, 0xda, 0x95, 0x34, 0x57, 0xc3, 0x9a, 0x4b, 0xca, 0x94,
-	0x48, 0x39, 0xbe, 0xab, 0xf4, 0xff, 0x80, 0x4e, 0x73, 0x73, 0x27, 0x9f, 0x29, 0x96, 0x70, 0x4b,
-	0x2f, 0xce, 0x68, 0xba, 0xdd, 0xd2, 0x82, 0xde, 0x03, 0x44, 0x65, 0xb9, 0x5b, 0x6a, 0xdd, 0x7a,
-	0x46, 0x6c, 0x21, 0x8a, 0x4d, 0xae, 0x32, 0xb1, 0xab, 0xc4, 0xce, 0x46, 0xe5, 0x74, 0x73, 0x27,
-	0x9f, 0x2e, 0x96, 0xf0, 0x9d, 0xad, 0x15, 0x4a, 0xc2, 0x91, 0xd2, 0x9a, 0x6e, 0x87, 0x1b, 0xd0,
-	0xd7, 0x60, 0x88, 0x02, 0x99, 0xab, 0xaa, 0x6b, 0x6b, 0xa6, 0xc3, 0x67, 0x5d, 0x19, 0x0b, 0x20,
-	0xa0, 0x58, 0xc2, 0x37, 0x57, 0xef, 0xd0, 0x4e, 0x0c, 0x9a, 0x6e, 0x8b, 0x67, 0x74, 0x09, 0x86,
-	0x29, 0xa3, 0x56, 0xd9, 0x50, 0xab, 0x46, 0xcd, 0x70, 0xf9, 0x12, 0x50, 0xb2, 0xcd, 0x9d, 0xfc,
-	0x60, 0xb1, 0x84, 0x8b, 0x95, 0x8d, 0x1b, 0xac, 0x59, 0xc2, 0x83, 0x9a, 0x6e, 0x7b, 0x3f, 0xc3,
-	0x6c, 0x3a, 0xa9, 0x6a, 0xdb, 0x6c, 0x4d, 0xb4, 0xb0, 0x95, 0x58, 0xb3, 0xcf, 0xc6, 0x7e, 0xa2,
-	0xff, 0x07, 0x49, 0x7b, 0x6b, 0x4e, 0xb0, 0x24, 0x99, 0x45, 0xc7, 0xdb, 0x2d, 0x8a, 0xb7, 0x18,
-	0xad, 0x92, 0xf0, 0x6c, 0x89, 0x13, 0xf6, 0xd6, 0x1c, 0xe7, 0xff, 0x3a, 0x8c, 0x32, 0x7e, 0x7f,
-	0x6e, 0xac, 0xb5, 0x35, 0x87, 0xb8, 0x32, 0x30, 0xe9, 0x71, 0x3e, 0xdc, 0x38, 0xce, 0x52, 0x06,
-	0x61, 0xe8, 0x5b, 0x8c, 0x02, 0xdd, 0x83, 0x11, 0x7b, 0x6b, 0xbe, 0x63, 0x56, 0x07, 0x0f, 0x32,
-	0xab, 0x81, 0x26, 0x19, 0x7b, 0x6b, 0xbe, 0x75, 0x06, 0x67, 0x61, 0x98, 0xe2, 0xae, 0xd9, 0xe4,
-	0x7b, 0x0d, 0x62, 0x56, 0xb6, 0xe5, 0xa1, 0x49, 0x69, 0x3a, 0xa6, 0x24, 0x77, 0x95, 0x81, 0xf9,
-	0xd8, 0xf4, 0x27, 0x7f, 0x3f, 0x80, 0x87, 0xec, 0xad, 0xf9, 0x25, 0xaf, 0x1b, 0xdd, 0x86, 0x14,
-	0x5d, 0x85, 0x7a, 0xc3, 0xdd, 0x56, 0x2b, 0xdb, 0x95, 0x2a, 0x91, 0x87, 0x99, 0x0a, 0x9d, 0xcb,
-	0x7e, 0x7d, 0xdd, 0x26, 0xeb, 0x9a, 0x4b, 0xf4, 0x52, 0xc3, 0xdd, 0x5e, 0xa0, 0xa4, 0x21, 0x45,
-	0x86, 0x6a, 0xda, 0x96, 0xdf, 0x8e, 0x74, 0x18, 0xb7, 0x09, 0x3d, 0xa4, 0x55, 0x7a, 0x23, 0xa8,
-	0x75, 0x62, 0x1b, 0x96, 0x6e, 0x54, 0x0c, 0x77, 0x5b, 0x4e, 0x31, 0xf4, 0x42, 0x87, 0x91, 0x19,
-	0x39, 0xdd, 0xb0, 0x8b, 0x5b, 0x75, 0xcb, 0x24, 0xa6, 0x1b, 0x02, 0x1f, 0xb3, 0xfd, 0xde, 0x95,
-	0x00, 0x0a, 0xad, 0x83, 0x2c, 0xa4, 0x54, 0xac, 0x86, 0xe9, 0xb6, 0x88, 0x49, 0x77, 0x1f, 0x04,
-	0x17, 0xb3, 0x40, 0xc9, 0xbb, 0xc8, 0x39, 0x62, 0x07, 0xdd, 0x61, 0x41, 0xdf, 0x84, 0x91, 0xba,
-	0x61, 0xae, 0xab, 0x4e, 0xd5, 0x72, 0x43, 0x96, 0xcd, 0x30, 0xcb, 0x0e, 0xee, 0x2a, 0x89, 0xf9,
-	0x01, 0x39, 0xc2, 0x6c, 0x9b, 0xa5, 0x74, 0xb7, 0xab, 0x96, 0x1b, 0x18, 0xf8, 0x21, 0x1c, 0x0d,
-	0x98, 0xdb, 0xa7, 0x3b, 0x7b, 0x90, 0xe9, 0x8e, 0xca, 0x12, 0x1e, 0xf3, 0x80, 0x5b, 0x67, 0xfb,
-	0x1d, 0xc8, 0xac, 0x12, 0xad, 0x62, 0x99, 0x21, 0xb5, 0x50, 0xa7, 0x5a, 0x69, 0x4e, 0x14, 0x28,
-	0x75, 0x1d, 0x12, 0x95, 0xa7, 0x9a, 0x69, 0x92, 0xaa, 0x23, 0x8f, 0xb0, 0x63, 0xee, 0x54, 0xbb,
-	0x0e, 0x2d, 0x87, 0xd5, 0xec, 0x02, 0xa7, 0x66, 0xc6, 0x7a, 0x21, 0x45, 0x13, 0x12, 0xf6, 0x01,
-	0xd0, 0x12, 0x64, 0x1b, 0x75, 0x7a, 0xd6, 0xa9, 0xfa, 0x33, 0x52, 0xad, 0xb2, 0x39, 0x97, 0x47,
-	0x7b, 0x9c, 0xc9, 0x8a, 0x65, 0x55, 0xef, 0x69, 0xd5, 0x06, 0xc1, 0x69, 0xce, 0x54, 0xa2, 0x3c,
-	0x74, 0x6a, 0xd1, 0x35, 0x18, 0xf1, 0x0e, 0xdf, 0x30, 0xd2, 0xd8, 0xbe, 0x48, 0x59, 0x8f, 0x2d,
-	0xc0, 0xda, 0x84, 0x23, 0x2d, 0xc7, 0x88, 0x4a, 0xc4, 0x74, 0xcb, 0x47, 0x18, 0xdc, 0x74, 0xc7,
-	0xf2, 0x0e, 0xce, 0x16, 0x6f, 0x65, 0x30, 0x70, 0x65, 0xbc, 0xb9, 0x93, 0x1f, 0xe9, 0xd2, 0x8b,
-	0x47, 0x42, 0xe7, 0x8f, 0xd7, 0x18, 0x96, 0xcb, 0x0e, 0x95, 0x40, 0xee, 0xf8, 0x5e, 0x72, 0xd9,
-	0x69, 0xd2, 0x53, 0x6e, 0x4b, 0xaf, 0x27, 0xb7, 0xa5, 0x11, 0xad, 0x43, 0xbe, 0xe7, 0x2a, 0x53,
-	0x37, 0x29, 0xa0, 0x2c, 0x33, 0x05, 0x0a, 0x7b, 0xae, 0x35, 0x6e, 0xcf, 0x89, 0xae, 0x8b, 0x8d,
-	0xf5, 0x4d, 0xfc, 0x3a, 0x0a, 0x71, 0xb1, 0x18, 0xd0, 0xdb, 0x90, 0x11, 0x13, 0x1f, 0xac, 0x3e,
-	0xa9, 0xfd, 0xb8, 0x11, 0xd3, 0x1c, 0xac, 0xbd, 0xaf, 0x03, 0xf2, 0xa7, 0x39, 0xe0, 0x8b, 0xb6,
-	0xf3, 0xf9, 0x93, 0x1a, 0x70, 0xde, 0x83, 0x91, 0x9a, 0x61, 0x76, 0x6c, 0xa2, 0xbe, 0x43, 0x9e,
-	0x99, 0x35, 0xc3, 0x6c, 0xdd, 0x45, 0x14, 0x97, 0x9e, 0x81, 0x5f, 0xe4, 0x86, 0x0d, 0xe3, 0x6a,
-	0x5b, 0xad, 0xb8, 0x27, 0x61, 0x98, 0x98, 0xda, 0x6a, 0x95, 0xa8, 0xdc, 0x06, 0xec, 0x22, 0x4d,
-	0xe0, 0x21, 0xde, 0x78, 0x97, 0xb5, 0x5d, 0x8e, 0x7d, 0xfa, 0x49, 0x3e, 0xc2, 0xff, 0x5e, 0x8b,
-	0x25, 0xa2, 0x99, 0xbe, 0x6b, 0xb1, 0x44, 0x5f, 0x26, 0x56, 0xa8, 0x41, 0x6a, 0xd1, 0xd4, 0x4b,
-	0x2c, 0xa2, 0x50, 0x6c, 0xcd, 0xd4, 0xd1, 0x11, 0x88, 0x1a, 0x3a, 0x33, 0x70, 0x52, 0x19, 0x68,
-	0xee, 0xe4, 0xa3, 0xe5, 0x12, 0x8e, 0x1a, 0x3a, 0x42, 0x10, 0x33, 0xb5, 0x1a, 0x61, 0x26, 0x4c,
-	0x62, 0xf6, 0x8c, 0x8e, 0x42, 0x5f, 0xc3, 0xae, 0x32, 0xd3, 0x24, 0x95, 0x78, 0x73, 0x27, 0xdf,
-	0x77, 0x17, 0xdf, 0xc0, 0xb4, 0x0d, 0x8d, 0x42, 0x7f, 0xd5, 0x5a, 0xb7, 0x1c, 0x39, 0x36, 0xd9,
-	0x37, 0x9d, 0xc4, 0xfc, 0x47, 0xe1, 0x5f, 0xa5, 0x90, 0xbc, 0x65, 0x4b, 0x27, 0x55, 0xb4, 0x0c,
-	0x89, 0x55, 0x2a, 0x58, 0xf5, 0xa5, 0xce, 0xef, 0x2a, 0x53, 0x76, 0x41, 0x9e, 0x9a, 0xcf, 0x3d,
-	0x79, 0xa8, 0xcd, 0x7c, 0xff, 0xc2, 0xcc, 0x37, 0x1e, 0x4f, 0x5f, 0xb9, 0xfc, 0x70, 0xe6, 0xf1,
-	0x15, 0xef, 0xe7, 0x99, 0x0f, 0xe7, 0xcf, 0x3d, 0x9f, 0xa2, 0x7e, 0x0c, 0xd3, 0xb9, 0x5c, 0xc2,
-	0x71, 0x86, 0x51, 0xd6, 0xd1, 0xbb, 0x4c, 0x7d, 0xa6, 0xa4, 0x32, 0x73, 0x70, 0xa0, 0xf6, 0x51,
-	0xf6, 0x05, 0xa3, 0x2c, 0xfc, 0x63, 0x14, 0x8e, 0xf9, 0x4a, 0xdf, 0x23, 0x36, 0x75, 0x6f, 0xcb,
-	0x41, 0x00, 0xf1, 0x65, 0x8f, 0x60, 0x19, 0x12, 0x35, 0x6a, 0x19, 0xd5, 0x1f, 0xc7, 0x61, 0xe0,
-	0x98, 0x51, 0x29, 0x1c, 0xc3, 0x28, 0xeb, 0xe8, 0x0c, 0x64, 0x9e, 0x6a, 0xb6, 0xfe, 0x4c, 0xb3,
-	0x89, 0xba, 0xc9, 0x95, 0x17, 0xa3, 0x4b, 0x7b, 0xed, 0x62, 0x4c, 0x94, 0x74, 0xcd, 0xb0, 0x6b,
-	0x2d, 0xa4, 0x31, 0x4e, 0xea, 0xb5, 0x0b, 0xd2, 0xc2, 0xaf, 0x07, 0x20, 0xd3, 0x6e, 0x13, 0x74,
-	0x0b, 0xfa, 0x0c, 0xdd, 0x61, 0x36, 0x18, 0x9c, 0x7f, 0xab, 0x7d, 0x45, 0xef, 0x61, 0xc2, 0x2e,
-	0x81, 0x02, 0x45, 0x42, 0x2a, 0xa4, 0x05, 0x80, 0xaf, 0x4f, 0x94, 0x6d, 0x97, 0x89, 0x2e, 0xf7,
-	0x88, 0x80, 0xa5, 0x1e, 0xa4, 0xef, 0x8d, 0xa6, 0x6e, 0x58, 0x58, 0xbb, 0x5f, 0xbc, 0x29, 0xfa,
-	0x70, 0x4a, 0xb0, 0x78, 0x1a, 0x1b, 0x30, 0xe2, 0x09, 0xa8, 0x3f, 0xdd, 0x6e, 0xb1, 0x4f, 0x17,
-	0x21, 0x2b, 0x57, 0xdf, 0xf7, 0x84, 0x9c, 0x08, 0x09, 0xc9, 0x0a, 0x21, 0x41, 0x37, 0xce, 0x0a,
-	0xae, 0x95, 0xa7, 0xdb, 0x9e, 0xa8, 0x25, 0xc8, 0xfa, 0xe7, 0x90, 0x5a, 0xaf, 0x6a, 0x26, 0x9d,
-	0x5f, 0x66, 0x5d, 0xe6, 0xf3, 0xda, 0x51, 0xf9, 0xdb, 0xd4, 0xe7, 0xf5, 0xcf, 0xa1, 0x95, 0xaa,
-	0x66, 0x96, 0x4b, 0x38, 0xbd, 0xd6, 0xd2, 0x40, 0xf7, 0xe7, 0x40, 0xfd, 0xa9, 0xe5, 0x5a, 0x8e,
-	0xdc, 0xcf, 0x76, 0x96, 0xf8, 0x85, 0xa6, 0x21, 0xe3, 0x34, 0xea, 0x75, 0xcb, 0x76, 0x1d, 0xb5,
-	0x52, 0xd5, 0x1c, 0x47, 0x5d, 0x65, 0xfe, 0x70, 0x02, 0xa7, 0xbc, 0xf6, 0x05, 0xda, 0xac, 0x74,
-	0xa1, 0xac, 0x30, 0xff, 0xb7, 0x9d, 0x72, 0x01, 0x11, 0x18, 0xd5, 0xc9, 0x9a, 0xd6, 0xa8, 0xba,
-	0x6a, 0x4d, 0xab, 0xa8, 0x0e, 0x71, 0x5d, 0x1a, 0x36, 0x8a, 0x50, 0xe8, 0x58, 0x97, 0x49, 0xb8,
-	0x2d, 0x48, 0x94, 0x23, 0xcd, 0x9d, 0x3c, 0x2a, 0x71, 0xe6, 0x50, 0x3b, 0x46, 0x02, 0x70, 0x59,
-	0xab, 0x78, 0x6d, 0xf4, 0x04, 0xa3, 0x27, 0x6e, 0x70, 0x4c, 0x53, 0x1f, 0x39, 0x86, 0x87, 0x6a,
-	0x46, 0xc8, 0x99, 0xa0, 0x44, 0xda, 0x56, 0x88, 0x08, 0x04, 0x91, 0xb6, 0xd5, 0x42, 0xe4, 0x0f,
-	0x8d, 0x3a, 0x59, 0xcc, 0xd3, 0x4d, 0xe0, 0x21, 0xaf, 0xf1, 0x9a, 0x65, 0x98, 0xe8, 0x1c, 0x20,
-	0x9b, 0x38, 0x44, 0x90, 0xa8, 0xa6, 0x65, 0x56, 0x88, 0xc3, 0x3c, 0xd8, 0x04, 0xce, 0xf0, 0x1e,
-	0x4a, 0x77, 0x93, 0xb5, 0x23, 0x02, 0x9e, 0xca, 0xea, 0x9a, 0x65, 0xd7, 0x34, 0x97, 0x7a, 0x2a,
-	0xcc, 0x7d, 0xed, 0x72, 0xcf, 0x2e, 0xf3, 0xa8, 0x7e, 0x45, 0xdb, 0xae, 0x5a, 0x9a, 0xbe, 0xe4,
-	0xd3, 0x2b, 0x43, 0xe1, 0x05, 0x8e, 0xb3, 0x02, 0x31, 0x20, 0xe0, 0x47, 0x73, 0xe1, 0x3f, 0x46,
-	0x60, 0x30, 0x64, 0x2d, 0xf4, 0x1e, 0xa4, 0xc5, 0x5c, 0x32, 0x2f, 0xc5, 0x6a, 0xb8, 0x62, 0x77,
-	0x1d, 0xed, 0x70, 0x54, 0x4a, 0x22, 0xeb, 0xa2, 0xc4, 0x7e, 0x4a, 0x23, 0xd0, 0x61, 0xc6, 0xa7,
-	0xdc, 0xe1, 0x5c, 0xe8, 0x3e, 0x8c, 0x05, 0x37, 0x77, 0xd8, 0x85, 0x8d, 0x32, 0xb8, 0x0e, 0x17,
-	0x76, 0x45, 0xdc, 0xcd, 0xdc, 0x41, 0xe5, 0x17, 0xf6, 0x48, 0xbd, 0xa5, 0x91, 0x7b, 0xad, 0x8f,
-	0xf6, 0x72, 0x3c, 0xfb, 0x0e, 0xec, 0x0c, 0xf4, 0xf0, 0x3c, 0xef, 0x77, 0xf7, 0x89, 0x63, 0x0c,
-	0xf7, 0x78, 0x87, 0x0d, 0xee, 0x96, 0x4d, 0xf7, 0x9d, 0xb7, 0xb9, 0x67, 0x13, 0xbe, 0xe4, 0x3b,
-	0xfd, 0x65, 0xdc, 0xc5, 0xa5, 0x3d, 0x7a, 0x38, 0xd4, 0x0e, 0x77, 0xd7, 0x9f, 0xac, 0x8a, 0x3f,
-	0x59, 0xfd, 0x87, 0x99, 0xac, 0x05, 0x6f, 0xb2, 0xbe, 0x11, 0x8e, 0x17, 0x07, 0x84, 0x56, 0xdd,
-	0xe3, 0x45, 0x6e, 0xbd, 0x20, 0x54, 0xbc, 0xd7, 0x23, 0x54, 0x8c, 0xef, 0x31, 0xb6, 0x8b, 0xf3,
-	0x7c, 0x6c, 0x7b, 0x05, 0x92, 0xdf, 0xe9, 0x1e, 0x48, 0x26, 0x0e, 0x3c, 0xc1, 0x9d, 0x31, 0xe4,
-	0x8d, 0xf6, 0x18, 0x32, 0x79, 0x38, 0xfb, 0xb7, 0x46, 0x98, 0xdf, 0x82, 0x89, 0x35, 0xad, 0xe2,
-	0x5a, 0xf6, 0xb6, 0x5a, 0x67, 0x7b, 0xd8, 0x07, 0x36, 0x88, 0x23, 0xc3, 0x64, 0xdf, 0x74, 0x0c,
-	0xcb, 0x82, 0x62, 0x85, 0x11, 0x2c, 0x05, 0xfd, 0xe8, 0x66, 0x47, 0x7c, 0x3a, 0xd8, 0xc3, 0x91,
-	0xee, 0x8c, 0x4f, 0xf9, 0xf8, 0x5a, 0x43, 0xd3, 0x0a, 0x8c, 0xf9, 0xe7, 0xd0, 0xc5, 0x79, 0x75,
-	0xd5, 0x10, 0xb9, 0x2e, 0x76, 0xca, 0xec, 0x19, 0x66, 0x28, 0x63, 0xf4, 0x46, 0xb9, 0x2d, 0x98,
-	0x2f, 0xce, 0x2b, 0x06, 0xcb, 0x88, 0xe1, 0xac, 0xd3, 0xde, 0x84, 0xae, 0x40, 0xbc, 0xe1, 0x10,
-	0x55, 0xd3, 0x6d, 0x71, 0x1c, 0xed, 0x05, 0x0b, 0xcd, 0x9d, 0xfc, 0xc0, 0x5d, 0x87, 0x14, 0x4b,
-	0x18, 0x0f, 0x34, 0x1c, 0x52, 0xd4, 0x6d, 0x54, 0x06, 0xa0, 0x61, 0x44, 0x4d, 0xb3, 0xd7, 0x0d,
-	0x93, 0xc5, 0xcc, 0xf4, 0x50, 0x6f, 0xc7, 0x58, 0xaa, 0x5a, 0x9a, 0x88, 0x16, 0x86, 0x9b, 0x3b,
-	0xf9, 0x64, 0xb1, 0x84, 0x97, 0x19, 0x07, 0x4e, 0x6a, 0xba, 0xcd, 0x1f, 0xd1, 0xb7, 0x60, 0x48,
-	0x9c, 0xa9, 0x7c, 0x9c, 0xe9, 0x7d, 0xc3, 0x29, 0xe0, 0xf4, 0x6c, 0x24, 0xf7, 0x61, 0xdc, 0x71,
-	0x35, 0xb7, 0xe1, 0x74, 0x46, 0xf2, 0x99, 0x83, 0xed, 0xa0, 0x31, 0xce, 0xdf, 0x1e, 0xbc, 0xdf,
-	0x03, 0x59, 0x00, 0x77, 0x06, 0xef, 0xd9, 0xfd, 0xb7, 0x04, 0x3e, 0xc2, 0xb9, 0x3b, 0x62, 0xf5,
-	0xab, 0x90, 0xd5, 0x89, 0x63, 0xd8, 0x44, 0x57, 0x83, 0x9d, 0x8a, 0x0e, 0xb0, 0x53, 0xd3, 0x82,
-	0x0d, 0x7b, 0x1b, 0xf6, 0x11, 0x1c, 0x6f, 0x41, 0x6a, 0xdf, 0xb8, 0x23, 0x07, 0xd0, 0x52, 0x0e,
-	0x81, 0xb6, 0x6e, 0xdb, 0xef, 0xc2, 0xb1, 0x00, 0xbd, 0x73, 0xfb, 0x8e, 0x1e, 0x78, 0xfb, 0x8e,
-	0xfb, 0x22, 0xda, 0x76, 0xf1, 0x43, 0x18, 0x0b, 0x4b, 0x08, 0x76, 0xf3, 0xd8, 0xe1, 0x76, 0xf3,
-	0x48, 0x20, 0x20, 0xd8, 0xd4, 0x8f, 0xe1, 0x88, 0x07, 0xde, 0xb6, 0x3d, 0x8f, 0x1c, 0x72, 0x7b,
-	0x7a, 0xf0, 0xcb, 0xe1, 0x5d, 0xfa, 0x77, 0x12, 0xe4, 0x3c, 0xfc, 0x1e, 0x71, 0xfc, 0xf8, 0x21,
-	0xe3, 0xf8, 0x5c, 0x73, 0x27, 0x3f, 0x51, 0xe2, 0x98, 0xdd, 0xc2, 0xf9, 0x09, 0x21, 0xaf, 0xd8,
-	0x25, 0xaa, 0xef, 0xa6, 0x4e, 0x5b, 0x78, 0x2f, 0x1f, 0x32, 0xbc, 0xef, 0x54, 0xa7, 0x35, 0xca,
-	0x6f, 0x55, 0xa7, 0x35, 0xd8, 0xdf, 0x80, 0x37, 0x3c, 0x6d, 0x7a, 0xdf, 0xf0, 0xc7, 0x0e, 0xbc,
-	0x82, 0xbc, 0x65, 0xbe, 0xd2, 0xf5, 0xa2, 0x5f, 0x0b, 0x16, 0x6a, 0xb7, 0x0b, 0xff, 0xf8, 0xe1,
-	0x16, 0x93, 0xdc, 0x26, 0x2b, 0x58, 0x51, 0x1a, 0x78, 0x7d, 0x6a, 0xc7, 0xfd, 0x7f, 0xe2, 0x70,
-	0x42, 0xbc, 0xa5, 0xa9, 0xb4, 0xba, 0x01, 0x85, 0x7f, 0x1f, 0x81, 0x04, 0xf5, 0xe1, 0x5c, 0xcd,
-	0x25, 0xe8, 0x01, 0xa0, 0x4a, 0xc3, 0xb6, 0x09, 0x3d, 0x7b, 0xfc, 0x3c, 0x97, 0xf0, 0xe1, 0x4e,
-	0xec, 0x99, 0x0c, 0x6b, 0x77, 0x19, 0x05, 0x4c, 0x28, 0xb5, 0xff, 0x80, 0x7a, 0xa6, 0xc2, 0x66,
-	0x01, 0x76, 0xf4, 0x0b, 0x60, 0x7b, 0xe6, 0x0a, 0xb0, 0x15, 0x18, 0xe2, 0x65, 0x46, 0x1e, 0x21,
-	0x88, 0x88, 0x68, 0xac, 0x1d, 0x95, 0x47, 0x14, 0x41, 0x76, 0x62, 0x90, 0x33, 0xb1, 0xe6, 0x6e,
-	0xd1, 0x5b, 0xec, 0x4b, 0x8d, 0xde, 0x1e, 0xc3, 0x84, 0x5f, 0xcf, 0x31, 0xec, 0x1a, 0xd1, 0xfd,
-	0xb2, 0x8a, 0xaa, 0x79, 0xbe, 0xd7, 0x5e, 0xf5, 0x9a, 0x18, 0xab, 0xd5, 0x8c, 0x7b, 0x75, 0x1f,
-	0x06, 0xe1, 0x55, 0x54, 0x8a, 0x2e, 0xba, 0x04, 0x32, 0x83, 0xd7, 0xc9, 0xa6, 0x2a, 0x6e, 0x11,
-	0xbf, 0x60, 0xc5, 0xeb, 0x4b, 0x23, 0xb4, 0xbf, 0x44, 0x36, 0x6f, 0xb3, 0x5e, 0x51, 0xb9, 0xea,
-	0xe9, 0x6a, 0xc7, 0x5f, 0xd3, 0xd5, 0x26, 0x70, 0xbc, 0x4e, 0x4c, 0x9d, 0x62, 0x77, 0x2b, 0x25,
-	0x09, 0x67, 0xec, 0x60, 0x95, 0x24, 0x01, 0xd4, 0xa5, 0x0f, 0x2d, 0x42, 0x46, 0x14, 0xac, 0x6c,
-	0xe2, 0xd4, 0x2d, 0xd3, 0x21, 0x5e, 0x91, 0xaa, 0xdb, 0xbc, 0x2d, 0x58, 0xb5, 0x9a, 0x66, 0xea,
-	0x38, 0xcd, 0x79, 0xb0, 0xc7, 0x42, 0x61, 0x3c, 0x6d, 0xd9, 0xd6, 0x70, 0x5c, 0xee, 0x86, 0xed,
-	0x03, 0x23, 0x78, 0xb0, 0x60, 0x41, 0xdf, 0x01, 0x24, 0xb4, 0x61, 0xc1, 0x9a, 0x56, 0xa9, 0x90,
-	0xba, 0x2b, 0xbc, 0xb3, 0x93, 0xdd, 0x02, 0x50, 0xba, 0xed, 0x66, 0x69, 0xfc, 0x56, 0x64, 0xa4,
-	0x58, 0x0c, 0x26, 0x68, 0x41, 0xcb, 0x30, 0xea, 0x69, 0xc6, 0x30, 0x85, 0x7a, 0xc2, 0x37, 0xeb,
-	0x88, 0x6a, 0x29, 0xa7, 0x50, 0x07, 0x23, 0xc1, 0x18, 0x6a, 0x43, 0x17, 0xa8, 0xcb, 0xad, 0x3e,
-	0x33, 0x4c, 0xdd, 0x7a, 0xe6, 0xa8, 0xda, 0xa6, 0x66, 0x54, 0xb5, 0x55, 0x51, 0xe1, 0x48, 0x60,
-	0x64, 0x6f, 0xdd, 0xe7, 0x5d, 0x45, 0xaf, 0x07, 0x95, 0x20, 0x65, 0x93, 0x0a, 0x61, 0x2b, 0x89,
-	0x17, 0x01, 0x53, 0xcc, 0x30, 0x1d, 0x9b, 0x96, 0x27, 0xef, 0x44, 0x50, 0x89, 0x87, 0x39, 0x13,
-	0x6f, 0x74, 0xd0, 0x35, 0xc8, 0x08, 0x94, 0xa0, 0x98, 0x98, 0x66, 0x38, 0xf9, 0x8e, 0xe3, 0x58,
-	0x10, 0x78, 0x48, 0x69, 0xce, 0xe8, 0x57, 0x0f, 0x51, 0x15, 0x0a, 0xbc, 0xda, 0xca, 0xeb, 0xc1,
-	0xaa, 0x61, 0x1a, 0xae, 0x41, 0xaf, 0xd1, 0x96, 0x1d, 0x95, 0x39, 0xe0, 0x8e, 0xca, 0xb1, 0x02,
-	0x2d, 0x87, 0x2a, 0x7b, 0x48, 0xa1, 0x8d, 0xf5, 0x13, 0x09, 0x72, 0x36, 0xf9, 0x80, 0x54, 0x5c,
-	0x71, 0xd3, 0xb5, 0xdd, 0x2a, 0xc4, 0x91, 0xb3, 0x93, 0x7d, 0xfb, 0x67, 0x45, 0x67, 0x76, 0x95,
-	0xa1, 0x17, 0x52, 0x32, 0x93, 0x2e, 0xf8, 0x47, 0xc6, 0x04, 0x16, 0xb8, 0xed, 0x65, 0x48, 0xe2,
-	0xe0, 0x09, 0x4f, 0x66, 0xb1, 0xad, 0x20, 0x49, 0x1c, 0x54, 0x83, 0x13, 0x2d, 0x1a, 0xb5, 0xd6,
-	0x26, 0x89, 0x23, 0xa3, 0xc9, 0xbe, 0xe9, 0x61, 0xe5, 0xad, 0x5d, 0x65, 0xf0, 0x85, 0x94, 0xc8,
-	0xa4, 0x0b, 0x5e, 0x85, 0xf1, 0x68, 0x48, 0x60, 0xb8, 0x36, 0x49, 0x1c, 0x7c, 0x34, 0x24, 0xaf,
-	0xb5, 0x0b, 0x15, 0x61, 0xd4, 0x17, 0x17, 0x8e, 0x53, 0x46, 0x68, 0x9c, 0xa2, 0xa4, 0xb8, 0x94,
-	0x82, 0xef, 0x1b, 0x79, 0xb4, 0xe1, 0x90, 0xe5, 0x1a, 0x64, 0xf8, 0xe9, 0x14, 0x9a, 0xa0, 0xd1,
-	0x03, 0x4e, 0x50, 0x8a, 0x9d, 0x5b, 0xc1, 0x84, 0x58, 0xe0, 0xeb, 0x1a, 0x9a, 0x0b, 0x5b, 0x33,
-	0xd7, 0x89, 0x23, 0x8f, 0xb1, 0x35, 0xf5, 0x76, 0xcf, 0xbd, 0xe6, 0x19, 0xc0, 0x33, 0x29, 0x66,
-	0x6c, 0x8b, 0xa6, 0x6b, 0x6f, 0xb3, 0x5a, 0x57, 0x97, 0xce, 0x89, 0x3f, 0x49, 0x00, 0xa1, 0x1d,
-	0x79, 0x12, 0xe2, 0x75, 0x9e, 0x32, 0x61, 0x57, 0xe3, 0x10, 0xbb, 0x66, 0xbf, 0x1f, 0xcb, 0x64,
-	0xe5, 0x37, 0xb0, 0xd7, 0x83, 0x16, 0x20, 0xee, 0xed, 0xd4, 0xe8, 0xbe, 0x3b, 0xb5, 0xed, 0x86,
-	0xf3, 0x38, 0xd1, 0xbb, 0x07, 0x7f, 0x79, 0xa1, 0x15, 0x81, 0xbf, 0xb8, 0x40, 0x43, 0x7c, 0xcb,
-	0xb6, 0x49, 0x95, 0x1f, 0xbd, 0x86, 0x2e, 0xf2, 0xdb, 0x4a, 0x6e, 0x57, 0x49, 0xbe, 0x90, 0x06,
-	0x0a, 0x31, 0x3b, 0x2a, 0xeb, 0xf4, 0xea, 0x5a, 0x08, 0xc8, 0xca, 0x25, 0x07, 0xa7, 0x42, 0x6c,
-	0x65, 0xdd, 0x99, 0xf8, 0xa5, 0x04, 0xc3, 0x2d, 0x36, 0xe9, 0x55, 0x76, 0x90, 0xfe, 0x4c, 0x65,
-	0x87, 0xe8, 0x6b, 0x96, 0x1d, 0x26, 0x1e, 0x40, 0xaa, 0x75, 0x52, 0xd1, 0x55, 0x18, 0x10, 0x4b,
-	0x46, 0x62, 0x4b, 0xe6, 0x74, 0xcf, 0x25, 0xd3, 0xc2, 0x18, 0xaa, 0xf6, 0x09, 0xfe, 0x09, 0x1b,
-	0x8e, 0xed, 0xb1, 0xaa, 0x50, 0x06, 0xfa, 0x36, 0x88, 0x28, 0x02, 0x61, 0xfa, 0x88, 0xde, 0x85,
-	0x7e, 0x5e, 0x7e, 0xe2, 0x2b, 0xe3, 0xcd, 0x83, 0x49, 0x76, 0x30, 0xe7, 0xba, 0x1c, 0xfd, 0xba,
-	0x24, 0x12, 0x70, 0x9f, 0x4b, 0xa1, 0x5c, 0x7f, 0xb1, 0xe1, 0x3e, 0x25, 0xa6, 0x2b, 0x2e, 0xc8,
-	0x05, 0x4b, 0x27, 0x68, 0xc6, 0x13, 0xc4, 0x13, 0xfd, 0xe3, 0xbb, 0xca, 0xa8, 0x8d, 0xe6, 0x33,
-	0x4f, 0x1e, 0x16, 0x67, 0x1e, 0x5c, 0x98, 0xf9, 0xc6, 0xe3, 0x0f, 0xe7, 0xce, 0x5d, 0x9c, 0x7f,
-	0x3e, 0x25, 0x80, 0xd1, 0x15, 0x00, 0xf6, 0x72, 0x95, 0xba, 0x66, 0x5b, 0x35, 0xa1, 0xdc, 0xfe,
-	0xdb, 0x33, 0xc9, 0x78, 0x96, 0x6c, 0xab, 0x86, 0xbe, 0x09, 0x09, 0x0e, 0xe0, 0x5a, 0x62, 0xcd,
-	0xee, 0xcf, 0x1e, 0x67, 0x1c, 0x77, 0x2c, 0x31, 0xa4, 0x3f, 0x4e, 0x42, 0xd2, 0x1f, 0x12, 0xba,
-	0x1a, 0xce, 0xd1, 0x4f, 0xf5, 0xcc, 0xd1, 0x1f, 0x20, 0x39, 0xbf, 0x00, 0x50, 0xb1, 0x89, 0x26,
-	0xde, 0x8e, 0x89, 0x1e, 0xe6, 0xed, 0x18, 0xc1, 0x57, 0x74, 0x29, 0x48, 0xa3, 0xae, 0x7b, 0x20,
-	0x7d, 0x87, 0x01, 0x11, 0x7c, 0x45, 0x17, 0x1d, 0x13, 0x45, 0x1b, 0x9e, 0x4d, 0x8f, 0xf3, 0x6c,
-	0xfa, 0xbc, 0xa8, 0x51, 0x9d, 0x85, 0x41, 0x9d, 0x38, 0x15, 0xdb, 0xa8, 0xd3, 0x49, 0x64, 0x5e,
-	0x61, 0x92, 0x2d, 0x39, 0xbb, 0x4f, 0xfe, 0x3c, 0x8d, 0xc3, 0x9d, 0xe8, 0x19, 0x80, 0xe6, 0xba,
-	0xb6, 0xb1, 0xda, 0x70, 0x89, 0x23, 0x0f, 0xb0, 0x55, 0x7c, 0xa6, 0xa7, 0x8d, 0x66, 0x8b, 0x3e,
-	0x2d, 0x5b, 0x97, 0xca, 0xb9, 0x5d, 0xe5, 0xcc, 0x3f, 0x49, 0xa7, 0x0b, 0x07, 0x2a, 0xd6, 0xe0,
-	0x90, 0x28, 0xf4, 0x08, 0x06, 0x85, 0x8b, 0xcc, 0xce, 0x94, 0xf8, 0xe1, 0x2b, 0x28, 0xa9, 0xe6,
-	0x4e, 0x1e, 0xbc, 0xf6, 0x92, 0x83, 0x61, 0xd3, 0xa3, 0x71, 0x50, 0x19, 0x90, 0x43, 0x6c, 0xe6,
-	0xcd, 0xd7, 0x6d, 0x6b, 0xcd, 0xa8, 0x12, 0xd5, 0xd0, 0x99, 0xbb, 0x98, 0x54, 0x8e, 0x05, 0xb5,
-	0x87, 0xcc, 0x6d, 0x4e, 0xb4, 0xc2, 0x69, 0xca, 0x25, 0x9c, 0x71, 0x5a, 0x5b, 0x74, 0xf4, 0x9f,
-	0x12, 0x1c, 0xf1, 0x9c, 0x04, 0xda, 0x49, 0x6c, 0xf6, 0x92, 0x19, 0x71, 0x1c, 0x96, 0xbe, 0x4b,
-	0x2a, 0xff, 0x20, 0xed, 0x2a, 0x3f, 0x96, 0xec, 0x1f, 0x4a, 0xf3, 0x7f, 0x2d, 0x3d, 0x99, 0xbe,
-	0x72, 0x99, 0x8e, 0x5d, 0x9b, 0xf9, 0xbe, 0xd8, 0x1e, 0x1f, 0x85, 0x9e, 0x83, 0xc7, 0x47, 0x33,
-	0x8f, 0xcf, 0x86, 0x3a, 0xce, 0x3c, 0x9a, 0x3d, 0x73, 0x96, 0xf2, 0x15, 0x67, 0x1e, 0x08, 0x93,
-	0x7d, 0x14, 0x7a, 0x0e, 0x1e, 0x19, 0x5f, 0xd0, 0x71, 0x66, 0xfa, 0xca, 0xe5, 0xcb, 0x0f, 0xc5,
-	0x2e, 0xbc, 0xf4, 0xfc, 0xcc, 0x95, 0xa9, 0x8f, 0x9e, 0x4c, 0xe1, 0x51, 0xa1, 0xee, 0x6d, 0xa6,
-	0x6d, 0x91, 0x2b, 0x8b, 0x1e, 0x80, 0xdc, 0x36, 0x8c, 0x0d, 0xb2, 0xa1, 0x56, 0xb5, 0x55, 0x52,
-	0x95, 0xcf, 0xb3, 0x81, 0xbc, 0xc1, 0x97, 0xc8, 0xc7, 0x99, 0xe6, 0x4e, 0x7e, 0xec, 0x66, 0x18,
-	0xe3, 0xfa, 0xe2, 0xf5, 0x1b, 0x94, 0x10, 0x8f, 0xb5, 0x40, 0x5f, 0x27, 0x1b, 0xac, 0x19, 0xfd,
-	0xb7, 0x04, 0x13, 0x61, 0x07, 0xbd, 0xcd, 0x4e, 0xf0, 0xd5, 0xb4, 0x93, 0x1c, 0x52, 0xb9, 0xd5,
-	0x56, 0x6b, 0x70, 0xbc, 0xcb, 0x70, 0x02, 0x7b, 0x5d, 0x60, 0x03, 0x3a, 0x15, 0xb2, 0xd7, 0xd1,
-	0x62, 0x3b, 0x96, 0x6f, 0xb3, 0xa3, 0x1d, 0x62, 0x7c, 0xbb, 0x61, 0x18, 0xeb, 0x22, 0xc7, 0xd0,
-	0xe5, 0x39, 0x26, 0x20, 0xc7, 0x57, 0xaa, 0xce, 0xde, 0x58, 0x68, 0x07, 0x29, 0x97, 0xf0, 0x48,
-	0x07, 0x72, 0x59, 0x47, 0xbf, 0x94, 0x60, 0x84, 0x39, 0xf9, 0x6d, 0x93, 0x30, 0xf8, 0xd5, 0x9c,
-	0x84, 0x2c, 0xd5, 0xb5, 0xd5, 0xfa, 0x2e, 0x24, 0xab, 0x16, 0x1f, 0x95, 0x23, 0x0f, 0xb1, 0x23,
-	0x69, 0xba, 0xf7, 0x91, 0x74, 0xc3, 0x23, 0xfd, 0x22, 0x27, 0x52, 0x20, 0x08, 0xcd, 0x41, 0x5c,
-	0xbc, 0x7f, 0x2a, 0xcf, 0xb3, 0xc3, 0x68, 0xbc, 0x33, 0x6c, 0x65, 0xdd, 0xd8, 0xa3, 0xeb, 0x5a,
-	0x80, 0x1c, 0x3e, 0x70, 0x01, 0x32, 0xd5, 0xb5, 0x00, 0xd9, 0x25, 0x85, 0x90, 0xfe, 0x4b, 0x14,
-	0x80, 0x33, 0x7f, 0xa9, 0x02, 0x70, 0xf6, 0xf0, 0x05, 0xe0, 0x8e, 0x6a, 0x29, 0x3a, 0x48, 0xb5,
-	0x74, 0xe4, 0x20, 0xd5, 0xd2, 0xd1, 0x03, 0x57, 0x4b, 0xc7, 0x7a, 0x54, 0x4b, 0x2f, 0x41, 0xd2,
-	0xb6, 0x2c, 0x57, 0x65, 0x4e, 0x36, 0x4f, 0xd2, 0xca, 0x1d, 0x09, 0x71, 0xcb, 0x72, 0xa9, 0x87,
-	0x8d, 0x13, 0xb6, 0x78, 0x42, 0xef, 0xc3, 0x80, 0x49, 0x5c, 0x6a, 0x90, 0x71, 0xe6, 0xff, 0x2b,
-	0xbf, 0xdd, 0xc9, 0x5f, 0x3a, 0xec, 0x9b, 0xca, 0x37, 0x89, 0x5b, 0x2e, 0x35, 0x77, 0xf2, 0xfd,
-	0xec, 0x01, 0xf7, 0x9b, 0xc4, 0x2d, 0xeb, 0xe8, 0x16, 0x0c, 0xb5, 0xd4, 0xae, 0xe5, 0xfd, 0x6b,
-	0xd7, 0x34, 0xae, 0x0b, 0x97, 0x61, 0xf1, 0x60, 0x2d, 0x54, 0xad, 0x5e, 0x80, 0x24, 0x03, 0xa4,
-	0xfe, 0xa4, 0xa8, 0x19, 0xca, 0xbd, 0xfc, 0x4d, 0x65, 0xa8, 0xb9, 0x93, 0xf7, 0xb3, 0x81, 0x38,
-	0x41, 0x71, 0x58, 0x5e, 0xf0, 0x7d, 0xc8, 0x7a, 0x39, 0x88, 0x00, 0xec, 0xdc, 0x3e, 0x60, 0x23,
-	0x74, 0x7d, 0xac, 0x70, 0x36, 0x1f, 0xd3, 0xcb, 0x98, 0x2c, 0x7b, 0xd0, 0x73, 0x10, 0x77, 0x78,
-	0x18, 0x23, 0x4f, 0x74, 0xdf, 0xba, 0x22, 0xca, 0xc1, 0x1e, 0x1d, 0xfa, 0x36, 0x78, 0x28, 0xaa,
-	0xc7, 0x7a, 0x6c, 0x6f, 0xd6, 0x94, 0xa0, 0xf7, 0x5e, 0x38, 0x9f, 0x82, 0x94, 0x9f, 0x2b, 0x63,
-	0x4b, 0x84, 0xa5, 0x6c, 0x87, 0xf1, 0x90, 0xc8, 0x90, 0xb1, 0xe5, 0x81, 0x4e, 0x43, 0xba, 0xe1,
-	0x10, 0x3d, 0xa0, 0x72, 0xe4, 0x13, 0x34, 0xae, 0xc6, 0xc3, 0xb4, 0xd9, 0x23, 0x73, 0x28, 0x1d,
-	0x43, 0x0b, 0x56, 0x9c, 0x9c, 0x0b, 0x5e, 0xe8, 0xf6, 0x97, 0x1b, 0xfa, 0x9a, 0xa0, 0xb3, 0x3f,
-	0x10, 0xf5, 0x9d, 0x0b, 0x72, 0x9e, 0xbd, 0x13, 0x4b, 0x2f, 0xa1, 0xa1, 0x1b, 0x9a, 0xe3, 0xe2,
-	0x6b, 0xac, 0x76, 0x73, 0x81, 0x2b, 0x82, 0x3f, 0xe0, 0xbf, 0x3a, 0x19, 0xe7, 0xe4, 0xc9, 0xae,
-	0x8c, 0x73, 0x2d, 0x8c, 0x73, 0xe8, 0x09, 0x1c, 0x6b, 0xcf, 0x09, 0xda, 0xa4, 0x42, 0x8c, 0x4d,
-	0xee, 0xc0, 0xbe, 0x71, 0x98, 0x9c, 0xa3, 0x9f, 0x38, 0xc4, 0x02, 0xa1, 0xe8, 0xa2, 0x45, 0x18,
-	0xe4, 0x79, 0x07, 0xbe, 0x22, 0x0a, 0x3d, 0xce, 0x21, 0x4a, 0xc2, 0xd7, 0x44, 0x10, 0xa2, 0x41,
-	0xdd, 0x6f, 0x45, 0x0f, 0x01, 0xad, 0xb2, 0x17, 0x0b, 0xb6, 0xd5, 0x3a, 0xb1, 0x2b, 0xc4, 0x74,
-	0xb5, 0x75, 0x22, 0x9f, 0xdc, 0xbf, 0xc2, 0x97, 0xde, 0x55, 0x86, 0x00, 0x4e, 0x44, 0x22, 0x1f,
-	0x5f, 0x99, 0x89, 0x44, 0x22, 0x11, 0x9c, 0x15, 0x38, 0x2b, 0x3e, 0x0c, 0x7a, 0x13, 0xd2, 0x7e,
-	0xd2, 0x41, 0xd4, 0x0e, 0xa7, 0x26, 0xa5, 0xe9, 0x7e, 0x9c, 0xf2, 0x9a, 0x45, 0x51, 0x50, 0xa3,
-	0x47, 0x07, 0xcb, 0x50, 0x69, 0xba, 0xed, 0xe7, 0xba, 0x4e, 0x1d, 0x20, 0xd7, 0xa5, 0x8c, 0x52,
-	0x7f, 0x14, 0x33, 0xe6, 0x62, 0x09, 0x8b, 0x94, 0x17, 0x16, 0x09, 0xaf, 0xa2, 0x6e, 0x7b, 0x49,
-	0xb0, 0xce, 0x54, 0xda, 0xe9, 0x2f, 0x29, 0x95, 0xf6, 0xe6, 0x17, 0x4c, 0xa5, 0xed, 0xf7, 0xbe,
-	0xff, 0xf4, 0x97, 0xf2, 0xbe, 0x3f, 0xba, 0x0a, 0x10, 0x7a, 0x1d, 0xe5, 0xcc, 0xe1, 0x5e, 0x47,
-	0xc1, 0x21, 0x5e, 0xb4, 0x0a, 0xa9, 0xba, 0x6d, 0x6d, 0x1a, 0x74, 0x1f, 0x73, 0x7f, 0xeb, 0x2c,
-	0xbb, 0x94, 0xbe, 0xb9, 0xab, 0xbc, 0x69, 0x9f, 0x92, 0xa7, 0xe6, 0xdf, 0xd8, 0xdb, 0x6d, 0xf8,
-	0xe8, 0xc9, 0x54, 0x73, 0x27, 0x3f, 0xbc, 0x12, 0x60, 0x94, 0x4b, 0x78, 0x38, 0x04, 0x59, 0xd6,
-	0x51, 0x09, 0xb2, 0x7e, 0x03, 0x3d, 0x65, 0x74, 0xcd, 0xd5, 0xe4, 0xb7, 0xc4, 0x11, 0xd3, 0xbe,
-	0x1c, 0x6f, 0xb3, 0xaf, 0x7f, 0x70, 0x26, 0xcc, 0x41, 0xe3, 0x76, 0x74, 0x1c, 0x92, 0xb5, 0x46,
-	0x95, 0xc6, 0xe3, 0x8e, 0x2b, 0xcf, 0xb0, 0x1b, 0x28, 0x68, 0x40, 0xeb, 0x70, 0xb4, 0x52, 0xd5,
-	0x8c, 0x9a, 0xaa, 0xb5, 0x84, 0xed, 0x6a, 0xc5, 0xd2, 0x89, 0x3c, 0xbb, 0x4f, 0x44, 0xd5, 0x19,
-	0xea, 0xe3, 0x71, 0x86, 0xd6, 0x25, 0x07, 0x30, 0x0b, 0x23, 0xce, 0x86, 0x51, 0x57, 0x45, 0x62,
-	0x4a, 0xad, 0xd8, 0xdb, 0x75, 0xd7, 0x92, 0x2f, 0x32, 0x85, 0xb2, 0xb4, 0x4b, 0x18, 0x7c, 0x81,
-	0x75, 0xa0, 0x87, 0x70, 0xbc, 0x0b, 0xbd, 0x6a, 0x6d, 0x12, 0xdb, 0x36, 0x74, 0x22, 0xbf, 0xbd,
-	0x6f, 0xad, 0xfc, 0x68, 0x07, 0xe8, 0x2d, 0xc1, 0x3c, 0xf1, 0x2e, 0xa4, 0xdb, 0xc2, 0xd0, 0x70,
-	0x7a, 0x24, 0xc9, 0xd3, 0x23, 0xa3, 0xe1, 0xf4, 0x48, 0x32, 0x94, 0xf5, 0x98, 0xb8, 0x07, 0xa9,
-	0x56, 0x97, 0xb1, 0x0b, 0xf7, 0x6c, 0x6b, 0x72, 0xa5, 0xe3, 0x7e, 0xf2, 0x00, 0x3a, 0xb3, 0x29,
-	0x57, 0x01, 0x7c, 0x0b, 0x3b, 0xe8, 0x32, 0x0c, 0x06, 0x9f, 0xaf, 0x79, 0x49, 0xa2, 0xa3, 0x3d,
-	0xa7, 0x04, 0x03, 0xf1, 0x79, 0x0b, 0x3a, 0x1c, 0x59, 0x60, 0x49, 0x83, 0xa0, 0x5b, 0x64, 0xf4,
-	0xae, 0x01, 0x04, 0xa8, 0xfe, 0xdb, 0x51, 0xbd, 0x40, 0xbb, 0x24, 0x33, 0x92, 0xbe, 0x98, 0xc2,
-	0xcf, 0x24, 0x38, 0x72, 0x97, 0xa5, 0x15, 0xfe, 0x9c, 0x62, 0xd0, 0x15, 0x80, 0xe0, 0x1b, 0xb8,
-	0x9e, 0x99, 0x93, 0x25, 0x4a, 0xb2, 0xac, 0x39, 0x1b, 0x4a, 0x8c, 0x65, 0x20, 0x93, 0x6b, 0x5e,
-	0x43, 0xe1, 0xdf, 0x24, 0x18, 0x79, 0x8f, 0xb8, 0x1d, 0x4a, 0x3e, 0x82, 0x54, 0xa0, 0xa4, 0xfa,
-	0xfa, 0x79, 0x9e, 0x21, 0x12, 0xd0, 0x39, 0xaf, 0xaf, 0xf6, 0xff, 0x4a, 0x70, 0x2a, 0xac, 0x76,
-	0x48, 0xf8, 0x92, 0x65, 0x2f, 0xde, 0x2d, 0x3b, 0xde, 0x40, 0x2a, 0x90, 0x60, 0x77, 0x3f, 0x69,
-	0x18, 0x22, 0x23, 0x7c, 0x55, 0x7c, 0xbf, 0x76, 0x68, 0xaf, 0x70, 0xf1, 0x6e, 0xf9, 0x9d, 0xb7,
-	0x9b, 0x3b, 0xf9, 0x38, 0x75, 0x1b, 0x16, 0xef, 0x96, 0x71, 0x9c, 0x22, 0x2f, 0x36, 0x0c, 0xf4,
-	0x5d, 0x88, 0xd3, 0x6b, 0x9c, 0xca, 0xe0, 0xdf, 0xc8, 0xbd, 0xf7, 0xba, 0x32, 0x06, 0x4a, 0x64,
-	0x93, 0x8a, 0x18, 0xd0, 0xc9, 0xe6, 0x62, 0xc3, 0x28, 0xbc, 0xe8, 0x83, 0xb1, 0x1b, 0x86, 0x13,
-	0x8c, 0xd8, 0x1f, 0xa0, 0x06, 0xe9, 0xf0, 0xf5, 0x10, 0x4c, 0xd5, 0xe9, 0x3d, 0x2e, 0x86, 0xbd,
-	0x27, 0x2b, 0xa5, 0x85, 0x29, 0x5f, 0x7f, 0xba, 0xd0, 0x27, 0x12, 0xf4, 0x5b, 0xb6, 0x4e, 0x6c,
-	0xf1, 0x82, 0xf7, 0xdf, 0x4a, 0xbb, 0xca, 0xdf, 0x48, 0xf6, 0x0f, 0x24, 0x1c, 0xc1, 0x49, 0x7f,
-	0x8d, 0x61, 0x98, 0x09, 0x9e, 0xfd, 0x59, 0xc3, 0xc9, 0x19, 0xff, 0xd1, 0xb3, 0x32, 0x4e, 0xcc,
-	0x78, 0x4f, 0x2c, 0x35, 0x87, 0xfb, 0x67, 0xd8, 0xbf, 0x70, 0x0a, 0x0e, 0x0f, 0xcd, 0x84, 0x7f,
-	0x85, 0x32, 0x8c, 0x78, 0x70, 0x26, 0xf4, 0x83, 0x2b, 0x86, 0x72, 0xd0, 0xcf, 0xbf, 0xdf, 0x62,
-	0x1f, 0x10, 0x32, 0x67, 0xe8, 0x6c, 0x9f, 0xfc, 0xfb, 0x38, 0xe6, 0xcd, 0x08, 0x41, 0xac, 0x4e,
-	0x3d, 0x1f, 0xfe, 0xe1, 0x20, 0x7b, 0x2e, 0xfc, 0xb3, 0x04, 0x23, 0xb7, 0xbb, 0x6c, 0x9e, 0xa5,
-	0xc3, 0xed, 0xf0, 0xd6, 0xf2, 0xc0, 0x97, 0xb9, 0xbb, 0xff, 0x4b, 0x82, 0xac, 0x2f, 0xe7, 0x0e,
-	0xa9, 0xd5, 0xab, 0xd4, 0xa5, 0xfb, 0xaa, 0xa8, 0x87, 0xa6, 0x61, 0xb0, 0xa6, 0xd5, 0x59, 0x89,
-	0x9b, 0x5e, 0x14, 0x7d, 0xe1, 0xa4, 0xab, 0x8e, 0x41, 0xf4, 0x5d, 0x27, 0xdb, 0x85, 0x4f, 0x25,
-	0x18, 0xef, 0x18, 0x08, 0xf7, 0x42, 0xfc, 0x9c, 0xad, 0xd4, 0xca, 0xde, 0x35, 0x67, 0x1b, 0x0d,
-	0xe7, 0x6c, 0x3f, 0x93, 0x5a, 0x73, 0xb6, 0x77, 0x20, 0xcd, 0x32, 0x9a, 0x64, 0xcb, 0x25, 0xa6,
-	0xc3, 0xb2, 0x24, 0x7d, 0xac, 0x24, 0xf3, 0xd6, 0xae, 0x32, 0xfd, 0x42, 0x3a, 0x95, 0xd1, 0x65,
-	0xa9, 0x90, 0xb7, 0x4f, 0xcc, 0x1f, 0x7b, 0x32, 0x7d, 0xe5, 0xf2, 0xa3, 0x59, 0xcf, 0x79, 0xf9,
-	0x70, 0xee, 0xdc, 0xdc, 0x3b, 0xcf, 0xcf, 0x7c, 0x38, 0x77, 0x6e, 0xfe, 0xf9, 0x14, 0x4e, 0x51,
-	0x8c, 0x45, 0x1f, 0xa2, 0xf0, 0x27, 0x09, 0xe4, 0x1e, 0xaa, 0x3b, 0xe8, 0x39, 0xc4, 0xb9, 0xff,
-	0xe4, 0x5d, 0x62, 0x97, 0x7a, 0xce, 0x43, 0x1b, 0xeb, 0xac, 0xf8, 0xff, 0x45, 0xb2, 0x33, 0x9e,
-	0xcc, 0x89, 0x0a, 0x0c, 0x85, 0x61, 0xba, 0xdc, 0xd8, 0xfb, 0x95, 0x43, 0x7a, 0xa8, 0x17, 0xba,
-	0xc0, 0x0b, 0x3f, 0x94, 0x20, 0xbf, 0x60, 0x99, 0x9b, 0xc4, 0x76, 0x3b, 0xa8, 0xbd, 0x1d, 0xb3,
-	0x02, 0x49, 0xae, 0x53, 0xf0, 0xe5, 0xc3, 0xc5, 0x83, 0x7f, 0xaa, 0x90, 0xe0, 0x42, 0xcb, 0x25,
-	0x9c, 0xe0, 0x28, 0x65, 0xf6, 0xf9, 0x05, 0x73, 0x0d, 0xd9, 0x79, 0x8c, 0xd9, 0xf3, 0xd9, 0x25,
-	0x80, 0x20, 0xde, 0x41, 0x59, 0x18, 0x5e, 0xb9, 0x75, 0x7f, 0x11, 0xab, 0x77, 0x6f, 0x5e, 0xbf,
-	0x79, 0xeb, 0xfe, 0xcd, 0x4c, 0x24, 0x68, 0x52, 0x8a, 0x77, 0xee, 0x2c, 0xe2, 0xf7, 0x33, 0x12,
-	0x42, 0x90, 0xe2, 0x4d, 0x8b, 0xff, 0xff, 0xce, 0x22, 0xbe, 0x59, 0xbc, 0x91, 0x89, 0x2a, 0x3f,
-	0x93, 0x3e, 0x7b, 0x99, 0x93, 0x3e, 0x7f, 0x99, 0x93, 0x7e, 0xf3, 0x32, 0x17, 0xf9, 0xdd, 0xcb,
-	0x5c, 0xe4, 0xf7, 0x2f, 0x73, 0x91, 0x3f, 0xbc, 0xcc, 0x45, 0xfe, 0xf8, 0x32, 0x27, 0x7d, 0xdc,
-	0xcc, 0x49, 0x3f, 0x6a, 0xe6, 0x22, 0x3f, 0x6f, 0xe6, 0xa4, 0x5f, 0x34, 0x73, 0x91, 0x4f, 0x9b,
-	0xb9, 0xc8, 0xaf, 0x9a, 0xb9, 0xc8, 0x67, 0xcd, 0x9c, 0xf4, 0x79, 0x33, 0x27, 0xfd, 0xa6, 0x99,
-	0x8b, 0xfc, 0xae, 0x99, 0x93, 0x7e, 0xdf, 0xcc, 0x45, 0xfe, 0xd0, 0xcc, 0x49, 0x7f, 0x6c, 0xe6,
-	0x22, 0x1f, 0xbf, 0xca, 0x45, 0x7e, 0xf4, 0x2a, 0x27, 0xfd, 0xe4, 0x55, 0x2e, 0xf2, 0xd3, 0x57,
-	0x39, 0xe9, 0x93, 0x57, 0xb9, 0xc8, 0xcf, 0x5f, 0xe5, 0x22, 0xbf, 0x78, 0x95, 0x93, 0x3e, 0x7d,
-	0x95, 0x93, 0x7e, 0xf5, 0x2a, 0x27, 0x3d, 0x38, 0x7f, 0x88, 0xcb, 0xc4, 0x35, 0xeb, 0xab, 0xab,
-	0x03, 0x6c, 0x13, 0x5e, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xd3, 0x51, 0xff, 0xfe,
-	0x3f, 0x00, 0x00,
+	// 5160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0x4d, 0x6c, 0x1b, 0xe7,
+	0x99, 0x3f, 0x87, 0xa4, 0x44, 0xf2, 0x11, 0xc5, 0x8f, 0x57, 0x92, 0x35, 0xa6, 0x6d, 0x52, 0x61,
+	0x9c, 0x44, 0x76, 0x2c, 0x39, 0x96, 0x93, 0xb4, 0x75, 0x9b, 0xbf, 0xcb, 0x11, 0xa5, 0x98, 0xb6,
+	0xe5, 0xa8, 0xaf, 0xbf, 0xfe, 0xb1, 0x9d, 0x4c, 0x47, 0x9c, 0x57, 0xf2, 0x44, 0xe4, 0x0c, 0x3b,
+	0x33, 0x94, 0xa5, 0xa6, 0x01, 0xb2, 0xc5, 0x2e, 0xda, 0x2d, 0x76, 0x17, 0xdd, 0x9c, 0x8a, 0x3d,
+	0x2c, 0x82, 0x05, 0x0a, 0xf4, 0xb4, 0x28, 0x16, 0x7b, 0x08, 0xf6, 0xd2, 0x5e, 0x76, 0x91, 0xcb,
+	0x02, 0x39, 0xf4, 0x50, 0xf4, 0xa0, 0xad, 0xe9, 0x4b, 0x4f, 0x8b, 0x1e, 0x0b, 0x1d, 0x8a, 0xc5,
+	0xfb, 0x31, 0x1f, 0xfc, 0x92, 0xa8, 0x24, 0x2d, 0x7a, 0x91, 0x86, 0xef, 0xfb, 0x3c, 0xbf, 0xe7,
+	0x79, 0x9f, 0xf7, 0xeb, 0xf9, 0x98, 0x81, 0x72, 0xc3, 0xb2, 0xb5, 0x27, 0x9a, 0xb9, 0xe0, 0xb8,
+	0x5a, 0x7d, 0xfb, 0xa2, 0xd6, 0x32, 0x2e, 0x12, 0x53, 0x57, 0x75, 0xb2, 0x63, 0xd4, 0xc9, 0x62,
+	0xcb, 0xb6, 0x5c, 0x0b, 0x65, 0x5c, 0xd7, 0x5c, 0x14, 0x74, 0x8b, 0x3b, 0x97, 0x0b, 0x95, 0x2d,
+	0xc3, 0x7d, 0xdc, 0xde, 0x58, 0xac, 0x5b, 0xcd, 0x8b, 0xc4, 0xdc, 0xb1, 0xf6, 0x5a, 0xb6, 0xb5,
+	0xbb, 0x77, 0x91, 0x11, 0xd7, 0x17, 0xb6, 0x88, 0xb9, 0xb0, 0xa3, 0x35, 0x0c, 0x5d, 0x73, 0xc9,
+	0xc5, 0xbe, 0x07, 0x0e, 0x59, 0x58, 0x08, 0x41, 0x6c, 0x59, 0x5b, 0x16, 0x67, 0xde, 0x68, 0x6f,
+	0xb2, 0x5f, 0xec, 0x07, 0x7b, 0x12, 0xe4, 0xc5, 0x2d, 0xcb, 0xda, 0x6a, 0x90, 0x80, 0x4a, 0x6f,
+	0xdb, 0x9a, 0x6b, 0x58, 0xa6, 0xe8, 0x9f, 0xeb, 0xed, 0xdf, 0x34, 0x48, 0x43, 0x57, 0x9b, 0x9a,
+	0xb3, 0x2d, 0x28, 0x4e, 0xf7, 0x52, 0x38, 0xae, 0xdd, 0xae, 0xbb, 0xa2, 0xb7, 0xd4, 0xdb, 0xeb,
+	0x1a, 0x4d, 0xe2, 0xb8, 0x5a, 0xb3, 0x35, 0x4c, 0x81, 0x27, 0xb6, 0xd6, 0x6a, 0x11, 0xdb, 0x11,
+	0xfd, 0xcf, 0xf7, 0x9b, 0xd1, 0xd0, 0x89, 0xe9, 0x1a, 0x9b, 0x46, 0x40, 0x74, 0xba, 0x9f, 0xe8,
+	0x3d, 0xcb, 0x30, 0x87, 0xf7, 0x6e, 0x93, 0x3d, 0x8f, 0xb7, 0xd4, 0xdf, 0xeb, 0xcd, 0x88, 0x30,
+	0x41, 0x3f, 0x41, 0x93, 0x38, 0x8e, 0xb6, 0x45, 0x0e, 0x81, 0x68, 0x19, 0x75, 0xb7, 0x6d, 0x93,
+	0xc3, 0x20, 0x5c, 0x4d, 0xd7, 0x5c, 0x8d, 0x53, 0x94, 0xff, 0x2a, 0x0e, 0x89, 0xdb, 0xc4, 0x71,
+	0x0c, 0xcb, 0x44, 0x0f, 0x20, 0xa9, 0x93, 0x1d, 0x55, 0xd3, 0x75, 0x5b, 0x8e, 0xce, 0x49, 0xf3,
+	0x69, 0xe5, 0xea, 0xa7, 0xfb, 0xa5, 0xc8, 0x6f, 0xf6, 0x4b, 0x5f, 0xd9, 0xb2, 0x16, 0xdd, 0xc7,
+	0xc4, 0x7d, 0x6c, 0x98, 0x5b, 0xce, 0xa2, 0x49, 0xdc, 0x27, 0x96, 0xbd, 0x7d, 0xb1, 0x1b, 0x7c,
+	0xe7, 0xf2, 0xc5, 0xd6, 0xf6, 0xd6, 0x45, 0x77, 0xaf, 0x45, 0x9c, 0xc5, 0x2a, 0xd9, 0xa9, 0xe8,
+	0xba, 0x8d, 0x13, 0x3a, 0x7f, 0x40, 0x15, 0x88, 0xd3, 0xb1, 0xcb, 0xb1, 0x39, 0x69, 0x7e, 0x62,
+	0xe9, 0xd4, 0x62, 0xf7, 0x02, 0x5c, 0x14, 0x2a, 0xdc, 0x20, 0x7b, 0x8e, 0x92, 0x3b, 0x50, 0xc6,
+	0x7e, 0x24, 0x45, 0x73, 0x12, 0x15, 0xfe, 0xd9, 0x7e, 0x49, 0xc2, 0x8c, 0x15, 0x3d, 0x07, 0x93,
+	0x0d, 0xcd, 0x71, 0xd5, 0x4d, 0xb5, 0x6e, 0xba, 0x6a, 0xbb, 0x25, 0xc7, 0xe7, 0xa4, 0xf9, 0x49,
+	0x0c, 0xb4, 0x71, 0x75, 0xd9, 0x74, 0xef, 0xb6, 0xd0, 0x3c, 0xe4, 0x19, 0x89, 0x29, 0x88, 0x74,
+	0xeb, 0x89, 0x29, 0x8f, 0x31, 0x32, 0xc6, 0x7b, 0x8b, 0xd2, 0x55, 0xad, 0x27, 0xa6, 0x4f, 0xa9,
+	0x85, 0x29, 0xc7, 0x03, 0xca, 0x8a, 0x4f, 0xb9, 0x08, 0xd3, 0x8c, 0xb2, 0x6e, 0x99, 0x9b, 0x61,
+	0xe2, 0x04, 0x23, 0xce, 0xd1, 0xbe, 0x65, 0xcb, 0xdc, 0xf4, 0xe9, 0x97, 0x01, 0x1c, 0x57, 0xb3,
+	0x5d, 0xa2, 0xab, 0x9a, 0x2b, 0x27, 0xd9, 0x78, 0x0b, 0x8b, 0x7c, 0xb5, 0x2d, 0x7a, 0xab, 0x6d,
+	0xf1, 0x8e, 0xb7, 0x1c, 0x95, 0x24, 0x1d, 0xe6, 0x8f, 0xff, 0xa7, 0x24, 0xe1, 0x94, 0xe0, 0xab,
+	0xb8, 0x88, 0xc0, 0xe9, 0xef, 0xb4, 0x49, 0x9b, 0x62, 0xb4, 0x5a, 0x0d, 0xa3, 0xce, 0xb6, 0x06,
+	0x93, 0xdb, 0x30, 0xcc, 0x6d, 0x47, 0x4e, 0xcd, 0xc5, 0xe6, 0x27, 0x96, 0x9e, 0xef, 0x35, 0x63,
+	0x25, 0x20, 0xae, 0x0a, 0x5a, 0x5c, 0xe0, 0x40, 0x03, 0xba, 0x9c, 0xeb, 0xf1, 0xa4, 0x94, 0x8b,
+	0x96, 0xff, 0x35, 0x07, 0x93, 0x6b, 0x95, 0xe5, 0x75, 0xcd, 0xd6, 0x9a, 0xc4, 0x25, 0xb6, 0x83,
+	0x5e, 0x84, 0x64, 0x53, 0xdb, 0x55, 0x89, 0x61, 0xb7, 0x64, 0x69, 0x4e, 0x9a, 0x8f, 0x2a, 0x13,
+	0x9d, 0xfd, 0x52, 0x62, 0x4d, 0xdb, 0x5d, 0xa9, 0xe1, 0x75, 0x9c, 0x68, 0x6a, 0xbb, 0x2b, 0x86,
+	0xdd, 0x42, 0xef, 0xc1, 0x94, 0xa6, 0xdb, 0x2a, 0x5d, 0x4f, 0xaa, 0xad, 0xb9, 0x44, 0x35, 0x4c,
+	0x9d, 0xec, 0xb2, 0x89, 0xc9, 0x2c, 0x9d, 0xe9, 0xd5, 0xae, 0xaa, 0xb9, 0x1a, 0xd6, 0x5c, 0x52,
+	0xa3, 0x44, 0xca, 0xe9, 0x03, 0x65, 0xec, 0xfb, 0x74, 0x9a, 0x3b, 0xfb, 0xa5, 0x5c, 0xa5, 0x8a,
+	0xbb, 0x7a, 0x71, 0x4e, 0xd3, 0xed, 0xae, 0x16, 0xf4, 0x26, 0x20, 0x2a, 0xcb, 0xdd, 0x55, 0x5b,
+	0xd6, 0x13, 0x62, 0x0b, 0x51, 0x6c, 0x72, 0x95, 0xc2, 0x81, 0x12, 0x3f, 0x1f, 0x95, 0xb3, 0x9d,
+	0xfd, 0x52, 0xb6, 0x52, 0xc5, 0x77, 0x76, 0xd7, 0x29, 0x09, 0x47, 0xca, 0x6a, 0xba, 0x1d, 0x6e,
+	0x40, 0x5f, 0x81, 0x34, 0x05, 0x32, 0x37, 0x54, 0xd7, 0xd6, 0x4c, 0x87, 0xcf, 0xba, 0x32, 0x13,
+	0x40, 0x40, 0xa5, 0x8a, 0x6f, 0x6d, 0xdc, 0xa1, 0x9d, 0x18, 0x34, 0xdd, 0x16, 0xcf, 0xe8, 0x35,
+	0x98, 0xa4, 0x8c, 0x5a, 0x7d, 0x5b, 0x6d, 0x18, 0x4d, 0xc3, 0xe5, 0x4b, 0x40, 0xc9, 0x77, 0xf6,
+	0x4b, 0x13, 0x95, 0x2a, 0xae, 0xd4, 0xb7, 0x6f, 0xb2, 0x66, 0x09, 0x4f, 0x68, 0xba, 0xed, 0xfd,
+	0x0c, 0xb3, 0xe9, 0xa4, 0xa1, 0xed, 0xc9, 0xc9, 0x5e, 0xb6, 0x2a, 0x6b, 0xf6, 0xd9, 0xd8, 0x4f,
+	0xf4, 0xff, 0x20, 0x65, 0xef, 0x5e, 0x12, 0x2c, 0x29, 0x66, 0xd1, 0xd9, 0x5e, 0x8b, 0xe2, 0x5d,
+	0x46, 0xab, 0x24, 0x3d, 0x5b, 0xe2, 0xa4, 0xbd, 0x7b, 0x89, 0xf3, 0x7f, 0x15, 0xa6, 0x19, 0xbf,
+	0x3f, 0x37, 0xd6, 0xe6, 0xa6, 0x43, 0x5c, 0x19, 0x98, 0xf4, 0x04, 0x1f, 0x6e, 0x02, 0xe7, 0x29,
+	0x83, 0x30, 0xf4, 0x5b, 0x8c, 0x02, 0xdd, 0x83, 0x29, 0x7b, 0x77, 0xa9, 0x6f, 0x56, 0x27, 0x46,
+	0x99, 0xd5, 0x40, 0x93, 0x9c, 0xbd, 0xbb, 0xd4, 0x3d, 0x83, 0x8b, 0x30, 0x49, 0x71, 0x37, 0x6d,
+	0xf2, 0x9d, 0x36, 0x31, 0xeb, 0x7b, 0x72, 0x7a, 0x4e, 0x9a, 0x8f, 0x2b, 0xa9, 0x03, 0x65, 0x7c,
+	0x29, 0x3e, 0xff, 0xf1, 0xdf, 0x8f, 0xe3, 0xb4, 0xbd, 0xbb, 0xb4, 0xea, 0x75, 0xa3, 0xdb, 0x90,
+	0xa1, 0xab, 0x50, 0x6f, 0xbb, 0x7b, 0x6a, 0x7d, 0xaf, 0xde, 0x20, 0xf2, 0x24, 0x53, 0xa1, 0x7f,
+	0xd9, 0x6f, 0x6d, 0xd9, 0x64, 0x4b, 0x73, 0x89, 0x5e, 0x6d, 0xbb, 0x7b, 0xcb, 0x94, 0x34, 0xa4,
+	0x48, 0xba, 0xa9, 0xed, 0xfa, 0xed, 0x48, 0x87, 0x59, 0x9b, 0xd0, 0x43, 0x5a, 0xa5, 0x37, 0x82,
+	0xda, 0x22, 0xb6, 0x61, 0xe9, 0x46, 0xdd, 0x70, 0xf7, 0xe4, 0x0c, 0x43, 0x2f, 0xf7, 0x19, 0x99,
+	0x91, 0xd3, 0x0d, 0xbb, 0xb2, 0xdb, 0xb2, 0x4c, 0x62, 0xba, 0x21, 0xf0, 0x19, 0xdb, 0xef, 0x5d,
+	0x0f, 0xa0, 0xd0, 0x16, 0xc8, 0x42, 0x4a, 0xdd, 0x6a, 0x9b, 0x6e, 0x97, 0x98, 0xec, 0xe0, 0x41,
+	0x70, 0x31, 0xcb, 0x94, 0x7c, 0x80, 0x9c, 0x13, 0x76, 0xd0, 0x1d, 0x16, 0xf4, 0x75, 0x98, 0x6a,
+	0x19, 0xe6, 0x96, 0xea, 0x34, 0x2c, 0x37, 0x64, 0xd9, 0x1c, 0xb3, 0xec, 0xc4, 0x81, 0x92, 0x5c,
+	0x1a, 0x97, 0x23, 0xcc, 0xb6, 0x79, 0x4a, 0x77, 0xbb, 0x61, 0xb9, 0x81, 0x81, 0x1f, 0xc2, 0xc9,
+	0x80, 0xb9, 0x77, 0xba, 0xf3, 0xa3, 0x4c, 0x77, 0x54, 0x96, 0xf0, 0x8c, 0x07, 0xdc, 0x3d, 0xdb,
+	0xaf, 0x43, 0x6e, 0x83, 0x68, 0x75, 0xcb, 0x0c, 0xa9, 0x85, 0xfa, 0xd5, 0xca, 0x72, 0xa2, 0x40,
+	0xa9, 0x1b, 0x90, 0xac, 0x3f, 0xd6, 0x4c, 0x93, 0x34, 0x1c, 0x79, 0x8a, 0x1d, 0x73, 0x2f, 0xf4,
+	0xea, 0xd0, 0x75, 0x58, 0x2d, 0x2e, 0x73, 0x6a, 0x66, 0xac, 0x8f, 0xa4, 0x68, 0x52, 0xc2, 0x3e,
+	0x00, 0x5a, 0x85, 0x7c, 0xbb, 0x45, 0xcf, 0x3a, 0x55, 0x7f, 0x42, 0x1a, 0x0d, 0x36, 0xe7, 0xf2,
+	0xf4, 0x90, 0x33, 0x59, 0xb1, 0xac, 0xc6, 0x3d, 0xad, 0xd1, 0x26, 0x38, 0xcb, 0x99, 0xaa, 0x94,
+	0x87, 0x4e, 0x2d, 0xba, 0x0e, 0x53, 0xde, 0xe1, 0x1b, 0x46, 0x9a, 0x39, 0x12, 0x29, 0xef, 0xb1,
+	0x05, 0x58, 0x3b, 0x70, 0xa2, 0xeb, 0x18, 0x51, 0x89, 0x98, 0x6e, 0xf9, 0x04, 0x83, 0x9b, 0xef,
+	0x5b, 0xde, 0xc1, 0xd9, 0xe2, 0xad, 0x0c, 0x06, 0xae, 0xcc, 0x76, 0xf6, 0x4b, 0x53, 0x03, 0x7a,
+	0xf1, 0x54, 0xe8, 0xfc, 0xf1, 0x1a, 0xc3, 0x72, 0xd9, 0xa1, 0x12, 0xc8, 0x9d, 0x3d, 0x4c, 0x2e,
+	0x3b, 0x4d, 0x86, 0xca, 0xed, 0xea, 0xf5, 0xe4, 0x76, 0x35, 0xa2, 0x2d, 0x28, 0x0d, 0x5d, 0x65,
+	0xea, 0x0e, 0x05, 0x94, 0x65, 0xa6, 0x40, 0xf9, 0xd0, 0xb5, 0xc6, 0xed, 0x59, 0x18, 0xb8, 0xd8,
+	0x58, 0x5f, 0xe1, 0x57, 0x51, 0x48, 0x88, 0xc5, 0x80, 0x5e, 0x85, 0x9c, 0x98, 0xf8, 0x60, 0xf5,
+	0x49, 0xbd, 0xc7, 0x8d, 0x98, 0xe6, 0x60, 0xed, 0x7d, 0x15, 0x90, 0x3f, 0xcd, 0x01, 0x5f, 0xb4,
+	0x97, 0xcf, 0x9f, 0xd4, 0x80, 0xf3, 0x1e, 0x4c, 0x35, 0x0d, 0xb3, 0x6f, 0x13, 0xc5, 0x8e, 0x79,
+	0x66, 0x36, 0x0d, 0xb3, 0x7b, 0x17, 0x51, 0x5c, 0x6d, 0xb7, 0x0f, 0x37, 0x7e, 0x5c, 0x5c, 0x6d,
+	0xb7, 0x1b, 0xf7, 0x79, 0x98, 0x24, 0xa6, 0xb6, 0xd1, 0x20, 0x2a, 0xb7, 0x01, 0xbb, 0x48, 0x93,
+	0x38, 0xcd, 0x1b, 0xef, 0xb2, 0xb6, 0x2b, 0xf1, 0x4f, 0x3e, 0x2e, 0x45, 0xf8, 0xdf, 0xeb, 0xf1,
+	0x64, 0x34, 0x17, 0xbb, 0x1e, 0x4f, 0xc6, 0x72, 0xf1, 0x72, 0x13, 0x32, 0x2b, 0xa6, 0x5e, 0x65,
+	0x11, 0x85, 0x62, 0x6b, 0xa6, 0x8e, 0x4e, 0x40, 0xd4, 0xd0, 0x99, 0x81, 0x53, 0xca, 0x78, 0x67,
+	0xbf, 0x14, 0xad, 0x55, 0x71, 0xd4, 0xd0, 0x11, 0x82, 0xb8, 0xa9, 0x35, 0x09, 0x33, 0x61, 0x0a,
+	0xb3, 0x67, 0x74, 0x12, 0x62, 0x6d, 0xbb, 0xc1, 0x4c, 0x93, 0x52, 0x12, 0x9d, 0xfd, 0x52, 0xec,
+	0x2e, 0xbe, 0x89, 0x69, 0x1b, 0x9a, 0x86, 0xb1, 0x86, 0xb5, 0x65, 0x39, 0x72, 0x7c, 0x2e, 0x36,
+	0x9f, 0xc2, 0xfc, 0x47, 0xf9, 0xdf, 0xa4, 0x90, 0xbc, 0x35, 0x4b, 0x27, 0x0d, 0xb4, 0x06, 0xc9,
+	0x0d, 0x2a, 0x58, 0xf5, 0xa5, 0x2e, 0x1d, 0x28, 0x67, 0xed, 0xb2, 0x7c, 0x76, 0xa9, 0xf8, 0xee,
+	0x43, 0x6d, 0xe1, 0xbb, 0xaf, 0x2c, 0x7c, 0xed, 0x9d, 0xf9, 0xab, 0x57, 0x1e, 0x2e, 0xbc, 0x73,
+	0xd5, 0xfb, 0x79, 0xee, 0xfd, 0xa5, 0x0b, 0x1f, 0x9c, 0xa5, 0x7e, 0x0c, 0xd3, 0xb9, 0x56, 0xc5,
+	0x09, 0x86, 0x51, 0xd3, 0xd1, 0x1b, 0x4c, 0x7d, 0xa6, 0xa4, 0xb2, 0x30, 0x3a, 0x50, 0xef, 0x28,
+	0x63, 0xc1, 0x28, 0xcb, 0xff, 0x18, 0x85, 0x53, 0xbe, 0xd2, 0xf7, 0x88, 0x4d, 0xdd, 0xdb, 0x5a,
+	0x10, 0x40, 0x7c, 0xd9, 0x23, 0x58, 0x83, 0x64, 0x93, 0x5a, 0x46, 0xf5, 0xc7, 0x71, 0x1c, 0x38,
+	0x66, 0x54, 0x0a, 0xc7, 0x30, 0x6a, 0x3a, 0x3a, 0x07, 0xb9, 0xc7, 0x9a, 0xad, 0x3f, 0xd1, 0x6c,
+	0xa2, 0xee, 0x70, 0xe5, 0xc5, 0xe8, 0xb2, 0x5e, 0xbb, 0x18, 0x13, 0x25, 0xdd, 0x34, 0xec, 0x66,
+	0x17, 0x69, 0x9c, 0x93, 0x7a, 0xed, 0x82, 0xb4, 0xfc, 0xab, 0x71, 0xc8, 0xf5, 0xda, 0x04, 0xbd,
+	0x05, 0x31, 0x43, 0x77, 0x98, 0x0d, 0x26, 0x96, 0x5e, 0xee, 0x5d, 0xd1, 0x87, 0x98, 0x70, 0x40,
+	0xa0, 0x40, 0x91, 0x90, 0x0a, 0x59, 0x01, 0xe0, 0xeb, 0x13, 0x65, 0xdb, 0xa5, 0x30, 0xe0, 0x1e,
+	0x11, 0xb0, 0x4a, 0xc1, 0xdb, 0x2b, 0x9d, 0xfd, 0x52, 0xe6, 0xa6, 0x85, 0xb5, 0xfb, 0x95, 0x5b,
+	0xa2, 0x0f, 0x67, 0x04, 0x8b, 0xa7, 0xb1, 0x01, 0x53, 0x9e, 0x80, 0xd6, 0xe3, 0xbd, 0x2e, 0xfb,
+	0x0c, 0x10, 0xb2, 0x7e, 0xed, 0x6d, 0x4f, 0xc8, 0x99, 0x90, 0x90, 0xbc, 0x10, 0x12, 0x74, 0xe3,
+	0xbc, 0xe0, 0x5a, 0x7f, 0xbc, 0xe7, 0x89, 0x5a, 0x85, 0xbc, 0x7f, 0x0e, 0xa9, 0xad, 0x86, 0x66,
+	0xd2, 0xf9, 0x65, 0xd6, 0x65, 0x3e, 0xaf, 0x1d, 0x95, 0xbf, 0x49, 0x7d, 0x5e, 0xff, 0x1c, 0x5a,
+	0x6f, 0x68, 0x66, 0xad, 0x8a, 0xb3, 0x9b, 0x5d, 0x0d, 0x74, 0x7f, 0x8e, 0xb7, 0x1e, 0x5b, 0xae,
+	0xe5, 0xc8, 0x63, 0x6c, 0x67, 0x89, 0x5f, 0x68, 0x1e, 0x72, 0x4e, 0xbb, 0xd5, 0xb2, 0x6c, 0xd7,
+	0x51, 0xeb, 0x0d, 0xcd, 0x71, 0xd4, 0x0d, 0xe6, 0x0f, 0x27, 0x71, 0xc6, 0x6b, 0x5f, 0xa6, 0xcd,
+	0xca, 0x00, 0xca, 0xba, 0x9c, 0x18, 0x40, 0xb9, 0x8c, 0x08, 0x4c, 0xeb, 0x64, 0x53, 0x6b, 0x37,
+	0x5c, 0xb5, 0xa9, 0xd5, 0x55, 0x87, 0xb8, 0x2e, 0x0d, 0x1b, 0xe5, 0xe4, 0xe0, 0xd0, 0x6f, 0xad,
+	0xb2, 0x7c, 0x5b, 0x90, 0x28, 0x27, 0x3a, 0xfb, 0x25, 0x54, 0xe5, 0xcc, 0xa1, 0x76, 0x8c, 0x04,
+	0xe0, 0x9a, 0x56, 0xf7, 0xda, 0xe8, 0x09, 0x46, 0x4f, 0xdc, 0xe0, 0x98, 0xa6, 0x3e, 0x72, 0x1c,
+	0xa7, 0x9b, 0x46, 0xc8, 0x99, 0xa0, 0x44, 0xda, 0x6e, 0x88, 0x08, 0x04, 0x91, 0xb6, 0xdb, 0x45,
+	0xe4, 0x0f, 0x8d, 0x3a, 0x59, 0xcc, 0xd3, 0x4d, 0xe2, 0xb4, 0xd7, 0x78, 0xdd, 0x32, 0x4c, 0x74,
+	0x01, 0x90, 0x4d, 0x1c, 0x22, 0x48, 0x54, 0xd3, 0x32, 0xeb, 0xc4, 0x61, 0x1e, 0x6c, 0x12, 0xe7,
+	0x78, 0x0f, 0xa5, 0xbb, 0xc5, 0xda, 0x11, 0x01, 0x4f, 0x65, 0x75, 0xd3, 0xb2, 0x9b, 0x9a, 0x4b,
+	0x3d, 0x15, 0x79, 0x72, 0xf0, 0x3d, 0xbb, 0xc6, 0xa3, 0xfa, 0x75, 0x6d, 0xaf, 0x61, 0x69, 0xfa,
+	0xaa, 0x4f, 0xaf, 0xa4, 0xc3, 0x0b, 0x1c, 0xe7, 0x05, 0x62, 0x40, 0xc0, 0x8f, 0xe6, 0xf2, 0xbf,
+	0x4c, 0xc3, 0x44, 0xc8, 0x5a, 0xe8, 0x4d, 0xc8, 0x8a, 0xb9, 0x64, 0x5e, 0x8a, 0xd5, 0x76, 0xc5,
+	0xee, 0x3a, 0xd9, 0xe7, 0xa8, 0x54, 0x45, 0xd6, 0x45, 0x89, 0xff, 0x84, 0x46, 0xa0, 0x93, 0x8c,
+	0x4f, 0xb9, 0xc3, 0xb9, 0xd0, 0x7d, 0x98, 0x09, 0x6e, 0xee, 0xb0, 0x0b, 0x1b, 0x65, 0x70, 0x7d,
+	0x2e, 0xec, 0xba, 0xb8, 0x9b, 0xb9, 0x83, 0xca, 0x2f, 0xec, 0xa9, 0x56, 0x57, 0x23, 0xf7, 0x5a,
+	0x1f, 0x1d, 0xe6, 0x78, 0xc6, 0x46, 0x76, 0x06, 0x86, 0x78, 0x9e, 0xf7, 0x07, 0xfb, 0xc4, 0x71,
+	0x86, 0x7b, 0xba, 0xcf, 0x06, 0x77, 0x6b, 0xa6, 0xfb, 0xfa, 0xab, 0xdc, 0xb3, 0x09, 0x5f, 0xf2,
+	0xfd, 0xfe, 0x32, 0x1e, 0xe0, 0xd2, 0x9e, 0x3c, 0x1e, 0x6a, 0x9f, 0xbb, 0xeb, 0x4f, 0x56, 0xdd,
+	0x9f, 0xac, 0xb1, 0xe3, 0x4c, 0xd6, 0xb2, 0x37, 0x59, 0x5f, 0x0b, 0xc7, 0x8b, 0xe3, 0x42, 0xab,
+	0xc1, 0xf1, 0x22, 0xb7, 0x5e, 0x10, 0x2a, 0xde, 0x1b, 0x12, 0x2a, 0x26, 0x0e, 0x19, 0xdb, 0xe5,
+	0x25, 0x3e, 0xb6, 0xc3, 0x02, 0xc9, 0x6f, 0x0d, 0x0e, 0x24, 0x93, 0x23, 0x4f, 0x70, 0x7f, 0x0c,
+	0x79, 0xb3, 0x37, 0x86, 0x4c, 0x1d, 0xcf, 0xfe, 0xdd, 0x11, 0xe6, 0x37, 0xa0, 0xb0, 0xa9, 0xd5,
+	0x5d, 0xcb, 0xde, 0x53, 0x5b, 0x6c, 0x0f, 0xfb, 0xc0, 0x06, 0x71, 0x64, 0x98, 0x8b, 0xcd, 0xc7,
+	0xb1, 0x2c, 0x28, 0xd6, 0x19, 0xc1, 0x6a, 0xd0, 0x8f, 0x6e, 0xf5, 0xc5, 0xa7, 0x13, 0x43, 0x1c,
+	0xe9, 0xfe, 0xf8, 0x94, 0x8f, 0xaf, 0x3b, 0x34, 0xad, 0xc3, 0x8c, 0x7f, 0x0e, 0x5d, 0x5e, 0x52,
+	0x37, 0x0c, 0x91, 0xeb, 0x92, 0xd3, 0x47, 0x85, 0x19, 0xca, 0x0c, 0xbd, 0x51, 0x6e, 0x0b, 0xe6,
+	0xcb, 0x4b, 0x8a, 0xc1, 0x32, 0x62, 0x38, 0xef, 0xf4, 0x36, 0xa1, 0xab, 0x90, 0x68, 0x3b, 0x44,
+	0xd5, 0x74, 0x5b, 0x9e, 0x3c, 0x12, 0x16, 0x3a, 0xfb, 0xa5, 0xf1, 0xbb, 0x0e, 0xa9, 0x54, 0x31,
+	0x1e, 0x6f, 0x3b, 0xa4, 0xa2, 0xdb, 0xa8, 0x06, 0x34, 0x27, 0xa2, 0x36, 0x35, 0x7b, 0xcb, 0x30,
+	0xe5, 0x8c, 0x38, 0xd4, 0x7b, 0x31, 0x56, 0x1b, 0x96, 0x26, 0xa2, 0x85, 0xc9, 0xce, 0x7e, 0x29,
+	0x55, 0xa9, 0xe2, 0x35, 0xc6, 0x81, 0x53, 0x9a, 0x6e, 0xf3, 0x47, 0xf4, 0x0d, 0x48, 0x8b, 0x33,
+	0x95, 0x8f, 0x33, 0x7b, 0x64, 0x38, 0x05, 0x9c, 0x9e, 0x8d, 0xe4, 0x3e, 0xcc, 0x3a, 0xae, 0xe6,
+	0xb6, 0x9d, 0xfe, 0x48, 0x3e, 0x37, 0xda, 0x0e, 0x9a, 0xe1, 0xfc, 0xbd, 0xc1, 0xfb, 0x3d, 0x90,
+	0x05, 0x70, 0x7f, 0xf0, 0x9e, 0x3f, 0x7a, 0x4b, 0xe0, 0x13, 0x9c, 0xbb, 0x2f, 0x56, 0xbf, 0x06,
+	0x79, 0x9d, 0x38, 0x86, 0x4d, 0x74, 0x35, 0xd8, 0xa9, 0x68, 0x84, 0x9d, 0x9a, 0x15, 0x6c, 0xd8,
+	0xdb, 0xb0, 0x8f, 0xe0, 0x74, 0x17, 0x52, 0xef, 0xc6, 0x9d, 0x1a, 0x41, 0x4b, 0x39, 0x04, 0xda,
+	0xbd, 0x6d, 0xbf, 0x0d, 0xa7, 0x02, 0xf4, 0xfe, 0xed, 0x3b, 0x3d, 0xf2, 0xf6, 0x9d, 0xf5, 0x45,
+	0xf4, 0xec, 0xe2, 0x87, 0x30, 0x13, 0x96, 0x10, 0xec, 0xe6, 0x99, 0xe3, 0xed, 0xe6, 0xa9, 0x40,
+	0x40, 0xb0, 0xa9, 0xdf, 0x81, 0x13, 0x1e, 0x78, 0xcf, 0xf6, 0x3c, 0x71, 0xcc, 0xed, 0xe9, 0xc1,
+	0xaf, 0x85, 0x77, 0xe9, 0xdf, 0x49, 0x50, 0xf4, 0xf0, 0x87, 0xc4, 0xf1, 0xb3, 0xc7, 0x8c, 0xe3,
+	0x8b, 0x9d, 0xfd, 0x52, 0xa1, 0xca, 0x31, 0x07, 0x10, 0xe1, 0x82, 0x90, 0x57, 0x19, 0x10, 0xd5,
+	0x0f, 0x52, 0xa7, 0x27, 0xbc, 0x97, 0x8f, 0x19, 0xde, 0xf7, 0xab, 0xd3, 0x45, 0xd4, 0xa3, 0x4e,
+	0x57, 0x1f, 0xda, 0x86, 0xe7, 0x3c, 0x6d, 0x86, 0xdf, 0xf0, 0xa7, 0x46, 0x5e, 0x41, 0xde, 0x32,
+	0x5f, 0x1f, 0x78, 0xd1, 0x6f, 0xc2, 0xa9, 0x7e, 0x61, 0xc1, 0x62, 0x3a, 0x7d, 0xbc, 0xc5, 0x24,
+	0xf7, 0xc8, 0x0a, 0x56, 0x94, 0x06, 0x5e, 0x9f, 0xda, 0x77, 0xff, 0x9f, 0x39, 0x9e, 0x10, 0x6f,
+	0x69, 0x2a, 0x3d, 0x6e, 0x80, 0x2a, 0x92, 0xc4, 0x8d, 0x2d, 0xcb, 0x36, 0xdc, 0xc7, 0x4d, 0xb9,
+	0xc8, 0x9c, 0xfc, 0x2b, 0x07, 0xca, 0x4b, 0xf6, 0x0b, 0xf2, 0xd9, 0xa5, 0xe7, 0x0e, 0x0f, 0xe2,
+	0xbe, 0xf7, 0x2e, 0x8d, 0xe3, 0xd2, 0x74, 0xb6, 0x3c, 0x04, 0x4c, 0xb3, 0xdc, 0xfe, 0xaf, 0xf2,
+	0x7f, 0x4c, 0x41, 0x92, 0x3a, 0x89, 0xae, 0xe6, 0x12, 0xf4, 0x00, 0x50, 0xbd, 0x6d, 0xdb, 0x84,
+	0x1e, 0x6e, 0x7e, 0x22, 0x4d, 0x38, 0x89, 0x67, 0x0e, 0xcd, 0xb6, 0xf5, 0xfa, 0xa4, 0x02, 0x26,
+	0x20, 0xa0, 0xd8, 0xfe, 0xa4, 0x04, 0xd8, 0xd1, 0xcf, 0x81, 0xed, 0xcd, 0x47, 0x80, 0xad, 0x40,
+	0x9a, 0xd7, 0x31, 0x79, 0x08, 0x22, 0x42, 0xae, 0x99, 0x5e, 0x54, 0x1e, 0xb2, 0x04, 0xe9, 0x8f,
+	0x09, 0xce, 0xc4, 0x9a, 0x07, 0x85, 0x87, 0xf1, 0x2f, 0x35, 0x3c, 0x7c, 0x07, 0x0a, 0x7e, 0xc1,
+	0xc8, 0xb0, 0x9b, 0x44, 0xf7, 0xeb, 0x36, 0xaa, 0xe6, 0x39, 0x77, 0x87, 0x15, 0x84, 0xe2, 0xac,
+	0x18, 0x34, 0xeb, 0x15, 0x96, 0x18, 0x84, 0x57, 0xb2, 0xa9, 0xd0, 0x72, 0x82, 0xcc, 0xe0, 0x69,
+	0xa9, 0x4e, 0x5c, 0x53, 0x7e, 0x45, 0x8c, 0x17, 0xb0, 0xa6, 0x68, 0x7f, 0x95, 0xec, 0xdc, 0x66,
+	0xbd, 0xa2, 0x34, 0x36, 0xd4, 0x97, 0x4f, 0x7c, 0x41, 0x5f, 0x9e, 0xc0, 0xe9, 0x16, 0x31, 0x75,
+	0x8a, 0x3d, 0xa8, 0x56, 0x25, 0x27, 0x07, 0xe3, 0x0f, 0x2c, 0x55, 0x09, 0xa0, 0x01, 0x7d, 0x68,
+	0x05, 0x72, 0xa2, 0x22, 0x66, 0x13, 0xa7, 0x65, 0x99, 0x0e, 0xf1, 0xaa, 0x60, 0x83, 0xe6, 0x6d,
+	0xd9, 0x6a, 0x36, 0x35, 0x53, 0xc7, 0x59, 0xce, 0x83, 0x3d, 0x16, 0x0a, 0xe3, 0x69, 0xcb, 0xf6,
+	0x9e, 0xe3, 0x72, 0x3f, 0xef, 0x08, 0x18, 0xc1, 0x83, 0x05, 0x0b, 0xfa, 0x16, 0x20, 0xa1, 0x0d,
+	0x8b, 0x06, 0xb5, 0x7a, 0x9d, 0xb4, 0x5c, 0x79, 0x62, 0xf0, 0x50, 0xbd, 0x6d, 0xb7, 0x48, 0x03,
+	0xc4, 0x0a, 0x23, 0xc5, 0x62, 0x30, 0x41, 0x0b, 0x5a, 0x83, 0x69, 0x4f, 0x33, 0x86, 0x29, 0xd4,
+	0x93, 0xd3, 0x83, 0xc3, 0x66, 0xca, 0x29, 0xd4, 0xc1, 0x48, 0x30, 0x86, 0xda, 0xd0, 0x2b, 0xd4,
+	0xa7, 0x57, 0x9f, 0x18, 0xa6, 0x6e, 0x3d, 0x71, 0x54, 0x6d, 0x47, 0x33, 0x1a, 0x34, 0xb3, 0xc7,
+	0x9c, 0xbe, 0x24, 0x46, 0xf6, 0xee, 0x7d, 0xde, 0x55, 0xf1, 0x7a, 0x50, 0x15, 0x32, 0x36, 0xa9,
+	0x13, 0xb6, 0x92, 0x78, 0x95, 0x31, 0x33, 0x17, 0x1b, 0xb4, 0x69, 0x79, 0x76, 0x50, 0x44, 0xad,
+	0x78, 0x92, 0x33, 0xf1, 0x46, 0x07, 0x5d, 0x87, 0x9c, 0x40, 0x09, 0xaa, 0x95, 0x59, 0x86, 0x53,
+	0xea, 0x3b, 0xef, 0x05, 0x81, 0x87, 0x94, 0xe5, 0x8c, 0x5e, 0xb3, 0x83, 0x1a, 0x50, 0xe6, 0xe5,
+	0x5c, 0x5e, 0x70, 0x56, 0x0d, 0xd3, 0x70, 0x0d, 0x7a, 0x4f, 0x77, 0xed, 0xa8, 0xdc, 0x88, 0x3b,
+	0xaa, 0xc8, 0x2a, 0xc0, 0x1c, 0xaa, 0xe6, 0x21, 0x85, 0x36, 0xd6, 0x8f, 0x25, 0x28, 0xda, 0xe4,
+	0x3d, 0x52, 0x77, 0xc5, 0x55, 0xda, 0x73, 0x6d, 0x11, 0x47, 0xce, 0xcf, 0xc5, 0x8e, 0x4e, 0xbb,
+	0x2e, 0x1c, 0x28, 0xe9, 0x8f, 0xa4, 0x54, 0x2e, 0x5b, 0xf6, 0x8f, 0x8c, 0x02, 0x16, 0xb8, 0xbd,
+	0x75, 0x4e, 0xe2, 0xe0, 0x82, 0x27, 0xb3, 0xd2, 0x53, 0xf1, 0x24, 0x0e, 0x6a, 0xc2, 0x99, 0x2e,
+	0x8d, 0xba, 0x8b, 0x9f, 0xc4, 0x91, 0xd1, 0x5c, 0x6c, 0x7e, 0x52, 0x79, 0xf9, 0x40, 0x99, 0xf8,
+	0x48, 0x4a, 0xe6, 0xb2, 0x65, 0xaf, 0x84, 0x79, 0x32, 0x24, 0x30, 0x5c, 0xfc, 0x24, 0x0e, 0x3e,
+	0x19, 0x92, 0xd7, 0xdd, 0x85, 0x2a, 0x30, 0xed, 0x8b, 0x0b, 0x07, 0x42, 0xb4, 0x0c, 0x13, 0x57,
+	0x32, 0x5c, 0x4a, 0xd9, 0x77, 0xbe, 0x3c, 0xda, 0x70, 0x4c, 0x74, 0x1d, 0x72, 0xfc, 0x74, 0x0a,
+	0x4d, 0xd0, 0xf4, 0x88, 0x13, 0x94, 0x61, 0xe7, 0x56, 0x30, 0x21, 0x16, 0xf8, 0xba, 0x86, 0xe6,
+	0xc2, 0xd6, 0xcc, 0x2d, 0xe2, 0xc8, 0x33, 0x6c, 0x4d, 0xbd, 0x3a, 0x74, 0xaf, 0x79, 0x06, 0xf0,
+	0x4c, 0x8a, 0x19, 0xdb, 0x8a, 0xe9, 0xda, 0x7b, 0xac, 0x98, 0x36, 0xa0, 0xb3, 0xf0, 0x47, 0x09,
+	0x20, 0xb4, 0x23, 0x9f, 0x87, 0x44, 0x8b, 0xe7, 0x64, 0xd8, 0xd5, 0x98, 0x66, 0xf7, 0xf8, 0x77,
+	0xe3, 0xb9, 0xbc, 0xfc, 0x1c, 0xf6, 0x7a, 0xd0, 0x32, 0x24, 0xbc, 0x9d, 0x1a, 0x3d, 0x72, 0xa7,
+	0xf6, 0xdc, 0x70, 0x1e, 0x27, 0x7a, 0x63, 0xf4, 0xb7, 0x23, 0xba, 0x11, 0x18, 0x1b, 0xcb, 0x21,
+	0x58, 0xb6, 0x4d, 0x1a, 0xfc, 0xe8, 0x35, 0x74, 0x91, 0x40, 0x57, 0x8a, 0x07, 0x4a, 0xea, 0x23,
+	0x69, 0xbc, 0x4c, 0x13, 0x85, 0x3a, 0xbd, 0xba, 0x96, 0x03, 0xb2, 0x5a, 0xd5, 0xc1, 0x99, 0x10,
+	0x5b, 0x4d, 0x77, 0x0a, 0xbf, 0x90, 0x60, 0xb2, 0xcb, 0x26, 0xc3, 0xea, 0x1a, 0xd2, 0x9f, 0xa8,
+	0xae, 0x11, 0xfd, 0x82, 0x75, 0x8d, 0xc2, 0x03, 0xc8, 0x74, 0x4f, 0x2a, 0xba, 0x06, 0xe3, 0x62,
+	0xc9, 0x48, 0x6c, 0xc9, 0xbc, 0x38, 0x74, 0xc9, 0x74, 0x31, 0x86, 0xca, 0x89, 0x82, 0xbf, 0x60,
+	0xc3, 0xa9, 0x43, 0x56, 0x15, 0xca, 0x41, 0x6c, 0x9b, 0x88, 0x2a, 0x13, 0xa6, 0x8f, 0xe8, 0x0d,
+	0x18, 0xe3, 0xf5, 0x2d, 0xbe, 0x32, 0x5e, 0x1a, 0x4d, 0xb2, 0x83, 0x39, 0xd7, 0x95, 0xe8, 0x57,
+	0x25, 0x91, 0xe1, 0xfb, 0x4c, 0x0a, 0x15, 0x13, 0x2a, 0x6d, 0xf7, 0x31, 0x31, 0x5d, 0x71, 0x41,
+	0x2e, 0x5b, 0x3a, 0x41, 0x0b, 0x9e, 0x20, 0x5e, 0x49, 0x98, 0x3d, 0x50, 0xa6, 0x6d, 0xb4, 0x94,
+	0x7b, 0xf7, 0x61, 0x65, 0xe1, 0x01, 0x75, 0x12, 0xdf, 0xbf, 0x74, 0xe1, 0xf2, 0xd2, 0x07, 0x67,
+	0x05, 0x30, 0xba, 0x0a, 0xc0, 0xde, 0xde, 0x52, 0x37, 0x6d, 0xab, 0x29, 0x47, 0x47, 0xdc, 0x9e,
+	0x29, 0xc6, 0xb3, 0x6a, 0x5b, 0x4d, 0xf4, 0x75, 0x48, 0x72, 0x00, 0xd7, 0x92, 0x63, 0x23, 0xb2,
+	0x27, 0x18, 0xc7, 0x1d, 0x4b, 0x0c, 0xe9, 0x0f, 0x73, 0x90, 0xf2, 0x87, 0x84, 0xae, 0x85, 0x8b,
+	0x00, 0x67, 0x87, 0x16, 0x01, 0x46, 0xc8, 0xfe, 0x2f, 0x03, 0xd4, 0x6d, 0xa2, 0x89, 0xd7, 0x6f,
+	0xa2, 0xc7, 0x79, 0xfd, 0x46, 0xf0, 0x55, 0x5c, 0x0a, 0xd2, 0x6e, 0xe9, 0x1e, 0x48, 0xec, 0x38,
+	0x20, 0x82, 0xaf, 0xe2, 0xa2, 0x53, 0xa2, 0x2a, 0xc4, 0xd3, 0xf5, 0x09, 0x9e, 0xae, 0x5f, 0x12,
+	0x45, 0xb0, 0xf3, 0x30, 0xa1, 0x13, 0xa7, 0x6e, 0x1b, 0x2d, 0x3a, 0x89, 0xcc, 0x2b, 0x4c, 0xb1,
+	0x25, 0x67, 0xc7, 0xe4, 0xcf, 0xb2, 0x38, 0xdc, 0x89, 0x9e, 0x00, 0x68, 0xae, 0x6b, 0x1b, 0x1b,
+	0x6d, 0x97, 0xd0, 0xd7, 0x55, 0xe8, 0x2a, 0x3e, 0x37, 0xd4, 0x46, 0x8b, 0x15, 0x9f, 0x96, 0xad,
+	0x4b, 0xe5, 0xc2, 0x81, 0x72, 0xee, 0x9f, 0xa4, 0x17, 0xcb, 0x23, 0x55, 0x83, 0x70, 0x48, 0x14,
+	0x7a, 0x04, 0x13, 0xc2, 0x45, 0x66, 0x67, 0x4a, 0xe2, 0xf8, 0x25, 0x9a, 0x0c, 0x7d, 0x9d, 0xc6,
+	0x6b, 0xaf, 0x3a, 0x18, 0x76, 0x3c, 0x1a, 0x07, 0xd5, 0x00, 0x39, 0xc4, 0xa6, 0x8c, 0x6a, 0xcb,
+	0xb6, 0x36, 0x8d, 0x06, 0xa1, 0xc5, 0x8d, 0x24, 0xb3, 0xc4, 0xa9, 0xa0, 0xb8, 0x91, 0xbb, 0xcd,
+	0x89, 0xd6, 0x39, 0x4d, 0xad, 0x8a, 0x73, 0x4e, 0x77, 0x8b, 0x8e, 0xfe, 0x53, 0x82, 0x13, 0x9e,
+	0x93, 0x40, 0x3b, 0x89, 0xcd, 0xde, 0x62, 0x23, 0x8e, 0xc3, 0xf2, 0x83, 0x29, 0xe5, 0x1f, 0xa4,
+	0x03, 0xe5, 0x47, 0x92, 0xfd, 0x03, 0x69, 0xe9, 0xaf, 0xa5, 0x77, 0xe7, 0xaf, 0x5e, 0xa1, 0x63,
+	0xd7, 0x16, 0xbe, 0x2b, 0xb6, 0xc7, 0xf7, 0x42, 0xcf, 0xc1, 0xe3, 0xa3, 0x85, 0x77, 0xce, 0x87,
+	0x3a, 0xce, 0x3d, 0x5a, 0x3c, 0x77, 0x9e, 0xf2, 0x55, 0x16, 0x1e, 0x08, 0x93, 0x7d, 0x2f, 0xf4,
+	0x1c, 0x3c, 0x32, 0xbe, 0xa0, 0xe3, 0xdc, 0xfc, 0xd5, 0x2b, 0x57, 0x1e, 0x8a, 0x5d, 0xf8, 0xda,
+	0x07, 0xe7, 0xae, 0xd2, 0x60, 0x0d, 0x4f, 0x0b, 0x75, 0x6f, 0x33, 0x6d, 0x2b, 0x5c, 0x59, 0xf4,
+	0x00, 0xe4, 0x9e, 0x61, 0x6c, 0x93, 0x6d, 0xb5, 0xa1, 0x6d, 0x90, 0x86, 0x7c, 0x91, 0x0d, 0xe4,
+	0x39, 0xbe, 0x44, 0x3e, 0xcc, 0x75, 0xf6, 0x4b, 0x33, 0xb7, 0xc2, 0x18, 0x37, 0x56, 0x6e, 0xdc,
+	0xa4, 0x84, 0x78, 0xa6, 0x0b, 0xfa, 0x06, 0xd9, 0x66, 0xcd, 0xe8, 0xbf, 0x25, 0x28, 0x84, 0x1d,
+	0xf4, 0x1e, 0x3b, 0xc1, 0x5f, 0xa6, 0x9d, 0xe4, 0x90, 0xca, 0xdd, 0xb6, 0xda, 0x84, 0xd3, 0x03,
+	0x86, 0x13, 0xd8, 0xeb, 0x15, 0x36, 0xa0, 0x17, 0x42, 0xf6, 0x3a, 0x59, 0xe9, 0xc5, 0xf2, 0x6d,
+	0x76, 0xb2, 0x4f, 0x8c, 0x6f, 0x37, 0x0c, 0x33, 0x03, 0xe4, 0x18, 0xba, 0x7c, 0x89, 0x09, 0x28,
+	0x1e, 0x28, 0xde, 0xed, 0x3a, 0xd5, 0x87, 0x5f, 0xab, 0xe2, 0xa9, 0x3e, 0xe4, 0x9a, 0x8e, 0x7e,
+	0x21, 0xc1, 0x14, 0x73, 0xf2, 0x7b, 0x26, 0x61, 0xe2, 0x2f, 0x73, 0x12, 0xf2, 0x54, 0xd7, 0x6e,
+	0xeb, 0xbb, 0x90, 0x6a, 0x58, 0x7c, 0x54, 0xb4, 0x0a, 0x16, 0x1b, 0x94, 0x60, 0x0a, 0x8e, 0xa4,
+	0x9b, 0x1e, 0xe9, 0xe7, 0x39, 0x91, 0x02, 0x41, 0xe8, 0x12, 0x24, 0xc4, 0x0b, 0xae, 0xf2, 0x12,
+	0x3b, 0x8c, 0x66, 0xfb, 0xc3, 0x56, 0xd6, 0x8d, 0x3d, 0xba, 0x81, 0x15, 0xce, 0xc9, 0x91, 0x2b,
+	0x9c, 0x99, 0x81, 0x15, 0xce, 0x01, 0x29, 0x84, 0xec, 0x9f, 0xa3, 0xc2, 0x9c, 0xfb, 0x73, 0x55,
+	0x98, 0xf3, 0xc7, 0xaf, 0x30, 0xf7, 0x95, 0x63, 0xd1, 0x28, 0xe5, 0xd8, 0xa9, 0x51, 0xca, 0xb1,
+	0xd3, 0x23, 0x97, 0x63, 0x67, 0x86, 0x94, 0x63, 0x5f, 0x83, 0x94, 0x6d, 0x59, 0xae, 0xca, 0x9c,
+	0x6c, 0x9e, 0x05, 0x96, 0xfb, 0x32, 0xee, 0x96, 0xe5, 0x52, 0x0f, 0x1b, 0x27, 0x6d, 0xf1, 0x84,
+	0xde, 0x86, 0x71, 0x93, 0xb8, 0xd4, 0x20, 0xb3, 0xcc, 0xff, 0x57, 0x7e, 0xb3, 0x5f, 0x7a, 0xed,
+	0xb8, 0xaf, 0x42, 0xdf, 0x22, 0x6e, 0xad, 0xda, 0xd9, 0x2f, 0x8d, 0xb1, 0x07, 0x3c, 0x66, 0x12,
+	0xb7, 0xa6, 0xa3, 0xb7, 0x20, 0xdd, 0x55, 0x1c, 0x97, 0x8f, 0x2e, 0x8e, 0xd3, 0xb8, 0x2e, 0x5c,
+	0xe7, 0xc5, 0x13, 0xcd, 0x50, 0x39, 0x7c, 0x19, 0x52, 0x0c, 0xd0, 0xd5, 0x5c, 0x22, 0x9f, 0x1c,
+	0x3c, 0x44, 0xcf, 0xdf, 0x54, 0xd2, 0x9d, 0xfd, 0x92, 0x9f, 0x0d, 0xc4, 0x49, 0x8a, 0x43, 0x9f,
+	0xd0, 0xdb, 0x90, 0xf7, 0x72, 0x10, 0x01, 0xd8, 0x85, 0x23, 0xc0, 0xa6, 0xe8, 0xfa, 0x58, 0xe7,
+	0x6c, 0x3e, 0xa6, 0x97, 0x31, 0x59, 0xf3, 0xa0, 0x2f, 0x41, 0xc2, 0xe1, 0x61, 0x8c, 0x5c, 0x18,
+	0xbc, 0x75, 0x45, 0x94, 0x83, 0x3d, 0x3a, 0xf4, 0x4d, 0xf0, 0x50, 0x54, 0x8f, 0xf5, 0xd4, 0xe1,
+	0xac, 0x19, 0x41, 0x2f, 0x7e, 0xa3, 0xb3, 0x90, 0xf1, 0x73, 0x65, 0x6c, 0x89, 0xb0, 0x9c, 0xf0,
+	0x24, 0x4e, 0x8b, 0x0c, 0x19, 0x5b, 0x1e, 0xe8, 0x45, 0xc8, 0xb6, 0x1d, 0xa2, 0x07, 0x54, 0x8e,
+	0x7c, 0x86, 0xc6, 0xd5, 0x78, 0x92, 0x36, 0x7b, 0x64, 0xf4, 0xad, 0xe8, 0x2c, 0x43, 0x0b, 0x56,
+	0x9c, 0x5c, 0x0c, 0xde, 0x18, 0xf7, 0x97, 0x1b, 0xfa, 0x8a, 0xa0, 0xb3, 0xdf, 0x13, 0x05, 0xa4,
+	0x57, 0xe4, 0x12, 0xa5, 0x53, 0xe8, 0x25, 0x94, 0xbe, 0xa9, 0x39, 0x2e, 0xbe, 0xce, 0x8a, 0x43,
+	0xaf, 0x70, 0x45, 0xf0, 0x7b, 0xfc, 0x57, 0x3f, 0xe3, 0x25, 0x79, 0x6e, 0x20, 0xe3, 0xa5, 0x2e,
+	0xc6, 0x4b, 0xe8, 0x5d, 0x38, 0xd5, 0x9b, 0x13, 0xa4, 0xb9, 0x14, 0x63, 0x87, 0x3b, 0xb0, 0xcf,
+	0x1d, 0x27, 0xe7, 0xe8, 0x27, 0x0e, 0xb1, 0x40, 0xa8, 0xb8, 0x68, 0x05, 0x26, 0x78, 0xde, 0x81,
+	0xaf, 0x88, 0xf2, 0x90, 0x73, 0x88, 0x92, 0xf0, 0x35, 0x11, 0x84, 0x68, 0xd0, 0xf2, 0x5b, 0xd1,
+	0x43, 0x40, 0x1b, 0xec, 0xcd, 0x85, 0x3d, 0x9a, 0x81, 0xac, 0x13, 0xd3, 0xd5, 0xb6, 0x88, 0xfc,
+	0xfc, 0xd1, 0x25, 0xc4, 0xec, 0x81, 0x92, 0x06, 0x38, 0x13, 0x89, 0x7c, 0x78, 0x75, 0x21, 0x12,
+	0x89, 0x44, 0x70, 0x5e, 0xe0, 0xac, 0xfb, 0x30, 0xe8, 0x25, 0xc8, 0xfa, 0x49, 0x07, 0x51, 0x9c,
+	0x3c, 0x3b, 0x27, 0xcd, 0x8f, 0xe1, 0x8c, 0xd7, 0x2c, 0xaa, 0x8e, 0x1a, 0x3d, 0x3a, 0x28, 0x17,
+	0x4b, 0xa9, 0x78, 0xb9, 0xae, 0x17, 0x46, 0xc8, 0x75, 0x29, 0xd3, 0xd4, 0x1f, 0xc5, 0x8c, 0xb9,
+	0x52, 0xc5, 0xbc, 0xcf, 0xc1, 0x22, 0xe1, 0x55, 0xd1, 0x6d, 0xd1, 0x32, 0x20, 0x95, 0xf6, 0xe2,
+	0x97, 0x94, 0x4a, 0x7b, 0xe9, 0x73, 0xa6, 0xd2, 0x8e, 0xfa, 0xa0, 0x60, 0xfe, 0x4b, 0xf9, 0xa0,
+	0x00, 0x5d, 0x03, 0x08, 0xbd, 0xef, 0x72, 0xee, 0x78, 0xef, 0xbb, 0xe0, 0x10, 0x2f, 0xda, 0x80,
+	0x4c, 0xcb, 0xb6, 0x76, 0x0c, 0xba, 0x8f, 0xb9, 0xbf, 0x75, 0x9e, 0x5d, 0x4a, 0x5f, 0x3f, 0x56,
+	0x45, 0x64, 0x72, 0x3d, 0xc0, 0xa8, 0x55, 0xf1, 0x64, 0x08, 0xb2, 0xa6, 0xa3, 0x2a, 0xe4, 0xfd,
+	0x06, 0x7a, 0xca, 0xe8, 0x9a, 0xab, 0xc9, 0x2f, 0x8b, 0x23, 0xa6, 0x77, 0x39, 0xde, 0x66, 0x9f,
+	0x17, 0xe1, 0x5c, 0x98, 0x83, 0xc6, 0xed, 0xe8, 0x34, 0xa4, 0x9a, 0xed, 0x06, 0x8d, 0xc7, 0x1d,
+	0x57, 0x5e, 0x60, 0x37, 0x50, 0xd0, 0x80, 0xb6, 0xe0, 0x64, 0xbd, 0xa1, 0x19, 0x4d, 0x55, 0xeb,
+	0x0a, 0xdb, 0xd5, 0xba, 0xa5, 0x13, 0x79, 0xf1, 0x88, 0x88, 0xaa, 0x3f, 0xd4, 0xc7, 0xb3, 0x0c,
+	0xad, 0xbf, 0x03, 0x2d, 0xc2, 0x94, 0xb3, 0x6d, 0xb4, 0x54, 0x91, 0x98, 0x52, 0xeb, 0xf6, 0x5e,
+	0xcb, 0xb5, 0xe4, 0xcb, 0x4c, 0xa1, 0x3c, 0xed, 0x12, 0x06, 0x5f, 0x66, 0x1d, 0xe8, 0x21, 0x9c,
+	0x1e, 0x40, 0xaf, 0x5a, 0x3b, 0xc4, 0xb6, 0x0d, 0x9d, 0xc8, 0xaf, 0x1e, 0x59, 0x8c, 0x3f, 0xd9,
+	0x07, 0xfa, 0x96, 0x60, 0x2e, 0xbc, 0x01, 0xd9, 0x9e, 0x30, 0x34, 0x9c, 0x1e, 0x49, 0xf1, 0xf4,
+	0xc8, 0x74, 0x38, 0x3d, 0x92, 0x0a, 0x65, 0x3d, 0x0a, 0xf7, 0x20, 0xd3, 0xed, 0x32, 0x0e, 0xe0,
+	0x5e, 0xec, 0x4e, 0xae, 0xf4, 0xdd, 0x4f, 0x1e, 0x40, 0x7f, 0x36, 0xe5, 0x1a, 0x80, 0x6f, 0x61,
+	0x07, 0x5d, 0x81, 0x89, 0xe0, 0xfb, 0x38, 0x2f, 0x49, 0x74, 0x72, 0xe8, 0x94, 0x60, 0x20, 0x3e,
+	0x6f, 0x59, 0x87, 0x13, 0xcb, 0x2c, 0x69, 0x10, 0x74, 0x8b, 0x8c, 0xde, 0x75, 0x80, 0x00, 0xd5,
+	0x7f, 0xfd, 0x6a, 0x18, 0xe8, 0x80, 0x64, 0x46, 0xca, 0x17, 0x53, 0xfe, 0xa9, 0x04, 0x27, 0xee,
+	0xb2, 0xb4, 0xc2, 0x9f, 0x52, 0x0c, 0xcd, 0x0a, 0x05, 0x1f, 0xd9, 0x0d, 0xcd, 0x9c, 0xac, 0x52,
+	0x92, 0x35, 0xcd, 0xd9, 0x56, 0xe2, 0x14, 0x04, 0xa7, 0x36, 0xbd, 0x86, 0xf2, 0xbf, 0x4b, 0x30,
+	0xf5, 0x26, 0x71, 0xfb, 0x94, 0x7c, 0x04, 0x99, 0x40, 0x49, 0xf5, 0x8b, 0xe7, 0x79, 0xd2, 0x24,
+	0xa0, 0x73, 0xbe, 0xb8, 0xda, 0xff, 0x2b, 0xc1, 0x0b, 0x61, 0xb5, 0x43, 0xc2, 0x57, 0x2d, 0x7b,
+	0xe5, 0x6e, 0xcd, 0xf1, 0x06, 0x52, 0x87, 0x24, 0xbb, 0xfb, 0x49, 0xdb, 0x10, 0x19, 0xe1, 0x6b,
+	0xe2, 0x03, 0xb9, 0x63, 0x7b, 0x85, 0x2b, 0x77, 0x6b, 0xaf, 0xbf, 0x4a, 0x5f, 0xbd, 0xa5, 0x6e,
+	0xc3, 0xca, 0xdd, 0x1a, 0x4e, 0x50, 0xe4, 0x95, 0xb6, 0x81, 0xbe, 0x0d, 0xf4, 0xa3, 0x39, 0x26,
+	0x83, 0x7f, 0x84, 0xf7, 0xe6, 0x17, 0x95, 0x31, 0x5e, 0x25, 0x3b, 0x54, 0xc4, 0xb8, 0x4e, 0x76,
+	0x56, 0xda, 0x46, 0xf9, 0xa3, 0x18, 0xcc, 0xdc, 0x34, 0x9c, 0x60, 0xc4, 0xfe, 0x00, 0x35, 0xc8,
+	0x86, 0xaf, 0x87, 0x60, 0xaa, 0x5e, 0x3c, 0xe4, 0x62, 0x38, 0x7c, 0xb2, 0x32, 0x5a, 0x98, 0xf2,
+	0x8b, 0x4f, 0x17, 0xfa, 0x58, 0x82, 0x31, 0xcb, 0xd6, 0x89, 0x2d, 0xde, 0x20, 0xff, 0x5b, 0xe9,
+	0x40, 0xf9, 0x1b, 0xc9, 0xfe, 0xbe, 0x84, 0x23, 0x38, 0xe5, 0xaf, 0x31, 0x0c, 0x0b, 0xc1, 0xb3,
+	0x3f, 0x6b, 0x38, 0xb5, 0xe0, 0x3f, 0x7a, 0x56, 0xc6, 0xc9, 0x05, 0xef, 0x89, 0xa5, 0xe6, 0xf0,
+	0xd8, 0x02, 0xfb, 0x17, 0x4e, 0xc1, 0xe1, 0xf4, 0x42, 0xf8, 0x57, 0x28, 0xc3, 0x88, 0x27, 0x16,
+	0x42, 0x3f, 0xb8, 0x62, 0xa8, 0x08, 0x63, 0xfc, 0x03, 0x31, 0xf6, 0x85, 0x22, 0x73, 0x86, 0xce,
+	0xc7, 0xe4, 0xdf, 0x25, 0x30, 0x6f, 0xa6, 0xef, 0x8b, 0xb7, 0xa8, 0xe7, 0xc3, 0xbf, 0x4c, 0x64,
+	0xcf, 0xe5, 0x7f, 0x96, 0x60, 0xea, 0xf6, 0x80, 0xcd, 0xb3, 0x7a, 0xbc, 0x1d, 0xde, 0x5d, 0x1e,
+	0xf8, 0x32, 0x77, 0xf7, 0x7f, 0x49, 0x90, 0xf7, 0xe5, 0xdc, 0x21, 0xcd, 0x56, 0x83, 0xba, 0x74,
+	0x7f, 0x29, 0xea, 0xa1, 0x79, 0x98, 0x68, 0x6a, 0x2d, 0x56, 0xe2, 0xa6, 0x17, 0x45, 0x2c, 0x9c,
+	0x74, 0xd5, 0x31, 0x88, 0xbe, 0x1b, 0x64, 0xaf, 0xfc, 0x89, 0x04, 0xb3, 0x7d, 0x03, 0xe1, 0x5e,
+	0x88, 0x9f, 0xb3, 0x95, 0xba, 0xd9, 0x07, 0xe6, 0x6c, 0xa3, 0xe1, 0x9c, 0xed, 0xa7, 0x52, 0x77,
+	0xce, 0xf6, 0x0e, 0x64, 0x59, 0x46, 0x93, 0xec, 0xba, 0xc4, 0x74, 0x58, 0x96, 0x24, 0xc6, 0x4a,
+	0x32, 0x2f, 0x1f, 0x28, 0xf3, 0x1f, 0x49, 0x2f, 0xe4, 0x74, 0x59, 0x2a, 0x97, 0xec, 0x33, 0x4b,
+	0xa7, 0x68, 0x86, 0xe7, 0xd1, 0xa2, 0xe7, 0xbc, 0xbc, 0x7f, 0xe9, 0xc2, 0xa5, 0xd7, 0x3f, 0x38,
+	0xf7, 0xfe, 0xa5, 0x0b, 0x34, 0x5f, 0x9f, 0xa1, 0x18, 0x2b, 0x3e, 0x44, 0xf9, 0x8f, 0x12, 0xc8,
+	0x43, 0x54, 0x77, 0xd0, 0x07, 0x90, 0xe0, 0xfe, 0x93, 0x77, 0x89, 0xbd, 0x36, 0x74, 0x1e, 0x7a,
+	0x58, 0x17, 0xc5, 0xff, 0xcf, 0x93, 0x9d, 0xf1, 0x64, 0x16, 0xea, 0x90, 0x0e, 0xc3, 0x0c, 0xb8,
+	0xb1, 0x8f, 0x2a, 0x87, 0x0c, 0x51, 0x2f, 0x74, 0x81, 0x97, 0x7f, 0x20, 0x41, 0x69, 0xd9, 0x32,
+	0x77, 0x88, 0xed, 0xf6, 0x51, 0x7b, 0x3b, 0x66, 0x1d, 0x52, 0x5c, 0xa7, 0xe0, 0xd3, 0x8a, 0xcb,
+	0xa3, 0x7f, 0x0b, 0x91, 0xe4, 0x42, 0x6b, 0x55, 0x9c, 0xe4, 0x28, 0x35, 0xf6, 0x7d, 0x07, 0x73,
+	0x0d, 0xd9, 0x79, 0x8c, 0xd9, 0xf3, 0xf9, 0x55, 0x80, 0x20, 0xde, 0x41, 0x79, 0x98, 0x5c, 0x7f,
+	0xeb, 0xfe, 0x0a, 0x56, 0xef, 0xde, 0xba, 0x71, 0xeb, 0xad, 0xfb, 0xb7, 0x72, 0x91, 0xa0, 0x49,
+	0xa9, 0xdc, 0xb9, 0xb3, 0x82, 0xdf, 0xce, 0x49, 0x08, 0x41, 0x86, 0x37, 0xad, 0xfc, 0xff, 0x3b,
+	0x2b, 0xf8, 0x56, 0xe5, 0x66, 0x2e, 0xaa, 0xfc, 0x54, 0xfa, 0xf4, 0x69, 0x51, 0xfa, 0xec, 0x69,
+	0x51, 0xfa, 0xf5, 0xd3, 0x62, 0xe4, 0xb7, 0x4f, 0x8b, 0x91, 0xdf, 0x3d, 0x2d, 0x46, 0x7e, 0xff,
+	0xb4, 0x18, 0xf9, 0xc3, 0xd3, 0xa2, 0xf4, 0x61, 0xa7, 0x28, 0xfd, 0xb0, 0x53, 0x8c, 0xfc, 0xac,
+	0x53, 0x94, 0x7e, 0xde, 0x29, 0x46, 0x3e, 0xe9, 0x14, 0x23, 0xbf, 0xec, 0x14, 0x23, 0x9f, 0x76,
+	0x8a, 0xd2, 0x67, 0x9d, 0xa2, 0xf4, 0xeb, 0x4e, 0x31, 0xf2, 0xdb, 0x4e, 0x51, 0xfa, 0x5d, 0xa7,
+	0x18, 0xf9, 0x7d, 0xa7, 0x28, 0xfd, 0xa1, 0x53, 0x8c, 0x7c, 0xf8, 0xac, 0x18, 0xf9, 0xe1, 0xb3,
+	0xa2, 0xf4, 0xe3, 0x67, 0xc5, 0xc8, 0x4f, 0x9e, 0x15, 0xa5, 0x8f, 0x9f, 0x15, 0x23, 0x3f, 0x7b,
+	0x56, 0x8c, 0xfc, 0xfc, 0x59, 0x51, 0xfa, 0xe4, 0x59, 0x51, 0xfa, 0xe5, 0xb3, 0xa2, 0xf4, 0xe0,
+	0xe2, 0x31, 0x2e, 0x13, 0xd7, 0x6c, 0x6d, 0x6c, 0x8c, 0xb3, 0x4d, 0x78, 0xf9, 0xff, 0x06, 0x00,
+	0xa0, 0xc1, 0x51, 0x29, 0x5f, 0x40, 0x00, 0x00,
 }
 
 func (x PowerState) String() string {
@@ -3612,6 +3622,9 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
 		return false
 	}
+	if this.ADRAlgorithm != that1.ADRAlgorithm {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -5053,6 +5066,15 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ADRAlgorithm) > 0 {
+		i -= len(m.ADRAlgorithm)
+		copy(dAtA[i:], m.ADRAlgorithm)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ADRAlgorithm)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if m.DesiredBeaconFrequency != nil {
 		{
 			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
@@ -7139,6 +7161,7 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
+	this.ADRAlgorithm = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -7779,6 +7802,10 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredBeaconFrequency.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	l = len(m.ADRAlgorithm)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -8537,6 +8564,7 @@ func (this *MACSettings) String() string {
 		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
 		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
 		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`ADRAlgorithm:` + fmt.Sprintf("%v", this.ADRAlgorithm) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11836,6 +11864,38 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ADRAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -136,6 +136,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
+	"default_mac_settings.adr_algorithm",
 	"default_mac_settings.adr_margin",
 	"default_mac_settings.beacon_frequency",
 	"default_mac_settings.class_b_timeout",
@@ -208,6 +209,7 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"supports_join",
 }
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -250,6 +252,7 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"beacon_frequency",
 	"class_b_timeout",
@@ -577,6 +580,7 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
 	"mac_settings.adr_margin",
 	"mac_settings.beacon_frequency",
 	"mac_settings.class_b_timeout",
@@ -1263,6 +1267,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -1891,6 +1896,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -2561,6 +2567,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -3191,6 +3198,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.class_b_timeout",
@@ -1125,6 +1125,16 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.DesiredBeaconFrequency = nil
 			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRAlgorithm = src.ADRAlgorithm
+			} else {
+				var zero string
+				dst.ADRAlgorithm = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
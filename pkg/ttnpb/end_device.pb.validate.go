@@ -1274,6 +1274,22 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 
 			}
 
+		case "adr_algorithm":
+
+			if utf8.RuneCountInString(m.GetADRAlgorithm()) > 36 {
+				return MACSettingsValidationError{
+					field:  "adr_algorithm",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_MACSettings_ADRAlgorithm_Pattern.MatchString(m.GetADRAlgorithm()) {
+				return MACSettingsValidationError{
+					field:  "adr_algorithm",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1338,6 +1354,8 @@ var _ interface {
 	ErrorName() string
 } = MACSettingsValidationError{}
 
+var _MACSettings_ADRAlgorithm_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
 // ValidateFields checks the field values on MACState with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -94,6 +94,9 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.ApplicationPackageRegistry/ListDefaultAssociations": ApplicationPackageDefaultAssociationFieldPathsNested,
 	"/ttn.lorawan.v3.ApplicationPackageRegistry/SetDefaultAssociation":   ApplicationPackageDefaultAssociationFieldPathsNested,
 
+	// Application FUOTA Sessions:
+	"/ttn.lorawan.v3.ApplicationFUOTASessions/Get": FUOTASessionFieldPathsNested,
+
 	// Application Links:
 	"/ttn.lorawan.v3.As/GetLink": ApplicationLinkFieldPathsNested,
 	"/ttn.lorawan.v3.As/SetLink": ApplicationLinkFieldPathsNested,
@@ -249,6 +252,7 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
+		"mac_settings.adr_algorithm",
 		"mac_settings.adr_margin",
 		"mac_settings.beacon_frequency",
 		"mac_settings.class_b_timeout",
@@ -744,6 +748,7 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
+		"mac_settings.adr_algorithm",
 		"mac_settings.adr_margin",
 		"mac_settings.beacon_frequency",
 		"mac_settings.class_b_timeout",
@@ -855,6 +860,10 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.GatewayRegistry/List":                GatewayFieldPathsNested,
 	"/ttn.lorawan.v3.GatewayRegistry/Update":              GatewayFieldPathsNested,
 
+	// Multicast Groups:
+	"/ttn.lorawan.v3.NsMulticastGroupRegistry/Get": MulticastGroupFieldPathsNested,
+	"/ttn.lorawan.v3.NsMulticastGroupRegistry/Set": MulticastGroupFieldPathsNested,
+
 	// Organizations:
 	"/ttn.lorawan.v3.OrganizationRegistry/Get":                 OrganizationFieldPathsNested,
 	"/ttn.lorawan.v3.OrganizationRegistry/List":                OrganizationFieldPathsNested,
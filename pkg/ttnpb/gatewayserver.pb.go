@@ -5,6 +5,7 @@ package ttnpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -242,6 +243,408 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
+type GetGatewayConnectionHistoryRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Start of the history. If not set, the history is returned from the oldest retained bucket.
+	Start *time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start,omitempty"`
+	// End of the history. If not set, the history is returned until now.
+	End *time.Time `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end,omitempty"`
+	// Requested resolution of the history.
+	// The history is returned with the finest retained resolution that is equal to or coarser than this resolution.
+	// If not set, the finest resolution is used.
+	Resolution           time.Duration `protobuf:"bytes,4,opt,name=resolution,proto3,stdduration" json:"resolution"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetGatewayConnectionHistoryRequest) Reset()      { *m = GetGatewayConnectionHistoryRequest{} }
+func (*GetGatewayConnectionHistoryRequest) ProtoMessage() {}
+func (*GetGatewayConnectionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
+}
+func (m *GetGatewayConnectionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayConnectionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayConnectionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetGatewayConnectionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayConnectionHistoryRequest.Merge(m, src)
+}
+func (m *GetGatewayConnectionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayConnectionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayConnectionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayConnectionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetGatewayConnectionHistoryRequest) GetStart() *time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionHistoryRequest) GetEnd() *time.Time {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionHistoryRequest) GetResolution() time.Duration {
+	if m != nil {
+		return m.Resolution
+	}
+	return 0
+}
+
+// GatewayConnectionHistory is the traffic, status and connection history of a gateway.
+type GatewayConnectionHistory struct {
+	// Resolution of the buckets.
+	Resolution time.Duration `protobuf:"bytes,1,opt,name=resolution,proto3,stdduration" json:"resolution"`
+	// Buckets in chronological order. Buckets in which the gateway was not connected are omitted.
+	Buckets []*GatewayConnectionHistory_Bucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// Connection periods that overlap with the history, in chronological order.
+	ConnectionPeriods    []*GatewayConnectionHistory_ConnectionPeriod `protobuf:"bytes,3,rep,name=connection_periods,json=connectionPeriods,proto3" json:"connection_periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GatewayConnectionHistory) Reset()      { *m = GatewayConnectionHistory{} }
+func (*GatewayConnectionHistory) ProtoMessage() {}
+func (*GatewayConnectionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *GatewayConnectionHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionHistory.Merge(m, src)
+}
+func (m *GatewayConnectionHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionHistory proto.InternalMessageInfo
+
+func (m *GatewayConnectionHistory) GetResolution() time.Duration {
+	if m != nil {
+		return m.Resolution
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory) GetBuckets() []*GatewayConnectionHistory_Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *GatewayConnectionHistory) GetConnectionPeriods() []*GatewayConnectionHistory_ConnectionPeriod {
+	if m != nil {
+		return m.ConnectionPeriods
+	}
+	return nil
+}
+
+// Distribution of values of received uplink messages.
+type GatewayConnectionHistory_Distribution struct {
+	Count uint64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Min   float32 `protobuf:"fixed32,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max   float32 `protobuf:"fixed32,3,opt,name=max,proto3" json:"max,omitempty"`
+	Mean  float32 `protobuf:"fixed32,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Lower bound of the first histogram bin.
+	HistogramStart float32 `protobuf:"fixed32,5,opt,name=histogram_start,json=histogramStart,proto3" json:"histogram_start,omitempty"`
+	// Width of the histogram bins.
+	HistogramBinWidth float32 `protobuf:"fixed32,6,opt,name=histogram_bin_width,json=histogramBinWidth,proto3" json:"histogram_bin_width,omitempty"`
+	// Number of values in each histogram bin.
+	// Values below the first bin are counted in the first bin and values above the last bin are counted in the last bin.
+	Histogram            []uint64 `protobuf:"varint,7,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayConnectionHistory_Distribution) Reset()      { *m = GatewayConnectionHistory_Distribution{} }
+func (*GatewayConnectionHistory_Distribution) ProtoMessage() {}
+func (*GatewayConnectionHistory_Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5, 0}
+}
+func (m *GatewayConnectionHistory_Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionHistory_Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionHistory_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionHistory_Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionHistory_Distribution.Merge(m, src)
+}
+func (m *GatewayConnectionHistory_Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionHistory_Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionHistory_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionHistory_Distribution proto.InternalMessageInfo
+
+func (m *GatewayConnectionHistory_Distribution) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetMin() float32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetMax() float32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetMean() float32 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetHistogramStart() float32 {
+	if m != nil {
+		return m.HistogramStart
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetHistogramBinWidth() float32 {
+	if m != nil {
+		return m.HistogramBinWidth
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Distribution) GetHistogram() []uint64 {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
+type GatewayConnectionHistory_Bucket struct {
+	// Start of the bucket.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// Time that the gateway was connected within the bucket.
+	ConnectedDuration time.Duration `protobuf:"bytes,2,opt,name=connected_duration,json=connectedDuration,proto3,stdduration" json:"connected_duration"`
+	UplinkCount       uint64        `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	DownlinkCount     uint64        `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	StatusCount       uint64        `protobuf:"varint,5,opt,name=status_count,json=statusCount,proto3" json:"status_count,omitempty"`
+	// Distribution of the RSSI (dBm) of received uplink messages.
+	RSSI *GatewayConnectionHistory_Distribution `protobuf:"bytes,6,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Distribution of the SNR (dB) of received uplink messages.
+	SNR *GatewayConnectionHistory_Distribution `protobuf:"bytes,7,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Highest downlink utilization of each sub band within the bucket.
+	// The utilization is sampled each time the history of the connected gateway is written.
+	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,8,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *GatewayConnectionHistory_Bucket) Reset()      { *m = GatewayConnectionHistory_Bucket{} }
+func (*GatewayConnectionHistory_Bucket) ProtoMessage() {}
+func (*GatewayConnectionHistory_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5, 1}
+}
+func (m *GatewayConnectionHistory_Bucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionHistory_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionHistory_Bucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionHistory_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionHistory_Bucket.Merge(m, src)
+}
+func (m *GatewayConnectionHistory_Bucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionHistory_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionHistory_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionHistory_Bucket proto.InternalMessageInfo
+
+func (m *GatewayConnectionHistory_Bucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetConnectedDuration() time.Duration {
+	if m != nil {
+		return m.ConnectedDuration
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetStatusCount() uint64 {
+	if m != nil {
+		return m.StatusCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetRSSI() *GatewayConnectionHistory_Distribution {
+	if m != nil {
+		return m.RSSI
+	}
+	return nil
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetSNR() *GatewayConnectionHistory_Distribution {
+	if m != nil {
+		return m.SNR
+	}
+	return nil
+}
+
+func (m *GatewayConnectionHistory_Bucket) GetSubBands() []*GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
+}
+
+type GatewayConnectionHistory_ConnectionPeriod struct {
+	ConnectedAt time.Time `protobuf:"bytes,1,opt,name=connected_at,json=connectedAt,proto3,stdtime" json:"connected_at"`
+	// Time when the gateway disconnected. Not set if the gateway is connected.
+	DisconnectedAt *time.Time `protobuf:"bytes,2,opt,name=disconnected_at,json=disconnectedAt,proto3,stdtime" json:"disconnected_at,omitempty"`
+	// Protocol used to connect (for example, udp, mqtt, grpc).
+	Protocol             string   `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) Reset() {
+	*m = GatewayConnectionHistory_ConnectionPeriod{}
+}
+func (*GatewayConnectionHistory_ConnectionPeriod) ProtoMessage() {}
+func (*GatewayConnectionHistory_ConnectionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5, 2}
+}
+func (m *GatewayConnectionHistory_ConnectionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionHistory_ConnectionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionHistory_ConnectionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayConnectionHistory_ConnectionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionHistory_ConnectionPeriod.Merge(m, src)
+}
+func (m *GatewayConnectionHistory_ConnectionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionHistory_ConnectionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionHistory_ConnectionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionHistory_ConnectionPeriod proto.InternalMessageInfo
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) GetConnectedAt() time.Time {
+	if m != nil {
+		return m.ConnectedAt
+	}
+	return time.Time{}
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) GetDisconnectedAt() *time.Time {
+	if m != nil {
+		return m.DisconnectedAt
+	}
+	return nil
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
 	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
@@ -251,6 +654,16 @@ func init() {
 	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
 	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
 	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*GetGatewayConnectionHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionHistoryRequest")
+	golang_proto.RegisterType((*GetGatewayConnectionHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionHistoryRequest")
+	proto.RegisterType((*GatewayConnectionHistory)(nil), "ttn.lorawan.v3.GatewayConnectionHistory")
+	golang_proto.RegisterType((*GatewayConnectionHistory)(nil), "ttn.lorawan.v3.GatewayConnectionHistory")
+	proto.RegisterType((*GatewayConnectionHistory_Distribution)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.Distribution")
+	golang_proto.RegisterType((*GatewayConnectionHistory_Distribution)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.Distribution")
+	proto.RegisterType((*GatewayConnectionHistory_Bucket)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.Bucket")
+	golang_proto.RegisterType((*GatewayConnectionHistory_Bucket)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.Bucket")
+	proto.RegisterType((*GatewayConnectionHistory_ConnectionPeriod)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.ConnectionPeriod")
+	golang_proto.RegisterType((*GatewayConnectionHistory_ConnectionPeriod)(nil), "ttn.lorawan.v3.GatewayConnectionHistory.ConnectionPeriod")
 }
 
 func init() {
@@ -261,61 +674,94 @@ func init() {
 }
 
 var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x68, 0x24, 0x45,
-	0x14, 0xad, 0x4a, 0x8c, 0x68, 0x05, 0xb3, 0xb1, 0x40, 0x9d, 0xcc, 0x66, 0x2b, 0x61, 0x44, 0x09,
-	0xe2, 0x74, 0x87, 0x09, 0x88, 0x17, 0x0f, 0x49, 0x66, 0x1d, 0x22, 0x46, 0x70, 0x92, 0x08, 0x0a,
-	0x21, 0x54, 0xa6, 0x2b, 0x35, 0xcd, 0x74, 0xaa, 0xda, 0xae, 0x9a, 0x99, 0x0c, 0x22, 0x2c, 0x9e,
-	0xf6, 0x28, 0x78, 0x70, 0xc1, 0x8b, 0x08, 0xc2, 0xe2, 0x69, 0x8f, 0x7b, 0xdc, 0x63, 0x8e, 0x0b,
-	0x5e, 0xf6, 0xe4, 0xee, 0x74, 0x7b, 0xc8, 0x71, 0x8f, 0x8b, 0x27, 0xe9, 0x9a, 0xea, 0x64, 0xa6,
-	0x27, 0xad, 0x5e, 0xbc, 0x75, 0xf5, 0x7f, 0xff, 0xfd, 0xf7, 0x5f, 0xfd, 0x5f, 0xe8, 0x9d, 0x40,
-	0x46, 0xb4, 0x4f, 0x45, 0x55, 0x69, 0xda, 0xea, 0xb8, 0x34, 0xf4, 0x5d, 0x4e, 0x35, 0xeb, 0xd3,
-	0x81, 0x62, 0x51, 0x8f, 0x45, 0x4e, 0x18, 0x49, 0x2d, 0xf1, 0x82, 0xd6, 0xc2, 0xb1, 0x50, 0xa7,
-	0xb7, 0x51, 0xde, 0xe4, 0xbe, 0x6e, 0x77, 0x8f, 0x9d, 0x96, 0x3c, 0x75, 0x99, 0xe8, 0xc9, 0x41,
-	0x18, 0xc9, 0xb3, 0x81, 0x6b, 0xc0, 0xad, 0x2a, 0x67, 0xa2, 0xda, 0xa3, 0x81, 0xef, 0x51, 0xcd,
-	0xdc, 0xa9, 0x8f, 0x11, 0x65, 0xb9, 0x3a, 0x46, 0xc1, 0x25, 0x97, 0xa3, 0xe4, 0xe3, 0xee, 0x89,
-	0x39, 0x99, 0x83, 0xf9, 0xb2, 0xf0, 0x65, 0x2e, 0x25, 0x0f, 0x98, 0x51, 0x48, 0x85, 0x90, 0x9a,
-	0x6a, 0x5f, 0x0a, 0x65, 0xa3, 0xc4, 0x46, 0x2f, 0x39, 0xbc, 0x6e, 0x64, 0x00, 0x36, 0x7e, 0x33,
-	0x1f, 0x67, 0xa7, 0xa1, 0x1e, 0xd8, 0xe0, 0xad, 0x69, 0x0f, 0x58, 0x14, 0x49, 0xdb, 0x7b, 0x79,
-	0xa5, 0xd0, 0x22, 0x0b, 0x78, 0x7b, 0x1a, 0xe0, 0x7b, 0x4c, 0x68, 0xff, 0xc4, 0x67, 0x51, 0xa6,
-	0x70, 0x75, 0x1a, 0x74, 0xca, 0x94, 0xa2, 0x9c, 0x65, 0x88, 0xe5, 0x6b, 0x10, 0x5f, 0x6b, 0x5d,
-	0x9c, 0x1f, 0x31, 0xee, 0x4b, 0x41, 0x83, 0x11, 0xa2, 0x72, 0x01, 0xd1, 0xab, 0x8d, 0x91, 0xb0,
-	0x83, 0x10, 0x7f, 0x8c, 0x6e, 0x74, 0xc3, 0xc0, 0x17, 0x9d, 0xa3, 0xac, 0x4c, 0x09, 0xae, 0xce,
-	0xae, 0xcd, 0xd7, 0x6e, 0x39, 0x93, 0x77, 0xe9, 0x1c, 0x18, 0xd8, 0xee, 0x08, 0xd5, 0x5c, 0xe8,
-	0x8e, 0x1f, 0x15, 0xae, 0xa3, 0x05, 0xdb, 0xed, 0x91, 0xd2, 0x54, 0x77, 0x55, 0x69, 0x66, 0x15,
-	0x5e, 0x47, 0x63, 0x4b, 0xef, 0x19, 0x50, 0xf3, 0x35, 0x3e, 0x7e, 0xc4, 0xbb, 0xe8, 0x75, 0x7d,
-	0x76, 0x44, 0x5b, 0x1d, 0x21, 0xfb, 0x01, 0xf3, 0xf8, 0x29, 0x13, 0xba, 0x34, 0x6b, 0x88, 0x56,
-	0xf3, 0x44, 0xfb, 0x67, 0x9b, 0x13, 0xb8, 0xe6, 0xa2, 0xce, 0xfd, 0xa9, 0x7c, 0x89, 0xe6, 0x6d,
-	0xb9, 0xba, 0xec, 0x0b, 0xfc, 0x09, 0x5a, 0xf4, 0x64, 0x5f, 0x8c, 0x77, 0x5b, 0x82, 0x86, 0x7c,
-	0x25, 0x4f, 0x5e, 0xb7, 0xb8, 0xac, 0xdd, 0x1b, 0xde, 0xe4, 0x8f, 0xca, 0x21, 0x2a, 0xed, 0xb5,
-	0xda, 0xcc, 0xeb, 0x06, 0x2c, 0xc3, 0x36, 0x99, 0x0a, 0xa5, 0x50, 0x0c, 0x6f, 0xa2, 0x39, 0x8f,
-	0x05, 0x74, 0x60, 0xc9, 0x97, 0x9c, 0xd1, 0x54, 0x39, 0xd9, 0x54, 0x39, 0x75, 0x3b, 0x75, 0x5b,
-	0x8b, 0x7f, 0x6d, 0xcd, 0xfd, 0x06, 0x67, 0x5e, 0x81, 0xe7, 0x7f, 0xac, 0x80, 0x7b, 0x4f, 0x57,
-	0x60, 0x73, 0x94, 0x59, 0x39, 0x44, 0xcb, 0x79, 0xfa, 0xdb, 0xe9, 0xac, 0xd5, 0x99, 0xa6, 0x7e,
-	0xa0, 0xf0, 0x47, 0x68, 0x3e, 0xa4, 0xba, 0x7d, 0x64, 0x06, 0x30, 0xbb, 0xb2, 0xe5, 0x7c, 0x17,
-	0xe3, 0x29, 0x4d, 0x94, 0x26, 0x98, 0x3f, 0xaa, 0xf6, 0x74, 0x16, 0xcd, 0x35, 0x74, 0xbf, 0xa1,
-	0xf0, 0x0e, 0x9a, 0xff, 0xd4, 0x17, 0x1d, 0x6b, 0x13, 0x5e, 0x2a, 0xb8, 0xae, 0x83, 0xb0, 0x7c,
-	0xb3, 0x20, 0x94, 0xea, 0x5b, 0x83, 0xeb, 0x10, 0xef, 0xa1, 0x37, 0x1a, 0x4c, 0x6f, 0x4b, 0xd1,
-	0x62, 0x42, 0x47, 0x54, 0xcb, 0x68, 0x5b, 0x8a, 0x13, 0x9f, 0xe3, 0x37, 0xa7, 0x0c, 0xb8, 0x9d,
-	0xae, 0x55, 0xb9, 0x92, 0x67, 0xbc, 0x26, 0xf7, 0x47, 0x68, 0x58, 0x77, 0x3f, 0xdf, 0xdf, 0xdf,
-	0x96, 0x42, 0xb0, 0x56, 0xea, 0xdb, 0x8e, 0x38, 0x91, 0xb8, 0x52, 0xa0, 0x67, 0xe7, 0x6a, 0xa7,
-	0xa6, 0x2b, 0x4c, 0xf3, 0x54, 0x3e, 0xf8, 0xee, 0xf7, 0x3f, 0x7f, 0x98, 0x59, 0xc7, 0x8e, 0xcb,
-	0xd5, 0xe5, 0xa3, 0xe6, 0x7e, 0x93, 0x4d, 0xb3, 0xef, 0x7d, 0x6b, 0xf6, 0xab, 0xda, 0xba, 0x4c,
-	0xab, 0xfa, 0x69, 0xfd, 0x9f, 0x20, 0x7a, 0xcb, 0x2a, 0xfb, 0xa2, 0xf6, 0x3f, 0x69, 0xfb, 0xd0,
-	0x68, 0xab, 0xe1, 0xf5, 0x7f, 0xd6, 0xd6, 0xab, 0xe5, 0xd5, 0xd5, 0x18, 0x7a, 0xe9, 0x33, 0xd5,
-	0x50, 0xf8, 0x10, 0x2d, 0xe6, 0x07, 0x09, 0xff, 0xdb, 0xb4, 0x97, 0xd7, 0xf2, 0x80, 0xa2, 0x51,
-	0xaf, 0xfd, 0x02, 0xd1, 0x4c, 0x43, 0xa5, 0x5e, 0x2c, 0x35, 0x98, 0xb6, 0x5d, 0x5e, 0x35, 0x91,
-	0xae, 0xb5, 0xfa, 0x4f, 0x6e, 0xbc, 0x5b, 0x80, 0xc9, 0x71, 0x55, 0x6a, 0xc6, 0x91, 0xf7, 0xf1,
-	0x7b, 0xc5, 0x8e, 0x5c, 0x59, 0xe1, 0xa6, 0x4f, 0x91, 0xda, 0xfa, 0x15, 0x9e, 0x0f, 0x09, 0x7c,
-	0x3c, 0x24, 0xf0, 0xc9, 0x90, 0x80, 0x67, 0x43, 0x02, 0x2e, 0x86, 0x04, 0x3c, 0x1f, 0x12, 0xf0,
-	0x62, 0x48, 0xe0, 0x9d, 0x98, 0xc0, 0xbb, 0x31, 0x01, 0xf7, 0x63, 0x02, 0x1f, 0xc4, 0x04, 0x3c,
-	0x8c, 0x09, 0x78, 0x14, 0x13, 0x70, 0x1e, 0x13, 0xf8, 0x38, 0x26, 0xf0, 0x49, 0x4c, 0xc0, 0xb3,
-	0x98, 0xc0, 0x8b, 0x98, 0x80, 0xe7, 0x31, 0x81, 0x2f, 0x62, 0x02, 0xee, 0x24, 0x04, 0xdc, 0x4d,
-	0x08, 0xfc, 0x3e, 0x21, 0xe0, 0x5e, 0x42, 0xe0, 0xcf, 0x09, 0x01, 0xf7, 0x13, 0x02, 0x1e, 0x24,
-	0x04, 0x3e, 0x4c, 0x08, 0x7c, 0x94, 0x10, 0xf8, 0x95, 0xcb, 0xa5, 0xa3, 0xdb, 0x4c, 0xb7, 0x7d,
-	0xc1, 0x95, 0x23, 0x98, 0xee, 0xcb, 0xa8, 0xe3, 0x4e, 0xbe, 0xcf, 0xbd, 0x0d, 0x37, 0xec, 0x70,
-	0x57, 0x6b, 0x11, 0x1e, 0x1f, 0xbf, 0x6c, 0xf6, 0x63, 0xe3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x90, 0xb7, 0x04, 0xec, 0x6d, 0x07, 0x00, 0x00,
+	// 1388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xde, 0xf1, 0x9f, 0x26, 0x19, 0xa7, 0x89, 0x33, 0xbf, 0x3f, 0x6c, 0xdd, 0x74, 0x9c, 0x1a,
+	0x15, 0x22, 0x44, 0xd6, 0x95, 0x2b, 0xaa, 0x52, 0x09, 0x89, 0x38, 0x29, 0x26, 0x40, 0xab, 0xb2,
+	0x4e, 0x40, 0x20, 0x55, 0xd6, 0xda, 0x3b, 0x59, 0xaf, 0x6c, 0xcf, 0xb8, 0x3b, 0xe3, 0x38, 0x11,
+	0x42, 0xaa, 0x38, 0x55, 0x9c, 0x2a, 0x71, 0xa0, 0x12, 0x17, 0x2e, 0x48, 0x15, 0x70, 0xe8, 0xb1,
+	0x17, 0xa4, 0x8a, 0x53, 0x8f, 0x95, 0x7a, 0xe9, 0x29, 0xad, 0xd7, 0x1c, 0x7a, 0xec, 0xb1, 0x70,
+	0x42, 0x3b, 0xbb, 0x6b, 0x3b, 0x76, 0xdc, 0x18, 0x10, 0xb7, 0x9d, 0xf7, 0xbe, 0xf7, 0xcd, 0x7b,
+	0xdf, 0x7b, 0xbb, 0x33, 0x0b, 0xcf, 0xd4, 0x99, 0x63, 0xb4, 0x0d, 0xba, 0xc2, 0x85, 0x51, 0xa9,
+	0x65, 0x8d, 0xa6, 0x9d, 0xb5, 0x0c, 0x41, 0xda, 0xc6, 0x1e, 0x27, 0xce, 0x0e, 0x71, 0xb4, 0xa6,
+	0xc3, 0x04, 0x43, 0x73, 0x42, 0x50, 0x2d, 0x80, 0x6a, 0x3b, 0xe7, 0x52, 0xab, 0x96, 0x2d, 0xaa,
+	0xad, 0xb2, 0x56, 0x61, 0x8d, 0x2c, 0xa1, 0x3b, 0x6c, 0xaf, 0xe9, 0xb0, 0xdd, 0xbd, 0xac, 0x04,
+	0x57, 0x56, 0x2c, 0x42, 0x57, 0x76, 0x8c, 0xba, 0x6d, 0x1a, 0x82, 0x64, 0x47, 0x1e, 0x7c, 0xca,
+	0xd4, 0xca, 0x00, 0x85, 0xc5, 0x2c, 0xe6, 0x07, 0x97, 0x5b, 0xdb, 0x72, 0x25, 0x17, 0xf2, 0x29,
+	0x80, 0x2f, 0x5a, 0x8c, 0x59, 0x75, 0x22, 0x33, 0x34, 0x28, 0x65, 0xc2, 0x10, 0x36, 0xa3, 0x3c,
+	0xf0, 0xe2, 0xc0, 0xdb, 0xe3, 0x30, 0x5b, 0x8e, 0x04, 0x04, 0xfe, 0x93, 0xc3, 0x7e, 0xd2, 0x68,
+	0x8a, 0xbd, 0xc0, 0x99, 0x1e, 0x76, 0x0a, 0xbb, 0x41, 0xb8, 0x30, 0x1a, 0xcd, 0x00, 0x70, 0x6a,
+	0x54, 0x24, 0xe2, 0x38, 0xcc, 0x09, 0xe3, 0xc7, 0x6a, 0x18, 0x00, 0x5e, 0x1d, 0x05, 0xd8, 0x26,
+	0xa1, 0xc2, 0xde, 0xb6, 0x89, 0x13, 0x96, 0xb0, 0x34, 0x0a, 0x6a, 0x10, 0xce, 0x0d, 0x8b, 0x84,
+	0x88, 0xc5, 0x43, 0x10, 0xd7, 0x85, 0x18, 0x1f, 0xef, 0x10, 0xcb, 0x66, 0xd4, 0xa8, 0xfb, 0x88,
+	0xcc, 0x33, 0x00, 0x67, 0x0a, 0x7e, 0x62, 0x5b, 0x4d, 0xf4, 0x1e, 0x9c, 0x6f, 0x35, 0xeb, 0x36,
+	0xad, 0x95, 0xc2, 0x6d, 0x54, 0xb0, 0x14, 0x5d, 0x4e, 0xe4, 0x4e, 0x69, 0x07, 0x9b, 0xad, 0x6d,
+	0x49, 0xd8, 0x65, 0x1f, 0xa5, 0xcf, 0xb5, 0x06, 0x97, 0x1c, 0xad, 0xc3, 0xb9, 0xa0, 0xda, 0x12,
+	0x17, 0x86, 0x68, 0x71, 0x35, 0xb2, 0x04, 0x0e, 0xa3, 0x09, 0xb6, 0x2e, 0x4a, 0x90, 0x7e, 0xdc,
+	0x1a, 0x5c, 0xa2, 0xcb, 0x70, 0x41, 0xec, 0x96, 0x8c, 0x4a, 0x8d, 0xb2, 0x76, 0x9d, 0x98, 0x56,
+	0x83, 0x50, 0xa1, 0x46, 0x25, 0xd1, 0xd2, 0x30, 0xd1, 0xe6, 0xee, 0xea, 0x01, 0x9c, 0x9e, 0x14,
+	0x43, 0x96, 0xcc, 0x67, 0x30, 0x11, 0x6c, 0xb7, 0xce, 0xda, 0x14, 0x7d, 0x00, 0x93, 0x26, 0x6b,
+	0xd3, 0xc1, 0x6a, 0x55, 0x20, 0xc9, 0xd3, 0xc3, 0xe4, 0xeb, 0x01, 0x2e, 0x2c, 0x77, 0xde, 0x3c,
+	0x68, 0xc8, 0x5c, 0x83, 0x6a, 0xb1, 0x52, 0x25, 0x66, 0xab, 0x4e, 0x42, 0xac, 0x4e, 0x78, 0x93,
+	0x51, 0x4e, 0xd0, 0x2a, 0x8c, 0x9b, 0xa4, 0x6e, 0xec, 0x05, 0xe4, 0x27, 0x34, 0x7f, 0xb2, 0xb4,
+	0x70, 0xb2, 0xb4, 0xf5, 0x60, 0x2c, 0xf3, 0xc9, 0x3f, 0xf2, 0xf1, 0x1f, 0x41, 0x64, 0x1a, 0x3c,
+	0xd8, 0x4f, 0x2b, 0xb7, 0x9f, 0xa4, 0x81, 0xee, 0x47, 0x66, 0xae, 0xc1, 0xc5, 0x61, 0xfa, 0x4b,
+	0xde, 0xac, 0xad, 0x13, 0x61, 0xd8, 0x75, 0x8e, 0xde, 0x81, 0x89, 0xa6, 0x21, 0xaa, 0x25, 0x39,
+	0x80, 0x61, 0xcb, 0x16, 0x87, 0xab, 0x18, 0x0c, 0xd1, 0xa1, 0x17, 0x20, 0x2d, 0x3c, 0xf3, 0x73,
+	0x04, 0x66, 0x0a, 0x44, 0x04, 0xe2, 0xac, 0x31, 0x4a, 0x49, 0xc5, 0x4b, 0xe8, 0x7d, 0x9b, 0x0b,
+	0xe6, 0xec, 0xe9, 0xe4, 0x7a, 0x8b, 0x70, 0x81, 0xb6, 0x60, 0x22, 0x6c, 0xaa, 0x6d, 0xf2, 0xa0,
+	0x9c, 0xcc, 0x98, 0x8e, 0x6e, 0xf4, 0x67, 0x59, 0xd6, 0xf5, 0x35, 0x88, 0x24, 0x65, 0x5d, 0x0f,
+	0xf7, 0xd3, 0x40, 0x87, 0x56, 0x88, 0xe2, 0xe8, 0x3c, 0x8c, 0x73, 0x61, 0x38, 0x22, 0x18, 0x91,
+	0xd4, 0x88, 0x3e, 0x9b, 0xe1, 0x9b, 0x97, 0x8f, 0xdd, 0x92, 0xa2, 0x48, 0x38, 0xca, 0xc1, 0x28,
+	0xa1, 0xa6, 0x1a, 0x9d, 0x30, 0xca, 0x03, 0xa3, 0x35, 0x08, 0x1d, 0xc2, 0x59, 0xbd, 0xe5, 0x95,
+	0xa7, 0xc6, 0x8e, 0x6a, 0xc8, 0x74, 0xaf, 0x11, 0x03, 0x61, 0x99, 0x9f, 0x66, 0xa0, 0x3a, 0x4e,
+	0xab, 0xa1, 0x1d, 0xc0, 0xdf, 0xda, 0x01, 0x6d, 0xc0, 0xa9, 0x72, 0xab, 0x52, 0x23, 0xc2, 0x7b,
+	0x6f, 0xbc, 0x5e, 0x66, 0xc7, 0xa8, 0x3c, 0xb2, 0xbf, 0x96, 0x97, 0x71, 0x7a, 0x18, 0x8f, 0xaa,
+	0x10, 0x55, 0x7a, 0xa0, 0x52, 0x93, 0x38, 0x36, 0x33, 0xb9, 0x1a, 0x95, 0xac, 0x6f, 0x4f, 0xcc,
+	0xda, 0xb7, 0x5c, 0x95, 0x0c, 0xfa, 0x42, 0x65, 0xc8, 0xc2, 0x53, 0x8f, 0x00, 0x9c, 0x5d, 0xb7,
+	0xb9, 0x70, 0xec, 0xb2, 0x5f, 0xc5, 0x7f, 0x61, 0xbc, 0xc2, 0x5a, 0x54, 0x48, 0x15, 0x62, 0xba,
+	0xbf, 0x40, 0x49, 0x18, 0x6d, 0xd8, 0x54, 0x36, 0x3b, 0xa2, 0x7b, 0x8f, 0xd2, 0x62, 0xec, 0xaa,
+	0xd1, 0xc0, 0x62, 0xec, 0x22, 0x04, 0x63, 0x0d, 0x62, 0xf8, 0x0d, 0x8a, 0xe8, 0xf2, 0x19, 0xbd,
+	0x0e, 0xe7, 0xab, 0x5e, 0x36, 0x96, 0x63, 0x34, 0x4a, 0xfe, 0xc0, 0xc4, 0xa5, 0x7b, 0xae, 0x67,
+	0x2e, 0xca, 0xb9, 0xd0, 0xe0, 0x7f, 0xfa, 0xc0, 0xb2, 0x4d, 0x4b, 0x6d, 0xdb, 0x14, 0x55, 0xf5,
+	0x98, 0x04, 0x2f, 0xf4, 0x5c, 0x79, 0x9b, 0x7e, 0xea, 0x39, 0xd0, 0x22, 0x9c, 0xe9, 0x19, 0xd5,
+	0xa9, 0xa5, 0xe8, 0x72, 0x4c, 0xef, 0x1b, 0x52, 0xbf, 0x47, 0xe1, 0x31, 0x5f, 0x53, 0x74, 0x31,
+	0x1c, 0x54, 0x70, 0xe4, 0xc8, 0xc9, 0xb6, 0x0e, 0x0e, 0xab, 0xde, 0x6b, 0x03, 0x31, 0x4b, 0xe1,
+	0x39, 0xa4, 0x46, 0x26, 0x1f, 0x8f, 0x85, 0x5e, 0x78, 0xe8, 0x44, 0xa7, 0xe1, 0x6c, 0xf0, 0xb1,
+	0xf6, 0x65, 0x8e, 0x4a, 0x99, 0x13, 0xbe, 0x6d, 0x4d, 0x8a, 0x7d, 0x06, 0xce, 0xf5, 0xbe, 0x71,
+	0x3e, 0x28, 0x26, 0x41, 0xc7, 0x43, 0xab, 0x0f, 0x3b, 0x0d, 0x67, 0xfd, 0xcf, 0x74, 0x00, 0x8a,
+	0xfb, 0x4c, 0xbe, 0xcd, 0x87, 0x14, 0x61, 0xcc, 0xe1, 0xdc, 0x96, 0x32, 0x26, 0x72, 0x6f, 0x4d,
+	0x3c, 0x39, 0x83, 0x13, 0x91, 0x9f, 0x76, 0xf7, 0xd3, 0x31, 0xbd, 0x58, 0xdc, 0xd0, 0x25, 0x19,
+	0xba, 0x0a, 0xa3, 0x9c, 0x3a, 0xea, 0xd4, 0x3f, 0xe1, 0x9c, 0x72, 0xf7, 0xd3, 0xd1, 0xe2, 0x15,
+	0x5d, 0xf7, 0xa8, 0xd0, 0x87, 0x70, 0x86, 0xb7, 0xca, 0xa5, 0xb2, 0x41, 0x4d, 0xae, 0x4e, 0xcb,
+	0x29, 0xd7, 0x8e, 0xe4, 0xf5, 0x8e, 0x1b, 0xae, 0x15, 0x5b, 0xe5, 0xbc, 0x41, 0x4d, 0x7d, 0x9a,
+	0xfb, 0x0f, 0x3c, 0xf5, 0x2b, 0x80, 0xc9, 0xe1, 0xc9, 0x47, 0x05, 0x38, 0xdb, 0xef, 0xa4, 0xf1,
+	0xd7, 0x86, 0x21, 0xd1, 0x8b, 0x5c, 0x15, 0x68, 0x03, 0xce, 0x9b, 0x36, 0x3f, 0xc0, 0x35, 0xe9,
+	0x17, 0x70, 0x6e, 0x30, 0x70, 0x55, 0xa0, 0x14, 0x9c, 0x96, 0xd8, 0x0a, 0xab, 0xcb, 0x29, 0x98,
+	0xd1, 0x7b, 0xeb, 0xdc, 0x93, 0x28, 0x8c, 0x17, 0x44, 0xbb, 0xc0, 0xd1, 0x06, 0x4c, 0x7c, 0x64,
+	0xd3, 0x5a, 0x50, 0x3e, 0x3a, 0x31, 0x46, 0x97, 0xad, 0x66, 0xea, 0xe4, 0x18, 0x97, 0x77, 0xf8,
+	0x2c, 0x83, 0xb3, 0x00, 0x15, 0xe1, 0xff, 0x0a, 0x44, 0xac, 0x31, 0x5a, 0x21, 0x54, 0x38, 0x86,
+	0x60, 0xce, 0x1a, 0xa3, 0xdb, 0xb6, 0x85, 0xfe, 0x3f, 0x92, 0xfb, 0x25, 0xef, 0x52, 0x95, 0x1a,
+	0x39, 0x26, 0x0e, 0x89, 0xfd, 0x16, 0x48, 0xd6, 0xcb, 0x1f, 0x6f, 0x6e, 0xf6, 0x55, 0xdf, 0xa0,
+	0xdb, 0x0c, 0x4d, 0x70, 0xc8, 0x8c, 0xee, 0x30, 0xca, 0x93, 0x39, 0xff, 0xd5, 0xa3, 0xdf, 0xbe,
+	0x89, 0x9c, 0x45, 0x5a, 0xd6, 0xe2, 0xbd, 0x2b, 0x6d, 0xf6, 0x8b, 0xfe, 0xa9, 0xf6, 0xa5, 0xbc,
+	0x3c, 0xad, 0xf4, 0x3f, 0x6e, 0x2b, 0xb6, 0xb7, 0xff, 0x77, 0x00, 0xbe, 0x12, 0x64, 0xf6, 0x49,
+	0xee, 0x5f, 0xca, 0xed, 0x82, 0xcc, 0x2d, 0x87, 0xce, 0xbe, 0x3c, 0xb7, 0x9d, 0xdc, 0x70, 0x76,
+	0x39, 0x02, 0x63, 0x57, 0x78, 0x81, 0xa3, 0x6b, 0x30, 0x39, 0x7c, 0x4b, 0x40, 0x47, 0x5d, 0x65,
+	0x52, 0xcb, 0xc3, 0x80, 0x71, 0xf7, 0x98, 0x9c, 0x1b, 0x81, 0x91, 0x02, 0xf7, 0xb4, 0x38, 0x71,
+	0xd8, 0x65, 0x41, 0xbe, 0x44, 0x13, 0xa9, 0xf1, 0xda, 0x64, 0x2f, 0x64, 0x26, 0x27, 0x15, 0x79,
+	0x13, 0xbd, 0x31, 0x5e, 0x91, 0xbe, 0x14, 0x59, 0x2e, 0xf7, 0xff, 0x05, 0xc0, 0x93, 0x2f, 0xb9,
+	0xca, 0xa0, 0xdc, 0xc8, 0xde, 0x47, 0xde, 0x7b, 0x46, 0x25, 0x1a, 0x17, 0x90, 0x79, 0x57, 0x66,
+	0x7c, 0x11, 0x5d, 0x18, 0x97, 0x31, 0xd7, 0xc6, 0x64, 0x5f, 0xf5, 0x19, 0xf2, 0x3f, 0x80, 0x07,
+	0x1d, 0x0c, 0x1e, 0x76, 0x30, 0x78, 0xdc, 0xc1, 0xca, 0xd3, 0x0e, 0x56, 0x9e, 0x75, 0xb0, 0xf2,
+	0xbc, 0x83, 0x95, 0x17, 0x1d, 0x0c, 0x6e, 0xb8, 0x18, 0xdc, 0x74, 0xb1, 0x72, 0xc7, 0xc5, 0xe0,
+	0xae, 0x8b, 0x95, 0x7b, 0x2e, 0x56, 0xee, 0xbb, 0x58, 0x79, 0xe0, 0x62, 0xf0, 0xd0, 0xc5, 0xe0,
+	0xb1, 0x8b, 0x95, 0xa7, 0x2e, 0x06, 0xcf, 0x5c, 0xac, 0x3c, 0x77, 0x31, 0x78, 0xe1, 0x62, 0xe5,
+	0x46, 0x17, 0x2b, 0x37, 0xbb, 0x18, 0xdc, 0xea, 0x62, 0xe5, 0x76, 0x17, 0x83, 0xef, 0xbb, 0x58,
+	0xb9, 0xd3, 0xc5, 0xca, 0xdd, 0x2e, 0x06, 0xf7, 0xba, 0x18, 0xdc, 0xef, 0x62, 0xf0, 0x79, 0xd6,
+	0x62, 0x9a, 0xa8, 0x12, 0x51, 0xb5, 0xa9, 0xc5, 0x35, 0x4a, 0x44, 0x9b, 0x39, 0xb5, 0xec, 0xc1,
+	0x9f, 0x87, 0x9d, 0x73, 0xd9, 0x66, 0xcd, 0xca, 0x0a, 0x41, 0x9b, 0xe5, 0xf2, 0x31, 0xf9, 0x7e,
+	0x9f, 0xfb, 0x73, 0x00, 0x60, 0x62, 0x04, 0x6b, 0x2b, 0x0e, 0x00, 0x00,
 }
 
 func (this *GatewayUp) Equal(that interface{}) bool {
@@ -430,35 +876,247 @@ func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetGatewayConnectionHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// Get configuration for the concentrator.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get connection information to connect an MQTT gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-}
-
-type gtwGsClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
-	return &gtwGsClient{cc}
+	that1, ok := that.(*GetGatewayConnectionHistoryRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayConnectionHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if that1.Start == nil {
+		if this.Start != nil {
+			return false
+		}
+	} else if !this.Start.Equal(*that1.Start) {
+		return false
+	}
+	if that1.End == nil {
+		if this.End != nil {
+			return false
+		}
+	} else if !this.End.Equal(*that1.End) {
+		return false
+	}
+	if this.Resolution != that1.Resolution {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionHistory)
+	if !ok {
+		that2, ok := that.(GatewayConnectionHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Resolution != that1.Resolution {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(that1.Buckets[i]) {
+			return false
+		}
+	}
+	if len(this.ConnectionPeriods) != len(that1.ConnectionPeriods) {
+		return false
+	}
+	for i := range this.ConnectionPeriods {
+		if !this.ConnectionPeriods[i].Equal(that1.ConnectionPeriods[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayConnectionHistory_Distribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionHistory_Distribution)
+	if !ok {
+		that2, ok := that.(GatewayConnectionHistory_Distribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Mean != that1.Mean {
+		return false
+	}
+	if this.HistogramStart != that1.HistogramStart {
+		return false
+	}
+	if this.HistogramBinWidth != that1.HistogramBinWidth {
+		return false
+	}
+	if len(this.Histogram) != len(that1.Histogram) {
+		return false
+	}
+	for i := range this.Histogram {
+		if this.Histogram[i] != that1.Histogram[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayConnectionHistory_Bucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionHistory_Bucket)
+	if !ok {
+		that2, ok := that.(GatewayConnectionHistory_Bucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.ConnectedDuration != that1.ConnectedDuration {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.StatusCount != that1.StatusCount {
+		return false
+	}
+	if !this.RSSI.Equal(that1.RSSI) {
+		return false
+	}
+	if !this.SNR.Equal(that1.SNR) {
+		return false
+	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayConnectionHistory_ConnectionPeriod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionHistory_ConnectionPeriod)
+	if !ok {
+		that2, ok := that.(GatewayConnectionHistory_ConnectionPeriod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ConnectedAt.Equal(that1.ConnectedAt) {
+		return false
+	}
+	if that1.DisconnectedAt == nil {
+		if this.DisconnectedAt != nil {
+			return false
+		}
+	} else if !this.DisconnectedAt.Equal(*that1.DisconnectedAt) {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link a gateway to the Gateway Server for streaming upstream messages and downstream messages.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// Get configuration for the concentrator.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get connection information to connect an MQTT gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get legacy connection information to connect a The Things Network Stack V2 MQTT gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	return &gtwGsClient{cc}
 }
 
 func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
@@ -745,6 +1403,9 @@ type GsClient interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Get the traffic, status and connection history of a gateway.
+	// The history is persisted between reconnects and downsampled to coarser resolutions as it ages.
+	GetGatewayConnectionHistory(ctx context.Context, in *GetGatewayConnectionHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionHistory, error)
 }
 
 type gsClient struct {
@@ -764,11 +1425,23 @@ func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIde
 	return out, nil
 }
 
+func (c *gsClient) GetGatewayConnectionHistory(ctx context.Context, in *GetGatewayConnectionHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionHistory, error) {
+	out := new(GatewayConnectionHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsServer is the server API for Gs service.
 type GsServer interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Get the traffic, status and connection history of a gateway.
+	// The history is persisted between reconnects and downsampled to coarser resolutions as it ages.
+	GetGatewayConnectionHistory(context.Context, *GetGatewayConnectionHistoryRequest) (*GatewayConnectionHistory, error)
 }
 
 // UnimplementedGsServer can be embedded to have forward compatible implementations.
@@ -778,6 +1451,9 @@ type UnimplementedGsServer struct {
 func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
 }
+func (*UnimplementedGsServer) GetGatewayConnectionHistory(ctx context.Context, req *GetGatewayConnectionHistoryRequest) (*GatewayConnectionHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionHistory not implemented")
+}
 
 func RegisterGsServer(s *grpc.Server, srv GsServer) {
 	s.RegisterService(&_Gs_serviceDesc, srv)
@@ -801,6 +1477,24 @@ func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_GetGatewayConnectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConnectionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionHistory(ctx, req.(*GetGatewayConnectionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Gs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Gs",
 	HandlerType: (*GsServer)(nil),
@@ -809,6 +1503,10 @@ var _Gs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGatewayConnectionStats",
 			Handler:    _Gs_GetGatewayConnectionStats_Handler,
 		},
+		{
+			MethodName: "GetGatewayConnectionHistory",
+			Handler:    _Gs_GetGatewayConnectionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
@@ -978,38 +1676,374 @@ func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetGatewayConnectionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
-		}
+
+func (m *GetGatewayConnectionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayConnectionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Resolution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution):])
+	if err5 != nil {
+		return 0, err5
 	}
-	if r.Intn(5) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	i -= n5
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if m.End != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.End):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if r.Intn(5) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	if m.Start != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+func (m *GatewayConnectionHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionPeriods) > 0 {
+		for iNdEx := len(m.ConnectionPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Resolution, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionHistory_Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionHistory_Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionHistory_Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Histogram) > 0 {
+		dAtA11 := make([]byte, len(m.Histogram)*10)
+		var j10 int
+		for _, num := range m.Histogram {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintGatewayserver(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HistogramBinWidth != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.HistogramBinWidth)))
+		i--
+		dAtA[i] = 0x35
+	}
+	if m.HistogramStart != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.HistogramStart)))
+		i--
+		dAtA[i] = 0x2d
+	}
+	if m.Mean != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Mean)))
+		i--
+		dAtA[i] = 0x25
+	}
+	if m.Max != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Max)))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.Min != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.Min)))
+		i--
+		dAtA[i] = 0x15
+	}
+	if m.Count != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.Count)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionHistory_Bucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionHistory_Bucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionHistory_Bucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubBands) > 0 {
+		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.SNR != nil {
+		{
+			size, err := m.SNR.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RSSI != nil {
+		{
+			size, err := m.RSSI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StatusCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.StatusCount)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.UplinkCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ConnectedDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConnectedDuration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Protocol) > 0 {
+		i -= len(m.Protocol)
+		copy(dAtA[i:], m.Protocol)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Protocol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DisconnectedAt != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DisconnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisconnectedAt):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ConnectedAt):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
 	this := &GatewayDown{}
 	if r.Intn(5) != 0 {
 		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
@@ -1042,6 +2076,119 @@ func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *
 	return this
 }
 
+func NewPopulatedGetGatewayConnectionHistoryRequest(r randyGatewayserver, easy bool) *GetGatewayConnectionHistoryRequest {
+	this := &GetGatewayConnectionHistoryRequest{}
+	v4 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v4
+	if r.Intn(5) != 0 {
+		this.Start = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.End = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Resolution = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionHistory(r randyGatewayserver, easy bool) *GatewayConnectionHistory {
+	this := &GatewayConnectionHistory{}
+	v6 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Resolution = *v6
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.Buckets = make([]*GatewayConnectionHistory_Bucket, v7)
+		for i := 0; i < v7; i++ {
+			this.Buckets[i] = NewPopulatedGatewayConnectionHistory_Bucket(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.ConnectionPeriods = make([]*GatewayConnectionHistory_ConnectionPeriod, v8)
+		for i := 0; i < v8; i++ {
+			this.ConnectionPeriods[i] = NewPopulatedGatewayConnectionHistory_ConnectionPeriod(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionHistory_Distribution(r randyGatewayserver, easy bool) *GatewayConnectionHistory_Distribution {
+	this := &GatewayConnectionHistory_Distribution{}
+	this.Count = uint64(uint64(r.Uint32()))
+	this.Min = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Min *= -1
+	}
+	this.Max = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Max *= -1
+	}
+	this.Mean = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.Mean *= -1
+	}
+	this.HistogramStart = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.HistogramStart *= -1
+	}
+	this.HistogramBinWidth = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.HistogramBinWidth *= -1
+	}
+	v9 := r.Intn(10)
+	this.Histogram = make([]uint64, v9)
+	for i := 0; i < v9; i++ {
+		this.Histogram[i] = uint64(uint64(r.Uint32()))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionHistory_Bucket(r randyGatewayserver, easy bool) *GatewayConnectionHistory_Bucket {
+	this := &GatewayConnectionHistory_Bucket{}
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Start = *v10
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.ConnectedDuration = *v11
+	this.UplinkCount = uint64(uint64(r.Uint32()))
+	this.DownlinkCount = uint64(uint64(r.Uint32()))
+	this.StatusCount = uint64(uint64(r.Uint32()))
+	if r.Intn(5) != 0 {
+		this.RSSI = NewPopulatedGatewayConnectionHistory_Distribution(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.SNR = NewPopulatedGatewayConnectionHistory_Distribution(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v12)
+		for i := 0; i < v12; i++ {
+			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayConnectionHistory_ConnectionPeriod(r randyGatewayserver, easy bool) *GatewayConnectionHistory_ConnectionPeriod {
+	this := &GatewayConnectionHistory_ConnectionPeriod{}
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ConnectedAt = *v13
+	if r.Intn(5) != 0 {
+		this.DisconnectedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Protocol = randStringGatewayserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyGatewayserver interface {
 	Float32() float32
 	Float64() float64
@@ -1061,9 +2208,9 @@ func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
 	return rune(ru + 61)
 }
 func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
+	v14 := r.Intn(100)
+	tmps := make([]rune, v14)
+	for i := 0; i < v14; i++ {
 		tmps[i] = randUTF8RuneGatewayserver(r)
 	}
 	return string(tmps)
@@ -1085,11 +2232,11 @@ func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
+		v15 := r.Int63()
 		if r.Intn(2) == 0 {
-			v5 *= -1
+			v15 *= -1
 		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v15))
 	case 1:
 		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1176,12 +2323,145 @@ func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
 	return n
 }
 
-func sovGatewayserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
+func (m *GetGatewayConnectionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Start != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Start)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.End != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.End)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *GatewayConnectionHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Resolution)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.ConnectionPeriods) > 0 {
+		for _, e := range m.ConnectionPeriods {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayConnectionHistory_Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovGatewayserver(m.Count)
+	}
+	if m.Min != 0 {
+		n += 5
+	}
+	if m.Max != 0 {
+		n += 5
+	}
+	if m.Mean != 0 {
+		n += 5
+	}
+	if m.HistogramStart != 0 {
+		n += 5
+	}
+	if m.HistogramBinWidth != 0 {
+		n += 5
+	}
+	if len(m.Histogram) > 0 {
+		l = 0
+		for _, e := range m.Histogram {
+			l += sovGatewayserver(uint64(e))
+		}
+		n += 1 + sovGatewayserver(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *GatewayConnectionHistory_Bucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ConnectedDuration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(m.UplinkCount)
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(m.DownlinkCount)
+	}
+	if m.StatusCount != 0 {
+		n += 1 + sovGatewayserver(m.StatusCount)
+	}
+	if m.RSSI != nil {
+		l = m.RSSI.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.SNR != nil {
+		l = m.SNR.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GatewayConnectionHistory_ConnectionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ConnectedAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.DisconnectedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DisconnectedAt)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
 func (this *GatewayUp) String() string {
 	if this == nil {
 		return "nil"
@@ -1227,22 +2507,1076 @@ func (this *ScheduleDownlinkErrorDetails) String() string {
 	for _, f := range this.PathErrors {
 		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
 	}
-	repeatedStringForPathErrors += "}"
-	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
-		`PathErrors:` + repeatedStringForPathErrors + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	repeatedStringForPathErrors += "}"
+	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
+		`PathErrors:` + repeatedStringForPathErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayConnectionHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayConnectionHistoryRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Start:` + strings.Replace(fmt.Sprintf("%v", this.Start), "Timestamp", "types.Timestamp", 1) + `,`,
+		`End:` + strings.Replace(fmt.Sprintf("%v", this.End), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Resolution:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBuckets := "[]*GatewayConnectionHistory_Bucket{"
+	for _, f := range this.Buckets {
+		repeatedStringForBuckets += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionHistory_Bucket", "GatewayConnectionHistory_Bucket", 1) + ","
+	}
+	repeatedStringForBuckets += "}"
+	repeatedStringForConnectionPeriods := "[]*GatewayConnectionHistory_ConnectionPeriod{"
+	for _, f := range this.ConnectionPeriods {
+		repeatedStringForConnectionPeriods += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionHistory_ConnectionPeriod", "GatewayConnectionHistory_ConnectionPeriod", 1) + ","
+	}
+	repeatedStringForConnectionPeriods += "}"
+	s := strings.Join([]string{`&GatewayConnectionHistory{`,
+		`Resolution:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resolution), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Buckets:` + repeatedStringForBuckets + `,`,
+		`ConnectionPeriods:` + repeatedStringForConnectionPeriods + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionHistory_Distribution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionHistory_Distribution{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`Mean:` + fmt.Sprintf("%v", this.Mean) + `,`,
+		`HistogramStart:` + fmt.Sprintf("%v", this.HistogramStart) + `,`,
+		`HistogramBinWidth:` + fmt.Sprintf("%v", this.HistogramBinWidth) + `,`,
+		`Histogram:` + fmt.Sprintf("%v", this.Histogram) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionHistory_Bucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubBands := "[]*GatewayConnectionStats_SubBand{"
+	for _, f := range this.SubBands {
+		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + ","
+	}
+	repeatedStringForSubBands += "}"
+	s := strings.Join([]string{`&GatewayConnectionHistory_Bucket{`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ConnectedDuration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ConnectedDuration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`StatusCount:` + fmt.Sprintf("%v", this.StatusCount) + `,`,
+		`RSSI:` + strings.Replace(fmt.Sprintf("%v", this.RSSI), "GatewayConnectionHistory_Distribution", "GatewayConnectionHistory_Distribution", 1) + `,`,
+		`SNR:` + strings.Replace(fmt.Sprintf("%v", this.SNR), "GatewayConnectionHistory_Distribution", "GatewayConnectionHistory_Distribution", 1) + `,`,
+		`SubBands:` + repeatedStringForSubBands + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionHistory_ConnectionPeriod) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionHistory_ConnectionPeriod{`,
+		`ConnectedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ConnectedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`DisconnectedAt:` + strings.Replace(fmt.Sprintf("%v", this.DisconnectedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGatewayConnectionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGatewayConnectionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGatewayConnectionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Resolution, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayConnectionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayConnectionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Resolution, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &GatewayConnectionHistory_Bucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionPeriods = append(m.ConnectionPeriods, &GatewayConnectionHistory_ConnectionPeriod{})
+			if err := m.ConnectionPeriods[len(m.ConnectionPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionHistory_Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Min = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Max = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Mean = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramStart", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.HistogramStart = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramBinWidth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.HistogramBinWidth = float32(math.Float32frombits(v))
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGatewayserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Histogram = append(m.Histogram, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGatewayserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGatewayserver
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGatewayserver
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Histogram) == 0 {
+					m.Histogram = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGatewayserver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Histogram = append(m.Histogram, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GatewayConnectionHistory_Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1265,15 +3599,15 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1300,14 +3634,13 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1334,16 +3667,70 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
-			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ConnectedDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCount", wireType)
+			}
+			m.StatusCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RSSI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1370,69 +3757,52 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
+			if m.RSSI == nil {
+				m.RSSI = &GatewayConnectionHistory_Distribution{}
 			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RSSI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNR", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SNR == nil {
+				m.SNR = &GatewayConnectionHistory_Distribution{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SNR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1459,10 +3829,8 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubBands = append(m.SubBands, &GatewayConnectionStats_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1490,7 +3858,7 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *GatewayConnectionHistory_ConnectionPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1513,15 +3881,15 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1548,68 +3916,51 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ConnectedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisconnectedAt", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DisconnectedAt == nil {
+				m.DisconnectedAt = new(time.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DisconnectedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGatewayserver
@@ -1619,25 +3970,23 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -128,3 +128,94 @@ var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
 	"path_errors",
 }
+var GetGatewayConnectionHistoryRequestFieldPathsNested = []string{
+	"end",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"resolution",
+	"start",
+}
+
+var GetGatewayConnectionHistoryRequestFieldPathsTopLevel = []string{
+	"end",
+	"gateway_ids",
+	"resolution",
+	"start",
+}
+var GatewayConnectionHistoryFieldPathsNested = []string{
+	"buckets",
+	"connection_periods",
+	"resolution",
+}
+
+var GatewayConnectionHistoryFieldPathsTopLevel = []string{
+	"buckets",
+	"connection_periods",
+	"resolution",
+}
+var GatewayConnectionHistory_DistributionFieldPathsNested = []string{
+	"count",
+	"histogram",
+	"histogram_bin_width",
+	"histogram_start",
+	"max",
+	"mean",
+	"min",
+}
+
+var GatewayConnectionHistory_DistributionFieldPathsTopLevel = []string{
+	"count",
+	"histogram",
+	"histogram_bin_width",
+	"histogram_start",
+	"max",
+	"mean",
+	"min",
+}
+var GatewayConnectionHistory_BucketFieldPathsNested = []string{
+	"connected_duration",
+	"downlink_count",
+	"rssi",
+	"rssi.count",
+	"rssi.histogram",
+	"rssi.histogram_bin_width",
+	"rssi.histogram_start",
+	"rssi.max",
+	"rssi.mean",
+	"rssi.min",
+	"snr",
+	"snr.count",
+	"snr.histogram",
+	"snr.histogram_bin_width",
+	"snr.histogram_start",
+	"snr.max",
+	"snr.mean",
+	"snr.min",
+	"start",
+	"status_count",
+	"sub_bands",
+	"uplink_count",
+}
+
+var GatewayConnectionHistory_BucketFieldPathsTopLevel = []string{
+	"connected_duration",
+	"downlink_count",
+	"rssi",
+	"snr",
+	"start",
+	"status_count",
+	"sub_bands",
+	"uplink_count",
+}
+var GatewayConnectionHistory_ConnectionPeriodFieldPathsNested = []string{
+	"connected_at",
+	"disconnected_at",
+	"protocol",
+}
+
+var GatewayConnectionHistory_ConnectionPeriodFieldPathsTopLevel = []string{
+	"connected_at",
+	"disconnected_at",
+	"protocol",
+}
@@ -153,3 +153,339 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 	}
 	return nil
 }
+
+func (dst *GetGatewayConnectionHistoryRequest) SetFields(src *GetGatewayConnectionHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				dst.Start = nil
+			}
+		case "end":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.End = src.End
+			} else {
+				dst.End = nil
+			}
+		case "resolution":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resolution' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Resolution = src.Resolution
+			} else {
+				var zero time.Duration
+				dst.Resolution = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionHistory) SetFields(src *GatewayConnectionHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "resolution":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resolution' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Resolution = src.Resolution
+			} else {
+				var zero time.Duration
+				dst.Resolution = zero
+			}
+		case "buckets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'buckets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Buckets = src.Buckets
+			} else {
+				dst.Buckets = nil
+			}
+		case "connection_periods":
+			if len(subs) > 0 {
+				return fmt.Errorf("'connection_periods' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConnectionPeriods = src.ConnectionPeriods
+			} else {
+				dst.ConnectionPeriods = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionHistory_Distribution) SetFields(src *GatewayConnectionHistory_Distribution, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint64
+				dst.Count = zero
+			}
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero float32
+				dst.Min = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero float32
+				dst.Max = zero
+			}
+		case "mean":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mean' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mean = src.Mean
+			} else {
+				var zero float32
+				dst.Mean = zero
+			}
+		case "histogram_start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'histogram_start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.HistogramStart = src.HistogramStart
+			} else {
+				var zero float32
+				dst.HistogramStart = zero
+			}
+		case "histogram_bin_width":
+			if len(subs) > 0 {
+				return fmt.Errorf("'histogram_bin_width' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.HistogramBinWidth = src.HistogramBinWidth
+			} else {
+				var zero float32
+				dst.HistogramBinWidth = zero
+			}
+		case "histogram":
+			if len(subs) > 0 {
+				return fmt.Errorf("'histogram' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Histogram = src.Histogram
+			} else {
+				dst.Histogram = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionHistory_Bucket) SetFields(src *GatewayConnectionHistory_Bucket, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				var zero time.Time
+				dst.Start = zero
+			}
+		case "connected_duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'connected_duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConnectedDuration = src.ConnectedDuration
+			} else {
+				var zero time.Duration
+				dst.ConnectedDuration = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "status_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StatusCount = src.StatusCount
+			} else {
+				var zero uint64
+				dst.StatusCount = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionHistory_Distribution
+				if (src == nil || src.RSSI == nil) && dst.RSSI == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RSSI
+				}
+				if dst.RSSI != nil {
+					newDst = dst.RSSI
+				} else {
+					newDst = &GatewayConnectionHistory_Distribution{}
+					dst.RSSI = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RSSI = src.RSSI
+				} else {
+					dst.RSSI = nil
+				}
+			}
+		case "snr":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionHistory_Distribution
+				if (src == nil || src.SNR == nil) && dst.SNR == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SNR
+				}
+				if dst.SNR != nil {
+					newDst = dst.SNR
+				} else {
+					newDst = &GatewayConnectionHistory_Distribution{}
+					dst.SNR = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SNR = src.SNR
+				} else {
+					dst.SNR = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionHistory_ConnectionPeriod) SetFields(src *GatewayConnectionHistory_ConnectionPeriod, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "connected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'connected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConnectedAt = src.ConnectedAt
+			} else {
+				var zero time.Time
+				dst.ConnectedAt = zero
+			}
+		case "disconnected_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'disconnected_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DisconnectedAt = src.DisconnectedAt
+			} else {
+				dst.DisconnectedAt = nil
+			}
+		case "protocol":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Protocol = src.Protocol
+			} else {
+				var zero string
+				dst.Protocol = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
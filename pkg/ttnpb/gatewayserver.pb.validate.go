@@ -428,3 +428,618 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on GetGatewayConnectionHistoryRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetGatewayConnectionHistoryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConnectionHistoryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionHistoryRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "start":
+
+			if v, ok := interface{}(m.GetStart()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionHistoryRequestValidationError{
+						field:  "start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end":
+
+			if v, ok := interface{}(m.GetEnd()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionHistoryRequestValidationError{
+						field:  "end",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "resolution":
+
+			if v, ok := interface{}(&m.Resolution).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionHistoryRequestValidationError{
+						field:  "resolution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewayConnectionHistoryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConnectionHistoryRequestValidationError is the validation error
+// returned by GetGatewayConnectionHistoryRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetGatewayConnectionHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConnectionHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConnectionHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConnectionHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConnectionHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConnectionHistoryRequestValidationError) ErrorName() string {
+	return "GetGatewayConnectionHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConnectionHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConnectionHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConnectionHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConnectionHistoryRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionHistory with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "resolution":
+
+			if v, ok := interface{}(&m.Resolution).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistoryValidationError{
+						field:  "resolution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "buckets":
+
+			for idx, item := range m.GetBuckets() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionHistoryValidationError{
+							field:  fmt.Sprintf("buckets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "connection_periods":
+
+			for idx, item := range m.GetConnectionPeriods() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionHistoryValidationError{
+							field:  fmt.Sprintf("connection_periods[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionHistoryValidationError is the validation error returned by
+// GatewayConnectionHistory.ValidateFields if the designated constraints
+// aren't met.
+type GatewayConnectionHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionHistoryValidationError) ErrorName() string {
+	return "GatewayConnectionHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionHistoryValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionHistory_Distribution with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConnectionHistory_Distribution) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionHistory_DistributionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "count":
+			// no validation rules for Count
+		case "min":
+			// no validation rules for Min
+		case "max":
+			// no validation rules for Max
+		case "mean":
+			// no validation rules for Mean
+		case "histogram_start":
+			// no validation rules for HistogramStart
+		case "histogram_bin_width":
+			// no validation rules for HistogramBinWidth
+		case "histogram":
+			// no validation rules for Histogram
+		default:
+			return GatewayConnectionHistory_DistributionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionHistory_DistributionValidationError is the validation error
+// returned by GatewayConnectionHistory_Distribution.ValidateFields if the
+// designated constraints aren't met.
+type GatewayConnectionHistory_DistributionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionHistory_DistributionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionHistory_DistributionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionHistory_DistributionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionHistory_DistributionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionHistory_DistributionValidationError) ErrorName() string {
+	return "GatewayConnectionHistory_DistributionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionHistory_DistributionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionHistory_Distribution.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionHistory_DistributionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionHistory_DistributionValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionHistory_Bucket
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayConnectionHistory_Bucket) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionHistory_BucketFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start":
+
+			if v, ok := interface{}(&m.Start).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_BucketValidationError{
+						field:  "start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "connected_duration":
+
+			if v, ok := interface{}(&m.ConnectedDuration).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_BucketValidationError{
+						field:  "connected_duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "status_count":
+			// no validation rules for StatusCount
+		case "rssi":
+
+			if v, ok := interface{}(m.GetRSSI()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_BucketValidationError{
+						field:  "rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "snr":
+
+			if v, ok := interface{}(m.GetSNR()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_BucketValidationError{
+						field:  "snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionHistory_BucketValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionHistory_BucketValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionHistory_BucketValidationError is the validation error
+// returned by GatewayConnectionHistory_Bucket.ValidateFields if the
+// designated constraints aren't met.
+type GatewayConnectionHistory_BucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionHistory_BucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionHistory_BucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionHistory_BucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionHistory_BucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionHistory_BucketValidationError) ErrorName() string {
+	return "GatewayConnectionHistory_BucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionHistory_BucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionHistory_Bucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionHistory_BucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionHistory_BucketValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionHistory_ConnectionPeriod with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConnectionHistory_ConnectionPeriod) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionHistory_ConnectionPeriodFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "connected_at":
+
+			if v, ok := interface{}(&m.ConnectedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_ConnectionPeriodValidationError{
+						field:  "connected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "disconnected_at":
+
+			if v, ok := interface{}(m.GetDisconnectedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionHistory_ConnectionPeriodValidationError{
+						field:  "disconnected_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "protocol":
+			// no validation rules for Protocol
+		default:
+			return GatewayConnectionHistory_ConnectionPeriodValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionHistory_ConnectionPeriodValidationError is the validation
+// error returned by GatewayConnectionHistory_ConnectionPeriod.ValidateFields
+// if the designated constraints aren't met.
+type GatewayConnectionHistory_ConnectionPeriodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) ErrorName() string {
+	return "GatewayConnectionHistory_ConnectionPeriodValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionHistory_ConnectionPeriodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionHistory_ConnectionPeriod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionHistory_ConnectionPeriodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionHistory_ConnectionPeriodValidationError{}
@@ -123,6 +123,8 @@ type RxMetadata struct {
 	UplinkToken []byte `protobuf:"bytes,15,opt,name=uplink_token,json=uplinkToken,proto3" json:"uplink_token,omitempty"`
 	// Index of the gateway channel that received the message.
 	ChannelIndex uint32 `protobuf:"varint,17,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	// Gateway's GPS time when the Rx finished, if the gateway is synchronized with GPS.
+	GPSTime *time.Time `protobuf:"bytes,19,opt,name=gps_time,json=gpsTime,proto3,stdtime" json:"gps_time,omitempty"`
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
@@ -282,6 +284,13 @@ func (m *RxMetadata) GetChannelIndex() uint32 {
 	return 0
 }
 
+func (m *RxMetadata) GetGPSTime() *time.Time {
+	if m != nil {
+		return m.GPSTime
+	}
+	return nil
+}
+
 func (m *RxMetadata) GetAdvanced() *types.Struct {
 	if m != nil {
 		return m.Advanced
@@ -559,99 +568,100 @@ func init() {
 }
 
 var fileDescriptor_e1123b3e8fd87092 = []byte{
-	// 1466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x3d, 0x8c, 0x1b, 0xc7,
-	0x15, 0xc7, 0x77, 0x8e, 0xbc, 0x13, 0x39, 0xfc, 0x10, 0x35, 0x67, 0x49, 0xab, 0x93, 0x32, 0xcb,
-	0x9c, 0x93, 0x80, 0x36, 0x72, 0x24, 0x20, 0xd9, 0x80, 0x91, 0xca, 0xc7, 0xe3, 0x49, 0x5e, 0xf8,
-	0x44, 0xca, 0xc3, 0x93, 0x8d, 0xa4, 0x59, 0xcc, 0xed, 0x0e, 0xc9, 0x0d, 0xc9, 0xd9, 0xcd, 0xee,
-	0xf0, 0x4e, 0xec, 0x84, 0x54, 0x42, 0x2a, 0xa7, 0x4b, 0x69, 0x20, 0x08, 0xe0, 0xd2, 0xa5, 0xca,
-	0x6b, 0x02, 0xa8, 0x54, 0x17, 0x23, 0x05, 0xe3, 0x5b, 0x36, 0x2e, 0x95, 0x4e, 0x50, 0x93, 0x60,
-	0x67, 0x3f, 0x78, 0x24, 0xa5, 0x04, 0x41, 0x58, 0x71, 0xfe, 0xef, 0xf7, 0xfe, 0xb3, 0xf3, 0x66,
-	0xde, 0x0c, 0xac, 0x8e, 0x1c, 0x8f, 0x9e, 0x51, 0xbe, 0xe7, 0x0b, 0x6a, 0x0e, 0x1b, 0xd4, 0xb5,
-	0x1b, 0x63, 0x26, 0xa8, 0x45, 0x05, 0xad, 0xbb, 0x9e, 0x23, 0x1c, 0x54, 0x16, 0x82, 0xd7, 0x63,
-	0xaa, 0x7e, 0x7a, 0x6f, 0x67, 0xbf, 0x6f, 0x8b, 0xc1, 0xe4, 0xa4, 0x6e, 0x3a, 0xe3, 0x06, 0xe3,
-	0xa7, 0xce, 0xd4, 0xf5, 0x9c, 0x27, 0xd3, 0x86, 0x84, 0xcd, 0xbd, 0x3e, 0xe3, 0x7b, 0xa7, 0x74,
-	0x64, 0x5b, 0x54, 0xb0, 0xc6, 0xda, 0x9f, 0xc8, 0x72, 0x67, 0xef, 0x92, 0x45, 0xdf, 0xe9, 0x3b,
-	0x51, 0xf2, 0xc9, 0xa4, 0x27, 0x47, 0x72, 0x20, 0xff, 0xc5, 0xf8, 0x9d, 0xbe, 0xe3, 0xf4, 0x47,
-	0x6c, 0x41, 0xf9, 0xc2, 0x9b, 0x98, 0x22, 0x8e, 0x6a, 0xab, 0x51, 0x61, 0x8f, 0x99, 0x2f, 0xe8,
-	0xd8, 0x8d, 0x01, 0xbc, 0x0a, 0x9c, 0x79, 0xd4, 0x75, 0x99, 0xe7, 0xc7, 0xf1, 0x9f, 0xac, 0x97,
-	0x80, 0xf1, 0xc9, 0x38, 0x09, 0xbf, 0xbf, 0x1e, 0xb6, 0x2d, 0xc6, 0x85, 0xdd, 0xb3, 0x53, 0x8f,
-	0xdd, 0xbf, 0xe5, 0x20, 0x24, 0x4f, 0x1e, 0xc6, 0x95, 0x43, 0x8f, 0x61, 0xa1, 0x4f, 0x05, 0x3b,
-	0xa3, 0x53, 0xc3, 0xb6, 0x7c, 0x15, 0x54, 0x41, 0xad, 0x70, 0x77, 0xb7, 0xbe, 0x5c, 0xc9, 0xfa,
-	0x83, 0x08, 0xd1, 0x17, 0x6e, 0xcd, 0xca, 0x9b, 0xe6, 0xe6, 0x1f, 0xc0, 0x46, 0x05, 0xbc, 0x98,
-	0x69, 0xca, 0xcb, 0x99, 0x06, 0x08, 0xec, 0x27, 0x94, 0x8f, 0x74, 0x58, 0x72, 0xa9, 0x39, 0x64,
-	0xc2, 0x38, 0xf1, 0x9c, 0x21, 0xf3, 0x54, 0x24, 0x8d, 0x7f, 0xb6, 0x6a, 0xfc, 0x48, 0x42, 0x4d,
-	0xc9, 0x24, 0xdf, 0x44, 0x8a, 0xee, 0x25, 0x15, 0xbd, 0x0f, 0x4b, 0x94, 0x0b, 0xc6, 0x39, 0x35,
-	0x6c, 0x6e, 0xb1, 0x27, 0xea, 0x46, 0x15, 0xd4, 0x4a, 0xa4, 0x18, 0x8b, 0x7a, 0xa8, 0xa1, 0x8f,
-	0x60, 0x36, 0x2c, 0xa6, 0x9a, 0x91, 0xd3, 0xec, 0xd4, 0xa3, 0x42, 0xd6, 0x93, 0x42, 0xd6, 0x8f,
-	0x93, 0x4a, 0x37, 0xb3, 0x5f, 0xff, 0x43, 0x03, 0x44, 0xd2, 0xe8, 0x0e, 0xcc, 0xa7, 0x5b, 0xa0,
-	0x66, 0xa5, 0xed, 0x42, 0x40, 0x3f, 0x87, 0xe5, 0x9e, 0xcd, 0x99, 0xb1, 0x40, 0x36, 0xab, 0xa0,
-	0x96, 0x25, 0xa5, 0x50, 0x4d, 0x0d, 0xd1, 0x27, 0x50, 0x65, 0xdc, 0xf4, 0xa6, 0xae, 0x60, 0x96,
-	0xb1, 0x92, 0xb0, 0x55, 0x05, 0xb5, 0x22, 0xb9, 0x91, 0xc6, 0xef, 0x2f, 0x65, 0x32, 0xa8, 0xbd,
-	0x2b, 0xd3, 0x18, 0xb2, 0x70, 0x43, 0xd4, 0x2b, 0x55, 0x50, 0xcb, 0x37, 0xb5, 0x60, 0xa6, 0xdd,
-	0x3e, 0x7c, 0xab, 0xc9, 0xe7, 0x6c, 0xaa, 0xb7, 0xc8, 0x6d, 0xf6, 0xce, 0xa0, 0x85, 0xee, 0xc0,
-	0xac, 0xe7, 0xfb, 0xb6, 0x9a, 0xab, 0x82, 0xda, 0x46, 0x33, 0x17, 0xcc, 0xb4, 0x2c, 0xe9, 0x76,
-	0x75, 0x22, 0x55, 0x74, 0x04, 0x0b, 0xbe, 0xdd, 0xe7, 0x74, 0x64, 0x48, 0xa8, 0x22, 0x0b, 0x78,
-	0x7b, 0xad, 0x80, 0xf7, 0x47, 0x0e, 0x15, 0x5f, 0xd2, 0xd1, 0x84, 0x35, 0xcb, 0xc1, 0x4c, 0x83,
-	0x5d, 0x99, 0x23, 0x7d, 0x60, 0x94, 0x4f, 0x42, 0xb7, 0xbb, 0xb0, 0x68, 0x0e, 0x28, 0xe7, 0x2c,
-	0xb6, 0xcb, 0xcb, 0x39, 0xaf, 0x06, 0x33, 0xad, 0x70, 0x10, 0xe9, 0x32, 0xa5, 0x10, 0x43, 0x32,
-	0xe7, 0x0b, 0x78, 0x33, 0x64, 0x0d, 0x5f, 0x50, 0x6e, 0x51, 0xcf, 0x32, 0x2c, 0x76, 0x6a, 0x53,
-	0x61, 0x3b, 0x5c, 0x85, 0x32, 0xfd, 0x56, 0x30, 0xd3, 0xae, 0x87, 0x79, 0xdd, 0x98, 0x68, 0x25,
-	0x00, 0xb9, 0x1e, 0x66, 0xae, 0xc9, 0xe8, 0x16, 0xcc, 0xf8, 0xdc, 0x53, 0x0b, 0x32, 0xfd, 0x4a,
-	0x30, 0xd3, 0x32, 0xdd, 0x36, 0x21, 0xa1, 0x86, 0x3e, 0x80, 0x95, 0x9e, 0xc7, 0x7e, 0x37, 0x61,
-	0xdc, 0x9c, 0x1a, 0x4e, 0xaf, 0xe7, 0x33, 0xa1, 0x16, 0xab, 0xa0, 0x96, 0x21, 0x57, 0x53, 0xbd,
-	0x23, 0x65, 0xf4, 0x11, 0xcc, 0x8d, 0x1c, 0x33, 0xfa, 0x92, 0x92, 0xac, 0x8b, 0xba, 0x7a, 0x7e,
-	0x8f, 0xe2, 0x38, 0x49, 0x49, 0xf4, 0x5b, 0xa8, 0x5a, 0xce, 0x19, 0x1f, 0xd9, 0x7c, 0x68, 0xb8,
-	0x54, 0x0c, 0x0c, 0xd3, 0xe1, 0xbe, 0xf0, 0xa8, 0xcd, 0x85, 0x5a, 0xae, 0x82, 0x5a, 0xf9, 0xee,
-	0x2f, 0x56, 0x5d, 0x5a, 0x31, 0xff, 0x88, 0x8a, 0xc1, 0x41, 0x4a, 0x37, 0x73, 0x6f, 0x9a, 0x9b,
-	0xbf, 0x0f, 0x5b, 0x8c, 0xdc, 0xb0, 0xde, 0x4a, 0xa0, 0x9f, 0xc2, 0xe2, 0xc4, 0x95, 0x33, 0x09,
-	0x67, 0xc8, 0xb8, 0x7a, 0x55, 0x9e, 0xb7, 0x42, 0xa4, 0x1d, 0x87, 0x12, 0xda, 0x83, 0xa5, 0x64,
-	0x47, 0xa2, 0xf6, 0xb9, 0x16, 0x9e, 0x73, 0xe9, 0xfd, 0x61, 0x46, 0xfd, 0x17, 0x20, 0xc9, 0x86,
-	0x45, 0x8d, 0x74, 0x0f, 0xe6, 0xa8, 0x75, 0x4a, 0xb9, 0xc9, 0x2c, 0xd5, 0x94, 0x6b, 0xbe, 0xb9,
-	0x76, 0x16, 0xba, 0xf2, 0x52, 0x23, 0x29, 0xf8, 0xab, 0xec, 0xf3, 0x6f, 0x34, 0x65, 0xf7, 0x15,
-	0x80, 0xb9, 0xa4, 0x1e, 0xa1, 0xcf, 0x88, 0x0a, 0x5b, 0x4c, 0x2c, 0x26, 0x2f, 0x15, 0xd0, 0xbc,
-	0xf9, 0xa6, 0xf9, 0x1e, 0x42, 0xb7, 0x94, 0xf0, 0xf7, 0xf4, 0xcb, 0x4f, 0x3f, 0x88, 0xff, 0x9c,
-	0x93, 0x14, 0x44, 0x1f, 0xc3, 0xfc, 0xc8, 0xe1, 0xfd, 0x28, 0x6b, 0x63, 0x3d, 0xab, 0x97, 0x64,
-	0xf5, 0xce, 0xc9, 0x82, 0x44, 0x3b, 0x30, 0x47, 0x47, 0xf1, 0x5c, 0xe1, 0x05, 0xb0, 0x49, 0xd2,
-	0xb1, 0x8c, 0x99, 0xe6, 0xc4, 0xa3, 0xe6, 0x54, 0x76, 0x78, 0x18, 0x8b, 0xc7, 0xe8, 0x53, 0xb8,
-	0xe5, 0x3b, 0x13, 0xcf, 0x64, 0xb2, 0xb1, 0xcb, 0x77, 0xf1, 0xbb, 0x76, 0xb7, 0x2b, 0xa9, 0x4b,
-	0xfb, 0x11, 0xe7, 0xed, 0xfe, 0x35, 0x0b, 0xdf, 0x7b, 0xdb, 0x15, 0x86, 0x7e, 0x09, 0xe1, 0x98,
-	0xf9, 0x3e, 0xed, 0xb3, 0xb0, 0x8b, 0x81, 0xec, 0xe2, 0x52, 0x30, 0xd3, 0xf2, 0x0f, 0x23, 0x55,
-	0x6f, 0x91, 0x7c, 0x0c, 0xe8, 0x16, 0x9a, 0xc2, 0x4a, 0xcf, 0xf1, 0xce, 0xa8, 0x67, 0x31, 0xcf,
-	0xe0, 0x4c, 0x84, 0x39, 0xe1, 0xf2, 0x8b, 0xcd, 0x4e, 0x78, 0xbb, 0xfe, 0x7d, 0xa6, 0x7d, 0xdc,
-	0x77, 0xea, 0x62, 0xc0, 0xc4, 0xc0, 0xe6, 0x7d, 0xbf, 0xce, 0x99, 0x38, 0x73, 0xbc, 0x61, 0x63,
-	0xf9, 0xbe, 0x3f, 0xbd, 0xd7, 0x70, 0x87, 0xfd, 0x86, 0x98, 0xba, 0xcc, 0xaf, 0xb7, 0x99, 0xd0,
-	0x5b, 0xc1, 0x4c, 0x2b, 0xdf, 0x4f, 0x8c, 0xa5, 0x42, 0xca, 0xbd, 0xcb, 0x63, 0x0b, 0x1d, 0xc2,
-	0xed, 0xc5, 0xd4, 0x82, 0x71, 0xca, 0xe5, 0xec, 0x19, 0xf9, 0xc5, 0xd7, 0x83, 0x99, 0x76, 0x2d,
-	0x35, 0x38, 0x96, 0x51, 0xbd, 0x45, 0xae, 0xf5, 0x56, 0x24, 0x2b, 0xec, 0xfb, 0x85, 0x8d, 0x6d,
-	0xc9, 0x52, 0xe7, 0xa3, 0xbe, 0x4f, 0xf3, 0xf5, 0x16, 0x29, 0xa4, 0x90, 0x6e, 0xa1, 0xa7, 0x00,
-	0x6e, 0x0f, 0x9c, 0x31, 0x33, 0xe2, 0xe5, 0x24, 0x2b, 0xdf, 0x94, 0x2b, 0xff, 0xe2, 0xff, 0x5d,
-	0x79, 0xe5, 0x33, 0x67, 0xcc, 0xda, 0x11, 0x1f, 0xad, 0xbd, 0x32, 0x58, 0x56, 0x2c, 0x74, 0x04,
-	0x6f, 0x2c, 0x7d, 0xc1, 0xa2, 0x00, 0x5b, 0x72, 0x01, 0x37, 0x83, 0x99, 0xb6, 0x7d, 0xc9, 0x27,
-	0x2d, 0xc1, 0xf6, 0x60, 0x4d, 0xb4, 0xd0, 0x27, 0x30, 0x3b, 0x70, 0x5c, 0x5f, 0xbd, 0x52, 0xcd,
-	0xfc, 0xb7, 0xb7, 0x8e, 0x38, 0x13, 0xc1, 0x3e, 0x73, 0x5c, 0x22, 0x33, 0x76, 0xff, 0x09, 0x96,
-	0xcf, 0x51, 0x12, 0x46, 0x87, 0xb0, 0xe0, 0x31, 0x93, 0xd9, 0xa7, 0xcc, 0x32, 0xa8, 0x88, 0x9f,
-	0xe7, 0xff, 0xf4, 0xbc, 0xe5, 0xc2, 0xb2, 0xc9, 0x27, 0x0e, 0x26, 0x89, 0xfb, 0x02, 0x69, 0xb0,
-	0xe0, 0x33, 0x2e, 0x4f, 0x17, 0x1d, 0x47, 0xad, 0x95, 0x27, 0x30, 0x92, 0xda, 0x74, 0xcc, 0xc2,
-	0xb7, 0x2e, 0x06, 0xa8, 0x65, 0x79, 0xcc, 0xf7, 0xa3, 0x13, 0x40, 0x4a, 0x91, 0xba, 0x1f, 0x89,
-	0xe1, 0x5b, 0x1c, 0xbb, 0xc6, 0x4e, 0x72, 0x9f, 0x49, 0x31, 0x11, 0x13, 0xaf, 0x14, 0xa2, 0x7d,
-	0xc6, 0x85, 0xdc, 0xd1, 0x3c, 0x49, 0x53, 0xf7, 0x43, 0xf1, 0xc3, 0x3f, 0x6e, 0xc0, 0xf2, 0x72,
-	0x83, 0x21, 0x04, 0xcb, 0xdd, 0xce, 0x63, 0x72, 0x70, 0x68, 0x3c, 0x6e, 0x7f, 0xde, 0xee, 0x7c,
-	0xd5, 0xae, 0x28, 0xa8, 0x0c, 0x61, 0xac, 0x3d, 0x78, 0xd4, 0xad, 0x00, 0xb4, 0x0d, 0xaf, 0xc6,
-	0x63, 0x72, 0xf8, 0x40, 0xef, 0x1e, 0x93, 0x5f, 0x57, 0x32, 0xe8, 0x16, 0xbc, 0x1e, 0x8b, 0xfa,
-	0x23, 0xe3, 0xc1, 0x61, 0xe7, 0xa8, 0x73, 0xb0, 0x7f, 0xac, 0x77, 0xda, 0x95, 0x2c, 0xaa, 0xc2,
-	0x3b, 0x71, 0xe8, 0x2b, 0xfd, 0xbe, 0x6e, 0x84, 0xcf, 0xc8, 0x12, 0xb1, 0x89, 0x30, 0xdc, 0x89,
-	0x89, 0xe6, 0xf1, 0x7a, 0x7c, 0xeb, 0x92, 0xc3, 0x51, 0x87, 0xec, 0xaf, 0x13, 0x57, 0x56, 0x89,
-	0xe3, 0x56, 0x67, 0x7f, 0x89, 0xc8, 0x21, 0x0d, 0xde, 0x8e, 0x89, 0x83, 0xce, 0xc3, 0xa6, 0xde,
-	0x3e, 0x6c, 0x2d, 0x01, 0xf9, 0x9d, 0xec, 0xb3, 0x3f, 0x63, 0xa5, 0xf9, 0x17, 0xf0, 0xe2, 0x02,
-	0x83, 0x97, 0x17, 0x18, 0x7c, 0x7f, 0x81, 0x95, 0x1f, 0x2e, 0xb0, 0xf2, 0xe3, 0x05, 0x56, 0x5e,
-	0x5d, 0x60, 0xe5, 0xf5, 0x05, 0x06, 0x4f, 0x03, 0x0c, 0x9e, 0x05, 0x58, 0xf9, 0x36, 0xc0, 0xe0,
-	0xbb, 0x00, 0x2b, 0xcf, 0x03, 0xac, 0x9c, 0x07, 0x58, 0x79, 0x11, 0x60, 0xf0, 0x32, 0xc0, 0xe0,
-	0xfb, 0x00, 0x2b, 0x3f, 0x04, 0x18, 0xfc, 0x18, 0x60, 0xe5, 0x55, 0x80, 0xc1, 0xeb, 0x00, 0x2b,
-	0x4f, 0xe7, 0x58, 0x79, 0x36, 0xc7, 0xe0, 0xeb, 0x39, 0x56, 0xfe, 0x34, 0xc7, 0xe0, 0x9b, 0x39,
-	0x56, 0xbe, 0x9d, 0x63, 0xe5, 0xbb, 0x39, 0x06, 0xcf, 0xe7, 0x18, 0x9c, 0xcf, 0x31, 0xf8, 0x4d,
-	0xe3, 0x7f, 0xe8, 0x31, 0xc1, 0xdd, 0x93, 0x93, 0x2d, 0x79, 0xf2, 0xee, 0xfd, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0xcb, 0x4c, 0xf6, 0x53, 0x94, 0x0b, 0x00, 0x00,
+	// 1488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xde, 0x91, 0x28, 0x89, 0x1c, 0x8a, 0x34, 0x3d, 0xf2, 0xcf, 0x5a, 0x76, 0x66, 0x19, 0x5d,
+	0x12, 0xf0, 0x0e, 0x11, 0x09, 0xd8, 0x77, 0xc0, 0x21, 0xd5, 0x89, 0xa2, 0xa4, 0x5b, 0x9c, 0x4c,
+	0xea, 0x86, 0xf2, 0x1d, 0x92, 0x66, 0x31, 0xda, 0x1d, 0x2e, 0x37, 0x24, 0x67, 0x37, 0xbb, 0x43,
+	0xc9, 0xec, 0x8c, 0x54, 0x46, 0xaa, 0x4b, 0x97, 0x2e, 0x07, 0x04, 0x01, 0xae, 0xbc, 0xd2, 0xa5,
+	0x9b, 0x00, 0x2e, 0x5d, 0x1e, 0x52, 0x30, 0xa7, 0x65, 0x73, 0xa5, 0xd3, 0x19, 0x6e, 0x12, 0xec,
+	0xec, 0x72, 0x29, 0x92, 0x36, 0x8c, 0x20, 0xac, 0x38, 0xdf, 0xfb, 0xbe, 0x6f, 0x76, 0xde, 0xbc,
+	0xf7, 0x06, 0x96, 0xfb, 0xae, 0x4f, 0x2f, 0x28, 0xdf, 0x0d, 0x04, 0x35, 0x7b, 0x35, 0xea, 0x39,
+	0xb5, 0x01, 0x13, 0xd4, 0xa2, 0x82, 0x56, 0x3d, 0xdf, 0x15, 0x2e, 0x2a, 0x0a, 0xc1, 0xab, 0x09,
+	0xab, 0x7a, 0xfe, 0x60, 0x7b, 0xcf, 0x76, 0x44, 0x77, 0x78, 0x56, 0x35, 0xdd, 0x41, 0x8d, 0xf1,
+	0x73, 0x77, 0xe4, 0xf9, 0xee, 0xe3, 0x51, 0x4d, 0x92, 0xcd, 0x5d, 0x9b, 0xf1, 0xdd, 0x73, 0xda,
+	0x77, 0x2c, 0x2a, 0x58, 0x6d, 0xe9, 0x4f, 0x6c, 0xb9, 0xbd, 0x7b, 0xc5, 0xc2, 0x76, 0x6d, 0x37,
+	0x16, 0x9f, 0x0d, 0x3b, 0x72, 0x25, 0x17, 0xf2, 0x5f, 0x42, 0xbf, 0x67, 0xbb, 0xae, 0xdd, 0x67,
+	0x33, 0x56, 0x20, 0xfc, 0xa1, 0x29, 0x92, 0xa8, 0xb6, 0x18, 0x15, 0xce, 0x80, 0x05, 0x82, 0x0e,
+	0xbc, 0x84, 0x80, 0x17, 0x09, 0x17, 0x3e, 0xf5, 0x3c, 0xe6, 0x07, 0x49, 0xfc, 0x67, 0xcb, 0x29,
+	0x60, 0x7c, 0x38, 0x98, 0x86, 0x3f, 0x58, 0x0e, 0x3b, 0x16, 0xe3, 0xc2, 0xe9, 0x38, 0xa9, 0xc7,
+	0xce, 0x5f, 0x73, 0x10, 0x92, 0xc7, 0x0f, 0x93, 0xcc, 0xa1, 0x47, 0x30, 0x6f, 0x53, 0xc1, 0x2e,
+	0xe8, 0xc8, 0x70, 0xac, 0x40, 0x05, 0x65, 0x50, 0xc9, 0xdf, 0xdf, 0xa9, 0xce, 0x67, 0xb2, 0x7a,
+	0x14, 0x53, 0xf4, 0x99, 0x5b, 0xbd, 0xf4, 0xa6, 0xbe, 0xf6, 0x27, 0xb0, 0x52, 0x02, 0x2f, 0xc6,
+	0x9a, 0xf2, 0x72, 0xac, 0x01, 0x02, 0xed, 0x29, 0x2b, 0x40, 0x3a, 0x2c, 0x78, 0xd4, 0xec, 0x31,
+	0x61, 0x9c, 0xf9, 0x6e, 0x8f, 0xf9, 0x2a, 0x92, 0xc6, 0xbf, 0x58, 0x34, 0x3e, 0x91, 0xa4, 0xba,
+	0xe4, 0x4c, 0xbf, 0x89, 0x6c, 0x7a, 0x57, 0x50, 0xf4, 0x01, 0x2c, 0x50, 0x2e, 0x18, 0xe7, 0xd4,
+	0x70, 0xb8, 0xc5, 0x1e, 0xab, 0x2b, 0x65, 0x50, 0x29, 0x90, 0xcd, 0x04, 0xd4, 0x23, 0x0c, 0x7d,
+	0x0c, 0x33, 0x51, 0x32, 0xd5, 0x55, 0xb9, 0xcd, 0x76, 0x35, 0x4e, 0x64, 0x75, 0x9a, 0xc8, 0xea,
+	0xe9, 0x34, 0xd3, 0xf5, 0xcc, 0x37, 0xff, 0xd2, 0x00, 0x91, 0x6c, 0x74, 0x0f, 0xe6, 0xd2, 0x2b,
+	0x50, 0x33, 0xd2, 0x76, 0x06, 0xa0, 0x5f, 0xc2, 0x62, 0xc7, 0xe1, 0xcc, 0x98, 0x51, 0xd6, 0xca,
+	0xa0, 0x92, 0x21, 0x85, 0x08, 0x4d, 0x0d, 0xd1, 0xa7, 0x50, 0x65, 0xdc, 0xf4, 0x47, 0x9e, 0x60,
+	0x96, 0xb1, 0x20, 0x58, 0x2f, 0x83, 0xca, 0x26, 0xb9, 0x95, 0xc6, 0x0f, 0xe7, 0x94, 0x0c, 0x6a,
+	0xef, 0x52, 0x1a, 0x3d, 0x16, 0x5d, 0x88, 0xba, 0x51, 0x06, 0x95, 0x5c, 0x5d, 0x0b, 0xc7, 0xda,
+	0xdd, 0x83, 0xb7, 0x9a, 0x7c, 0xc1, 0x46, 0x7a, 0x83, 0xdc, 0x65, 0xef, 0x0c, 0x5a, 0xe8, 0x1e,
+	0xcc, 0xf8, 0x41, 0xe0, 0xa8, 0xd9, 0x32, 0xa8, 0xac, 0xd4, 0xb3, 0xe1, 0x58, 0xcb, 0x90, 0x76,
+	0x5b, 0x27, 0x12, 0x45, 0xc7, 0x30, 0x1f, 0x38, 0x36, 0xa7, 0x7d, 0x43, 0x92, 0x4a, 0x32, 0x81,
+	0x77, 0x97, 0x12, 0x78, 0xd8, 0x77, 0xa9, 0xf8, 0x8a, 0xf6, 0x87, 0xac, 0x5e, 0x0c, 0xc7, 0x1a,
+	0x6c, 0x4b, 0x8d, 0xf4, 0x81, 0xb1, 0x9e, 0x44, 0x6e, 0xf7, 0xe1, 0xa6, 0xd9, 0xa5, 0x9c, 0xb3,
+	0xc4, 0x2e, 0x27, 0xf7, 0xbc, 0x16, 0x8e, 0xb5, 0xfc, 0x7e, 0x8c, 0x4b, 0x49, 0x3e, 0x21, 0x49,
+	0xcd, 0x97, 0xf0, 0x76, 0xc4, 0x35, 0x02, 0x41, 0xb9, 0x45, 0x7d, 0xcb, 0xb0, 0xd8, 0xb9, 0x43,
+	0x85, 0xe3, 0x72, 0x15, 0x4a, 0xf9, 0x9d, 0x70, 0xac, 0xdd, 0x8c, 0x74, 0xed, 0x84, 0xd1, 0x98,
+	0x12, 0xc8, 0xcd, 0x48, 0xb9, 0x04, 0xa3, 0x3b, 0x70, 0x35, 0xe0, 0xbe, 0x9a, 0x97, 0xf2, 0x8d,
+	0x70, 0xac, 0xad, 0xb6, 0x9b, 0x84, 0x44, 0x18, 0xfa, 0x10, 0x96, 0x3a, 0x3e, 0xfb, 0xc3, 0x90,
+	0x71, 0x73, 0x64, 0xb8, 0x9d, 0x4e, 0xc0, 0x84, 0xba, 0x59, 0x06, 0x95, 0x55, 0x72, 0x2d, 0xc5,
+	0x5b, 0x12, 0x46, 0x1f, 0xc3, 0x6c, 0xdf, 0x35, 0xe3, 0x2f, 0x29, 0xc8, 0xbc, 0xa8, 0x8b, 0xf5,
+	0x7b, 0x9c, 0xc4, 0x49, 0xca, 0x44, 0xbf, 0x87, 0xaa, 0xe5, 0x5e, 0xf0, 0xbe, 0xc3, 0x7b, 0x86,
+	0x47, 0x45, 0xd7, 0x30, 0x5d, 0x1e, 0x08, 0x9f, 0x3a, 0x5c, 0xa8, 0xc5, 0x32, 0xa8, 0x14, 0xef,
+	0xff, 0x6a, 0xd1, 0xa5, 0x91, 0xf0, 0x4f, 0xa8, 0xe8, 0xee, 0xa7, 0xec, 0x7a, 0xf6, 0x4d, 0x7d,
+	0xed, 0x8f, 0x51, 0x8b, 0x91, 0x5b, 0xd6, 0x5b, 0x19, 0xe8, 0xe7, 0x70, 0x73, 0xe8, 0xc9, 0x9d,
+	0x84, 0xdb, 0x63, 0x5c, 0xbd, 0x26, 0xeb, 0x2d, 0x1f, 0x63, 0xa7, 0x11, 0x84, 0x76, 0x61, 0x61,
+	0x7a, 0x23, 0x71, 0xfb, 0x5c, 0x8f, 0xea, 0x5c, 0x7a, 0x7f, 0xb4, 0xaa, 0xfe, 0x07, 0x90, 0xe9,
+	0x85, 0xc5, 0x8d, 0x74, 0x08, 0xb3, 0xb6, 0x17, 0xc8, 0x42, 0x54, 0xb7, 0xde, 0xdb, 0x4c, 0xd1,
+	0xc5, 0x6e, 0x1c, 0x9d, 0xb4, 0x23, 0x44, 0xf6, 0xd5, 0x86, 0xed, 0x05, 0xd1, 0x02, 0x3d, 0x80,
+	0x59, 0x6a, 0x9d, 0x53, 0x6e, 0x32, 0x4b, 0x35, 0xa5, 0xcf, 0xed, 0x25, 0x9f, 0xb6, 0x1c, 0x8e,
+	0x24, 0x25, 0xfe, 0x26, 0xf3, 0xec, 0x5b, 0x4d, 0xd9, 0x79, 0x05, 0x60, 0x76, 0x9a, 0xd7, 0xc8,
+	0xa7, 0x4f, 0x85, 0x23, 0x86, 0x16, 0x93, 0xc3, 0x09, 0xd4, 0x6f, 0xbf, 0xa9, 0xdf, 0x40, 0xe8,
+	0x8e, 0x12, 0xfd, 0x9e, 0x7c, 0xf5, 0xd9, 0x87, 0xc9, 0x9f, 0xe7, 0x24, 0x25, 0xa2, 0x4f, 0x60,
+	0xae, 0xef, 0x72, 0x3b, 0x56, 0xad, 0x2c, 0xab, 0x3a, 0x53, 0x55, 0xe7, 0x39, 0x99, 0x31, 0xd1,
+	0x36, 0xcc, 0xd2, 0x7e, 0xb2, 0x57, 0x34, 0x48, 0xd6, 0x48, 0xba, 0x96, 0x31, 0xd3, 0x1c, 0xfa,
+	0xd4, 0x1c, 0xa9, 0x99, 0x24, 0x96, 0xac, 0xd1, 0x67, 0x70, 0x3d, 0x70, 0x87, 0xbe, 0xc9, 0xe4,
+	0x80, 0x28, 0xde, 0xc7, 0xef, 0xaa, 0x92, 0xb6, 0x64, 0x5d, 0xb9, 0xd7, 0x44, 0xb7, 0xf3, 0x8f,
+	0x0c, 0xbc, 0xf1, 0xb6, 0x51, 0x88, 0x7e, 0x0d, 0xe1, 0x80, 0x05, 0x01, 0xb5, 0x59, 0x34, 0x0d,
+	0x80, 0x9c, 0x06, 0x85, 0x70, 0xac, 0xe5, 0x1e, 0xc6, 0xa8, 0xde, 0x20, 0xb9, 0x84, 0xa0, 0x5b,
+	0x68, 0x04, 0x4b, 0x1d, 0xd7, 0xbf, 0xa0, 0xbe, 0xc5, 0x7c, 0x83, 0x33, 0x11, 0x69, 0xa2, 0xe3,
+	0x6f, 0xd6, 0x5b, 0xd1, 0x94, 0xfe, 0xe7, 0x58, 0xfb, 0xc4, 0x76, 0xab, 0xa2, 0xcb, 0x44, 0xd7,
+	0xe1, 0x76, 0x50, 0xe5, 0x4c, 0x5c, 0xb8, 0x7e, 0xaf, 0x36, 0xff, 0x6e, 0x9c, 0x3f, 0xa8, 0x79,
+	0x3d, 0xbb, 0x26, 0x46, 0x1e, 0x0b, 0xaa, 0x4d, 0x26, 0xf4, 0x46, 0x38, 0xd6, 0x8a, 0x87, 0x53,
+	0x63, 0x89, 0x90, 0x62, 0xe7, 0xea, 0xda, 0x42, 0x07, 0x70, 0x6b, 0xb6, 0xb5, 0x60, 0x9c, 0x72,
+	0xb9, 0xfb, 0xaa, 0xfc, 0xe2, 0x9b, 0xe1, 0x58, 0xbb, 0x9e, 0x1a, 0x9c, 0xca, 0xa8, 0xde, 0x20,
+	0xd7, 0x3b, 0x0b, 0x90, 0x15, 0xcd, 0x8f, 0x99, 0x8d, 0x63, 0xc9, 0x54, 0xe7, 0xe2, 0xf9, 0x91,
+	0xea, 0xf5, 0x06, 0xc9, 0xa7, 0x24, 0xdd, 0x42, 0x4f, 0x00, 0xdc, 0xea, 0xba, 0x03, 0x66, 0x24,
+	0xc7, 0x99, 0x9e, 0x7c, 0x4d, 0x9e, 0xfc, 0xcb, 0xff, 0xf7, 0xe4, 0xa5, 0xcf, 0xdd, 0x01, 0x6b,
+	0xc6, 0xfc, 0xf8, 0xec, 0xa5, 0xee, 0x3c, 0x62, 0xa1, 0x63, 0x78, 0x6b, 0xee, 0x0b, 0x66, 0x09,
+	0x58, 0x97, 0x07, 0xb8, 0x1d, 0x8e, 0xb5, 0xad, 0x2b, 0x3e, 0x69, 0x0a, 0xb6, 0xba, 0x4b, 0xa0,
+	0x85, 0x3e, 0x85, 0x99, 0xae, 0xeb, 0x05, 0xea, 0x46, 0x79, 0xf5, 0x7d, 0x6f, 0x26, 0x71, 0x87,
+	0x82, 0x7d, 0xee, 0x7a, 0x44, 0x2a, 0x76, 0xfe, 0x0d, 0xe0, 0x8d, 0xb7, 0x85, 0xd1, 0x01, 0xcc,
+	0xfb, 0xcc, 0x64, 0xce, 0x39, 0xb3, 0x0c, 0x2a, 0x54, 0xf0, 0xde, 0xce, 0xce, 0x46, 0x69, 0x93,
+	0x2d, 0x0d, 0xa7, 0xc2, 0x3d, 0x81, 0x34, 0x98, 0x0f, 0x18, 0x97, 0xd5, 0x45, 0x07, 0x71, 0x6b,
+	0xe5, 0x08, 0x8c, 0xa1, 0x26, 0x1d, 0xb0, 0xe8, 0xcd, 0x4c, 0x08, 0xd4, 0xb2, 0x7c, 0x16, 0x04,
+	0x71, 0x05, 0x90, 0x42, 0x8c, 0xee, 0xc5, 0x60, 0xf4, 0xa6, 0x27, 0xae, 0x89, 0x93, 0xbc, 0x67,
+	0xb2, 0x39, 0x05, 0xa7, 0x5e, 0x29, 0x89, 0xda, 0x8c, 0x0b, 0x79, 0xa3, 0x39, 0x92, 0x4a, 0xf7,
+	0x22, 0xf0, 0xa3, 0x3f, 0xaf, 0xc0, 0xe2, 0x7c, 0x83, 0x21, 0x04, 0x8b, 0xed, 0xd6, 0x23, 0xb2,
+	0x7f, 0x60, 0x3c, 0x6a, 0x7e, 0xd1, 0x6c, 0x7d, 0xdd, 0x2c, 0x29, 0xa8, 0x08, 0x61, 0x82, 0x1d,
+	0x9d, 0xb4, 0x4b, 0x00, 0x6d, 0xc1, 0x6b, 0xc9, 0x9a, 0x1c, 0x1c, 0xe9, 0xed, 0x53, 0xf2, 0xdb,
+	0xd2, 0x2a, 0xba, 0x03, 0x6f, 0x26, 0xa0, 0x7e, 0x62, 0x1c, 0x1d, 0xb4, 0x8e, 0x5b, 0xfb, 0x7b,
+	0xa7, 0x7a, 0xab, 0x59, 0xca, 0xa0, 0x32, 0xbc, 0x97, 0x84, 0xbe, 0xd6, 0x0f, 0x75, 0x23, 0x7a,
+	0x8e, 0xe6, 0x18, 0x6b, 0x08, 0xc3, 0xed, 0x84, 0x51, 0x3f, 0x5d, 0x8e, 0xaf, 0x5f, 0x71, 0x38,
+	0x6e, 0x91, 0xbd, 0x65, 0xc6, 0xc6, 0x22, 0xe3, 0xb4, 0xd1, 0xda, 0x9b, 0x63, 0x64, 0x91, 0x06,
+	0xef, 0x26, 0x8c, 0xfd, 0xd6, 0xc3, 0xba, 0xde, 0x3c, 0x68, 0xcc, 0x11, 0x72, 0xdb, 0x99, 0xa7,
+	0x7f, 0xc3, 0x4a, 0xfd, 0xef, 0xe0, 0xc5, 0x25, 0x06, 0x2f, 0x2f, 0x31, 0xf8, 0xe1, 0x12, 0x2b,
+	0x3f, 0x5e, 0x62, 0xe5, 0xa7, 0x4b, 0xac, 0xbc, 0xba, 0xc4, 0xca, 0xeb, 0x4b, 0x0c, 0x9e, 0x84,
+	0x18, 0x3c, 0x0d, 0xb1, 0xf2, 0x5d, 0x88, 0xc1, 0xf7, 0x21, 0x56, 0x9e, 0x85, 0x58, 0x79, 0x1e,
+	0x62, 0xe5, 0x45, 0x88, 0xc1, 0xcb, 0x10, 0x83, 0x1f, 0x42, 0xac, 0xfc, 0x18, 0x62, 0xf0, 0x53,
+	0x88, 0x95, 0x57, 0x21, 0x06, 0xaf, 0x43, 0xac, 0x3c, 0x99, 0x60, 0xe5, 0xe9, 0x04, 0x83, 0x6f,
+	0x26, 0x58, 0xf9, 0xcb, 0x04, 0x83, 0x6f, 0x27, 0x58, 0xf9, 0x6e, 0x82, 0x95, 0xef, 0x27, 0x18,
+	0x3c, 0x9b, 0x60, 0xf0, 0x7c, 0x82, 0xc1, 0xef, 0x6a, 0xff, 0x43, 0x8f, 0x09, 0xee, 0x9d, 0x9d,
+	0xad, 0xcb, 0xca, 0x7b, 0xf0, 0xdf, 0x01, 0x00, 0x8d, 0xac, 0xe2, 0x44, 0xdc, 0x0b, 0x00, 0x00,
 }
 
 func (x LocationSource) String() string {
@@ -738,6 +748,13 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if this.ChannelIndex != that1.ChannelIndex {
 		return false
 	}
+	if that1.GPSTime == nil {
+		if this.GPSTime != nil {
+			return false
+		}
+	} else if !this.GPSTime.Equal(*that1.GPSTime) {
+		return false
+	}
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
@@ -896,6 +913,18 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.GPSTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.GPSTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.GPSTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMetadata(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.PacketBroker != nil {
 		{
 			size, err := m.PacketBroker.MarshalToSizedBuffer(dAtA[:i])
@@ -1009,12 +1038,12 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.Time != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintMetadata(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintMetadata(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1219,12 +1248,12 @@ func (m *PacketBrokerRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintMetadata(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintMetadata(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1438,6 +1467,10 @@ func (m *RxMetadata) Size() (n int) {
 		l = m.PacketBroker.Size()
 		n += 2 + l + sovMetadata(uint64(l))
 	}
+	if m.GPSTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GPSTime)
+		n += 2 + l + sovMetadata(uint64(l))
+	}
 	if m.Advanced != nil {
 		l = m.Advanced.Size()
 		n += 2 + l + sovMetadata(uint64(l))
@@ -1560,6 +1593,7 @@ func (this *RxMetadata) String() string {
 		`SignalRSSI:` + strings.Replace(fmt.Sprintf("%v", this.SignalRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
 		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
 		`PacketBroker:` + strings.Replace(this.PacketBroker.String(), "PacketBrokerMetadata", "PacketBrokerMetadata", 1) + `,`,
+		`GPSTime:` + strings.Replace(fmt.Sprintf("%v", this.GPSTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
 		`}`,
 	}, "")
@@ -2086,6 +2120,42 @@ func (m *RxMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GPSTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GPSTime == nil {
+				m.GPSTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GPSTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
@@ -2183,7 +2253,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Latitude = float64(math.Float64frombits(v))
 		case 2:
@@ -2194,7 +2264,7 @@ func (m *Location) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Longitude = float64(math.Float64frombits(v))
 		case 3:
@@ -15,6 +15,7 @@ var RxMetadataFieldPathsNested = []string{
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
+	"gps_time",
 	"location",
 	"location.accuracy",
 	"location.altitude",
@@ -49,6 +50,7 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"fine_timestamp",
 	"frequency_offset",
 	"gateway_ids",
+	"gps_time",
 	"location",
 	"packet_broker",
 	"rssi",
@@ -226,6 +226,15 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+		case "gps_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gps_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GPSTime = src.GPSTime
+			} else {
+				dst.GPSTime = nil
+			}
 		case "advanced":
 			if len(subs) > 0 {
 				return fmt.Errorf("'advanced' has no subfields, but %s were specified", subs)
@@ -6,11 +6,20 @@ package ttnpb
 import (
 	context "context"
 	fmt "fmt"
+	io "io"
 	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,6 +30,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,189 +38,4149 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type PacketBrokerNetworkIdentifier struct {
+	// LoRa Alliance NetID.
+	NetID uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	// Tenant identifier if the registration leases DevAddr blocks from a NetID.
+	TenantID             string   `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PacketBrokerNetworkIdentifier) Reset()      { *m = PacketBrokerNetworkIdentifier{} }
+func (*PacketBrokerNetworkIdentifier) ProtoMessage() {}
+func (*PacketBrokerNetworkIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{0}
+}
+func (m *PacketBrokerNetworkIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerNetworkIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerNetworkIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerNetworkIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerNetworkIdentifier.Merge(m, src)
+}
+func (m *PacketBrokerNetworkIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerNetworkIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerNetworkIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerNetworkIdentifier proto.InternalMessageInfo
+
+func (m *PacketBrokerNetworkIdentifier) GetNetID() uint32 {
+	if m != nil {
+		return m.NetID
+	}
+	return 0
+}
+
+func (m *PacketBrokerNetworkIdentifier) GetTenantID() string {
+	if m != nil {
+		return m.TenantID
+	}
+	return ""
+}
+
+type PacketBrokerNetwork struct {
+	// Packet Broker network identifier.
+	ID *PacketBrokerNetworkIdentifier `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the network.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// DevAddr blocks that are assigned to this registration.
+	DevAddrPrefixes      []string `protobuf:"bytes,3,rep,name=dev_addr_prefixes,json=devAddrPrefixes,proto3" json:"dev_addr_prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PacketBrokerNetwork) Reset()      { *m = PacketBrokerNetwork{} }
+func (*PacketBrokerNetwork) ProtoMessage() {}
+func (*PacketBrokerNetwork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{1}
+}
+func (m *PacketBrokerNetwork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerNetwork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerNetwork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerNetwork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerNetwork.Merge(m, src)
+}
+func (m *PacketBrokerNetwork) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerNetwork) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerNetwork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerNetwork proto.InternalMessageInfo
+
+func (m *PacketBrokerNetwork) GetID() *PacketBrokerNetworkIdentifier {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *PacketBrokerNetwork) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PacketBrokerNetwork) GetDevAddrPrefixes() []string {
+	if m != nil {
+		return m.DevAddrPrefixes
+	}
+	return nil
+}
+
+type PacketBrokerNetworks struct {
+	Networks             []*PacketBrokerNetwork `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *PacketBrokerNetworks) Reset()      { *m = PacketBrokerNetworks{} }
+func (*PacketBrokerNetworks) ProtoMessage() {}
+func (*PacketBrokerNetworks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{2}
+}
+func (m *PacketBrokerNetworks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerNetworks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerNetworks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerNetworks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerNetworks.Merge(m, src)
+}
+func (m *PacketBrokerNetworks) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerNetworks) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerNetworks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerNetworks proto.InternalMessageInfo
+
+func (m *PacketBrokerNetworks) GetNetworks() []*PacketBrokerNetwork {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+type PacketBrokerRoutingPolicyUplink struct {
+	// Forward join-request messages.
+	JoinRequest bool `protobuf:"varint,1,opt,name=join_request,json=joinRequest,proto3" json:"join_request,omitempty"`
+	// Forward uplink messages with FPort 0.
+	MacData bool `protobuf:"varint,2,opt,name=mac_data,json=macData,proto3" json:"mac_data,omitempty"`
+	// Forward uplink messages with FPort 1-255.
+	ApplicationData bool `protobuf:"varint,3,opt,name=application_data,json=applicationData,proto3" json:"application_data,omitempty"`
+	// Forward RSSI and SNR.
+	SignalQuality bool `protobuf:"varint,4,opt,name=signal_quality,json=signalQuality,proto3" json:"signal_quality,omitempty"`
+	// Forward gateway location, RSSI, SNR and fine timestamp.
+	Localization         bool     `protobuf:"varint,5,opt,name=localization,proto3" json:"localization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) Reset()      { *m = PacketBrokerRoutingPolicyUplink{} }
+func (*PacketBrokerRoutingPolicyUplink) ProtoMessage() {}
+func (*PacketBrokerRoutingPolicyUplink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{3}
+}
+func (m *PacketBrokerRoutingPolicyUplink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerRoutingPolicyUplink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerRoutingPolicyUplink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerRoutingPolicyUplink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerRoutingPolicyUplink.Merge(m, src)
+}
+func (m *PacketBrokerRoutingPolicyUplink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerRoutingPolicyUplink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerRoutingPolicyUplink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerRoutingPolicyUplink proto.InternalMessageInfo
+
+func (m *PacketBrokerRoutingPolicyUplink) GetJoinRequest() bool {
+	if m != nil {
+		return m.JoinRequest
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) GetMacData() bool {
+	if m != nil {
+		return m.MacData
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) GetApplicationData() bool {
+	if m != nil {
+		return m.ApplicationData
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) GetSignalQuality() bool {
+	if m != nil {
+		return m.SignalQuality
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) GetLocalization() bool {
+	if m != nil {
+		return m.Localization
+	}
+	return false
+}
+
+type PacketBrokerRoutingPolicyDownlink struct {
+	// Allow join-accept messages.
+	JoinAccept bool `protobuf:"varint,1,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	// Allow downlink messages with FPort 0.
+	MacData bool `protobuf:"varint,2,opt,name=mac_data,json=macData,proto3" json:"mac_data,omitempty"`
+	// Allow downlink messages with FPort between 1 and 255.
+	ApplicationData      bool     `protobuf:"varint,3,opt,name=application_data,json=applicationData,proto3" json:"application_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) Reset()      { *m = PacketBrokerRoutingPolicyDownlink{} }
+func (*PacketBrokerRoutingPolicyDownlink) ProtoMessage() {}
+func (*PacketBrokerRoutingPolicyDownlink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{4}
+}
+func (m *PacketBrokerRoutingPolicyDownlink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerRoutingPolicyDownlink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerRoutingPolicyDownlink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerRoutingPolicyDownlink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerRoutingPolicyDownlink.Merge(m, src)
+}
+func (m *PacketBrokerRoutingPolicyDownlink) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerRoutingPolicyDownlink) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerRoutingPolicyDownlink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerRoutingPolicyDownlink proto.InternalMessageInfo
+
+func (m *PacketBrokerRoutingPolicyDownlink) GetJoinAccept() bool {
+	if m != nil {
+		return m.JoinAccept
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) GetMacData() bool {
+	if m != nil {
+		return m.MacData
+	}
+	return false
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) GetApplicationData() bool {
+	if m != nil {
+		return m.ApplicationData
+	}
+	return false
+}
+
+type PacketBrokerDefaultRoutingPolicy struct {
+	// Timestamp when the policy got last updated.
+	UpdatedAt *time.Time `protobuf:"bytes,1,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	// Uplink policy.
+	Uplink *PacketBrokerRoutingPolicyUplink `protobuf:"bytes,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Downlink policy.
+	Downlink             *PacketBrokerRoutingPolicyDownlink `protobuf:"bytes,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) Reset()      { *m = PacketBrokerDefaultRoutingPolicy{} }
+func (*PacketBrokerDefaultRoutingPolicy) ProtoMessage() {}
+func (*PacketBrokerDefaultRoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{5}
+}
+func (m *PacketBrokerDefaultRoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerDefaultRoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerDefaultRoutingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerDefaultRoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerDefaultRoutingPolicy.Merge(m, src)
+}
+func (m *PacketBrokerDefaultRoutingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerDefaultRoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerDefaultRoutingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerDefaultRoutingPolicy proto.InternalMessageInfo
+
+func (m *PacketBrokerDefaultRoutingPolicy) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) GetUplink() *PacketBrokerRoutingPolicyUplink {
+	if m != nil {
+		return m.Uplink
+	}
+	return nil
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) GetDownlink() *PacketBrokerRoutingPolicyDownlink {
+	if m != nil {
+		return m.Downlink
+	}
+	return nil
+}
+
+type PacketBrokerRoutingPolicy struct {
+	// Packet Broker identifier of the Forwarder.
+	ForwarderID *PacketBrokerNetworkIdentifier `protobuf:"bytes,1,opt,name=forwarder_id,json=forwarderId,proto3" json:"forwarder_id,omitempty"`
+	// Packet Broker identifier of the Home Network.
+	HomeNetworkID *PacketBrokerNetworkIdentifier `protobuf:"bytes,2,opt,name=home_network_id,json=homeNetworkId,proto3" json:"home_network_id,omitempty"`
+	// Timestamp when the policy got last updated.
+	UpdatedAt *time.Time `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at,omitempty"`
+	// Uplink policy.
+	Uplink *PacketBrokerRoutingPolicyUplink `protobuf:"bytes,4,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Downlink policy.
+	Downlink             *PacketBrokerRoutingPolicyDownlink `protobuf:"bytes,5,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *PacketBrokerRoutingPolicy) Reset()      { *m = PacketBrokerRoutingPolicy{} }
+func (*PacketBrokerRoutingPolicy) ProtoMessage() {}
+func (*PacketBrokerRoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{6}
+}
+func (m *PacketBrokerRoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerRoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerRoutingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerRoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerRoutingPolicy.Merge(m, src)
+}
+func (m *PacketBrokerRoutingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerRoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerRoutingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerRoutingPolicy proto.InternalMessageInfo
+
+func (m *PacketBrokerRoutingPolicy) GetForwarderID() *PacketBrokerNetworkIdentifier {
+	if m != nil {
+		return m.ForwarderID
+	}
+	return nil
+}
+
+func (m *PacketBrokerRoutingPolicy) GetHomeNetworkID() *PacketBrokerNetworkIdentifier {
+	if m != nil {
+		return m.HomeNetworkID
+	}
+	return nil
+}
+
+func (m *PacketBrokerRoutingPolicy) GetUpdatedAt() *time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *PacketBrokerRoutingPolicy) GetUplink() *PacketBrokerRoutingPolicyUplink {
+	if m != nil {
+		return m.Uplink
+	}
+	return nil
+}
+
+func (m *PacketBrokerRoutingPolicy) GetDownlink() *PacketBrokerRoutingPolicyDownlink {
+	if m != nil {
+		return m.Downlink
+	}
+	return nil
+}
+
+type SetPacketBrokerDefaultRoutingPolicyRequest struct {
+	// Uplink policy.
+	Uplink *PacketBrokerRoutingPolicyUplink `protobuf:"bytes,1,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Downlink policy.
+	Downlink             *PacketBrokerRoutingPolicyDownlink `protobuf:"bytes,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) Reset() {
+	*m = SetPacketBrokerDefaultRoutingPolicyRequest{}
+}
+func (*SetPacketBrokerDefaultRoutingPolicyRequest) ProtoMessage() {}
+func (*SetPacketBrokerDefaultRoutingPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{7}
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPacketBrokerDefaultRoutingPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPacketBrokerDefaultRoutingPolicyRequest.Merge(m, src)
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPacketBrokerDefaultRoutingPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPacketBrokerDefaultRoutingPolicyRequest proto.InternalMessageInfo
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) GetUplink() *PacketBrokerRoutingPolicyUplink {
+	if m != nil {
+		return m.Uplink
+	}
+	return nil
+}
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) GetDownlink() *PacketBrokerRoutingPolicyDownlink {
+	if m != nil {
+		return m.Downlink
+	}
+	return nil
+}
+
+type PacketBrokerRoutingPolicies struct {
+	Policies             []*PacketBrokerRoutingPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *PacketBrokerRoutingPolicies) Reset()      { *m = PacketBrokerRoutingPolicies{} }
+func (*PacketBrokerRoutingPolicies) ProtoMessage() {}
+func (*PacketBrokerRoutingPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{8}
+}
+func (m *PacketBrokerRoutingPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketBrokerRoutingPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketBrokerRoutingPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketBrokerRoutingPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketBrokerRoutingPolicies.Merge(m, src)
+}
+func (m *PacketBrokerRoutingPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketBrokerRoutingPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketBrokerRoutingPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketBrokerRoutingPolicies proto.InternalMessageInfo
+
+func (m *PacketBrokerRoutingPolicies) GetPolicies() []*PacketBrokerRoutingPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type SetPacketBrokerRoutingPolicyRequest struct {
+	// Packet Broker identifier of the Home Network.
+	HomeNetworkID *PacketBrokerNetworkIdentifier `protobuf:"bytes,1,opt,name=home_network_id,json=homeNetworkId,proto3" json:"home_network_id,omitempty"`
+	// Uplink policy.
+	Uplink *PacketBrokerRoutingPolicyUplink `protobuf:"bytes,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Downlink policy.
+	Downlink             *PacketBrokerRoutingPolicyDownlink `protobuf:"bytes,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *SetPacketBrokerRoutingPolicyRequest) Reset()      { *m = SetPacketBrokerRoutingPolicyRequest{} }
+func (*SetPacketBrokerRoutingPolicyRequest) ProtoMessage() {}
+func (*SetPacketBrokerRoutingPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a44242dc5cd678e, []int{9}
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetPacketBrokerRoutingPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPacketBrokerRoutingPolicyRequest.Merge(m, src)
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPacketBrokerRoutingPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPacketBrokerRoutingPolicyRequest proto.InternalMessageInfo
+
+func (m *SetPacketBrokerRoutingPolicyRequest) GetHomeNetworkID() *PacketBrokerNetworkIdentifier {
+	if m != nil {
+		return m.HomeNetworkID
+	}
+	return nil
+}
+
+func (m *SetPacketBrokerRoutingPolicyRequest) GetUplink() *PacketBrokerRoutingPolicyUplink {
+	if m != nil {
+		return m.Uplink
+	}
+	return nil
+}
+
+func (m *SetPacketBrokerRoutingPolicyRequest) GetDownlink() *PacketBrokerRoutingPolicyDownlink {
+	if m != nil {
+		return m.Downlink
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PacketBrokerNetworkIdentifier)(nil), "ttn.lorawan.v3.PacketBrokerNetworkIdentifier")
+	golang_proto.RegisterType((*PacketBrokerNetworkIdentifier)(nil), "ttn.lorawan.v3.PacketBrokerNetworkIdentifier")
+	proto.RegisterType((*PacketBrokerNetwork)(nil), "ttn.lorawan.v3.PacketBrokerNetwork")
+	golang_proto.RegisterType((*PacketBrokerNetwork)(nil), "ttn.lorawan.v3.PacketBrokerNetwork")
+	proto.RegisterType((*PacketBrokerNetworks)(nil), "ttn.lorawan.v3.PacketBrokerNetworks")
+	golang_proto.RegisterType((*PacketBrokerNetworks)(nil), "ttn.lorawan.v3.PacketBrokerNetworks")
+	proto.RegisterType((*PacketBrokerRoutingPolicyUplink)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicyUplink")
+	golang_proto.RegisterType((*PacketBrokerRoutingPolicyUplink)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicyUplink")
+	proto.RegisterType((*PacketBrokerRoutingPolicyDownlink)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicyDownlink")
+	golang_proto.RegisterType((*PacketBrokerRoutingPolicyDownlink)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicyDownlink")
+	proto.RegisterType((*PacketBrokerDefaultRoutingPolicy)(nil), "ttn.lorawan.v3.PacketBrokerDefaultRoutingPolicy")
+	golang_proto.RegisterType((*PacketBrokerDefaultRoutingPolicy)(nil), "ttn.lorawan.v3.PacketBrokerDefaultRoutingPolicy")
+	proto.RegisterType((*PacketBrokerRoutingPolicy)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicy")
+	golang_proto.RegisterType((*PacketBrokerRoutingPolicy)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicy")
+	proto.RegisterType((*SetPacketBrokerDefaultRoutingPolicyRequest)(nil), "ttn.lorawan.v3.SetPacketBrokerDefaultRoutingPolicyRequest")
+	golang_proto.RegisterType((*SetPacketBrokerDefaultRoutingPolicyRequest)(nil), "ttn.lorawan.v3.SetPacketBrokerDefaultRoutingPolicyRequest")
+	proto.RegisterType((*PacketBrokerRoutingPolicies)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicies")
+	golang_proto.RegisterType((*PacketBrokerRoutingPolicies)(nil), "ttn.lorawan.v3.PacketBrokerRoutingPolicies")
+	proto.RegisterType((*SetPacketBrokerRoutingPolicyRequest)(nil), "ttn.lorawan.v3.SetPacketBrokerRoutingPolicyRequest")
+	golang_proto.RegisterType((*SetPacketBrokerRoutingPolicyRequest)(nil), "ttn.lorawan.v3.SetPacketBrokerRoutingPolicyRequest")
+}
+
 func init() {
 	proto.RegisterFile("lorawan-stack/api/packetbrokeragent.proto", fileDescriptor_1a44242dc5cd678e)
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/packetbrokeragent.proto", fileDescriptor_1a44242dc5cd678e)
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/packetbrokeragent.proto", fileDescriptor_1a44242dc5cd678e)
+}
+
+var fileDescriptor_1a44242dc5cd678e = []byte{
+	// 1325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6c, 0x13, 0xc7,
+	0x1a, 0xdf, 0xb1, 0xe3, 0x3c, 0x67, 0x4c, 0x08, 0xcc, 0x43, 0xef, 0x05, 0xa7, 0x8c, 0xcd, 0x26,
+	0x51, 0x93, 0x50, 0xef, 0x52, 0x47, 0x6a, 0x55, 0xa4, 0x2a, 0x8a, 0x65, 0x9a, 0xba, 0x2a, 0x28,
+	0x6c, 0x68, 0x91, 0x8a, 0xc0, 0x1a, 0x7b, 0x27, 0xeb, 0x6d, 0xd6, 0xbb, 0xcb, 0xee, 0x38, 0x21,
+	0x50, 0x24, 0x54, 0xf5, 0x80, 0x38, 0x21, 0x21, 0x55, 0x95, 0xda, 0x43, 0xab, 0xaa, 0x12, 0xbd,
+	0x71, 0xe4, 0x48, 0xd5, 0x0b, 0x47, 0xa4, 0xaa, 0x12, 0xa7, 0x14, 0xaf, 0x7b, 0xe0, 0xc8, 0x11,
+	0xd1, 0x4b, 0xe5, 0xd9, 0x5d, 0x63, 0x3b, 0x76, 0x62, 0x12, 0x6e, 0xb3, 0x33, 0xdf, 0x9f, 0xdf,
+	0xef, 0xf7, 0x7d, 0xf3, 0x79, 0x0c, 0x67, 0x0d, 0xcb, 0x21, 0x1b, 0xc4, 0xcc, 0xb8, 0x8c, 0x94,
+	0xd7, 0x64, 0x62, 0xeb, 0xb2, 0x4d, 0xca, 0x6b, 0x94, 0x95, 0x1c, 0x6b, 0x8d, 0x3a, 0x44, 0xa3,
+	0x26, 0x93, 0x6c, 0xc7, 0x62, 0x16, 0x3a, 0xc8, 0x98, 0x29, 0x05, 0xe6, 0xd2, 0xfa, 0x7c, 0x72,
+	0x51, 0xd3, 0x59, 0xa5, 0x56, 0x92, 0xca, 0x56, 0x55, 0xa6, 0xe6, 0xba, 0xb5, 0x69, 0x3b, 0xd6,
+	0xd5, 0x4d, 0x99, 0x1b, 0x97, 0x33, 0x1a, 0x35, 0x33, 0xeb, 0xc4, 0xd0, 0x55, 0xc2, 0xa8, 0xbc,
+	0x6d, 0xe1, 0x87, 0x4c, 0x66, 0xda, 0x42, 0x68, 0x96, 0x66, 0xf9, 0xce, 0xa5, 0xda, 0x2a, 0xff,
+	0xe2, 0x1f, 0x7c, 0x15, 0x98, 0xbf, 0xa5, 0x59, 0x96, 0x66, 0x50, 0x8e, 0x92, 0x98, 0xa6, 0xc5,
+	0x08, 0xd3, 0x2d, 0xd3, 0x0d, 0x4e, 0x27, 0x82, 0xd3, 0x56, 0x0c, 0x5a, 0xb5, 0xd9, 0x66, 0x70,
+	0x98, 0xea, 0x3e, 0x64, 0x7a, 0x95, 0xba, 0x8c, 0x54, 0xed, 0xc0, 0x20, 0xbd, 0x5d, 0x88, 0x2a,
+	0x75, 0x5d, 0xa2, 0xd1, 0x20, 0xbe, 0xf8, 0x2d, 0x80, 0xc7, 0x96, 0xb9, 0x36, 0x39, 0xae, 0xcd,
+	0x59, 0xca, 0x36, 0x2c, 0x67, 0xad, 0xa0, 0x52, 0x93, 0xe9, 0xab, 0x3a, 0x75, 0x50, 0x1a, 0x0e,
+	0x9b, 0x94, 0x15, 0x75, 0x75, 0x1c, 0xa4, 0xc1, 0xcc, 0x68, 0x6e, 0xc4, 0xdb, 0x4a, 0xc5, 0xce,
+	0x52, 0x56, 0xc8, 0x2b, 0x31, 0x93, 0xb2, 0x82, 0x8a, 0x56, 0xe0, 0x08, 0xa3, 0x26, 0x31, 0xb9,
+	0x51, 0x24, 0x0d, 0x66, 0x46, 0x72, 0xef, 0xbd, 0xcc, 0xbd, 0xed, 0x4c, 0x8f, 0x4f, 0x65, 0x8f,
+	0x5f, 0xbe, 0x48, 0x32, 0xd7, 0x4e, 0x66, 0x3e, 0xb8, 0x34, 0xb3, 0x70, 0xea, 0x62, 0xe6, 0xd2,
+	0x42, 0xf8, 0x39, 0x7b, 0x3d, 0xfb, 0xce, 0x8d, 0xa9, 0xaf, 0x2e, 0x4f, 0x79, 0x5b, 0xa9, 0xf8,
+	0x79, 0xee, 0x5e, 0xc8, 0x2b, 0x71, 0x3f, 0x50, 0x41, 0x15, 0x7f, 0x00, 0xf0, 0xbf, 0x3d, 0x80,
+	0xa1, 0xd3, 0x30, 0x12, 0x40, 0x49, 0x64, 0x33, 0x52, 0x67, 0xf5, 0xa4, 0x1d, 0x99, 0xe4, 0x86,
+	0xbd, 0xad, 0x54, 0xa4, 0x90, 0x57, 0x22, 0xba, 0x8a, 0x10, 0x1c, 0x32, 0x49, 0x95, 0xfa, 0x70,
+	0x15, 0xbe, 0x46, 0x73, 0xf0, 0xb0, 0x4a, 0xd7, 0x8b, 0x44, 0x55, 0x9d, 0xa2, 0xed, 0xd0, 0x55,
+	0xfd, 0x2a, 0x75, 0xc7, 0xa3, 0xe9, 0xe8, 0xcc, 0x88, 0x32, 0xa6, 0xd2, 0xf5, 0x45, 0x55, 0x75,
+	0x96, 0x83, 0x6d, 0xf1, 0x02, 0x3c, 0xd2, 0x23, 0x99, 0x8b, 0x16, 0x60, 0xdc, 0x0c, 0xd6, 0xe3,
+	0x20, 0x1d, 0x9d, 0x49, 0x64, 0x27, 0x07, 0x00, 0xa9, 0xb4, 0x9c, 0xc4, 0x3f, 0x01, 0x4c, 0xb5,
+	0x5b, 0x28, 0x56, 0x8d, 0xe9, 0xa6, 0xb6, 0x6c, 0x19, 0x7a, 0x79, 0xf3, 0x33, 0xdb, 0xd0, 0xcd,
+	0x35, 0x74, 0x1c, 0x1e, 0xf8, 0xd2, 0xd2, 0xcd, 0xa2, 0x43, 0xaf, 0xd4, 0xa8, 0xcb, 0xb8, 0x1a,
+	0x71, 0x25, 0xd1, 0xdc, 0x53, 0xfc, 0x2d, 0x74, 0x14, 0xc6, 0xab, 0xa4, 0x5c, 0x54, 0x09, 0x23,
+	0x9c, 0x63, 0x5c, 0xf9, 0x4f, 0x95, 0x94, 0xf3, 0x84, 0x11, 0x34, 0x0b, 0x0f, 0x11, 0xdb, 0x36,
+	0xf4, 0x32, 0x6f, 0x34, 0xdf, 0x24, 0xca, 0x4d, 0xc6, 0xda, 0xf6, 0xb9, 0xe9, 0x34, 0x3c, 0xe8,
+	0xea, 0x9a, 0x49, 0x8c, 0xe2, 0x95, 0x1a, 0x31, 0x74, 0xb6, 0x39, 0x3e, 0xc4, 0x0d, 0x47, 0xfd,
+	0xdd, 0x73, 0xfe, 0x26, 0x12, 0xe1, 0x01, 0xc3, 0x2a, 0x13, 0x43, 0xbf, 0xc6, 0x5d, 0xc7, 0x63,
+	0xdc, 0xa8, 0x63, 0x4f, 0xbc, 0x0d, 0xe0, 0xf1, 0xbe, 0xbc, 0xf2, 0xd6, 0x86, 0xc9, 0x99, 0xa5,
+	0x20, 0x67, 0x51, 0x24, 0xe5, 0x32, 0xb5, 0x43, 0x62, 0xb0, 0xb9, 0xb5, 0xc8, 0x77, 0xde, 0x0c,
+	0x2f, 0xf1, 0x1f, 0x00, 0xd3, 0xed, 0x60, 0xf2, 0x74, 0x95, 0xd4, 0x0c, 0xd6, 0x81, 0x09, 0x2d,
+	0x40, 0x58, 0xb3, 0x9b, 0xf7, 0x5a, 0x2d, 0x12, 0x16, 0x74, 0x5c, 0x52, 0xf2, 0xaf, 0x9c, 0x14,
+	0x5e, 0x39, 0xe9, 0x7c, 0x78, 0xe5, 0x72, 0x43, 0x77, 0xfe, 0x4a, 0x01, 0x65, 0x24, 0xf0, 0x59,
+	0x64, 0x68, 0x09, 0x0e, 0xd7, 0x78, 0xc1, 0x38, 0xd2, 0x44, 0x56, 0xde, 0xa9, 0x13, 0x7a, 0xd4,
+	0x59, 0x09, 0xdc, 0xd1, 0x19, 0x18, 0x57, 0x03, 0x85, 0x38, 0xa3, 0x44, 0xf6, 0xdd, 0x81, 0x43,
+	0x85, 0xd2, 0x2a, 0xad, 0x10, 0xe2, 0x6f, 0x51, 0x78, 0xb4, 0xaf, 0x3d, 0x22, 0xf0, 0xc0, 0xaa,
+	0xe5, 0x6c, 0x10, 0x47, 0xa5, 0x4e, 0x71, 0xaf, 0x57, 0x6d, 0xcc, 0xdb, 0x4a, 0x25, 0x3e, 0x0a,
+	0xc3, 0x14, 0xf2, 0x4a, 0xa2, 0x15, 0xb3, 0xa0, 0xa2, 0x0a, 0x1c, 0xab, 0x58, 0x55, 0x5a, 0x0c,
+	0x9a, 0x3e, 0x1c, 0x1b, 0xaf, 0x9d, 0xe5, 0xb0, 0xb7, 0x95, 0x1a, 0xfd, 0xd8, 0xaa, 0xd2, 0xf0,
+	0x28, 0xaf, 0x8c, 0x56, 0xda, 0x3e, 0xd5, 0xae, 0x1a, 0x46, 0xf7, 0x53, 0xc3, 0xa1, 0x37, 0x57,
+	0xc3, 0xd8, 0xfe, 0x6b, 0xf8, 0x04, 0xc0, 0xb9, 0x15, 0xca, 0x76, 0x6b, 0xe2, 0x70, 0x1c, 0x9c,
+	0x6b, 0xd1, 0x00, 0x7b, 0xa2, 0x91, 0x8b, 0xbf, 0xcc, 0xc5, 0x6e, 0x83, 0xc8, 0x21, 0xd0, 0x22,
+	0x74, 0xa1, 0x8d, 0x50, 0x64, 0x8f, 0x84, 0xda, 0xc2, 0xbe, 0xa2, 0xa6, 0xc2, 0x89, 0x7e, 0x8e,
+	0x3a, 0x75, 0xd1, 0x69, 0x18, 0xb7, 0x83, 0x75, 0x30, 0x61, 0x67, 0x07, 0xce, 0xab, 0xb4, 0x5c,
+	0xc5, 0xdf, 0x23, 0x70, 0xb2, 0x4b, 0xc0, 0x9e, 0xca, 0xd9, 0xdb, 0x7b, 0x75, 0x4f, 0x37, 0xe2,
+	0x68, 0xc8, 0x74, 0xd7, 0x9e, 0x3d, 0xb7, 0xcf, 0xb1, 0xb1, 0x4b, 0xad, 0xa2, 0x6f, 0xb0, 0x56,
+	0xd9, 0xcf, 0x61, 0x6c, 0xc9, 0x5d, 0x2e, 0x11, 0x74, 0x06, 0x8e, 0x2e, 0xd7, 0x4a, 0x86, 0xee,
+	0x56, 0x82, 0xdf, 0xa8, 0xa9, 0xee, 0x04, 0x4b, 0x84, 0xd1, 0x0d, 0x12, 0x60, 0x3c, 0xe3, 0xbf,
+	0x42, 0x92, 0xff, 0xdb, 0x76, 0x17, 0x4f, 0x37, 0xdf, 0x37, 0xd9, 0x15, 0x18, 0x3b, 0xcb, 0xe3,
+	0x7e, 0x02, 0xc7, 0x82, 0xb8, 0xaf, 0x7e, 0x23, 0xba, 0x23, 0x87, 0x27, 0xbb, 0x05, 0xfd, 0x09,
+	0xc2, 0x68, 0x33, 0xe6, 0xf7, 0x00, 0x8a, 0x4b, 0x94, 0xb5, 0x15, 0xa1, 0xe7, 0xfc, 0xef, 0x13,
+	0x26, 0x79, 0x72, 0x27, 0xe9, 0x7a, 0x45, 0x12, 0x4f, 0x7c, 0xfd, 0xc7, 0xdf, 0x77, 0x23, 0xd3,
+	0x68, 0x52, 0xb6, 0x4b, 0x44, 0x6e, 0x56, 0x3b, 0x13, 0xfe, 0xde, 0xcb, 0x61, 0x43, 0xca, 0xaa,
+	0xef, 0x89, 0xee, 0x01, 0x28, 0xae, 0xec, 0x8e, 0xee, 0x54, 0x37, 0x8a, 0xc1, 0xa7, 0x41, 0x3f,
+	0x81, 0x44, 0x89, 0xe3, 0x9c, 0x49, 0x0e, 0x82, 0xf3, 0x14, 0x98, 0x43, 0xdf, 0x00, 0x38, 0x9d,
+	0xa7, 0x06, 0x65, 0x74, 0xaf, 0x5a, 0xf6, 0x43, 0x12, 0x28, 0x36, 0x37, 0x90, 0x62, 0xb7, 0x01,
+	0xc4, 0x9f, 0xea, 0x6e, 0xbb, 0x64, 0xdd, 0x43, 0xa3, 0x5f, 0xfe, 0x13, 0x83, 0x5e, 0x83, 0xe6,
+	0xc8, 0x98, 0xe4, 0xa0, 0x8e, 0xa1, 0x89, 0x1d, 0x40, 0xa1, 0x9f, 0x01, 0x9c, 0xe8, 0x6c, 0xae,
+	0x4e, 0x25, 0x5e, 0x6f, 0x6c, 0x24, 0x07, 0x9f, 0x6d, 0xe2, 0x1c, 0x87, 0x37, 0x85, 0xc4, 0x1e,
+	0xf0, 0xae, 0xfb, 0x4f, 0xf8, 0x1b, 0x3e, 0xce, 0x4d, 0xf4, 0x2b, 0x80, 0x13, 0x2b, 0x3b, 0xa0,
+	0x9c, 0xdf, 0xa5, 0xbb, 0x5e, 0xab, 0xad, 0x3e, 0xe4, 0xc0, 0xde, 0x4f, 0x66, 0x7b, 0x01, 0xeb,
+	0x1a, 0xae, 0x52, 0x27, 0xd0, 0x66, 0x97, 0xdd, 0x05, 0x10, 0x6f, 0xeb, 0xb2, 0x7d, 0x89, 0xda,
+	0x0f, 0x68, 0xa0, 0xe0, 0xdc, 0x20, 0x0a, 0x1a, 0xf0, 0x50, 0x57, 0xcf, 0xf5, 0xef, 0xb2, 0xa9,
+	0x01, 0xe0, 0xb9, 0x62, 0x92, 0x67, 0x3f, 0x82, 0xd0, 0xf6, 0xec, 0x48, 0x83, 0x07, 0x9b, 0xd9,
+	0x5a, 0x2f, 0xaa, 0xfd, 0xe6, 0xfa, 0x3f, 0xcf, 0x75, 0x18, 0x8d, 0xf1, 0x5c, 0xad, 0xb7, 0x99,
+	0x9b, 0xfb, 0x05, 0x3c, 0xaa, 0x63, 0xf0, 0xb8, 0x8e, 0xc1, 0x93, 0x3a, 0x16, 0x9e, 0xd6, 0xb1,
+	0xf0, 0xac, 0x8e, 0x85, 0xe7, 0x75, 0x2c, 0xbc, 0xa8, 0x63, 0x70, 0xd3, 0xc3, 0xe0, 0x96, 0x87,
+	0x85, 0x7b, 0x1e, 0x06, 0xf7, 0x3d, 0x2c, 0x3c, 0xf0, 0xb0, 0xf0, 0xd0, 0xc3, 0xc2, 0x23, 0x0f,
+	0x83, 0xc7, 0x1e, 0x06, 0x4f, 0x3c, 0x2c, 0x3c, 0xf5, 0x30, 0x78, 0xe6, 0x61, 0xe1, 0xb9, 0x87,
+	0xc1, 0x0b, 0x0f, 0x0b, 0x37, 0x1b, 0x58, 0xb8, 0xd5, 0xc0, 0xe0, 0x4e, 0x03, 0x0b, 0xdf, 0x35,
+	0x30, 0xf8, 0xb1, 0x81, 0x85, 0x7b, 0x0d, 0x2c, 0xdc, 0x6f, 0x60, 0xf0, 0xa0, 0x81, 0xc1, 0xc3,
+	0x06, 0x06, 0x5f, 0xc8, 0x9a, 0x25, 0xb1, 0x0a, 0x65, 0x15, 0xdd, 0xd4, 0x5c, 0x29, 0xa0, 0x2d,
+	0x77, 0xfe, 0x7b, 0x5d, 0x9f, 0x97, 0xed, 0x35, 0x4d, 0x66, 0xcc, 0xb4, 0x4b, 0xa5, 0x61, 0x4e,
+	0x7b, 0xfe, 0xdf, 0x01, 0x00, 0xa1, 0xcd, 0x20, 0xa6, 0xeb, 0x0f, 0x00, 0x00,
+}
+
+func (this *PacketBrokerNetworkIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerNetworkIdentifier)
+	if !ok {
+		that2, ok := that.(PacketBrokerNetworkIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NetID != that1.NetID {
+		return false
+	}
+	if this.TenantID != that1.TenantID {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerNetwork) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerNetwork)
+	if !ok {
+		that2, ok := that.(PacketBrokerNetwork)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ID.Equal(that1.ID) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.DevAddrPrefixes) != len(that1.DevAddrPrefixes) {
+		return false
+	}
+	for i := range this.DevAddrPrefixes {
+		if this.DevAddrPrefixes[i] != that1.DevAddrPrefixes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PacketBrokerNetworks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerNetworks)
+	if !ok {
+		that2, ok := that.(PacketBrokerNetworks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Networks) != len(that1.Networks) {
+		return false
+	}
+	for i := range this.Networks {
+		if !this.Networks[i].Equal(that1.Networks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PacketBrokerRoutingPolicyUplink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerRoutingPolicyUplink)
+	if !ok {
+		that2, ok := that.(PacketBrokerRoutingPolicyUplink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JoinRequest != that1.JoinRequest {
+		return false
+	}
+	if this.MacData != that1.MacData {
+		return false
+	}
+	if this.ApplicationData != that1.ApplicationData {
+		return false
+	}
+	if this.SignalQuality != that1.SignalQuality {
+		return false
+	}
+	if this.Localization != that1.Localization {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerRoutingPolicyDownlink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerRoutingPolicyDownlink)
+	if !ok {
+		that2, ok := that.(PacketBrokerRoutingPolicyDownlink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JoinAccept != that1.JoinAccept {
+		return false
+	}
+	if this.MacData != that1.MacData {
+		return false
+	}
+	if this.ApplicationData != that1.ApplicationData {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerDefaultRoutingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerDefaultRoutingPolicy)
+	if !ok {
+		that2, ok := that.(PacketBrokerDefaultRoutingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerRoutingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerRoutingPolicy)
+	if !ok {
+		that2, ok := that.(PacketBrokerRoutingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ForwarderID.Equal(that1.ForwarderID) {
+		return false
+	}
+	if !this.HomeNetworkID.Equal(that1.HomeNetworkID) {
+		return false
+	}
+	if that1.UpdatedAt == nil {
+		if this.UpdatedAt != nil {
+			return false
+		}
+	} else if !this.UpdatedAt.Equal(*that1.UpdatedAt) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+func (this *SetPacketBrokerDefaultRoutingPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPacketBrokerDefaultRoutingPolicyRequest)
+	if !ok {
+		that2, ok := that.(SetPacketBrokerDefaultRoutingPolicyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+func (this *PacketBrokerRoutingPolicies) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PacketBrokerRoutingPolicies)
+	if !ok {
+		that2, ok := that.(PacketBrokerRoutingPolicies)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Policies) != len(that1.Policies) {
+		return false
+	}
+	for i := range this.Policies {
+		if !this.Policies[i].Equal(that1.Policies[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetPacketBrokerRoutingPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPacketBrokerRoutingPolicyRequest)
+	if !ok {
+		that2, ok := that.(SetPacketBrokerRoutingPolicyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.HomeNetworkID.Equal(that1.HomeNetworkID) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GsPbaClient is the client API for GsPba service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsPbaClient interface {
+	PublishUplink(ctx context.Context, in *GatewayUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type gsPbaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsPbaClient(cc *grpc.ClientConn) GsPbaClient {
+	return &gsPbaClient{cc}
+}
+
+func (c *gsPbaClient) PublishUplink(ctx context.Context, in *GatewayUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsPba/PublishUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsPbaServer is the server API for GsPba service.
+type GsPbaServer interface {
+	PublishUplink(context.Context, *GatewayUplinkMessage) (*types.Empty, error)
+}
+
+// UnimplementedGsPbaServer can be embedded to have forward compatible implementations.
+type UnimplementedGsPbaServer struct {
+}
+
+func (*UnimplementedGsPbaServer) PublishUplink(ctx context.Context, req *GatewayUplinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishUplink not implemented")
+}
+
+func RegisterGsPbaServer(s *grpc.Server, srv GsPbaServer) {
+	s.RegisterService(&_GsPba_serviceDesc, srv)
+}
+
+func _GsPba_PublishUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayUplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsPbaServer).PublishUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsPba/PublishUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsPbaServer).PublishUplink(ctx, req.(*GatewayUplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GsPba_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GsPba",
+	HandlerType: (*GsPbaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishUplink",
+			Handler:    _GsPba_PublishUplink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+}
+
+// NsPbaClient is the client API for NsPba service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsPbaClient interface {
+	// PublishDownlink instructs the Packet Broker Agent to publish a downlink message to Packet Broker Router.
+	PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type nsPbaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsPbaClient(cc *grpc.ClientConn) NsPbaClient {
+	return &nsPbaClient{cc}
+}
+
+func (c *nsPbaClient) PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsPba/PublishDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsPbaServer is the server API for NsPba service.
+type NsPbaServer interface {
+	// PublishDownlink instructs the Packet Broker Agent to publish a downlink message to Packet Broker Router.
+	PublishDownlink(context.Context, *DownlinkMessage) (*types.Empty, error)
+}
+
+// UnimplementedNsPbaServer can be embedded to have forward compatible implementations.
+type UnimplementedNsPbaServer struct {
+}
+
+func (*UnimplementedNsPbaServer) PublishDownlink(ctx context.Context, req *DownlinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDownlink not implemented")
+}
+
+func RegisterNsPbaServer(s *grpc.Server, srv NsPbaServer) {
+	s.RegisterService(&_NsPba_serviceDesc, srv)
+}
+
+func _NsPba_PublishDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsPbaServer).PublishDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsPba/PublishDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsPbaServer).PublishDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsPba_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsPba",
+	HandlerType: (*NsPbaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishDownlink",
+			Handler:    _NsPba_PublishDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+}
+
+// PbaClient is the client API for Pba service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PbaClient interface {
+	// Get the default routing policy.
+	GetHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerDefaultRoutingPolicy, error)
+	// Set the default routing policy.
+	SetHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *SetPacketBrokerDefaultRoutingPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Delete the default routing policy.
+	DeleteHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the routing policies for specific Packet Broker Home Networks.
+	ListHomeNetworkRoutingPolicies(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerRoutingPolicies, error)
+	// Get the routing policy for the given Packet Broker Home Network.
+	GetHomeNetworkRoutingPolicy(ctx context.Context, in *PacketBrokerNetworkIdentifier, opts ...grpc.CallOption) (*PacketBrokerRoutingPolicy, error)
+	// Set the routing policy for the given Packet Broker Home Network.
+	SetHomeNetworkRoutingPolicy(ctx context.Context, in *SetPacketBrokerRoutingPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Delete the routing policy for the given Packet Broker Home Network.
+	DeleteHomeNetworkRoutingPolicy(ctx context.Context, in *PacketBrokerNetworkIdentifier, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the Home Networks that are registered with Packet Broker.
+	ListHomeNetworks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerNetworks, error)
+	// List the Forwarders that are registered with Packet Broker.
+	ListForwarders(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerNetworks, error)
+}
+
+type pbaClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPbaClient(cc *grpc.ClientConn) PbaClient {
+	return &pbaClient{cc}
+}
+
+func (c *pbaClient) GetHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerDefaultRoutingPolicy, error) {
+	out := new(PacketBrokerDefaultRoutingPolicy)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/GetHomeNetworkDefaultRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) SetHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *SetPacketBrokerDefaultRoutingPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/SetHomeNetworkDefaultRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) DeleteHomeNetworkDefaultRoutingPolicy(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/DeleteHomeNetworkDefaultRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) ListHomeNetworkRoutingPolicies(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerRoutingPolicies, error) {
+	out := new(PacketBrokerRoutingPolicies)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/ListHomeNetworkRoutingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) GetHomeNetworkRoutingPolicy(ctx context.Context, in *PacketBrokerNetworkIdentifier, opts ...grpc.CallOption) (*PacketBrokerRoutingPolicy, error) {
+	out := new(PacketBrokerRoutingPolicy)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/GetHomeNetworkRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) SetHomeNetworkRoutingPolicy(ctx context.Context, in *SetPacketBrokerRoutingPolicyRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/SetHomeNetworkRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) DeleteHomeNetworkRoutingPolicy(ctx context.Context, in *PacketBrokerNetworkIdentifier, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/DeleteHomeNetworkRoutingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) ListHomeNetworks(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerNetworks, error) {
+	out := new(PacketBrokerNetworks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/ListHomeNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pbaClient) ListForwarders(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PacketBrokerNetworks, error) {
+	out := new(PacketBrokerNetworks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Pba/ListForwarders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PbaServer is the server API for Pba service.
+type PbaServer interface {
+	// Get the default routing policy.
+	GetHomeNetworkDefaultRoutingPolicy(context.Context, *types.Empty) (*PacketBrokerDefaultRoutingPolicy, error)
+	// Set the default routing policy.
+	SetHomeNetworkDefaultRoutingPolicy(context.Context, *SetPacketBrokerDefaultRoutingPolicyRequest) (*types.Empty, error)
+	// Delete the default routing policy.
+	DeleteHomeNetworkDefaultRoutingPolicy(context.Context, *types.Empty) (*types.Empty, error)
+	// List the routing policies for specific Packet Broker Home Networks.
+	ListHomeNetworkRoutingPolicies(context.Context, *types.Empty) (*PacketBrokerRoutingPolicies, error)
+	// Get the routing policy for the given Packet Broker Home Network.
+	GetHomeNetworkRoutingPolicy(context.Context, *PacketBrokerNetworkIdentifier) (*PacketBrokerRoutingPolicy, error)
+	// Set the routing policy for the given Packet Broker Home Network.
+	SetHomeNetworkRoutingPolicy(context.Context, *SetPacketBrokerRoutingPolicyRequest) (*types.Empty, error)
+	// Delete the routing policy for the given Packet Broker Home Network.
+	DeleteHomeNetworkRoutingPolicy(context.Context, *PacketBrokerNetworkIdentifier) (*types.Empty, error)
+	// List the Home Networks that are registered with Packet Broker.
+	ListHomeNetworks(context.Context, *types.Empty) (*PacketBrokerNetworks, error)
+	// List the Forwarders that are registered with Packet Broker.
+	ListForwarders(context.Context, *types.Empty) (*PacketBrokerNetworks, error)
+}
+
+// UnimplementedPbaServer can be embedded to have forward compatible implementations.
+type UnimplementedPbaServer struct {
+}
+
+func (*UnimplementedPbaServer) GetHomeNetworkDefaultRoutingPolicy(ctx context.Context, req *types.Empty) (*PacketBrokerDefaultRoutingPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeNetworkDefaultRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) SetHomeNetworkDefaultRoutingPolicy(ctx context.Context, req *SetPacketBrokerDefaultRoutingPolicyRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHomeNetworkDefaultRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) DeleteHomeNetworkDefaultRoutingPolicy(ctx context.Context, req *types.Empty) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHomeNetworkDefaultRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) ListHomeNetworkRoutingPolicies(ctx context.Context, req *types.Empty) (*PacketBrokerRoutingPolicies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHomeNetworkRoutingPolicies not implemented")
+}
+func (*UnimplementedPbaServer) GetHomeNetworkRoutingPolicy(ctx context.Context, req *PacketBrokerNetworkIdentifier) (*PacketBrokerRoutingPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHomeNetworkRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) SetHomeNetworkRoutingPolicy(ctx context.Context, req *SetPacketBrokerRoutingPolicyRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHomeNetworkRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) DeleteHomeNetworkRoutingPolicy(ctx context.Context, req *PacketBrokerNetworkIdentifier) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHomeNetworkRoutingPolicy not implemented")
+}
+func (*UnimplementedPbaServer) ListHomeNetworks(ctx context.Context, req *types.Empty) (*PacketBrokerNetworks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHomeNetworks not implemented")
+}
+func (*UnimplementedPbaServer) ListForwarders(ctx context.Context, req *types.Empty) (*PacketBrokerNetworks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForwarders not implemented")
+}
+
+func RegisterPbaServer(s *grpc.Server, srv PbaServer) {
+	s.RegisterService(&_Pba_serviceDesc, srv)
+}
+
+func _Pba_GetHomeNetworkDefaultRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).GetHomeNetworkDefaultRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/GetHomeNetworkDefaultRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).GetHomeNetworkDefaultRoutingPolicy(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_SetHomeNetworkDefaultRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPacketBrokerDefaultRoutingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).SetHomeNetworkDefaultRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/SetHomeNetworkDefaultRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).SetHomeNetworkDefaultRoutingPolicy(ctx, req.(*SetPacketBrokerDefaultRoutingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_DeleteHomeNetworkDefaultRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).DeleteHomeNetworkDefaultRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/DeleteHomeNetworkDefaultRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).DeleteHomeNetworkDefaultRoutingPolicy(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_ListHomeNetworkRoutingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).ListHomeNetworkRoutingPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/ListHomeNetworkRoutingPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).ListHomeNetworkRoutingPolicies(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_GetHomeNetworkRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketBrokerNetworkIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).GetHomeNetworkRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/GetHomeNetworkRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).GetHomeNetworkRoutingPolicy(ctx, req.(*PacketBrokerNetworkIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_SetHomeNetworkRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPacketBrokerRoutingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).SetHomeNetworkRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/SetHomeNetworkRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).SetHomeNetworkRoutingPolicy(ctx, req.(*SetPacketBrokerRoutingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_DeleteHomeNetworkRoutingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PacketBrokerNetworkIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).DeleteHomeNetworkRoutingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/DeleteHomeNetworkRoutingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).DeleteHomeNetworkRoutingPolicy(ctx, req.(*PacketBrokerNetworkIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_ListHomeNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).ListHomeNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/ListHomeNetworks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).ListHomeNetworks(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pba_ListForwarders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PbaServer).ListForwarders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Pba/ListForwarders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PbaServer).ListForwarders(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Pba_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Pba",
+	HandlerType: (*PbaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHomeNetworkDefaultRoutingPolicy",
+			Handler:    _Pba_GetHomeNetworkDefaultRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "SetHomeNetworkDefaultRoutingPolicy",
+			Handler:    _Pba_SetHomeNetworkDefaultRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteHomeNetworkDefaultRoutingPolicy",
+			Handler:    _Pba_DeleteHomeNetworkDefaultRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "ListHomeNetworkRoutingPolicies",
+			Handler:    _Pba_ListHomeNetworkRoutingPolicies_Handler,
+		},
+		{
+			MethodName: "GetHomeNetworkRoutingPolicy",
+			Handler:    _Pba_GetHomeNetworkRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "SetHomeNetworkRoutingPolicy",
+			Handler:    _Pba_SetHomeNetworkRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteHomeNetworkRoutingPolicy",
+			Handler:    _Pba_DeleteHomeNetworkRoutingPolicy_Handler,
+		},
+		{
+			MethodName: "ListHomeNetworks",
+			Handler:    _Pba_ListHomeNetworks_Handler,
+		},
+		{
+			MethodName: "ListForwarders",
+			Handler:    _Pba_ListForwarders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+}
+
+func (m *PacketBrokerNetworkIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerNetworkIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerNetworkIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TenantID) > 0 {
+		i -= len(m.TenantID)
+		copy(dAtA[i:], m.TenantID)
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.TenantID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NetID != 0 {
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(m.NetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerNetwork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerNetwork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerNetwork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DevAddrPrefixes) > 0 {
+		for iNdEx := len(m.DevAddrPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DevAddrPrefixes[iNdEx])
+			copy(dAtA[i:], m.DevAddrPrefixes[iNdEx])
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.DevAddrPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != nil {
+		{
+			size, err := m.ID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerNetworks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerNetworks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerNetworks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for iNdEx := len(m.Networks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Networks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerRoutingPolicyUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Localization {
+		i--
+		if m.Localization {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignalQuality {
+		i--
+		if m.SignalQuality {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ApplicationData {
+		i--
+		if m.ApplicationData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MacData {
+		i--
+		if m.MacData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JoinRequest {
+		i--
+		if m.JoinRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerRoutingPolicyDownlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApplicationData {
+		i--
+		if m.ApplicationData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MacData {
+		i--
+		if m.MacData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JoinAccept {
+		i--
+		if m.JoinAccept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerDefaultRoutingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpdatedAt != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerRoutingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerRoutingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerRoutingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpdatedAt != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintPacketbrokeragent(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HomeNetworkID != nil {
+		{
+			size, err := m.HomeNetworkID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ForwarderID != nil {
+		{
+			size, err := m.ForwarderID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketBrokerRoutingPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketBrokerRoutingPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketBrokerRoutingPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPacketBrokerRoutingPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-var fileDescriptor_1a44242dc5cd678e = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0xd0, 0xa1, 0x6f, 0x22, 0x41,
-	0x14, 0x06, 0xf0, 0xf7, 0x04, 0x27, 0x36, 0xb9, 0xbb, 0x04, 0x71, 0x82, 0x4b, 0xde, 0x9d, 0x38,
-	0x73, 0xe2, 0x66, 0x12, 0xf8, 0x0f, 0x2e, 0x6d, 0x48, 0x9a, 0xd0, 0x90, 0x34, 0xad, 0xa8, 0x9b,
-	0x25, 0xd3, 0x65, 0xb3, 0xcb, 0xcc, 0x66, 0x77, 0x60, 0x83, 0x43, 0x22, 0x2b, 0x2b, 0x6b, 0x9a,
-	0x20, 0x91, 0x48, 0x24, 0x12, 0x89, 0x64, 0x67, 0x0c, 0x12, 0x89, 0x6c, 0xba, 0x0b, 0x82, 0x56,
-	0x54, 0x7f, 0x5f, 0x7e, 0xdf, 0xcb, 0xf3, 0xfe, 0xc6, 0x3a, 0x15, 0xb9, 0x50, 0xff, 0x32, 0x23,
-	0x7a, 0x11, 0x17, 0x49, 0xc8, 0x13, 0xd1, 0x8b, 0xa4, 0xf1, 0x53, 0x1d, 0xc9, 0x54, 0x04, 0x52,
-	0x19, 0x96, 0xa4, 0xda, 0xe8, 0xfa, 0x37, 0x63, 0x14, 0x3b, 0xd6, 0xd9, 0xa8, 0xd5, 0xf8, 0x19,
-	0x68, 0x1d, 0xc4, 0x92, 0x97, 0xa9, 0x3f, 0x7c, 0xe0, 0x72, 0x90, 0x98, 0x71, 0x55, 0x6e, 0xfc,
-	0xfe, 0xe8, 0x0e, 0x64, 0x96, 0x89, 0x40, 0x66, 0x55, 0xa3, 0x79, 0xe7, 0xd5, 0xda, 0x59, 0xd7,
-	0x17, 0xf5, 0x8e, 0xf7, 0xb5, 0x3b, 0xf4, 0xe3, 0x30, 0xeb, 0xdf, 0x26, 0x71, 0xa8, 0xa2, 0xfa,
-	0x1f, 0x76, 0xbe, 0xc4, 0xda, 0xc2, 0xc8, 0x5c, 0x8c, 0xab, 0xb8, 0x53, 0x31, 0x8d, 0x1f, 0xac,
-	0xda, 0x67, 0xa7, 0x7d, 0x76, 0xf9, 0xb6, 0xdf, 0xbc, 0xf1, 0x6a, 0xd7, 0xa5, 0x7b, 0xe5, 0x7d,
-	0x3f, 0xba, 0x17, 0x3a, 0x57, 0xa5, 0xfc, 0xeb, 0xbd, 0x7c, 0x4a, 0x3e, 0x41, 0xff, 0xbf, 0xe0,
-	0xaa, 0x20, 0x5c, 0x17, 0x84, 0x9b, 0x82, 0x60, 0x5b, 0x10, 0xec, 0x0a, 0x82, 0x7d, 0x41, 0x70,
-	0x28, 0x08, 0x27, 0x96, 0x70, 0x6a, 0x09, 0x66, 0x96, 0x70, 0x6e, 0x09, 0x16, 0x96, 0x60, 0x69,
-	0x09, 0x56, 0x96, 0x70, 0x6d, 0x09, 0x37, 0x96, 0x60, 0x6b, 0x09, 0x77, 0x96, 0x60, 0x6f, 0x09,
-	0x0f, 0x96, 0x60, 0xe2, 0x08, 0xa6, 0x8e, 0xf0, 0xd1, 0x11, 0x3c, 0x39, 0xc2, 0x67, 0x47, 0x30,
-	0x73, 0x04, 0x73, 0x47, 0xb8, 0x70, 0x84, 0x4b, 0x47, 0x78, 0xcf, 0x03, 0xcd, 0x4c, 0x5f, 0x9a,
-	0x7e, 0xa8, 0x82, 0x8c, 0x29, 0x69, 0x72, 0x9d, 0x46, 0xfc, 0xfc, 0xb5, 0xa3, 0x16, 0x4f, 0xa2,
-	0x80, 0x1b, 0xa3, 0x12, 0xdf, 0xff, 0x52, 0xde, 0xdd, 0x7a, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xdc,
-	0xa8, 0x26, 0xba, 0xd7, 0x01, 0x00, 0x00,
+func (m *SetPacketBrokerRoutingPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SetPacketBrokerRoutingPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HomeNetworkID != nil {
+		{
+			size, err := m.HomeNetworkID.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacketbrokeragent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func encodeVarintPacketbrokeragent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacketbrokeragent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedPacketBrokerNetworkIdentifier(r randyPacketbrokeragent, easy bool) *PacketBrokerNetworkIdentifier {
+	this := &PacketBrokerNetworkIdentifier{}
+	this.NetID = r.Uint32()
+	this.TenantID = randStringPacketbrokeragent(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
 
-// GsPbaClient is the client API for GsPba service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsPbaClient interface {
-	PublishUplink(ctx context.Context, in *GatewayUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+func NewPopulatedPacketBrokerNetwork(r randyPacketbrokeragent, easy bool) *PacketBrokerNetwork {
+	this := &PacketBrokerNetwork{}
+	if r.Intn(5) != 0 {
+		this.ID = NewPopulatedPacketBrokerNetworkIdentifier(r, easy)
+	}
+	this.Name = randStringPacketbrokeragent(r)
+	v1 := r.Intn(10)
+	this.DevAddrPrefixes = make([]string, v1)
+	for i := 0; i < v1; i++ {
+		this.DevAddrPrefixes[i] = randStringPacketbrokeragent(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-type gsPbaClient struct {
-	cc *grpc.ClientConn
+func NewPopulatedPacketBrokerNetworks(r randyPacketbrokeragent, easy bool) *PacketBrokerNetworks {
+	this := &PacketBrokerNetworks{}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(5)
+		this.Networks = make([]*PacketBrokerNetwork, v2)
+		for i := 0; i < v2; i++ {
+			this.Networks[i] = NewPopulatedPacketBrokerNetwork(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func NewGsPbaClient(cc *grpc.ClientConn) GsPbaClient {
-	return &gsPbaClient{cc}
+func NewPopulatedPacketBrokerRoutingPolicyUplink(r randyPacketbrokeragent, easy bool) *PacketBrokerRoutingPolicyUplink {
+	this := &PacketBrokerRoutingPolicyUplink{}
+	this.JoinRequest = bool(r.Intn(2) == 0)
+	this.MacData = bool(r.Intn(2) == 0)
+	this.ApplicationData = bool(r.Intn(2) == 0)
+	this.SignalQuality = bool(r.Intn(2) == 0)
+	this.Localization = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (c *gsPbaClient) PublishUplink(ctx context.Context, in *GatewayUplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsPba/PublishUplink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func NewPopulatedPacketBrokerRoutingPolicyDownlink(r randyPacketbrokeragent, easy bool) *PacketBrokerRoutingPolicyDownlink {
+	this := &PacketBrokerRoutingPolicyDownlink{}
+	this.JoinAccept = bool(r.Intn(2) == 0)
+	this.MacData = bool(r.Intn(2) == 0)
+	this.ApplicationData = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	return out, nil
+	return this
 }
 
-// GsPbaServer is the server API for GsPba service.
-type GsPbaServer interface {
-	PublishUplink(context.Context, *GatewayUplinkMessage) (*types.Empty, error)
+func NewPopulatedPacketBrokerDefaultRoutingPolicy(r randyPacketbrokeragent, easy bool) *PacketBrokerDefaultRoutingPolicy {
+	this := &PacketBrokerDefaultRoutingPolicy{}
+	if r.Intn(5) != 0 {
+		this.UpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedPacketBrokerRoutingPolicyUplink(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedPacketBrokerRoutingPolicyDownlink(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-// UnimplementedGsPbaServer can be embedded to have forward compatible implementations.
-type UnimplementedGsPbaServer struct {
+func NewPopulatedPacketBrokerRoutingPolicy(r randyPacketbrokeragent, easy bool) *PacketBrokerRoutingPolicy {
+	this := &PacketBrokerRoutingPolicy{}
+	if r.Intn(5) != 0 {
+		this.ForwarderID = NewPopulatedPacketBrokerNetworkIdentifier(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.HomeNetworkID = NewPopulatedPacketBrokerNetworkIdentifier(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedPacketBrokerRoutingPolicyUplink(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedPacketBrokerRoutingPolicyDownlink(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (*UnimplementedGsPbaServer) PublishUplink(ctx context.Context, req *GatewayUplinkMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishUplink not implemented")
+func NewPopulatedSetPacketBrokerDefaultRoutingPolicyRequest(r randyPacketbrokeragent, easy bool) *SetPacketBrokerDefaultRoutingPolicyRequest {
+	this := &SetPacketBrokerDefaultRoutingPolicyRequest{}
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedPacketBrokerRoutingPolicyUplink(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedPacketBrokerRoutingPolicyDownlink(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func RegisterGsPbaServer(s *grpc.Server, srv GsPbaServer) {
-	s.RegisterService(&_GsPba_serviceDesc, srv)
+func NewPopulatedPacketBrokerRoutingPolicies(r randyPacketbrokeragent, easy bool) *PacketBrokerRoutingPolicies {
+	this := &PacketBrokerRoutingPolicies{}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Policies = make([]*PacketBrokerRoutingPolicy, v3)
+		for i := 0; i < v3; i++ {
+			this.Policies[i] = NewPopulatedPacketBrokerRoutingPolicy(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func _GsPba_PublishUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayUplinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func NewPopulatedSetPacketBrokerRoutingPolicyRequest(r randyPacketbrokeragent, easy bool) *SetPacketBrokerRoutingPolicyRequest {
+	this := &SetPacketBrokerRoutingPolicyRequest{}
+	if r.Intn(5) != 0 {
+		this.HomeNetworkID = NewPopulatedPacketBrokerNetworkIdentifier(r, easy)
 	}
-	if interceptor == nil {
-		return srv.(GsPbaServer).PublishUplink(ctx, in)
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedPacketBrokerRoutingPolicyUplink(r, easy)
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GsPba/PublishUplink",
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedPacketBrokerRoutingPolicyDownlink(r, easy)
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsPbaServer).PublishUplink(ctx, req.(*GatewayUplinkMessage))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return interceptor(ctx, in, info, handler)
+	return this
 }
 
-var _GsPba_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GsPba",
-	HandlerType: (*GsPbaServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PublishUplink",
-			Handler:    _GsPba_PublishUplink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+type randyPacketbrokeragent interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
 }
 
-// NsPbaClient is the client API for NsPba service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsPbaClient interface {
-	// PublishDownlink instructs the Packet Broker Agent to publish a downlink message to Packet Broker Router.
-	PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+func randUTF8RunePacketbrokeragent(r randyPacketbrokeragent) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringPacketbrokeragent(r randyPacketbrokeragent) string {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
+		tmps[i] = randUTF8RunePacketbrokeragent(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedPacketbrokeragent(r randyPacketbrokeragent, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldPacketbrokeragent(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldPacketbrokeragent(dAtA []byte, r randyPacketbrokeragent, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		v5 := r.Int63()
+		if r.Intn(2) == 0 {
+			v5 *= -1
+		}
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(v5))
+	case 1:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulatePacketbrokeragent(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulatePacketbrokeragent(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *PacketBrokerNetworkIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NetID != 0 {
+		n += 1 + sovPacketbrokeragent(uint64(m.NetID))
+	}
+	l = len(m.TenantID)
+	if l > 0 {
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
 }
 
-type nsPbaClient struct {
-	cc *grpc.ClientConn
+func (m *PacketBrokerNetwork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != nil {
+		l = m.ID.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if len(m.DevAddrPrefixes) > 0 {
+		for _, s := range m.DevAddrPrefixes {
+			l = len(s)
+			n += 1 + l + sovPacketbrokeragent(uint64(l))
+		}
+	}
+	return n
 }
 
-func NewNsPbaClient(cc *grpc.ClientConn) NsPbaClient {
-	return &nsPbaClient{cc}
+func (m *PacketBrokerNetworks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Networks) > 0 {
+		for _, e := range m.Networks {
+			l = e.Size()
+			n += 1 + l + sovPacketbrokeragent(uint64(l))
+		}
+	}
+	return n
 }
 
-func (c *nsPbaClient) PublishDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsPba/PublishDownlink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketBrokerRoutingPolicyUplink) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return out, nil
+	var l int
+	_ = l
+	if m.JoinRequest {
+		n += 2
+	}
+	if m.MacData {
+		n += 2
+	}
+	if m.ApplicationData {
+		n += 2
+	}
+	if m.SignalQuality {
+		n += 2
+	}
+	if m.Localization {
+		n += 2
+	}
+	return n
 }
 
-// NsPbaServer is the server API for NsPba service.
-type NsPbaServer interface {
-	// PublishDownlink instructs the Packet Broker Agent to publish a downlink message to Packet Broker Router.
-	PublishDownlink(context.Context, *DownlinkMessage) (*types.Empty, error)
+func (m *PacketBrokerRoutingPolicyDownlink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinAccept {
+		n += 2
+	}
+	if m.MacData {
+		n += 2
+	}
+	if m.ApplicationData {
+		n += 2
+	}
+	return n
 }
 
-// UnimplementedNsPbaServer can be embedded to have forward compatible implementations.
-type UnimplementedNsPbaServer struct {
+func (m *PacketBrokerDefaultRoutingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
 }
 
-func (*UnimplementedNsPbaServer) PublishDownlink(ctx context.Context, req *DownlinkMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishDownlink not implemented")
+func (m *PacketBrokerRoutingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForwarderID != nil {
+		l = m.ForwarderID.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.HomeNetworkID != nil {
+		l = m.HomeNetworkID.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAt)
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
 }
 
-func RegisterNsPbaServer(s *grpc.Server, srv NsPbaServer) {
-	s.RegisterService(&_NsPba_serviceDesc, srv)
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
 }
 
-func _NsPba_PublishDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PacketBrokerRoutingPolicies) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if interceptor == nil {
-		return srv.(NsPbaServer).PublishDownlink(ctx, in)
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovPacketbrokeragent(uint64(l))
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsPba/PublishDownlink",
+	return n
+}
+
+func (m *SetPacketBrokerRoutingPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsPbaServer).PublishDownlink(ctx, req.(*DownlinkMessage))
+	var l int
+	_ = l
+	if m.HomeNetworkID != nil {
+		l = m.HomeNetworkID.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
 	}
-	return interceptor(ctx, in, info, handler)
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovPacketbrokeragent(uint64(l))
+	}
+	return n
 }
 
-var _NsPba_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsPba",
-	HandlerType: (*NsPbaServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PublishDownlink",
-			Handler:    _NsPba_PublishDownlink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/packetbrokeragent.proto",
+func sovPacketbrokeragent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacketbrokeragent(x uint64) (n int) {
+	return sovPacketbrokeragent((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *PacketBrokerNetworkIdentifier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerNetworkIdentifier{`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerNetwork) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerNetwork{`,
+		`ID:` + strings.Replace(this.ID.String(), "PacketBrokerNetworkIdentifier", "PacketBrokerNetworkIdentifier", 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DevAddrPrefixes:` + fmt.Sprintf("%v", this.DevAddrPrefixes) + `,`,
+		`}`,
+	}, "")
+	return s
 }
+func (this *PacketBrokerNetworks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNetworks := "[]*PacketBrokerNetwork{"
+	for _, f := range this.Networks {
+		repeatedStringForNetworks += strings.Replace(f.String(), "PacketBrokerNetwork", "PacketBrokerNetwork", 1) + ","
+	}
+	repeatedStringForNetworks += "}"
+	s := strings.Join([]string{`&PacketBrokerNetworks{`,
+		`Networks:` + repeatedStringForNetworks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerRoutingPolicyUplink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerRoutingPolicyUplink{`,
+		`JoinRequest:` + fmt.Sprintf("%v", this.JoinRequest) + `,`,
+		`MacData:` + fmt.Sprintf("%v", this.MacData) + `,`,
+		`ApplicationData:` + fmt.Sprintf("%v", this.ApplicationData) + `,`,
+		`SignalQuality:` + fmt.Sprintf("%v", this.SignalQuality) + `,`,
+		`Localization:` + fmt.Sprintf("%v", this.Localization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerRoutingPolicyDownlink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerRoutingPolicyDownlink{`,
+		`JoinAccept:` + fmt.Sprintf("%v", this.JoinAccept) + `,`,
+		`MacData:` + fmt.Sprintf("%v", this.MacData) + `,`,
+		`ApplicationData:` + fmt.Sprintf("%v", this.ApplicationData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerDefaultRoutingPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerDefaultRoutingPolicy{`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Uplink:` + strings.Replace(this.Uplink.String(), "PacketBrokerRoutingPolicyUplink", "PacketBrokerRoutingPolicyUplink", 1) + `,`,
+		`Downlink:` + strings.Replace(this.Downlink.String(), "PacketBrokerRoutingPolicyDownlink", "PacketBrokerRoutingPolicyDownlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerRoutingPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PacketBrokerRoutingPolicy{`,
+		`ForwarderID:` + strings.Replace(this.ForwarderID.String(), "PacketBrokerNetworkIdentifier", "PacketBrokerNetworkIdentifier", 1) + `,`,
+		`HomeNetworkID:` + strings.Replace(this.HomeNetworkID.String(), "PacketBrokerNetworkIdentifier", "PacketBrokerNetworkIdentifier", 1) + `,`,
+		`UpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Uplink:` + strings.Replace(this.Uplink.String(), "PacketBrokerRoutingPolicyUplink", "PacketBrokerRoutingPolicyUplink", 1) + `,`,
+		`Downlink:` + strings.Replace(this.Downlink.String(), "PacketBrokerRoutingPolicyDownlink", "PacketBrokerRoutingPolicyDownlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPacketBrokerDefaultRoutingPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPacketBrokerDefaultRoutingPolicyRequest{`,
+		`Uplink:` + strings.Replace(this.Uplink.String(), "PacketBrokerRoutingPolicyUplink", "PacketBrokerRoutingPolicyUplink", 1) + `,`,
+		`Downlink:` + strings.Replace(this.Downlink.String(), "PacketBrokerRoutingPolicyDownlink", "PacketBrokerRoutingPolicyDownlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PacketBrokerRoutingPolicies) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPolicies := "[]*PacketBrokerRoutingPolicy{"
+	for _, f := range this.Policies {
+		repeatedStringForPolicies += strings.Replace(f.String(), "PacketBrokerRoutingPolicy", "PacketBrokerRoutingPolicy", 1) + ","
+	}
+	repeatedStringForPolicies += "}"
+	s := strings.Join([]string{`&PacketBrokerRoutingPolicies{`,
+		`Policies:` + repeatedStringForPolicies + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPacketBrokerRoutingPolicyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPacketBrokerRoutingPolicyRequest{`,
+		`HomeNetworkID:` + strings.Replace(this.HomeNetworkID.String(), "PacketBrokerNetworkIdentifier", "PacketBrokerNetworkIdentifier", 1) + `,`,
+		`Uplink:` + strings.Replace(this.Uplink.String(), "PacketBrokerRoutingPolicyUplink", "PacketBrokerRoutingPolicyUplink", 1) + `,`,
+		`Downlink:` + strings.Replace(this.Downlink.String(), "PacketBrokerRoutingPolicyDownlink", "PacketBrokerRoutingPolicyDownlink", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPacketbrokeragent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PacketBrokerNetworkIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerNetworkIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerNetworkIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+			}
+			m.NetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerNetwork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerNetwork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerNetwork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ID == nil {
+				m.ID = &PacketBrokerNetworkIdentifier{}
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddrPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevAddrPrefixes = append(m.DevAddrPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerNetworks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerNetworks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerNetworks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Networks = append(m.Networks, &PacketBrokerNetwork{})
+			if err := m.Networks[len(m.Networks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerRoutingPolicyUplink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicyUplink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicyUplink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinRequest = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MacData = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplicationData = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalQuality", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignalQuality = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Localization", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Localization = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerRoutingPolicyDownlink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicyDownlink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicyDownlink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JoinAccept = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MacData = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplicationData = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerDefaultRoutingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerDefaultRoutingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerDefaultRoutingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &PacketBrokerRoutingPolicyUplink{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &PacketBrokerRoutingPolicyDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerRoutingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForwarderID == nil {
+				m.ForwarderID = &PacketBrokerNetworkIdentifier{}
+			}
+			if err := m.ForwarderID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeNetworkID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HomeNetworkID == nil {
+				m.HomeNetworkID = &PacketBrokerNetworkIdentifier{}
+			}
+			if err := m.HomeNetworkID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &PacketBrokerRoutingPolicyUplink{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &PacketBrokerRoutingPolicyDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPacketBrokerDefaultRoutingPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPacketBrokerDefaultRoutingPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPacketBrokerDefaultRoutingPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &PacketBrokerRoutingPolicyUplink{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &PacketBrokerRoutingPolicyDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketBrokerRoutingPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketBrokerRoutingPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, &PacketBrokerRoutingPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPacketBrokerRoutingPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPacketBrokerRoutingPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPacketBrokerRoutingPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeNetworkID", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HomeNetworkID == nil {
+				m.HomeNetworkID = &PacketBrokerNetworkIdentifier{}
+			}
+			if err := m.HomeNetworkID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &PacketBrokerRoutingPolicyUplink{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &PacketBrokerRoutingPolicyDownlink{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacketbrokeragent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacketbrokeragent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacketbrokeragent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacketbrokeragent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacketbrokeragent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacketbrokeragent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacketbrokeragent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacketbrokeragent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacketbrokeragent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacketbrokeragent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacketbrokeragent = fmt.Errorf("proto: unexpected end of group")
+)
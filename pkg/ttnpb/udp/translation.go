@@ -195,6 +195,12 @@ func convertUplink(rx RxPacket, md UpstreamMetadata) (ttnpb.UplinkMessage, error
 		}
 		up.Settings.Time = &goTime
 	}
+	if rx.Tmms != nil {
+		gpsTime := gpstime.Parse(time.Duration(*rx.Tmms) * time.Millisecond)
+		for _, md := range up.RxMetadata {
+			md.GPSTime = &gpsTime
+		}
+	}
 
 	up.Settings.DataRate = rx.DatR.DataRate
 	if lora := up.Settings.DataRate.GetLoRa(); lora != nil {
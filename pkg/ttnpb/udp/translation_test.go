@@ -23,6 +23,7 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/kr/pretty"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -35,6 +36,8 @@ var ids = ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
 
 func timePtr(t time.Time) *time.Time { return &t }
 
+func uint64Ptr(v uint64) *uint64 { return &v }
+
 func TestStatusRaw(t *testing.T) {
 	a := assertions.New(t)
 
@@ -104,6 +107,7 @@ func TestToGatewayUp(t *testing.T) {
 					Data: "QCkuASaAAAAByFaF53Iu+vzmwQ==",
 					Size: 19,
 					Tmst: 1000,
+					Tmms: uint64Ptr(1234567890000),
 				},
 			},
 		},
@@ -122,6 +126,7 @@ func TestToGatewayUp(t *testing.T) {
 	a.So(msg.Settings.Frequency, should.Equal, 868000000)
 	a.So(msg.Settings.Timestamp, should.Equal, 1000)
 	a.So(msg.RxMetadata[0].Timestamp, should.Equal, 1000)
+	a.So(*msg.RxMetadata[0].GPSTime, should.Equal, gpstime.Parse(1234567890*time.Second))
 	a.So(msg.RawPayload, should.Resemble, []byte{0x40, 0x29, 0x2e, 0x01, 0x26, 0x80, 0x00, 0x00, 0x01, 0xc8, 0x56, 0x85, 0xe7, 0x72, 0x2e, 0xfa, 0xfc, 0xe6, 0xc1})
 }
 